@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	goflags "flag"
 	"fmt"
 	"os"
@@ -9,7 +10,9 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/klog/v2"
 
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
 	"github.com/faroshq/faros-hub/pkg/cliplugins/faros/cmd"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
 )
 
 func main() {
@@ -28,6 +31,11 @@ func main() {
 	farosCmd.PersistentFlags().AddGoFlagSet(fs)
 
 	if err := farosCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", apistatus.Describe(err))
+		var exitErr *base.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		os.Exit(1)
 	}
 }
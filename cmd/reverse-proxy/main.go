@@ -17,11 +17,11 @@ var (
 	keyFile       = flag.String("keyFile", "dev/proxy.key", "file containing server key")
 	serverAddress = flag.String("serverAddress", "0.0.0.0:8443", "Server address")
 
-	clientCertFile      = flag.String("clientCertFile", "dev/proxy-client.crt", "file containing client certificate")
+	clientCertFile      = flag.String("clientCertFile", "dev/proxy-client.crt", "file containing client certificate, trusted by the server to authenticate clients")
 	clientCertKeyFile   = flag.String("clientCertKeyFile", "dev/proxy-client.key", "file containing client key")
 	clientUpstreamURL   = flag.String("clientUpstreamUrl", "https://localhost:8443", "Server external address")
 	clientDownstreamURL = flag.String("clientDownstreamUrl", "http://localhost:8080", "Client forward address")
-	clientID            = flag.String("clientID", "faros-dev", "Client ID")
+	clientID            = flag.String("clientID", "proxy-client", "Client ID, must match the common name of the client certificate")
 )
 
 func main() {
@@ -53,7 +53,7 @@ func run(ctx context.Context) error {
 }
 
 func runServer(ctx context.Context) error {
-	server, err := devproxyserver.New(*serverAddress, *certFile, *keyFile)
+	server, err := devproxyserver.New(*serverAddress, *certFile, *keyFile, *clientCertFile, *clientID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,75 @@
+package v1alpha1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// registrationServiceAccountPrefix prefixes the service accounts of
+// registrations, whose tokens agents use
+const registrationServiceAccountPrefix = "registration-"
+
+// RegistrationServiceAccountName returns the name of the service account of
+// registration
+func RegistrationServiceAccountName(registration string) string {
+	return registrationServiceAccountPrefix + registration
+}
+
+// RegistrationOfToken returns the name of the Registration whose service
+// account token is token. The token is not verified, so it must be compared
+// with the token of the Registration before it is trusted.
+func RegistrationOfToken(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("token is not a service account token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("token is not a service account token: %w", err)
+	}
+
+	// legacy tokens of secrets, and bound tokens
+	var claims struct {
+		ServiceAccountName string `json:"kubernetes.io/serviceaccount/service-account.name"`
+		Kubernetes         struct {
+			ServiceAccount struct {
+				Name string `json:"name"`
+			} `json:"serviceaccount"`
+		} `json:"kubernetes.io"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("token is not a service account token: %w", err)
+	}
+	name := claims.ServiceAccountName
+	if name == "" {
+		name = claims.Kubernetes.ServiceAccount.Name
+	}
+	if !strings.HasPrefix(name, registrationServiceAccountPrefix) || name == registrationServiceAccountPrefix {
+		return "", fmt.Errorf("token is not a token of a registration")
+	}
+	return strings.TrimPrefix(name, registrationServiceAccountPrefix), nil
+}
+
+// AgentTunnelID returns the id an agent uses for its reverse tunnel to the hub
+func AgentTunnelID(clusterName, namespace, name string) string {
+	return clusterName + ":" + namespace + ":" + name
+}
+
+// ParseAgentTunnelID splits the tunnel id of an agent into the logical
+// cluster, namespace and name of the Agent. Logical cluster names contain
+// colons, so the id is parsed from the right.
+func ParseAgentTunnelID(id string) (clusterName, namespace, name string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) < 3 {
+		return "", "", "", fmt.Errorf("invalid agent tunnel id %q", id)
+	}
+	name = parts[len(parts)-1]
+	namespace = parts[len(parts)-2]
+	clusterName = strings.Join(parts[:len(parts)-2], ":")
+	if clusterName == "" || namespace == "" || name == "" {
+		return "", "", "", fmt.Errorf("invalid agent tunnel id %q", id)
+	}
+	return clusterName, namespace, name, nil
+}
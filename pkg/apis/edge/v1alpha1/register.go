@@ -13,6 +13,9 @@ var GroupName = "edge.faros.sh"
 // RegistrationKind is the kind for a Registration
 const RegistrationKind = "Registration"
 
+// AgentKind is the kind for an Agent
+const AgentKind = "Agent"
+
 // SchemeGroupVersion is group version used to register these objects
 var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
 
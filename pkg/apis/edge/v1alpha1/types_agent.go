@@ -42,8 +42,33 @@ type AgentStatus struct {
 	// Current processing state of the Agent.
 	// +optional
 	Conditions conditionsv1alpha1.Conditions `json:"conditions,omitempty"`
+	// Version of the agent
+	// +optional
+	Version string `json:"version,omitempty"`
+	// LastHeartbeatTime is the last time the agent reported it is alive
+	// +optional
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
 }
 
+const (
+	// AgentTunnelConnected means the agent holds a reverse tunnel to the hub,
+	// through which the hub reaches its local services.
+	AgentTunnelConnected conditionsv1alpha1.ConditionType = "TunnelConnected"
+
+	// AgentTunnelDisconnectedReason means the tunnel was lost and the agent is
+	// reconnecting.
+	AgentTunnelDisconnectedReason = "TunnelDisconnected"
+	// AgentTunnelDisabledReason means no tunnel endpoint is configured.
+	AgentTunnelDisabledReason = "TunnelDisabled"
+)
+
+const (
+	// AgentRegistrationLabel is set by agents, when they register, to the
+	// name of the Registration whose token they use. Agents can't update
+	// their labels, so only that token can open tunnels for them.
+	AgentRegistrationLabel = "edge.faros.sh/registration"
+)
+
 func (in *Agent) SetConditions(c conditionsv1alpha1.Conditions) {
 	in.Status.Conditions = c
 }
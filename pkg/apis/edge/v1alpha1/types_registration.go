@@ -39,6 +39,16 @@ type RegistrationStatus struct {
 	CA string `json:"ca,omitempty"`
 }
 
+const (
+	// RegistrationRotateTokenAnnotation requests a new token for the
+	// registration. The old token is revoked, agents using it lose access.
+	RegistrationRotateTokenAnnotation = "edge.faros.sh/rotate-token"
+
+	// RegistrationTokenRotatingReason means the old token was revoked and a new
+	// one is being issued.
+	RegistrationTokenRotatingReason = "TokenRotating"
+)
+
 func (in *Registration) SetConditions(c conditionsv1alpha1.Conditions) {
 	in.Status.Conditions = c
 }
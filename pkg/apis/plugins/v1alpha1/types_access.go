@@ -23,7 +23,54 @@ type Access struct {
 }
 
 // AccessSpec defines the desired state of plugin
-type AccessSpec struct{}
+type AccessSpec struct {
+	// Agent is the name of the agent, in the namespace of the plugin, access is provided to
+	Agent string `json:"agent,omitempty"`
+	// PortForwards are the targets reachable from the agent users can forward local ports to
+	// +optional
+	PortForwards []PortForwardSpec `json:"portForwards,omitempty"`
+	// Shell configures remote shell sessions to the agent. Remote shell is
+	// disabled unless enabled for the workspace and by an Access of the agent
+	// in the workspace.
+	// +optional
+	Shell *ShellSpec `json:"shell,omitempty"`
+}
+
+// PortForwardSpec defines a TCP target reachable from the agent
+type PortForwardSpec struct {
+	// Name of the port forward
+	Name string `json:"name,omitempty"`
+	// Host is the host the agent dials, i.e. localhost
+	Host string `json:"host"`
+	// Port is the port the agent dials
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+	// AllowedUsers are the emails of the workspace members allowed to forward
+	// to the target. All workspace members are allowed if empty.
+	// +optional
+	AllowedUsers []string `json:"allowedUsers,omitempty"`
+}
+
+// ShellSpec defines remote shell sessions to the agent
+type ShellSpec struct {
+	// Enabled allows remote shell sessions to the agent
+	Enabled bool `json:"enabled,omitempty"`
+	// Command is started for interactive sessions without a command.
+	// Defaults to /bin/sh.
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// AllowedUsers are the emails of the workspace members allowed to start
+	// sessions. All workspace members are allowed if empty.
+	// +optional
+	AllowedUsers []string `json:"allowedUsers,omitempty"`
+	// SessionTimeout is the maximum duration of a session. Defaults to 1h.
+	// +optional
+	SessionTimeout *metav1.Duration `json:"sessionTimeout,omitempty"`
+	// IdleTimeout closes sessions without input for the duration. Defaults to 15m.
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+}
 
 // AccessStatus defines the observed state of plugin
 type AccessStatus struct {
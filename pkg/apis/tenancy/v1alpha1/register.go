@@ -16,6 +16,24 @@ const WorkspaceKind = "Workspace"
 // UserKind is the kind for a User
 const UserKind = "User"
 
+// WorkspaceTemplateKind is the kind for a WorkspaceTemplate
+const WorkspaceTemplateKind = "WorkspaceTemplate"
+
+// UserQuotaKind is the kind for a UserQuota
+const UserQuotaKind = "UserQuota"
+
+// WorkspaceImportKind is the kind for a WorkspaceImport
+const WorkspaceImportKind = "WorkspaceImport"
+
+// OrganizationKind is the kind for an Organization
+const OrganizationKind = "Organization"
+
+// TeamKind is the kind for a Team
+const TeamKind = "Team"
+
+// ReconcileErrorKind is the kind for a ReconcileError
+const ReconcileErrorKind = "ReconcileError"
+
 // SchemeGroupVersion is group version used to register these objects
 var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
 
@@ -41,6 +59,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&WorkspaceList{},
 		&User{},
 		&UserList{},
+		&WorkspaceTemplate{},
+		&WorkspaceTemplateList{},
+		&UserQuota{},
+		&WorkspaceImport{},
+		&Organization{},
+		&OrganizationList{},
+		&Team{},
+		&TeamList{},
+		&ReconcileError{},
+		&ReconcileErrorList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,41 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReconcileRequestedAnnotation is set by hub administrators to the time they
+// forced a reconcile of an object, which updating the object triggers
+const ReconcileRequestedAnnotation = "faros.sh/reconcile-requested-at"
+
+// AgentWorkspaceAnnotation is set on agents served to hub administrators to
+// the <namespace>/<name> of the workspace they are in
+const AgentWorkspaceAnnotation = "tenancy.faros.sh/workspace"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReconcileError is an object controllers failed to reconcile, named and
+// namespaced as the object. It is served by the hub api to hub administrators
+// and not stored.
+type ReconcileError struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Resource is the resource of the object, i.e. workspaces
+	Resource string `json:"resource"`
+	// Reason is the reason of the failed Ready condition of the object
+	Reason string `json:"reason,omitempty"`
+	// Message is the error of the last reconcile of the object
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is when the object became not ready
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// ReconcileErrorList contains a list of ReconcileError
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ReconcileErrorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReconcileError `json:"items"`
+}
@@ -0,0 +1,130 @@
+package v1alpha1
+
+import (
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrganizationNamespacePrefix prefixes the namespace of organizations in the
+// tenants workspace, so they never clash with namespaces of users. Workspaces
+// and teams of an organization live in its namespace, and the kcp workspaces
+// of its workspaces under <tenants workspace prefix>:org-<organization>.
+const OrganizationNamespacePrefix = "org-"
+
+// +crd
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=organizations,scope=Cluster
+// +kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.displayName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:object:root=true
+
+// Organization owns workspaces shared by its teams, instead of a single user
+type Organization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSpec   `json:"spec,omitempty"`
+	Status OrganizationStatus `json:"status,omitempty"`
+}
+
+// OrganizationSpec defines the desired state of organization
+type OrganizationSpec struct {
+	// DisplayName is the organization's display name
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+	// Owners is a list of user emails who manage the teams and workspaces
+	// of the organization. They are admins of all its workspaces.
+	Owners []string `json:"owners,omitempty"`
+}
+
+// OrganizationStatus defines the observed state of Organization
+type OrganizationStatus struct {
+	// Current processing state of the Organization.
+	// +optional
+	Conditions conditionsv1alpha1.Conditions `json:"conditions,omitempty"`
+
+	// Namespace is the namespace of the workspaces and teams of the
+	// organization in the tenants workspace
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+func (in *Organization) SetConditions(c conditionsv1alpha1.Conditions) {
+	in.Status.Conditions = c
+}
+
+func (in *Organization) GetConditions() conditionsv1alpha1.Conditions {
+	return in.Status.Conditions
+}
+
+// OrganizationNamespace returns the namespace of the organization named name
+func OrganizationNamespace(name string) string {
+	return OrganizationNamespacePrefix + name
+}
+
+// OrganizationList contains a list of Organization
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Organization `json:"items"`
+}
+
+// TeamRole is the role members of a team have in workspaces of their
+// organization
+type TeamRole string
+
+const (
+	// TeamRoleAdmin makes members admins of workspaces, like their members.
+	TeamRoleAdmin TeamRole = "Admin"
+	// TeamRoleEdit lets members manage faros objects in workspaces.
+	TeamRoleEdit TeamRole = "Edit"
+	// TeamRoleView lets members read faros objects in workspaces.
+	TeamRoleView TeamRole = "View"
+)
+
+// +crd
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Role",type="string",JSONPath=".spec.role"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:object:root=true
+
+// Team grants its members a role in workspaces of the organization whose
+// namespace it is in
+type Team struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TeamSpec `json:"spec,omitempty"`
+}
+
+// TeamSpec defines the desired state of team
+type TeamSpec struct {
+	// Description is a user readable description of the team
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Members is a list of user emails who are members of the team
+	Members []string `json:"members,omitempty"`
+	// Role is the role of members in the workspaces of the team
+	// +kubebuilder:validation:Enum=Admin;Edit;View
+	Role TeamRole `json:"role"`
+	// Workspaces are the names of the workspaces of the organization the
+	// role is granted in, all of them if empty
+	// +optional
+	Workspaces []string `json:"workspaces,omitempty"`
+}
+
+// TeamList contains a list of Team
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+type TeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Team `json:"items"`
+}
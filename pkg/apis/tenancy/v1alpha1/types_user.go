@@ -11,6 +11,8 @@ import (
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=users,scope=Cluster
+// +kubebuilder:printcolumn:name="Email",type="string",JSONPath=".spec.email"
+// +kubebuilder:printcolumn:name="Disabled",type="boolean",JSONPath=".spec.disabled"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:object:root=true
 
@@ -31,6 +33,38 @@ type UserSpec struct {
 	DisplayName string `json:"displayName,omitempty"`
 	// Provider is the identity provider of the user
 	Provider string `json:"provider,omitempty"`
+	// Groups are the groups of the user in the identity provider, as of
+	// their last login
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+	// Limits overrides the hub default limits of the user
+	// +optional
+	Limits *UserLimits `json:"limits,omitempty"`
+	// Disabled blocks login of the user and removes them from the members
+	// of all workspaces, without deleting anything
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+	// TransferTo is the email of the user the workspaces of the user are
+	// transferred to when the user is deleted. Workspaces are transferred to
+	// their first other active member if empty, and deleted if there is none.
+	// +optional
+	TransferTo string `json:"transferTo,omitempty"`
+}
+
+// UserLimits limits objects a user can create. Unset limits default to the
+// hub defaults, 0 is unlimited.
+type UserLimits struct {
+	// Workspaces is the maximum number of workspaces the user can create
+	// +optional
+	Workspaces *int32 `json:"workspaces,omitempty"`
+	// AgentsPerWorkspace is the maximum number of agents in each workspace
+	// of the user
+	// +optional
+	AgentsPerWorkspace *int32 `json:"agentsPerWorkspace,omitempty"`
+	// RequestsPerWorkspace is the maximum number of concurrent access
+	// requests in each workspace of the user
+	// +optional
+	RequestsPerWorkspace *int32 `json:"requestsPerWorkspace,omitempty"`
 }
 
 // UserStatus defines the observed state of User
@@ -56,3 +90,30 @@ type UserList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []User `json:"items"`
 }
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UserQuota is the usage of a user against their limits. It is served by the
+// hub api and not stored.
+type UserQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Limits are the effective limits of the user, with hub defaults applied
+	Limits UserLimits `json:"limits"`
+	// Workspaces is the number of workspaces of the user
+	Workspaces int32 `json:"workspaces"`
+	// WorkspaceUsage is the usage of each workspace of the user
+	// +optional
+	WorkspaceUsage []WorkspaceUsage `json:"workspaceUsage,omitempty"`
+}
+
+// WorkspaceUsage is the usage of a workspace against per workspace limits
+type WorkspaceUsage struct {
+	// Name of the workspace
+	Name string `json:"name"`
+	// Agents is the number of agents in the workspace
+	Agents int32 `json:"agents"`
+	// Requests is the number of access requests in the workspace
+	Requests int32 `json:"requests"`
+}
@@ -10,6 +10,7 @@ import (
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:object:root=true
 
@@ -28,6 +29,20 @@ type WorkspaceSpec struct {
 	Description string `json:"description,omitempty"`
 	// Members is a list of user emails who are members of this workspace
 	Members []string `json:"members,omitempty"`
+	// Template is the name of the WorkspaceTemplate the workspace is
+	// provisioned with
+	// +optional
+	Template string `json:"template,omitempty"`
+	// Shell configures remote shell sessions to the agents of the workspace
+	// +optional
+	Shell *WorkspaceShellSpec `json:"shell,omitempty"`
+}
+
+// WorkspaceShellSpec defines remote shell sessions to the agents of a workspace
+type WorkspaceShellSpec struct {
+	// Enabled allows remote shell sessions to the agents of the workspace,
+	// which an Access of the agent must enable as well
+	Enabled bool `json:"enabled,omitempty"`
 }
 
 // WorkspaceStatus defines the observed state of Workspace
@@ -38,8 +53,99 @@ type WorkspaceStatus struct {
 
 	// WorkspaceURL is the URL of the workspace
 	WorkspaceURL string `json:"workspaceURL,omitempty"`
+
+	// TemplateGeneration is the generation of the template last applied to
+	// the workspace
+	// +optional
+	TemplateGeneration int64 `json:"templateGeneration,omitempty"`
+
+	// Phase is the lifecycle phase of the workspace
+	// +optional
+	Phase WorkspacePhase `json:"phase,omitempty"`
+
+	// DeletionDeadline is when a terminating workspace is deleted. It can be
+	// restored until then.
+	// +optional
+	DeletionDeadline *metav1.Time `json:"deletionDeadline,omitempty"`
+
+	// Archive is the name of the secret in the namespace of the workspace
+	// holding the archive of its objects, taken when it started terminating
+	// +optional
+	Archive string `json:"archive,omitempty"`
+
+	// Path is the kcp workspace of workspaces transferred from another owner,
+	// whose content stays where it was created. Only set by the users
+	// controller, through the status of the workspace users can't write.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// TransferredTo is the <namespace>/<name> of the workspace a workspace
+	// of a deleted user was transferred to. Deleting it leaves the kcp
+	// workspace and its RBAC to the new one.
+	// +optional
+	TransferredTo string `json:"transferredTo,omitempty"`
 }
 
+// WorkspacePhase is the lifecycle phase of a workspace
+type WorkspacePhase string
+
+const (
+	// WorkspacePhaseReady means the workspace is provisioned and can be used.
+	WorkspacePhaseReady WorkspacePhase = "Ready"
+	// WorkspacePhaseTerminating means the workspace was deleted by a user and
+	// is deleted once its grace period passed, unless it is restored.
+	WorkspacePhaseTerminating WorkspacePhase = "Terminating"
+
+	// WorkspaceDeletionDeadlineAnnotation is set by the hub api on workspaces
+	// deleted by users, to the time the grace period ends in RFC3339.
+	// Workspaces are restored by removing it before.
+	WorkspaceDeletionDeadlineAnnotation = "tenancy.faros.sh/deletion-deadline"
+
+	// WorkspaceTransferPendingAnnotation is set by the users controller on
+	// workspaces it transfers until their Path is set, so they are not
+	// provisioned in a new kcp workspace before.
+	WorkspaceTransferPendingAnnotation = "tenancy.faros.sh/transfer-pending"
+
+	// WorkspaceAnnotationPrefix is the prefix of annotations managed by the
+	// hub, which users can't set
+	WorkspaceAnnotationPrefix = "tenancy.faros.sh/"
+)
+
+const (
+	// WorkspaceQuotaExceededReason means the workspace is not provisioned,
+	// because its owner has more workspaces than their limit.
+	WorkspaceQuotaExceededReason = "QuotaExceeded"
+
+	// WorkspaceArchived means the objects of a terminating workspace were
+	// archived.
+	WorkspaceArchived conditionsv1alpha1.ConditionType = "Archived"
+	// WorkspaceMembersNotified means members of a terminating workspace were
+	// notified it will be deleted.
+	WorkspaceMembersNotified conditionsv1alpha1.ConditionType = "MembersNotified"
+
+	// WorkspaceArchiveFailedReason means archiving the workspace failed, it
+	// is not deleted until it succeeds.
+	WorkspaceArchiveFailedReason = "ArchiveFailed"
+	// WorkspaceNotificationFailedReason means notifying members failed.
+	WorkspaceNotificationFailedReason = "NotificationFailed"
+
+	// WorkspaceTemplateApplied means the content of the template of the
+	// workspace was applied to it.
+	WorkspaceTemplateApplied conditionsv1alpha1.ConditionType = "TemplateApplied"
+	// WorkspaceTemplateInSync means the content of the workspace still
+	// matches its template. Drift is reported, not reverted.
+	WorkspaceTemplateInSync conditionsv1alpha1.ConditionType = "TemplateInSync"
+
+	// WorkspaceTemplateNotFoundReason means the template of the workspace does
+	// not exist.
+	WorkspaceTemplateNotFoundReason = "TemplateNotFound"
+	// WorkspaceTemplateFailedReason means applying the template failed.
+	WorkspaceTemplateFailedReason = "TemplateFailed"
+	// WorkspaceTemplateDriftedReason means objects of the template were
+	// changed or deleted in the workspace.
+	WorkspaceTemplateDriftedReason = "Drifted"
+)
+
 func (in *Workspace) SetConditions(c conditionsv1alpha1.Conditions) {
 	in.Status.Conditions = c
 }
@@ -56,3 +162,38 @@ type WorkspaceList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Workspace `json:"items"`
 }
+
+// ImportConflictPolicy is how objects of an import which already exist in the
+// workspace are handled
+type ImportConflictPolicy string
+
+const (
+	// ImportConflictFail fails the import before anything is created if any
+	// object exists already.
+	ImportConflictFail ImportConflictPolicy = "Fail"
+	// ImportConflictSkip keeps existing objects as they are.
+	ImportConflictSkip ImportConflictPolicy = "Skip"
+	// ImportConflictOverwrite replaces existing objects with the imported ones.
+	ImportConflictOverwrite ImportConflictPolicy = "Overwrite"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkspaceImport is the result of importing a workspace export. It is served
+// by the hub api and not stored.
+type WorkspaceImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// ConflictPolicy is how existing objects were handled
+	ConflictPolicy ImportConflictPolicy `json:"conflictPolicy"`
+	// Created are the objects created, as <resource>.<group>/<namespace>/<name>
+	// +optional
+	Created []string `json:"created,omitempty"`
+	// Updated are the existing objects overwritten
+	// +optional
+	Updated []string `json:"updated,omitempty"`
+	// Skipped are the existing objects kept
+	// +optional
+	Skipped []string `json:"skipped,omitempty"`
+}
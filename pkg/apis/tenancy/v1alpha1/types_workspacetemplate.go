@@ -0,0 +1,62 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +crd
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Description",type="string",JSONPath=".spec.description"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:object:root=true
+
+// WorkspaceTemplate is the Schema for the WorkspaceTemplate API. Workspaces
+// referencing a template are pre-provisioned with its content.
+type WorkspaceTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec WorkspaceTemplateSpec `json:"spec,omitempty"`
+}
+
+// WorkspaceTemplateSpec defines the content of workspaces created from the
+// template
+type WorkspaceTemplateSpec struct {
+	// Description is a user readable description of the template
+	Description string `json:"description,omitempty"`
+	// APIBindings are APIs bound in workspaces, in addition to the faros APIs
+	// bound by default
+	// +optional
+	APIBindings []WorkspaceTemplateAPIBinding `json:"apiBindings,omitempty"`
+	// Namespaces created in workspaces
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Manifests are objects created in workspaces, i.e. plugins or quotas.
+	// Namespaced objects without namespace are created in the default
+	// namespace.
+	// +optional
+	Manifests []runtime.RawExtension `json:"manifests,omitempty"`
+}
+
+// WorkspaceTemplateAPIBinding is an APIExport bound in workspaces
+type WorkspaceTemplateAPIBinding struct {
+	// ExportName is the name of the APIExport, i.e. edge.faros.sh
+	ExportName string `json:"exportName"`
+	// Path of the workspace of the APIExport, defaults to the workspace of
+	// faros controllers
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// WorkspaceTemplateList contains a list of WorkspaceTemplate
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+type WorkspaceTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkspaceTemplate `json:"items"`
+}
@@ -1,6 +1,6 @@
 // Code generated by go-bindata. (@generated) DO NOT EDIT.
 
-//Package bootstrap generated by go-bindata.// sources:
+// Package bootstrap generated by go-bindata.// sources:
 // ../../config/crds/access.faros.sh_requests.yaml
 // ../../config/crds/bases/_.yaml
 // ../../config/crds/bases/access.faros.sh_requests.yaml
@@ -21,8 +21,11 @@
 // ../../config/crds/plugins.faros.sh_monitorings.yaml
 // ../../config/crds/plugins.faros.sh_networks.yaml
 // ../../config/crds/plugins.faros.sh_notifications.yaml
+// ../../config/crds/tenancy.faros.sh_organizations.yaml
+// ../../config/crds/tenancy.faros.sh_teams.yaml
 // ../../config/crds/tenancy.faros.sh_users.yaml
 // ../../config/crds/tenancy.faros.sh_workspaces.yaml
+// ../../config/crds/tenancy.faros.sh_workspacetemplates.yaml
 // ../../config/kcp/apiexport-access.yaml
 // ../../config/kcp/apiexport-edge.yaml
 // ../../config/kcp/apiexport-plugins.yaml
@@ -43,8 +46,11 @@
 // ../../config/samples/v1alpha1_agent.yaml
 // ../../config/samples/v1alpha1_registration.yaml
 // ../../config/samples/v1alpha1_request.yaml
+// ../../config/samples/v1alpha1_tenancy_organization.yaml
+// ../../config/samples/v1alpha1_tenancy_team.yaml
 // ../../config/samples/v1alpha1_tenancy_user.yaml
 // ../../config/samples/v1alpha1_tenancy_workspace.yaml
+// ../../config/samples/v1alpha1_tenancy_workspacetemplate.yaml
 package bootstrap
 
 import (
@@ -121,7 +127,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _crdsAccessFarosSh_requestsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4f\x6f\x2b\xb9\x0d\xbf\xfb\x53\x10\xe8\xe1\xb5\x40\x3c\x79\x0f\xbd\x14\x06\x7a\x08\xd2\x16\x08\xba\x5b\x04\x49\x76\xef\x1c\x89\xb6\xb5\xd1\x48\x53\x91\xf2\x3e\xb7\xe8\x77\x2f\x28\xcd\x8c\x27\xb6\x27\x2f\xaf\xc5\xea\x14\x4b\x1c\xfe\xf9\x91\xfc\x91\x59\xaf\xd7\x2b\xec\xdd\xcf\x94\xd8\xc5\xb0\x01\xec\x1d\x7d\x15\x0a\xfa\x8b\x9b\xd7\x3f\x71\xe3\xe2\xed\xe1\xcb\xea\xd5\x05\xbb\x81\xfb\xcc\x12\xbb\x27\xe2\x98\x93\xa1\xbf\xd0\xd6\x05\x27\x2e\x86\x55\x47\x82\x16\x05\x37\x2b\x00\x0c\x21\x0a\xea\x35\xeb\x4f\x00\x13\x83\xa4\xe8\x3d\xa5\xf5\x8e\x42\xf3\x9a\x5b\x6a\xb3\xf3\x96\x52\x51\x3e\x9a\x3e\x7c\x6e\xbe\x7c\x6e\x3e\xaf\x00\x4c\xa2\xf2\xfd\x8b\xeb\x88\x05\xbb\x7e\x03\x21\x7b\xbf\x02\x08\xd8\xd1\x06\x12\xfd\x33\x13\x0b\x37\x68\x0c\x31\x37\x5b\x4c\x91\x1b\xde\xaf\xb8\x27\xa3\x36\x77\x29\xe6\x7e\x03\xe7\xcf\xf5\xfb\xd1\x2b\x14\xda\xc5\xe4\xc6\xdf\x6b\x78\x35\x7d\xf9\xab\xc6\xfa\x54\x8d\x94\x1b\xef\x58\xfe\x3e\xbf\xfd\xc1\x0d\x2f\xbd\xcf\x09\xfd\xc9\xa5\x72\xc9\x2e\xec\xb2\xc7\x34\x5d\xaf\x00\xd8\xc4\x9e\x36\xf0\x0f\x75\xa0\x47\x43\x76\x05\x30\x84\x5e\x1c\x58\x03\x5a\x5b\xc0\x44\xff\x98\x5c\x10\x4a\xf7\xd1\xe7\x2e\x4c\xee\xfd\xc2\x31\x3c\xa2\xec\x37\xd0\x8c\x70\x37\x17\x48\x15\xd9\x11\xa7\xbb\x1d\x0d\xbf\xe5\xa8\xc6\x2d\x4a\xbd\xa8\xcf\x87\x2f\xe8\xfb\x3d\x7e\xa9\x3e\x9b\x3d\x75\xb8\x19\xe4\x63\x4f\xe1\xee\xf1\xe1\xe7\x3f\x3e\xbf\xb9\x06\xb0\xc4\x26\xb9\x5e\x4a\xc6\x06\x30\xc0\x31\xc8\x9e\xa0\xca\xc2\x36\xa6\xf2\xf3\xae\xa0\x3f\x09\xdd\x3d\x3e\x4c\x5a\xfa\x14\x7b\x4a\x32\x61\x5f\xcf\xac\x0e\x67\xb7\x67\x36\x3f\xa9\x5b\x55\x0a\xac\x16\x20\x55\xe3\x03\x96\x64\x87\x48\x20\x6e\x41\xf6\x8e\x21\x51\x9f\x88\x29\xd4\x92\x7c\xa3\x18\x54\x08\x03\xc4\xf6\x17\x32\xd2\xc0\x33\x25\x55\x03\xbc\x8f\xd9\x5b\xad\xdb\x03\x25\x81\x44\x26\xee\x82\xfb\xd7\xa4\x9b\x41\x62\x31\xea\x51\xc6\x12\x39\x9d\x92\xbb\x80\x1e\x0e\xe8\x33\xdd\x00\x06\x0b\x1d\x1e\x21\x91\x5a\x81\x1c\x66\xfa\x8a\x08\x37\xf0\x63\x4c\x04\x2e\x6c\xe3\x06\xf6\x22\x3d\x6f\x6e\x6f\x77\x4e\xc6\xfe\x33\xb1\xeb\x72\x70\x72\xbc\x2d\xad\xe4\xda\x2c\x31\xf1\xad\xa5\x03\xf9\x5b\x76\xbb\x35\x26\xb3\x77\x42\x46\x72\xa2\x5b\xec\xdd\xba\xb8\x1e\x4a\x0f\x36\x9d\xfd\x5d\x1a\x3a\x96\x3f\xbd\xf1\xb5\x56\x05\x4b\x72\x61\x37\x7b\x28\xf5\xff\x4e\x06\xb4\x13\x34\xe7\x38\x7c\x5a\xa3\x38\x01\xad\x57\x8a\xce\xd3\x5f\x9f\x5f\x60\x34\x5d\x92\x71\x8e\x7e\xc1\xfd\xf4\x21\x9f\x52\xa0\x80\xb9\xb0\xa5\x54\x93\xb8\x4d\xb1\x2b\x3a\x29\xd8\x3e\xba\x20\xe5\x87\xf1\x8e\xc2\x39\xfc\x9c\xdb\xce\x09\x4f\x1d\x09\x12\x1b\xb8\x2f\xa4\x04\x2d\x41\xee\xb5\x0b\x6c\x03\x0f\x01\xee\xb1\x23\x7f\x8f\x4c\xbf\x79\x02\x14\x69\x5e\x2b\xb0\x1f\x4b\xc1\x9c\x4f\xcf\x85\x2b\x6a\xb3\x87\x91\xf5\x16\xf2\x35\x34\xe0\x73\x4f\xe6\x4d\xc7\x58\x62\x97\xb4\xa6\x05\x85\xb4\x13\xe6\x8c\x37\x9e\xeb\x9d\xaa\xc7\xf8\xcc\x42\x49\x09\xed\xfc\x69\x31\xac\xf2\x24\xfe\x3b\xe4\x97\x42\x16\x94\xcc\x1f\x08\xba\xc8\xbd\x09\x3b\xb6\xac\x35\xf6\x36\xee\xa3\x32\xd4\x85\x95\x77\xc3\x8f\xa1\xd2\xf5\xc5\xcb\x99\x27\xf7\x39\x25\x0a\xa2\xaa\x94\x0f\xb5\x39\x26\xcb\xa5\x4b\xaa\xa7\xcd\x85\x16\x27\xd4\x5d\x51\x7e\xae\x7e\xf4\x63\x0a\xb2\x10\x9a\xc6\x58\x08\xaf\x50\xdc\xd8\x69\x1a\x0c\xd6\x19\x73\x45\x6f\x85\x95\x2e\x3d\x79\x0f\x87\x7a\x3c\xb2\xbc\x24\x0c\xec\xc6\x69\x74\x5d\xee\xcc\xf9\x1f\x90\x05\xc4\x75\x54\xdb\x79\x0a\x45\x26\x55\x64\x6b\xef\xc7\x40\x43\xd2\x17\xf4\x82\x72\x32\x86\x28\x7b\x4a\x0d\xbc\x28\x69\x0c\x34\xde\x12\xfc\xba\xa7\x50\x4c\xe4\x60\x29\xf9\xa3\x26\xe1\x64\xcd\xec\x31\xec\xc8\x5e\x8b\xbb\x9e\x07\xcd\x14\x96\x39\xa7\x2c\xf2\x1a\xe2\xaf\xe1\x46\xf5\x05\xc8\x3c\xb2\x5d\x09\x63\x32\x74\xf7\xf8\x00\x5b\x47\xde\x2e\x2a\x1d\xac\x16\x22\x35\x86\x7a\xc1\xd6\x5f\xc5\x5e\xcf\x36\xa6\x0e\xa5\x4e\xf0\xb5\x5a\x5a\x90\x7b\xa7\xef\xea\xe9\x88\x19\x77\x1f\xcb\xce\x1d\xec\x73\x87\x01\x12\xa1\x55\xe7\xc6\x8f\xc1\x05\xeb\x0c\x16\x9e\xb7\x24\xe8\x3c\x03\xb6\x31\x9f\x33\xf1\xcc\x2d\xc5\x67\xca\xe9\x90\x9e\x02\x4f\x21\xfa\x96\x80\xba\x5e\x8e\x4b\xd1\x7f\x33\xaa\x44\xc8\xe7\x5b\xc3\x42\x50\x2f\x7b\x1a\xc4\xa7\x45\x65\xaa\x84\x4f\x5c\x0a\x79\xe6\xea\x62\x44\xee\xcd\xf4\x50\xa5\xca\xc2\x6e\xeb\x4c\x49\xbd\x46\x65\xf6\x31\x72\x29\x09\xad\x49\x88\xa9\x14\xcf\x95\x31\x78\x3a\x15\x12\xc7\xea\x12\x3b\x4b\x4a\xcf\x08\xbb\x8c\x09\x83\x10\x59\xd5\x7d\x81\x5e\x1d\x6c\x8b\x3a\xff\x3f\x64\x99\x0e\x94\x9c\x1c\x3f\x84\xed\xf3\x20\xac\x74\x71\x70\xb6\x72\x11\x7d\xed\xbd\x33\x4e\xc0\x78\x64\x56\x84\x46\x5e\x5a\x74\xf9\xa9\xe6\xc7\x44\x4b\x37\xc0\x75\xd5\xca\xac\x6b\x41\x4c\xd0\xa1\xd9\x17\x9e\x33\x18\xc0\x75\x1d\x59\x87\x42\xfe\x58\x7b\x9b\x05\xc3\x72\xcf\x95\x6c\x0f\x7c\xcc\x4e\x72\xf5\x44\x17\x34\x34\xa2\x6d\x18\x93\x75\x61\xe7\x8f\x35\xa5\x53\x3c\xef\x77\xf2\x8f\x3f\x3d\xbf\x68\x0d\x33\x09\xc4\xe0\x8f\x95\x05\xea\xe8\xf9\xf3\xdf\xd0\xf3\x62\x5b\x7f\x1b\xfe\x2b\x73\x6e\x09\xfc\x3a\xea\x86\xa9\x32\xd5\xf4\x4d\xa1\xce\xb8\x85\x97\xa4\xcb\x68\x71\xe7\x06\x7e\x0a\x85\xc4\xfe\x67\xbf\x8a\xc0\x87\xda\xed\xd8\x17\xeb\x27\xb6\x9d\x77\x8e\xe6\xd3\x69\x23\xc6\x86\xbe\x62\xd7\x7b\x6a\x4c\xec\x6e\x4f\x9d\xb5\x8c\x39\x86\x23\x34\x93\xd6\x46\x1d\x1a\xb6\x69\xc0\x44\xb5\x81\x58\x34\xd1\x68\x52\x64\x9e\x16\xd1\xe5\xee\xf3\xee\x95\xe0\xee\x80\xce\x2b\xdb\xdd\x40\x9b\xb5\xb1\x0c\x66\x26\xc0\xd4\x3a\x49\x98\x8e\xb3\xc1\x5f\x2a\xb0\x2d\xa5\xb9\xcd\xd7\x07\xaa\x9e\xdf\x33\x11\x34\x21\x5a\x6a\x6a\x3a\x4f\x6e\xf3\x1f\xca\x18\x01\x6c\x9d\xd7\x3a\x93\x08\x96\x4c\x0c\x5b\xef\x8c\x8e\x9b\x65\xfa\xe9\xfa\x98\x04\xc3\x95\xb5\x61\x96\xa0\xe5\x0c\xea\x72\xac\xcb\xdf\xb5\x1c\xae\xaf\x4c\xf3\xab\x62\x8b\xf3\x78\x5d\xcc\x5f\x79\x58\xd8\xe6\xe6\x8f\x98\x12\x1e\xcf\x57\xfa\x63\x30\x2f\x98\x76\x24\xdf\xd8\xb5\xb4\x69\xf5\x7f\xdb\xb1\x0b\x9e\xa7\x0f\xeb\x00\x2f\xff\x4b\xcc\xfe\x6b\x6d\x63\xbe\xca\x16\x12\x2f\x71\xfd\xbe\x15\xf5\xe2\xb2\x2e\x9d\x1b\x90\x94\x2b\x32\x2c\x31\xe9\x24\x9e\xdd\xe4\x76\x2a\xd2\x31\xd0\x81\x00\xe0\xdf\xff\x59\xfd\x37\x00\x00\xff\xff\x04\x59\x7f\x01\xab\x11\x00\x00")
+var _crdsAccessFarosSh_requestsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5b\x6f\xe3\xca\x0d\x7e\xf7\xaf\x20\xd0\x87\x6d\x81\x58\xd9\x45\x5f\x0a\x03\x7d\x08\xd2\x16\x08\x7a\xb6\x08\xd6\x3e\xe7\x9d\x9a\xa1\xad\x39\x19\xcd\xa8\x43\x8e\x77\xdd\xa2\xff\xbd\xe0\xe8\xe2\x4b\xa4\x20\x4d\x71\x2c\xbd\xcc\x45\xbc\x7c\x24\x3f\xd2\xeb\xf5\x7a\x85\x9d\xfb\x85\x12\xbb\x18\x36\x80\x9d\xa3\x1f\x42\x41\x57\x5c\xbd\xfc\x89\x2b\x17\xef\x8f\x5f\x56\x2f\x2e\xd8\x0d\x3c\x66\x96\xd8\x7e\x23\x8e\x39\x19\xfa\x0b\xed\x5d\x70\xe2\x62\x58\xb5\x24\x68\x51\x70\xb3\x02\xc0\x10\xa2\xa0\x6e\xb3\x2e\x01\x4c\x0c\x92\xa2\xf7\x94\xd6\x07\x0a\xd5\x4b\xae\xa9\xce\xce\x5b\x4a\x45\xf8\xa8\xfa\xf8\xb9\xfa\xf2\xb9\xfa\xbc\x02\x30\x89\xca\xf7\x3b\xd7\x12\x0b\xb6\xdd\x06\x42\xf6\x7e\x05\x10\xb0\xa5\x0d\x24\xfa\x67\x26\x16\xae\xd0\x18\x62\xae\xf6\x98\x22\x57\xdc\xac\xb8\x23\xa3\x3a\x0f\x29\xe6\x6e\x03\xb7\xc7\xfd\xf7\xa3\x55\x28\x74\x88\xc9\x8d\xeb\x35\xbc\x98\xae\x9c\xf4\xbe\x7e\xeb\x95\x94\x1d\xef\x58\xfe\x7e\xb9\xfb\x93\x1b\x4e\x3a\x9f\x13\xfa\xb3\x49\x65\x93\x5d\x38\x64\x8f\x69\xda\x5e\x01\xb0\x89\x1d\x6d\xe0\x1f\xd8\x12\x77\x68\xc8\xae\x00\x06\xd7\x8b\x01\x6b\x40\x6b\x0b\x98\xe8\x9f\x93\x0b\x42\xe9\x31\xfa\xdc\x8e\x20\xae\xe1\x57\x8e\xe1\x19\xa5\xd9\x40\x35\xc2\x5d\xbd\x42\xaa\xe8\x1f\x71\x7a\x38\xd0\xb0\x96\x93\x2a\xb7\x28\xfd\x46\x7f\x7c\xfc\x82\xbe\x6b\xf0\x4b\xd9\x62\xd3\x50\x5b\xe2\xa7\xab\xd8\x51\x78\x78\x7e\xfa\xe5\x8f\xdb\xab\x6d\x00\x4b\x6c\x92\xeb\x54\xe7\x04\x06\x38\x06\x69\x08\xfa\xbb\xb0\x8f\xa9\x2c\x1f\x0a\xfa\xd3\xa5\x87\xe7\xa7\x49\x4a\x97\x62\x47\x49\x26\xec\xfb\xf7\x22\x0f\x2f\x76\x6f\x74\x7e\x52\xb3\xfa\x5b\x60\x35\x01\xa9\x57\x3e\x60\x49\x76\xf0\x04\xe2\x1e\xa4\x71\x0c\x89\xba\x44\x4c\xa1\x4f\xc9\x2b\xc1\xa0\x97\x30\x40\xac\x7f\x25\x23\x15\x6c\x29\xa9\x18\xe0\x26\x66\x6f\x35\x6f\x8f\x94\x04\x12\x99\x78\x08\xee\x5f\x93\x6c\x06\x89\x45\xa9\x47\x19\x53\xe4\xfc\x2b\xb1\x0b\xe8\xe1\x88\x3e\xd3\x1d\x60\xb0\xd0\xe2\x09\x12\xa9\x16\xc8\xe1\x42\x5e\xb9\xc2\x15\x7c\x8d\x89\xc0\x85\x7d\xdc\x40\x23\xd2\xf1\xe6\xfe\xfe\xe0\x64\xac\x3f\x13\xdb\x36\x07\x27\xa7\xfb\x52\x4a\xae\xce\x12\x13\xdf\x5b\x3a\x92\xbf\x67\x77\x58\x63\x32\x8d\x13\x32\x92\x13\xdd\x63\xe7\xd6\xc5\xf4\xa0\x0e\x73\xd5\xda\xdf\xa5\xa1\x62\xf9\xd3\x95\xad\x7d\x56\xb0\x24\x17\x0e\x17\x07\x25\xff\xdf\x88\x80\x56\x82\xc6\x1c\x87\x4f\x7b\x47\xcf\x40\xeb\x96\xa2\xf3\xed\xaf\xdb\x1d\x8c\xaa\x4b\x30\xae\x84\xc2\x80\xfb\xf9\x43\x3e\x87\x40\x01\x73\x61\x4f\x9a\x4a\x8e\x61\x9f\x62\x5b\x10\xa7\x60\xbb\xe8\x82\x94\x85\xf1\x8e\xc2\x2d\xfc\x9c\xeb\xd6\x09\x4f\x15\x09\x12\x2b\x78\x2c\xa4\x04\x35\x41\xee\xb4\x0a\x6c\x05\x4f\x01\x1e\xb1\x25\xff\x88\x4c\xbf\x79\x00\x14\x69\x5e\x2b\xb0\xef\x0b\xc1\x25\x9f\x9e\x7f\x2a\x65\x33\xa0\x76\x71\x30\xb2\xde\x42\xbc\x86\x02\xdc\x76\x64\xae\x2a\xc6\x12\xbb\xa4\x39\x2d\x28\xa4\x95\x30\x5c\xbc\x92\x34\x5f\xa9\xfa\x18\x9f\x59\x28\x29\xa1\xdd\x1e\x2d\xba\xa5\xaf\x88\xff\x1f\xee\x2f\xb9\x2c\x28\x99\xdf\xe1\x74\xb9\x77\xe5\x76\xac\x59\xcb\xfc\xda\xef\x93\xd2\xd8\x2b\x2d\x6f\xba\x1f\x43\x4f\xd7\xaf\x4e\x6e\x2c\x79\xcc\x29\x51\x10\x15\xa5\x7c\xa8\xc5\x31\x69\xd6\x24\x1e\x2c\xad\x5e\x49\x71\x42\xed\x8c\xf0\x5b\xf1\xa3\x1d\x93\x93\x85\xd0\xd4\xc7\x42\x78\x1a\x58\x1c\x5c\x03\x75\xa6\xec\xa2\x9f\x91\x0b\x3d\x24\xaf\x2d\x79\x0b\x87\xfe\xf1\xc8\xb2\x4b\x18\xb8\x40\xa2\xdd\x68\xfe\xde\x8d\xf1\x3f\x21\x0b\x88\x6b\xa9\x44\xc6\x4c\xae\xc8\x24\x8a\x6c\x5f\xfb\x31\xd0\x10\xf4\x05\xb9\xa0\x9c\x8c\x21\x4a\x43\xa9\x82\x5d\xe3\x26\x1a\xaf\x09\xbe\x37\x14\x8a\x8a\x1c\x2c\x25\x7f\xd2\x20\x9c\xb5\x99\x06\xc3\x81\xec\x9c\xdf\xfd\xf3\xa4\xad\x04\x45\x39\x4f\x59\xe4\x25\xc4\xef\xe1\x4e\xe5\x05\xc8\x3c\xb2\x5d\x71\x63\x52\xf4\xf0\xfc\x04\x7b\x47\xde\x2e\x0a\x1d\xb4\xaa\x50\x9d\x53\x3a\xc1\xda\xcf\x62\xaf\xef\x3e\xa6\x16\xa5\xef\xe0\x6b\xd5\xb4\x70\xef\x8d\xba\x1b\x89\x85\x19\x0f\xef\x8b\xce\x03\x34\xb9\xc5\x00\x89\xd0\xaa\x71\xe3\xc7\xe0\x82\x75\x06\x45\x3d\xb7\x24\xe8\x3c\x03\xd6\x31\xcb\x6a\x46\x62\x79\x15\xfa\x73\x4c\x87\xf0\x14\x78\x4a\x67\xac\x09\xa8\xed\xe4\x54\x7d\xd4\xab\x44\xc8\xb7\x53\xc3\x82\x53\xbb\x86\xd4\x21\x8e\x61\x1a\x54\xa6\x4c\xf8\xc4\x25\x91\x2f\x4c\x5d\x90\xa8\x4d\xfe\xb2\x7b\xa8\x50\x65\x61\xb7\x77\xa6\x84\x5e\xbd\x32\x4d\x8c\x5c\x72\x4f\x73\x12\x62\x2a\xc9\x33\xd3\x06\xcf\x4f\x0f\x89\x63\x1d\x3d\xd8\x59\x52\x7a\x46\x38\x64\x4c\x18\x84\xc8\xaa\xec\x57\xe8\xa9\xd4\x7a\x29\x21\xe0\xff\x44\x96\xe9\x48\xc9\xc9\xe9\x5d\xd8\x6e\x87\xcb\x4a\x17\x47\x67\x7b\x2e\xa2\x1f\x9d\x77\xc6\x09\x18\x8f\xcc\x8a\xd0\xc8\x4b\x0b\x22\x01\xbe\xf5\xf1\x31\xd1\xd2\x1d\x70\x3f\x6a\x65\xd6\xb1\x20\x26\x68\xd1\x34\x85\xe7\x0c\x06\x70\x6d\x4b\xd6\xa1\x90\x3f\xf5\xb5\xcd\x82\x61\xb9\xe6\x54\x90\x19\xf8\x98\x9d\xe4\xde\x12\x1d\xd0\xd0\x88\xfe\x5d\x88\xc9\xba\x70\xf0\x27\x05\x99\xce\xfe\xbc\x5d\xc9\x5f\x7f\xde\xee\x74\xb4\x60\x12\x88\xc1\x9f\x34\xe4\x01\xb6\x85\xad\xfe\xfc\x37\xf4\x4c\x1f\x87\x7f\xa6\xcf\x2d\x81\x5f\xae\x8e\x5d\x65\xca\xe9\xbb\x42\x9d\x71\x0f\xbb\xa4\xc3\x68\x31\xe7\x0e\x7e\x0e\x85\xc4\x3e\x6c\x57\xb9\xf0\x1e\xab\x76\xa7\xae\x68\x9f\xec\xb9\xaa\x1c\x2d\x0a\x17\x60\x1f\x63\x45\x3f\xb0\xed\x3c\x55\x26\xb6\xf7\xe7\xca\x5a\x50\x01\xf0\x15\xc3\x09\xaa\x49\x6a\xa5\x06\xf5\x73\x28\x03\xa6\xe2\x3f\x3b\x16\x6d\xbc\x68\x52\x64\x9e\x06\xd1\xe5\xea\xf3\xee\x85\xe0\xe1\x88\xce\x2b\xdb\xdd\x41\x9d\xb5\xb0\x0c\x66\x26\xc0\x54\x3b\x49\x98\x4e\x67\x64\xfb\x0c\xd4\x91\x92\x69\x9f\xe7\x1b\xaa\x3e\xbf\x67\x22\xa8\x42\xb4\x54\xf5\x1d\xec\x6c\x36\xff\xa1\xb4\x11\xc0\xda\x79\xad\x1b\x89\x60\xc9\xc4\xb0\xf7\xce\x68\xbb\x59\x94\xe9\xda\x2e\x26\xc1\x20\x1f\x8c\xa0\x0e\xc7\x3a\xfc\xcd\x65\xd6\x7a\xa6\x9b\xcf\x5e\x5b\xec\xc7\xeb\x92\xd8\x33\x07\x0b\xd3\xdc\xe5\x21\xa6\x84\xa7\x9b\x33\x3e\x05\xb3\xc3\x74\x20\xd9\xac\xde\xce\xb6\x86\xca\x5f\xdf\xb1\x0a\xb6\xd3\x87\x7d\x03\x57\xf2\x1d\x07\x2e\x6d\xbc\x75\xcc\xb3\x6c\x21\xb1\x5a\xbd\x1b\xd1\x59\xa7\x5e\x6d\xea\x40\x46\x76\x03\x92\x72\xcf\xd5\x2c\x31\x69\x27\xbe\xd8\xc9\xf5\x94\xa4\xa3\xa3\x2c\x28\x99\x37\xf0\xef\xff\xac\xfe\x3b\x00\x04\x59\x7f\x01\xab\x11\x00\x00")
 
 func crdsAccessFarosSh_requestsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -141,7 +147,7 @@ func crdsAccessFarosSh_requestsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBases_Yaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8e\x41\x4e\xc4\x30\x0c\x45\xf7\x39\x85\x35\xfb\x86\xce\x0e\x65\x0b\x27\x40\x88\xbd\x27\x35\x23\xab\x89\x1d\xc5\x4e\xc5\xf1\x51\x4b\xd8\xe5\x7d\x29\xef\x79\x59\x96\x80\x8d\xbf\xa8\x1b\xab\x24\xc0\xc6\xf4\xe3\x24\x27\x59\xdc\x5f\x2d\xb2\xbe\x1c\xf7\xb0\xb3\x6c\x09\xde\x86\xb9\xd6\x0f\x32\x1d\x3d\xd3\x3b\x7d\xb3\xb0\xb3\x4a\xa8\xe4\xb8\xa1\x63\x0a\x00\x28\xa2\x8e\xe7\x6c\x27\x02\x64\x15\xef\x5a\x0a\xf5\xe5\x49\x12\xf7\xf1\xa0\xc7\xe0\xb2\x51\xbf\xe4\xff\xe9\x63\x8d\xf7\x35\xae\x01\x20\x77\xba\xfe\x7f\x72\x25\x73\xac\x2d\x81\x8c\x52\x82\x35\xca\xa7\xf2\xd9\x75\xb4\x04\xb7\x5b\x00\x10\xac\x34\x3b\x7f\x37\x5e\x2b\x40\x2b\xa3\x63\x99\x68\x59\x1b\xcd\xf7\xec\xd9\x74\xfe\x06\x00\x00\xff\xff\x92\x26\x2a\xef\x01\x01\x00\x00")
+var _crdsBases_Yaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8e\x41\x4e\xc4\x30\x0c\x45\xf7\x39\x85\x35\xfb\x86\xce\x0e\x65\x0b\x27\x40\x88\xbd\x27\x35\x23\xab\x8e\x1d\xc5\x49\xc5\xf1\x51\x4b\xd8\xf9\x7d\xc9\xff\xfd\x65\x59\x02\x56\xfe\xa2\xe6\x6c\x9a\x00\x2b\xd3\x4f\x27\x3d\xc9\xe3\xfe\xea\x91\xed\xe5\xb8\x87\x9d\x75\x4b\xf0\x36\xbc\x5b\xf9\x20\xb7\xd1\x32\xbd\xd3\x37\x2b\x77\x36\x0d\x85\x3a\x6e\xd8\x31\x05\x00\x54\xb5\x8e\x67\xec\x27\x02\x64\xd3\xde\x4c\x84\xda\xf2\x24\x8d\xfb\x78\xd0\x63\xb0\x6c\xd4\xae\xf2\x7f\xf5\xb1\xc6\xfb\x1a\xd7\x00\x90\x1b\x5d\xff\x9f\x5c\xc8\x3b\x96\x9a\x40\x87\x48\xf0\x4a\xf9\xac\x7c\x36\x1b\x35\xc1\xed\x16\x00\x14\x0b\x4d\xcf\xdf\xc6\x2b\x05\xa8\x32\x1a\xca\x44\xcf\x56\x69\xde\xd3\xe7\x09\x74\x88\x84\xdf\x01\x00\x92\x26\x2a\xef\x01\x01\x00\x00")
 
 func crdsBases_YamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -161,7 +167,7 @@ func crdsBases_Yaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBasesAccessFarosSh_requestsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4f\x6f\x2b\xb9\x0d\xbf\xfb\x53\x10\xe8\xe1\xb5\x40\x3c\x79\x0f\xbd\x14\x06\x7a\x08\xd2\x16\x08\xba\x5b\x04\x49\x76\xef\x1c\x89\xb6\xb5\xd1\x48\x53\x91\xf2\x3e\xb7\xe8\x77\x2f\x28\xcd\x8c\x27\xb6\x27\x2f\xaf\xc5\xea\x14\x4b\x1c\xfe\xf9\x91\xfc\x91\x59\xaf\xd7\x2b\xec\xdd\xcf\x94\xd8\xc5\xb0\x01\xec\x1d\x7d\x15\x0a\xfa\x8b\x9b\xd7\x3f\x71\xe3\xe2\xed\xe1\xcb\xea\xd5\x05\xbb\x81\xfb\xcc\x12\xbb\x27\xe2\x98\x93\xa1\xbf\xd0\xd6\x05\x27\x2e\x86\x55\x47\x82\x16\x05\x37\x2b\x00\x0c\x21\x0a\xea\x35\xeb\x4f\x00\x13\x83\xa4\xe8\x3d\xa5\xf5\x8e\x42\xf3\x9a\x5b\x6a\xb3\xf3\x96\x52\x51\x3e\x9a\x3e\x7c\x6e\xbe\x7c\x6e\x3e\xaf\x00\x4c\xa2\xf2\xfd\x8b\xeb\x88\x05\xbb\x7e\x03\x21\x7b\xbf\x02\x08\xd8\xd1\x06\x12\xfd\x33\x13\x0b\x37\x68\x0c\x31\x37\x5b\x4c\x91\x1b\xde\xaf\xb8\x27\xa3\x36\x77\x29\xe6\x7e\x03\xe7\xcf\xf5\xfb\xd1\x2b\x14\xda\xc5\xe4\xc6\xdf\x6b\x78\x35\x7d\xf9\xab\xc6\xfa\x54\x8d\x94\x1b\xef\x58\xfe\x3e\xbf\xfd\xc1\x0d\x2f\xbd\xcf\x09\xfd\xc9\xa5\x72\xc9\x2e\xec\xb2\xc7\x34\x5d\xaf\x00\xd8\xc4\x9e\x36\xf0\x0f\x75\xa0\x47\x43\x76\x05\x30\x84\x5e\x1c\x58\x03\x5a\x5b\xc0\x44\xff\x98\x5c\x10\x4a\xf7\xd1\xe7\x2e\x4c\xee\xfd\xc2\x31\x3c\xa2\xec\x37\xd0\x8c\x70\x37\x17\x48\x15\xd9\x11\xa7\xbb\x1d\x0d\xbf\xe5\xa8\xc6\x2d\x4a\xbd\xa8\xcf\x87\x2f\xe8\xfb\x3d\x7e\xa9\x3e\x9b\x3d\x75\xb8\x19\xe4\x63\x4f\xe1\xee\xf1\xe1\xe7\x3f\x3e\xbf\xb9\x06\xb0\xc4\x26\xb9\x5e\x4a\xc6\x06\x30\xc0\x31\xc8\x9e\xa0\xca\xc2\x36\xa6\xf2\xf3\xae\xa0\x3f\x09\xdd\x3d\x3e\x4c\x5a\xfa\x14\x7b\x4a\x32\x61\x5f\xcf\xac\x0e\x67\xb7\x67\x36\x3f\xa9\x5b\x55\x0a\xac\x16\x20\x55\xe3\x03\x96\x64\x87\x48\x20\x6e\x41\xf6\x8e\x21\x51\x9f\x88\x29\xd4\x92\x7c\xa3\x18\x54\x08\x03\xc4\xf6\x17\x32\xd2\xc0\x33\x25\x55\x03\xbc\x8f\xd9\x5b\xad\xdb\x03\x25\x81\x44\x26\xee\x82\xfb\xd7\xa4\x9b\x41\x62\x31\xea\x51\xc6\x12\x39\x9d\x92\xbb\x80\x1e\x0e\xe8\x33\xdd\x00\x06\x0b\x1d\x1e\x21\x91\x5a\x81\x1c\x66\xfa\x8a\x08\x37\xf0\x63\x4c\x04\x2e\x6c\xe3\x06\xf6\x22\x3d\x6f\x6e\x6f\x77\x4e\xc6\xfe\x33\xb1\xeb\x72\x70\x72\xbc\x2d\xad\xe4\xda\x2c\x31\xf1\xad\xa5\x03\xf9\x5b\x76\xbb\x35\x26\xb3\x77\x42\x46\x72\xa2\x5b\xec\xdd\xba\xb8\x1e\x4a\x0f\x36\x9d\xfd\x5d\x1a\x3a\x96\x3f\xbd\xf1\xb5\x56\x05\x4b\x72\x61\x37\x7b\x28\xf5\xff\x4e\x06\xb4\x13\x34\xe7\x38\x7c\x5a\xa3\x38\x01\xad\x57\x8a\xce\xd3\x5f\x9f\x5f\x60\x34\x5d\x92\x71\x8e\x7e\xc1\xfd\xf4\x21\x9f\x52\xa0\x80\xb9\xb0\xa5\x54\x93\xb8\x4d\xb1\x2b\x3a\x29\xd8\x3e\xba\x20\xe5\x87\xf1\x8e\xc2\x39\xfc\x9c\xdb\xce\x09\x4f\x1d\x09\x12\x1b\xb8\x2f\xa4\x04\x2d\x41\xee\xb5\x0b\x6c\x03\x0f\x01\xee\xb1\x23\x7f\x8f\x4c\xbf\x79\x02\x14\x69\x5e\x2b\xb0\x1f\x4b\xc1\x9c\x4f\xcf\x85\x2b\x6a\xb3\x87\x91\xf5\x16\xf2\x35\x34\xe0\x73\x4f\xe6\x4d\xc7\x58\x62\x97\xb4\xa6\x05\x85\xb4\x13\xe6\x8c\x37\x9e\xeb\x9d\xaa\xc7\xf8\xcc\x42\x49\x09\xed\xfc\x69\x31\xac\xf2\x24\xfe\x3b\xe4\x97\x42\x16\x94\xcc\x1f\x08\xba\xc8\xbd\x09\x3b\xb6\xac\x35\xf6\x36\xee\xa3\x32\xd4\x85\x95\x77\xc3\x8f\xa1\xd2\xf5\xc5\xcb\x99\x27\xf7\x39\x25\x0a\xa2\xaa\x94\x0f\xb5\x39\x26\xcb\xa5\x4b\xaa\xa7\xcd\x85\x16\x27\xd4\x5d\x51\x7e\xae\x7e\xf4\x63\x0a\xb2\x10\x9a\xc6\x58\x08\xaf\x50\xdc\xd8\x69\x1a\x0c\xd6\x19\x73\x45\x6f\x85\x95\x2e\x3d\x79\x0f\x87\x7a\x3c\xb2\xbc\x24\x0c\xec\xc6\x69\x74\x5d\xee\xcc\xf9\x1f\x90\x05\xc4\x75\x54\xdb\x79\x0a\x45\x26\x55\x64\x6b\xef\xc7\x40\x43\xd2\x17\xf4\x82\x72\x32\x86\x28\x7b\x4a\x0d\xbc\x28\x69\x0c\x34\xde\x12\xfc\xba\xa7\x50\x4c\xe4\x60\x29\xf9\xa3\x26\xe1\x64\xcd\xec\x31\xec\xc8\x5e\x8b\xbb\x9e\x07\xcd\x14\x96\x39\xa7\x2c\xf2\x1a\xe2\xaf\xe1\x46\xf5\x05\xc8\x3c\xb2\x5d\x09\x63\x32\x74\xf7\xf8\x00\x5b\x47\xde\x2e\x2a\x1d\xac\x16\x22\x35\x86\x7a\xc1\xd6\x5f\xc5\x5e\xcf\x36\xa6\x0e\xa5\x4e\xf0\xb5\x5a\x5a\x90\x7b\xa7\xef\xea\xe9\x88\x19\x77\x1f\xcb\xce\x1d\xec\x73\x87\x01\x12\xa1\x55\xe7\xc6\x8f\xc1\x05\xeb\x0c\x16\x9e\xb7\x24\xe8\x3c\x03\xb6\x31\x9f\x33\xf1\xcc\x2d\xc5\x67\xca\xe9\x90\x9e\x02\x4f\x21\xfa\x96\x80\xba\x5e\x8e\x4b\xd1\x7f\x33\xaa\x44\xc8\xe7\x5b\xc3\x42\x50\x2f\x7b\x1a\xc4\xa7\x45\x65\xaa\x84\x4f\x5c\x0a\x79\xe6\xea\x62\x44\xee\xcd\xf4\x50\xa5\xca\xc2\x6e\xeb\x4c\x49\xbd\x46\x65\xf6\x31\x72\x29\x09\xad\x49\x88\xa9\x14\xcf\x95\x31\x78\x3a\x15\x12\xc7\xea\x12\x3b\x4b\x4a\xcf\x08\xbb\x8c\x09\x83\x10\x59\xd5\x7d\x81\x5e\x1d\x6c\x8b\x3a\xff\x3f\x64\x99\x0e\x94\x9c\x1c\x3f\x84\xed\xf3\x20\xac\x74\x71\x70\xb6\x72\x11\x7d\xed\xbd\x33\x4e\xc0\x78\x64\x56\x84\x46\x5e\x5a\x74\xf9\xa9\xe6\xc7\x44\x4b\x37\xc0\x75\xd5\xca\xac\x6b\x41\x4c\xd0\xa1\xd9\x17\x9e\x33\x18\xc0\x75\x1d\x59\x87\x42\xfe\x58\x7b\x9b\x05\xc3\x72\xcf\x95\x6c\x0f\x7c\xcc\x4e\x72\xf5\x44\x17\x34\x34\xa2\x6d\x18\x93\x75\x61\xe7\x8f\x35\xa5\x53\x3c\xef\x77\xf2\x8f\x3f\x3d\xbf\x68\x0d\x33\x09\xc4\xe0\x8f\x95\x05\xea\xe8\xf9\xf3\xdf\xd0\xf3\x62\x5b\x7f\x1b\xfe\x2b\x73\x6e\x09\xfc\x3a\xea\x86\xa9\x32\xd5\xf4\x4d\xa1\xce\xb8\x85\x97\xa4\xcb\x68\x71\xe7\x06\x7e\x0a\x85\xc4\xfe\x67\xbf\x8a\xc0\x87\xda\xed\xd8\x17\xeb\x27\xb6\x9d\x77\x8e\xe6\xd3\x69\x23\xc6\x86\xbe\x62\xd7\x7b\x6a\x4c\xec\x6e\x4f\x9d\xb5\x8c\x39\x86\x23\x34\x93\xd6\x46\x1d\x1a\xb6\x69\xc0\x44\xb5\x81\x58\x34\xd1\x68\x52\x64\x9e\x16\xd1\xe5\xee\xf3\xee\x95\xe0\xee\x80\xce\x2b\xdb\xdd\x40\x9b\xb5\xb1\x0c\x66\x26\xc0\xd4\x3a\x49\x98\x8e\xb3\xc1\x5f\x2a\xb0\x2d\xa5\xb9\xcd\xd7\x07\xaa\x9e\xdf\x33\x11\x34\x21\x5a\x6a\x6a\x3a\x4f\x6e\xf3\x1f\xca\x18\x01\x6c\x9d\xd7\x3a\x93\x08\x96\x4c\x0c\x5b\xef\x8c\x8e\x9b\x65\xfa\xe9\xfa\x98\x04\xc3\x95\xb5\x61\x96\xa0\xe5\x0c\xea\x72\xac\xcb\xdf\xb5\x1c\xae\xaf\x4c\xf3\xab\x62\x8b\xf3\x78\x5d\xcc\x5f\x79\x58\xd8\xe6\xe6\x8f\x98\x12\x1e\xcf\x57\xfa\x63\x30\x2f\x98\x76\x24\xdf\xd8\xb5\xb4\x69\xf5\x7f\xdb\xb1\x0b\x9e\xa7\x0f\xeb\x00\x2f\xff\x4b\xcc\xfe\x6b\x6d\x63\xbe\xca\x16\x12\x2f\x71\xfd\xbe\x15\xf5\xe2\xb2\x2e\x9d\x1b\x90\x94\x2b\x32\x2c\x31\xe9\x24\x9e\xdd\xe4\x76\x2a\xd2\x31\xd0\x81\x00\xe0\xdf\xff\x59\xfd\x37\x00\x00\xff\xff\x04\x59\x7f\x01\xab\x11\x00\x00")
+var _crdsBasesAccessFarosSh_requestsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5b\x6f\xe3\xca\x0d\x7e\xf7\xaf\x20\xd0\x87\x6d\x81\x58\xd9\x45\x5f\x0a\x03\x7d\x08\xd2\x16\x08\x7a\xb6\x08\xd6\x3e\xe7\x9d\x9a\xa1\xad\x39\x19\xcd\xa8\x43\x8e\x77\xdd\xa2\xff\xbd\xe0\xe8\xe2\x4b\xa4\x20\x4d\x71\x2c\xbd\xcc\x45\xbc\x7c\x24\x3f\xd2\xeb\xf5\x7a\x85\x9d\xfb\x85\x12\xbb\x18\x36\x80\x9d\xa3\x1f\x42\x41\x57\x5c\xbd\xfc\x89\x2b\x17\xef\x8f\x5f\x56\x2f\x2e\xd8\x0d\x3c\x66\x96\xd8\x7e\x23\x8e\x39\x19\xfa\x0b\xed\x5d\x70\xe2\x62\x58\xb5\x24\x68\x51\x70\xb3\x02\xc0\x10\xa2\xa0\x6e\xb3\x2e\x01\x4c\x0c\x92\xa2\xf7\x94\xd6\x07\x0a\xd5\x4b\xae\xa9\xce\xce\x5b\x4a\x45\xf8\xa8\xfa\xf8\xb9\xfa\xf2\xb9\xfa\xbc\x02\x30\x89\xca\xf7\x3b\xd7\x12\x0b\xb6\xdd\x06\x42\xf6\x7e\x05\x10\xb0\xa5\x0d\x24\xfa\x67\x26\x16\xae\xd0\x18\x62\xae\xf6\x98\x22\x57\xdc\xac\xb8\x23\xa3\x3a\x0f\x29\xe6\x6e\x03\xb7\xc7\xfd\xf7\xa3\x55\x28\x74\x88\xc9\x8d\xeb\x35\xbc\x98\xae\x9c\xf4\xbe\x7e\xeb\x95\x94\x1d\xef\x58\xfe\x7e\xb9\xfb\x93\x1b\x4e\x3a\x9f\x13\xfa\xb3\x49\x65\x93\x5d\x38\x64\x8f\x69\xda\x5e\x01\xb0\x89\x1d\x6d\xe0\x1f\xd8\x12\x77\x68\xc8\xae\x00\x06\xd7\x8b\x01\x6b\x40\x6b\x0b\x98\xe8\x9f\x93\x0b\x42\xe9\x31\xfa\xdc\x8e\x20\xae\xe1\x57\x8e\xe1\x19\xa5\xd9\x40\x35\xc2\x5d\xbd\x42\xaa\xe8\x1f\x71\x7a\x38\xd0\xb0\x96\x93\x2a\xb7\x28\xfd\x46\x7f\x7c\xfc\x82\xbe\x6b\xf0\x4b\xd9\x62\xd3\x50\x5b\xe2\xa7\xab\xd8\x51\x78\x78\x7e\xfa\xe5\x8f\xdb\xab\x6d\x00\x4b\x6c\x92\xeb\x54\xe7\x04\x06\x38\x06\x69\x08\xfa\xbb\xb0\x8f\xa9\x2c\x1f\x0a\xfa\xd3\xa5\x87\xe7\xa7\x49\x4a\x97\x62\x47\x49\x26\xec\xfb\xf7\x22\x0f\x2f\x76\x6f\x74\x7e\x52\xb3\xfa\x5b\x60\x35\x01\xa9\x57\x3e\x60\x49\x76\xf0\x04\xe2\x1e\xa4\x71\x0c\x89\xba\x44\x4c\xa1\x4f\xc9\x2b\xc1\xa0\x97\x30\x40\xac\x7f\x25\x23\x15\x6c\x29\xa9\x18\xe0\x26\x66\x6f\x35\x6f\x8f\x94\x04\x12\x99\x78\x08\xee\x5f\x93\x6c\x06\x89\x45\xa9\x47\x19\x53\xe4\xfc\x2b\xb1\x0b\xe8\xe1\x88\x3e\xd3\x1d\x60\xb0\xd0\xe2\x09\x12\xa9\x16\xc8\xe1\x42\x5e\xb9\xc2\x15\x7c\x8d\x89\xc0\x85\x7d\xdc\x40\x23\xd2\xf1\xe6\xfe\xfe\xe0\x64\xac\x3f\x13\xdb\x36\x07\x27\xa7\xfb\x52\x4a\xae\xce\x12\x13\xdf\x5b\x3a\x92\xbf\x67\x77\x58\x63\x32\x8d\x13\x32\x92\x13\xdd\x63\xe7\xd6\xc5\xf4\xa0\x0e\x73\xd5\xda\xdf\xa5\xa1\x62\xf9\xd3\x95\xad\x7d\x56\xb0\x24\x17\x0e\x17\x07\x25\xff\xdf\x88\x80\x56\x82\xc6\x1c\x87\x4f\x7b\x47\xcf\x40\xeb\x96\xa2\xf3\xed\xaf\xdb\x1d\x8c\xaa\x4b\x30\xae\x84\xc2\x80\xfb\xf9\x43\x3e\x87\x40\x01\x73\x61\x4f\x9a\x4a\x8e\x61\x9f\x62\x5b\x10\xa7\x60\xbb\xe8\x82\x94\x85\xf1\x8e\xc2\x2d\xfc\x9c\xeb\xd6\x09\x4f\x15\x09\x12\x2b\x78\x2c\xa4\x04\x35\x41\xee\xb4\x0a\x6c\x05\x4f\x01\x1e\xb1\x25\xff\x88\x4c\xbf\x79\x00\x14\x69\x5e\x2b\xb0\xef\x0b\xc1\x25\x9f\x9e\x7f\x2a\x65\x33\xa0\x76\x71\x30\xb2\xde\x42\xbc\x86\x02\xdc\x76\x64\xae\x2a\xc6\x12\xbb\xa4\x39\x2d\x28\xa4\x95\x30\x5c\xbc\x92\x34\x5f\xa9\xfa\x18\x9f\x59\x28\x29\xa1\xdd\x1e\x2d\xba\xa5\xaf\x88\xff\x1f\xee\x2f\xb9\x2c\x28\x99\xdf\xe1\x74\xb9\x77\xe5\x76\xac\x59\xcb\xfc\xda\xef\x93\xd2\xd8\x2b\x2d\x6f\xba\x1f\x43\x4f\xd7\xaf\x4e\x6e\x2c\x79\xcc\x29\x51\x10\x15\xa5\x7c\xa8\xc5\x31\x69\xd6\x24\x1e\x2c\xad\x5e\x49\x71\x42\xed\x8c\xf0\x5b\xf1\xa3\x1d\x93\x93\x85\xd0\xd4\xc7\x42\x78\x1a\x58\x1c\x5c\x03\x75\xa6\xec\xa2\x9f\x91\x0b\x3d\x24\xaf\x2d\x79\x0b\x87\xfe\xf1\xc8\xb2\x4b\x18\xb8\x40\xa2\xdd\x68\xfe\xde\x8d\xf1\x3f\x21\x0b\x88\x6b\xa9\x44\xc6\x4c\xae\xc8\x24\x8a\x6c\x5f\xfb\x31\xd0\x10\xf4\x05\xb9\xa0\x9c\x8c\x21\x4a\x43\xa9\x82\x5d\xe3\x26\x1a\xaf\x09\xbe\x37\x14\x8a\x8a\x1c\x2c\x25\x7f\xd2\x20\x9c\xb5\x99\x06\xc3\x81\xec\x9c\xdf\xfd\xf3\xa4\xad\x04\x45\x39\x4f\x59\xe4\x25\xc4\xef\xe1\x4e\xe5\x05\xc8\x3c\xb2\x5d\x71\x63\x52\xf4\xf0\xfc\x04\x7b\x47\xde\x2e\x0a\x1d\xb4\xaa\x50\x9d\x53\x3a\xc1\xda\xcf\x62\xaf\xef\x3e\xa6\x16\xa5\xef\xe0\x6b\xd5\xb4\x70\xef\x8d\xba\x1b\x89\x85\x19\x0f\xef\x8b\xce\x03\x34\xb9\xc5\x00\x89\xd0\xaa\x71\xe3\xc7\xe0\x82\x75\x06\x45\x3d\xb7\x24\xe8\x3c\x03\xd6\x31\xcb\x6a\x46\x62\x79\x15\xfa\x73\x4c\x87\xf0\x14\x78\x4a\x67\xac\x09\xa8\xed\xe4\x54\x7d\xd4\xab\x44\xc8\xb7\x53\xc3\x82\x53\xbb\x86\xd4\x21\x8e\x61\x1a\x54\xa6\x4c\xf8\xc4\x25\x91\x2f\x4c\x5d\x90\xa8\x4d\xfe\xb2\x7b\xa8\x50\x65\x61\xb7\x77\xa6\x84\x5e\xbd\x32\x4d\x8c\x5c\x72\x4f\x73\x12\x62\x2a\xc9\x33\xd3\x06\xcf\x4f\x0f\x89\x63\x1d\x3d\xd8\x59\x52\x7a\x46\x38\x64\x4c\x18\x84\xc8\xaa\xec\x57\xe8\xa9\xd4\x7a\x29\x21\xe0\xff\x44\x96\xe9\x48\xc9\xc9\xe9\x5d\xd8\x6e\x87\xcb\x4a\x17\x47\x67\x7b\x2e\xa2\x1f\x9d\x77\xc6\x09\x18\x8f\xcc\x8a\xd0\xc8\x4b\x0b\x22\x01\xbe\xf5\xf1\x31\xd1\xd2\x1d\x70\x3f\x6a\x65\xd6\xb1\x20\x26\x68\xd1\x34\x85\xe7\x0c\x06\x70\x6d\x4b\xd6\xa1\x90\x3f\xf5\xb5\xcd\x82\x61\xb9\xe6\x54\x90\x19\xf8\x98\x9d\xe4\xde\x12\x1d\xd0\xd0\x88\xfe\x5d\x88\xc9\xba\x70\xf0\x27\x05\x99\xce\xfe\xbc\x5d\xc9\x5f\x7f\xde\xee\x74\xb4\x60\x12\x88\xc1\x9f\x34\xe4\x01\xb6\x85\xad\xfe\xfc\x37\xf4\x4c\x1f\x87\x7f\xa6\xcf\x2d\x81\x5f\xae\x8e\x5d\x65\xca\xe9\xbb\x42\x9d\x71\x0f\xbb\xa4\xc3\x68\x31\xe7\x0e\x7e\x0e\x85\xc4\x3e\x6c\x57\xb9\xf0\x1e\xab\x76\xa7\xae\x68\x9f\xec\xb9\xaa\x1c\x2d\x0a\x17\x60\x1f\x63\x45\x3f\xb0\xed\x3c\x55\x26\xb6\xf7\xe7\xca\x5a\x50\x01\xf0\x15\xc3\x09\xaa\x49\x6a\xa5\x06\xf5\x73\x28\x03\xa6\xe2\x3f\x3b\x16\x6d\xbc\x68\x52\x64\x9e\x06\xd1\xe5\xea\xf3\xee\x85\xe0\xe1\x88\xce\x2b\xdb\xdd\x41\x9d\xb5\xb0\x0c\x66\x26\xc0\x54\x3b\x49\x98\x4e\x67\x64\xfb\x0c\xd4\x91\x92\x69\x9f\xe7\x1b\xaa\x3e\xbf\x67\x22\xa8\x42\xb4\x54\xf5\x1d\xec\x6c\x36\xff\xa1\xb4\x11\xc0\xda\x79\xad\x1b\x89\x60\xc9\xc4\xb0\xf7\xce\x68\xbb\x59\x94\xe9\xda\x2e\x26\xc1\x20\x1f\x8c\xa0\x0e\xc7\x3a\xfc\xcd\x65\xd6\x7a\xa6\x9b\xcf\x5e\x5b\xec\xc7\xeb\x92\xd8\x33\x07\x0b\xd3\xdc\xe5\x21\xa6\x84\xa7\x9b\x33\x3e\x05\xb3\xc3\x74\x20\xd9\xac\xde\xce\xb6\x86\xca\x5f\xdf\xb1\x0a\xb6\xd3\x87\x7d\x03\x57\xf2\x1d\x07\x2e\x6d\xbc\x75\xcc\xb3\x6c\x21\xb1\x5a\xbd\x1b\xd1\x59\xa7\x5e\x6d\xea\x40\x46\x76\x03\x92\x72\xcf\xd5\x2c\x31\x69\x27\xbe\xd8\xc9\xf5\x94\xa4\xa3\xa3\x2c\x28\x99\x37\xf0\xef\xff\xac\xfe\x3b\x00\x04\x59\x7f\x01\xab\x11\x00\x00")
 
 func crdsBasesAccessFarosSh_requestsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -181,7 +187,7 @@ func crdsBasesAccessFarosSh_requestsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBasesEdgeFarosSh_agentsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4b\x8f\xdb\xbe\x11\xbf\xfb\x53\x0c\xd0\x43\x5a\x60\x2d\x67\xd1\x4b\x61\xa0\x07\x63\xdb\x02\x8b\xfe\x53\x2c\xb2\x9b\xdc\x47\xe4\x58\x62\x96\x22\x55\x0e\xe9\xc4\x2d\xfa\xdd\x8b\x21\x25\xd9\xde\x95\xb2\x6e\x9a\xf0\x26\x3e\xe6\xf5\xfb\xcd\x43\xeb\xf5\x7a\x85\xbd\xf9\x4c\x81\x8d\x77\x5b\xc0\xde\xd0\xb7\x48\x4e\xbe\xb8\x7a\xfe\x13\x57\xc6\x6f\x0e\xb7\xab\x67\xe3\xf4\x16\xee\x12\x47\xdf\x7d\x24\xf6\x29\x28\xfa\x0b\xed\x8d\x33\xd1\x78\xb7\xea\x28\xa2\xc6\x88\xdb\x15\x00\x3a\xe7\x23\xca\x36\xcb\x27\x80\xf2\x2e\x06\x6f\x2d\x85\x75\x43\xae\x7a\x4e\x35\xd5\xc9\x58\x4d\x21\x0b\x1f\x55\x1f\xde\x57\xb7\xef\xab\xf7\x2b\x00\x15\x28\xbf\x7f\x32\x1d\x71\xc4\xae\xdf\x82\x4b\xd6\xae\x00\x1c\x76\xb4\x05\x6c\xc8\x45\xae\x48\x37\x54\xed\x31\x78\xae\xb8\x5d\x71\x4f\x4a\xf4\x35\xc1\xa7\x7e\x0b\x97\x87\xe5\xe5\x60\x4f\xf1\x65\x27\x42\xf2\xb7\x35\x1c\xff\x7e\xda\xfb\xcd\x70\xd9\xef\x6d\x0a\x68\x47\x75\x79\x8b\x8d\x6b\x92\xc5\x30\x6c\xae\x00\x58\xf9\x9e\xb6\xf0\x0f\x11\xdf\xa3\x22\xbd\x02\x18\x5c\xca\xea\xd6\x80\x5a\xe7\x20\xa1\x7d\x08\xc6\x45\x0a\x77\xde\xa6\x6e\x0c\xce\x1a\xbe\xb0\x77\x0f\x18\xdb\x2d\x54\x63\x18\xab\x57\x11\xc8\x77\x47\xff\x77\x0d\x0d\xdf\xf1\x28\xca\x35\xc6\xb2\x51\x8e\x0f\xb7\x68\xfb\x16\x6f\x8b\xc5\xaa\xa5\x0e\xb7\xc3\x7d\xdf\x93\xdb\x3d\xdc\x7f\xfe\xe3\xe3\xc5\x36\x80\x26\x56\xc1\xf4\x31\x23\x91\xc3\x00\x86\x21\xb6\x04\xe5\x26\xec\x7d\xc8\x9f\xe5\x6c\xf7\x70\x3f\x3d\xed\x83\xef\x29\x44\x33\x86\xb7\xac\x33\x52\x9d\xed\xbe\x50\xf4\x4e\x6c\x29\xb7\x40\x0b\x9b\xa8\xe8\x1c\x02\x48\x7a\x30\x1f\xfc\x1e\x62\x6b\x18\x02\xf5\x81\x98\x5c\xe1\xd7\x85\x60\x90\x4b\xe8\xc0\xd7\x5f\x48\xc5\x0a\x1e\x29\x88\x18\xe0\xd6\x27\xab\x85\x84\x07\x0a\x11\x02\x29\xdf\x38\xf3\xaf\x49\x36\x43\xf4\x59\xa9\xc5\x48\x03\xf2\xa7\x95\x01\x73\x68\xe1\x80\x36\xd1\x0d\xa0\xd3\xd0\xe1\x11\x02\x89\x16\x48\xee\x4c\x5e\xbe\xc2\x15\x7c\xf0\x81\xc0\xb8\xbd\xdf\x42\x1b\x63\xcf\xdb\xcd\xa6\x31\x71\x4c\x26\xe5\xbb\x2e\x39\x13\x8f\x9b\x9c\x17\xa6\x4e\xd1\x07\xde\x68\x3a\x90\xdd\xb0\x69\xd6\x18\x54\x6b\x22\xa9\x98\x02\x6d\xb0\x37\xeb\x6c\xba\xcb\x09\x55\x75\xfa\x77\x61\x48\x3f\x7e\x77\x61\x6b\xa1\x02\xc7\x60\x5c\x73\x76\x90\xc9\xfe\x1d\x04\x84\xf8\x02\x35\x0e\x4f\x8b\x17\xa7\x40\xcb\x96\x44\xe7\xe3\x5f\x1f\x9f\x60\x54\x9d\xc1\x78\x19\xfd\x1c\xf7\xd3\x43\x3e\x41\x20\x01\x33\x6e\x4f\xa1\x80\xb8\x0f\xbe\xcb\x32\xc9\xe9\xde\x1b\x17\xf3\x87\xb2\x66\x4c\xc8\xd3\xe2\x54\x77\x26\x0a\xee\xff\x4c\xc4\x51\xb0\xaa\xe0\x2e\x57\x18\xa8\x09\x52\x2f\xd4\xd7\x15\xdc\x3b\xb8\xc3\x8e\xec\x1d\x32\xfd\x72\x00\x24\xd2\xbc\x96\xc0\x5e\x07\xc1\x79\x71\x7c\x79\xb9\x44\xed\xec\x60\x2c\x63\x0b\x78\xe5\xf4\x7b\xec\x49\x5d\xe4\x8b\x26\x36\x41\x18\x1d\x31\x52\xce\x83\xe6\x65\x2c\xe7\xb3\x14\x4a\x99\x6b\x8c\x7b\xb5\x0d\x60\x22\x75\x33\xdb\x2f\x2c\x7a\xc8\xcf\xdf\x36\xa9\xa8\x99\x91\xb6\x6c\x59\x59\xca\xbb\xbd\x69\xe6\xcf\x5e\xd8\x72\x97\xaf\x96\x6a\x41\xcb\x1a\x61\x19\xab\xd3\xca\xb5\xf4\x1a\xa5\x52\xfa\x7f\x8e\xca\xc3\x5c\xc1\x5c\xd0\x3a\x96\xcd\x9f\xa0\x78\x81\x89\xe7\x87\x18\x02\x1e\xaf\xa2\x6f\xc4\x98\xf8\x4d\x02\xe7\x5b\x17\x7c\xf1\x35\x4b\xb5\x38\x23\xcc\xee\x7f\xe0\xb0\xf2\xae\x34\xd9\x19\x0e\x5d\x32\x24\x85\x20\x0d\xac\x0f\x5e\x11\x4b\x3b\x3f\x29\x9c\xda\x5b\xf5\x83\xa9\x70\x37\x5a\x31\x79\x96\xfb\x91\x38\x96\xfb\x55\xce\xcc\xb1\x50\x8a\x2b\x58\xe6\x82\x59\xd8\xb2\x59\xaf\x2d\x79\x3b\x5f\x2c\x72\x7c\x0a\xe8\xd8\x8c\x13\xc4\x55\x84\xfa\x0d\x39\x42\x34\x1d\x95\x6a\x3c\xb9\x12\x27\x51\xa4\x4b\xe9\xf6\x8e\x06\x9c\x17\xe4\x82\xb4\x54\x74\x3e\xb6\x14\x2a\x78\x92\x9a\x3f\x74\xe1\x9a\xe0\x6b\x4b\x2e\xab\x48\x4e\x53\xb0\x47\x81\xe0\xa4\x4d\xb5\xe8\x1a\xd2\x73\x7e\x97\x75\x2f\x38\x61\x9e\x4e\xa4\x09\x3c\x3b\xff\xd5\xdd\x88\x3c\x07\x89\xc7\x66\x95\xdd\x98\x14\xed\x1e\xee\x61\x6f\xc8\xea\x45\xa1\x83\xd6\xdc\x07\x95\xa2\x3e\x62\x6d\x67\x63\x2f\x6b\xef\x43\x87\xb1\x4c\x5d\x6b\xd1\xf4\xa3\xe9\xde\x11\x33\x36\xd7\xa1\xb3\x83\x36\x75\xe8\x20\x10\x6a\x31\x6e\x7c\x0c\xc6\x69\xa3\x30\xb7\x69\x4d\x11\x8d\x65\xc0\xda\xa7\xd7\x89\x3c\x99\x25\xf1\x99\x30\x1d\xe0\xc9\xe1\xc9\x7d\xba\x26\xa0\xae\x8f\xc7\x25\xef\xdf\xf4\x2a\x10\xf2\x95\x35\xec\xa9\xa5\xe1\xfa\x34\x5e\x4e\x4c\x78\xc7\x99\xc8\x67\xa6\x2e\x7a\x64\x2e\x9a\xbf\x08\x95\x26\x6a\xf6\x46\x65\xe8\xc5\x2b\xd5\x7a\xcf\x99\x12\xc2\x49\xf0\x21\x93\x67\x66\x8a\x39\xad\x12\x12\xc3\x62\x12\x1b\x4d\xd2\xcc\x10\x9a\x84\x01\x5d\x24\xd2\x22\xfb\x55\xf4\xca\x5c\xb2\x28\xf3\xff\x8b\x2c\xd3\x81\x82\x89\xc7\xab\x62\xfb\x38\x5c\x96\x72\x71\x30\xba\xd4\x22\xfa\xd6\x5b\xa3\x4c\x04\x65\x91\x59\x22\x34\xd6\xa5\x45\x93\x3f\x16\x7c\x94\xd7\x74\x03\x5c\x26\xe5\xc4\x32\xd5\xf9\x00\x1d\xaa\x36\xd7\x39\x85\x0e\x4c\xd7\x91\x36\x18\xc9\x1e\x4b\x6e\x73\x44\xb7\x9c\x73\x19\xed\xa1\x1a\xb3\x89\xa9\x58\x22\xf3\x35\xaa\x28\x69\xe8\x83\x36\xae\xb1\xc7\x02\xe9\xe4\xcf\xf7\x33\xf9\xc3\xa7\xc7\x27\xe1\x30\x53\x04\xef\xec\xb1\x54\x81\xd2\x6f\xfe\xfc\x37\xb4\xbc\x98\xd6\x6f\x87\x7f\xa6\xb5\x2d\x05\xbf\xf4\xb7\xa1\xa7\x4c\x9c\xbe\xc9\xa5\xd3\xef\xe1\x29\xc8\xbf\x44\x36\xe7\x06\x3e\xb9\x5c\xc4\x7e\xd8\xae\x7c\xe1\xaa\x74\x3b\xf6\x59\xfb\xa9\xda\x9e\x67\x8e\xe0\x69\x24\x11\x7d\x45\xdf\xb0\xeb\x2d\x55\xca\x77\x9b\x53\x66\x2d\xc7\x1c\xdd\x11\xaa\x49\x6a\x25\x06\x0d\x3f\x43\x80\x81\x4a\x02\x71\x14\xa0\x51\x05\xcf\x3c\xfd\x47\x2c\x67\x9f\x35\xcf\x04\xbb\x03\x1a\x2b\xd5\xee\x06\xea\x24\x89\xa5\x30\x31\x01\x86\xda\xc4\x80\xe1\x78\xd6\xf6\x33\x03\xeb\x4c\xcd\x7d\x9a\x6f\xa8\xb2\x7e\xcf\x44\x50\x39\xaf\xa9\x2a\x70\x9e\xcc\xe6\x3f\xe4\x36\x02\x58\x1b\x2b\x3c\x8b\x1e\x34\xc9\xec\x69\x8d\x92\x76\xb3\x5c\x7e\xba\xde\x87\x88\x73\x63\xc3\x19\x40\xcb\x08\xca\xbf\x8d\x8c\xca\x73\x18\xae\x67\xba\xf9\xec\xb5\xc5\x7e\xbc\xce\xea\x7f\xe9\xd4\xf7\x6a\xb3\x4c\x72\x5b\x88\x21\x15\xcd\x1c\x7d\x90\x4e\x77\xb6\x93\xea\x89\x04\xa3\xe3\x43\x82\xc1\xbf\xff\xb3\xfa\x6f\x00\x00\x00\xff\xff\x21\xe2\x45\x3d\x97\x12\x00\x00")
+var _crdsBasesEdgeFarosSh_agentsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x4b\x6f\x23\xb9\xf1\xbf\xeb\x53\x14\xf0\x3f\xcc\x3f\x80\xd5\x9e\x41\x2e\x81\x80\x1c\x0c\x27\x41\x8c\xcc\x04\xc6\xd8\xbb\xf7\x6a\xb2\x24\x71\xcd\x26\x3b\xac\xa2\x76\x94\x20\xdf\x3d\x28\xb2\x5b\x0f\xbb\xdb\xa3\xf5\x38\x87\xa8\x75\x61\x91\x5d\x8f\x5f\x3d\xd9\xcb\xe5\x72\x81\xbd\xfb\x99\x12\xbb\x18\x56\x80\xbd\xa3\x6f\x42\x41\x57\xdc\x3c\xfd\x81\x1b\x17\xaf\x77\x9f\x16\x4f\x2e\xd8\x15\xdc\x66\x96\xd8\x7d\x25\x8e\x39\x19\xfa\x13\xad\x5d\x70\xe2\x62\x58\x74\x24\x68\x51\x70\xb5\x00\xc0\x10\xa2\xa0\x92\x59\x97\x00\x26\x06\x49\xd1\x7b\x4a\xcb\x0d\x85\xe6\x29\xb7\xd4\x66\xe7\x2d\xa5\xc2\x7c\x14\xbd\xfb\xd8\x7c\xfa\xd8\x7c\x5c\x00\x98\x44\xe5\xfd\x47\xd7\x11\x0b\x76\xfd\x0a\x42\xf6\x7e\x01\x10\xb0\xa3\x15\xe0\x86\x82\x70\x43\x76\x43\xcd\x1a\x53\xe4\x86\xb7\x0b\xee\xc9\xa8\xbc\x4d\x8a\xb9\x5f\xc1\xf9\x66\x7d\x73\xd0\xa7\xda\x72\xa3\x4c\xca\xda\x3b\x96\xbf\x1d\x69\x9f\x1d\x57\x7a\xef\x73\x42\x3f\x8a\x2b\x24\x76\x61\x93\x3d\xa6\x81\xb8\x00\x60\x13\x7b\x5a\xc1\xdf\xb1\x23\xee\xd1\x90\x5d\x00\x0c\x26\x15\x71\x4b\x40\x6b\x0b\x48\xe8\xef\x93\x0b\x42\xe9\x36\xfa\xdc\x8d\xe0\x2c\xe1\x17\x8e\xe1\x1e\x65\xbb\x82\x66\x84\xb1\x79\x81\x40\x91\x3e\xda\x7f\xb3\xa1\x61\x2d\x7b\x15\x6e\x51\x2a\xa1\x6e\xef\x3e\xa1\xef\xb7\xf8\xa9\x90\xd8\x6c\xa9\x2b\x7e\xd1\x55\xec\x29\xdc\xdc\xdf\xfd\xfc\xfb\x87\x33\x32\x80\x25\x36\xc9\xf5\x2a\x73\x80\x01\x1c\x83\x6c\x09\xea\x49\x58\xc7\x54\x96\x75\xef\xe6\xfe\xee\xf0\x6a\x9f\x62\x4f\x49\xdc\x08\x6f\x7d\x4e\x82\xea\x84\xfa\x4c\xd0\x07\xd5\xa5\x9e\x02\xab\xd1\x44\x55\xe6\x00\x20\xd9\x41\x7d\x88\x6b\x90\xad\x63\x48\xd4\x27\x62\x0a\x35\xbe\xce\x18\x83\x1e\xc2\x00\xb1\xfd\x85\x8c\x34\xf0\x40\x49\xd9\x00\x6f\x63\xf6\x56\x83\x70\x47\x49\x20\x91\x89\x9b\xe0\xfe\x79\xe0\xcd\x20\xb1\x08\xf5\x28\x34\x78\xfe\xf8\x14\x87\x05\xf4\xb0\x43\x9f\xe9\x0a\x30\x58\xe8\x70\x0f\x89\x54\x0a\xe4\x70\xc2\xaf\x1c\xe1\x06\xbe\xc4\x44\xe0\xc2\x3a\xae\x60\x2b\xd2\xf3\xea\xfa\x7a\xe3\x64\x4c\x26\x13\xbb\x2e\x07\x27\xfb\xeb\x92\x17\xae\xcd\x12\x13\x5f\x5b\xda\x91\xbf\x66\xb7\x59\x62\x32\x5b\x27\x64\x24\x27\xba\xc6\xde\x2d\x8b\xea\x41\x0d\xe6\xa6\xb3\xff\x97\x86\xf4\xe3\x0f\x67\xba\xd6\x50\x60\x49\x2e\x6c\x4e\x36\x4a\xb0\xbf\xe2\x01\x0d\x7c\x75\x35\x0e\xaf\x56\x43\x8f\x40\x2b\x49\xd1\xf9\xfa\xe7\x87\x47\x18\x45\x17\x67\x9c\x31\x85\x01\xf7\xe3\x8b\x7c\x74\x81\x02\xe6\xc2\x9a\x34\x82\x1c\xc3\x3a\xc5\xae\x20\x4e\xc1\xf6\xd1\x05\x29\x0b\xe3\xdd\x98\x90\xc7\x1f\xe7\xb6\x73\xa2\x7e\xff\x47\x26\x16\xf5\x55\x03\xb7\xa5\xc2\x40\x4b\x90\x7b\x0d\x7d\xdb\xc0\x5d\x80\x5b\xec\xc8\xdf\x22\xd3\x7f\xdd\x01\x8a\x34\x2f\x15\xd8\xcb\x5c\x70\x5a\x1c\x8f\x3f\xe5\xb2\x1a\x50\x3b\xd9\x18\xcb\xd8\x8c\xbf\x4a\xfa\x3d\xf4\x64\xce\xf2\xc5\x12\xbb\xa4\x11\x2d\x28\x54\xf2\xe0\x50\xdc\x5e\xcf\xd2\xa1\xcc\x6d\xdc\x58\x8e\x4e\x7f\x4e\xa8\x9b\x20\x3f\xd3\xe8\xde\xe7\x8d\x0b\xdf\x57\xa9\x8a\x99\xe0\x36\xaf\x59\x7d\x4c\x0c\x6b\xb7\x99\xde\x7b\xa6\xcb\x6d\x39\xaa\xd2\x34\xa2\x66\x25\xbe\xe2\xab\xe3\x53\x6a\xe9\x25\x42\xb5\xf4\xbf\x8f\xc8\xa1\xec\x5d\x64\xea\x58\x36\xdf\x41\xf0\x4c\x24\x9e\x6e\x62\x4a\xb8\x5f\x5c\xf0\x12\x0b\x4a\x7e\xe6\xc8\x33\xbd\x6b\x00\x97\x53\x67\xf1\x12\x5b\xd6\x82\x7d\x12\x30\xc7\x06\xfd\xfd\x48\x31\x31\xd4\x26\xfb\x62\xe7\x99\xf8\xdb\x9c\x92\x36\xb0\x3e\x45\x43\xac\xed\xfc\x28\xf0\xd0\xde\x9a\x37\xa6\xc2\xed\xa8\xc5\xc1\xb2\xd2\x8f\xd4\xb0\xd2\xaf\x54\x08\x0e\x98\x81\x9a\x52\xa8\xe8\x27\xf8\x56\x24\xa9\x79\x43\xbe\x78\x64\x79\x4c\x18\xb8\x00\xa2\x13\xc4\xf4\xb9\x67\xca\x7f\x46\x16\x10\xd7\x51\x71\xc7\x01\x50\x90\x03\x2b\xb2\xb5\x74\xc7\x40\x83\x9f\x67\xf8\x82\xb6\x54\x0c\x51\xb6\x94\x1a\x78\xdc\xba\x43\x17\x6e\x09\x7e\xdd\x52\x28\x22\x72\xb0\x94\xfc\x5e\x5d\x70\x94\x66\xb6\x18\x36\x64\xa7\xec\xae\xcf\x9d\xfa\x09\x45\x5b\x96\x36\x81\xa7\x10\x7f\x0d\x57\xca\x2f\x40\xe6\xb1\x59\x15\x33\x0e\x82\x6e\xee\xef\x60\xed\xc8\xdb\x59\xa6\x83\x54\x65\x8a\xc6\x50\x2f\xd8\xfa\x49\xec\xf5\xbf\x8e\xa9\x43\xa9\x53\xd7\x52\x25\xbd\x2d\xeb\xb4\x2f\x30\xe3\xe6\x32\xef\xdc\xc0\x36\x77\x18\x20\x11\x5a\x55\x6e\x7c\x19\x5c\xb0\xce\xa0\xa8\xe5\x96\x04\x9d\x67\xc0\x36\x66\x59\x4c\x70\x2c\x7f\x85\xfe\xe8\xd3\xc1\x3d\x05\x9e\x32\xd8\xb4\x04\xd4\xf5\xb2\x6f\xde\x6a\x55\x22\xe4\x0b\x6b\xd8\xe3\x96\xd4\x20\x8e\xe1\x30\x5e\x1e\x22\xe1\x03\x97\x40\x3e\x51\x75\x86\xa3\xce\x68\xa7\xcd\x5f\x99\x6a\x13\x75\x6b\x67\x8a\xeb\xd5\x2a\xb3\x8d\x91\x4b\xec\x69\x4c\x42\x4c\x25\x78\x26\xa6\x98\xe3\x53\x21\x71\xac\x93\x23\x3b\x4b\xda\x5f\x11\x36\x19\x13\x06\x21\xb2\xca\xfb\x05\x7a\xca\xb5\x9d\x0b\x08\xf8\x41\x64\x99\x76\x94\x9c\xec\x2f\xc2\xf6\x61\x38\x0c\x7d\x8a\x3b\x67\x6b\x2d\xa2\x6f\xbd\x77\xc6\x09\x18\x8f\xcc\x8a\xd0\x58\x97\x66\x58\x02\x7c\xad\xfe\x31\xd1\xd2\x15\x70\x9d\x94\x33\xeb\x54\x17\x13\x74\x68\xb6\xa5\xce\x19\x0c\xe0\xba\x8e\xac\x43\x21\xbf\xaf\xb9\xcd\x82\x61\x3e\xe7\x94\x91\x19\xaa\x31\x3b\xc9\x55\x13\x9d\xaf\xd1\x08\xa0\x31\x31\x59\x17\x36\x7e\xaf\x20\xd3\xd1\x9e\xd7\x33\xf9\xcb\x4f\x0f\x8f\x3a\x19\x32\x09\xc4\xe0\xf7\xea\xf2\x00\x0f\xa5\x5a\xfd\xf1\x2f\xe8\x99\xde\x0e\xff\x44\x6b\x9b\x03\xbf\x1c\x1d\x7b\xca\x21\xa6\xaf\x4a\xe9\x8c\x6b\x78\x4c\x7a\x97\x28\xea\x5c\xc1\x4f\xa1\x14\xb1\x37\xeb\x55\x0e\x5c\xa2\xd5\xe3\xbe\x2f\xd2\x0f\xfa\x9c\x65\x8e\x26\x85\x0b\xb0\x8e\xb1\xa1\x6f\xd8\xf5\x9e\x1a\x13\xbb\xeb\x63\x66\xcd\x88\x00\xf8\x82\x61\x0f\xcd\x81\x6b\xa3\x0a\xd5\x6b\x04\x03\xa6\x62\x3f\x3b\x16\x6d\xbb\x68\x52\x64\x3e\xdc\x23\xe6\xb3\xcf\xbb\x27\x82\x9b\x1d\x3a\xaf\xd5\xee\x0a\xda\xac\x89\x65\x30\x33\x01\xa6\xd6\x49\xc2\xb4\x3f\x22\x5b\x23\x50\x6f\x04\x4c\xeb\x3c\xdd\x50\xf5\xf9\x7f\x26\x82\x26\x44\x4b\x4d\xed\x60\x47\xb5\xf9\x77\xa5\x8d\x00\xb6\xce\x6b\xde\x48\x04\x4b\x3a\x7b\x7a\x67\xb4\xdd\xcc\xf2\x74\x5d\x1f\x93\x60\x90\x37\x7a\x50\xef\x36\x3a\x2a\x4f\x45\xd6\x72\xa2\x9b\x4f\x1e\x9b\xed\xc7\xcb\x12\xd8\xef\x35\xf5\xd5\xe9\xe2\xaf\x84\x49\x5a\x42\x99\x1e\x2e\xce\x82\xee\xf3\xf3\xf3\xe3\x77\x05\x7f\x36\x6f\x94\xcf\x29\x7a\x79\x8c\x49\xc8\x82\x9b\x41\x1c\xbd\xdb\xd1\xe2\xb7\x77\xe4\x57\x5c\x30\x7b\xfd\x39\x33\xa3\xde\x72\x6a\x3c\xbf\x1c\x57\x0f\xa9\x3e\x30\x1b\x97\xe3\x47\xa2\xb7\x0c\x93\xc3\xbd\xea\x92\x49\x19\xe7\xc7\xff\xef\x0d\x8b\xbf\x65\x06\xf9\x32\x8c\x1c\x15\x98\x76\x50\xe7\x6c\x7c\xfe\x9f\xbb\x72\x15\xed\x2f\x92\xf9\xf0\x7e\x76\xfe\xf0\x3d\x0f\x52\x0e\x61\x8e\xfb\x0f\x16\x1c\x75\xc2\xfb\x95\x8b\x59\x53\x5f\x33\x72\x3a\x6d\x66\xad\x9a\x54\xed\x05\xb1\x26\xcd\x0a\x24\xe5\x5a\x1f\x58\x62\xd2\xd0\x3f\xa1\xe4\xf6\xd0\x99\x46\x8d\x59\x50\x32\xaf\xe0\x5f\xff\x5e\xfc\x67\x00\x5a\x00\x54\x74\x2c\x17\x00\x00")
 
 func crdsBasesEdgeFarosSh_agentsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -201,7 +207,7 @@ func crdsBasesEdgeFarosSh_agentsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBasesEdgeFarosSh_registrationsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\x23\xb9\x11\xbd\xeb\x57\x14\x90\xc3\x24\x80\xd5\x9e\x41\x2e\x81\x80\x1c\x0c\x27\x01\x8c\xec\x06\xc6\xd8\xbb\xf7\x12\x59\x52\x73\xcd\x26\x3b\xac\xa2\x76\x94\x20\xff\x3d\x28\xb2\x5b\x6a\x49\xdd\x1e\x23\xc1\xf2\xd6\x24\xbb\x3e\xde\xab\x7a\xc5\xf5\x7a\xbd\xc2\xde\xfd\x4c\x89\x5d\x0c\x1b\xc0\xde\xd1\x37\xa1\xa0\x5f\xdc\xbc\xfd\x89\x1b\x17\xef\x0f\x5f\x56\x6f\x2e\xd8\x0d\x3c\x66\x96\xd8\x7d\x25\x8e\x39\x19\xfa\x0b\xed\x5c\x70\xe2\x62\x58\x75\x24\x68\x51\x70\xb3\x02\xc0\x10\xa2\xa0\x6e\xb3\x7e\x02\x98\x18\x24\x45\xef\x29\xad\xf7\x14\x9a\xb7\xbc\xa5\x6d\x76\xde\x52\x2a\xc6\x47\xd7\x87\xcf\xcd\x97\xcf\xcd\xe7\x15\x80\x49\x54\xfe\x7f\x75\x1d\xb1\x60\xd7\x6f\x20\x64\xef\x57\x00\x01\x3b\xda\x40\xa2\xbd\x63\x49\xd5\x47\x43\x76\x4f\xcd\x0e\x53\xe4\x86\xdb\x15\xf7\x64\xd4\xed\x3e\xc5\xdc\x6f\xe0\xf2\xb0\x1a\x18\xc2\xaa\x29\x7d\x9d\xd8\x2a\xdb\xde\xb1\xfc\xfd\xe6\xe8\x07\xc7\x52\x8e\x7b\x9f\x13\xfa\xab\x18\xca\x09\xbb\xb0\xcf\x1e\xd3\xe5\xd9\x0a\x80\x4d\xec\x69\x03\xff\x50\xd7\x3d\x1a\xb2\x2b\x80\x21\xeb\x12\xca\x1a\xd0\xda\x82\x23\xfa\xe7\xe4\x82\x50\x7a\x8c\x3e\x77\x23\x7e\x6b\xf8\x85\x63\x78\x46\x69\x37\xd0\x8c\x48\x37\x37\x20\x95\xbb\x23\x44\x0f\x7b\x1a\xbe\xe5\xa8\xce\x2d\x4a\xdd\xa8\xc7\x87\x2f\xe8\xfb\x16\xbf\xd4\xc0\x4d\x4b\x1d\x6e\x86\xfb\xb1\xa7\xf0\xf0\xfc\xf4\xf3\x1f\x5f\x2e\xb6\x01\x2c\xb1\x49\xae\x97\x42\xd6\x14\x1b\x70\x0c\xd2\x12\xd4\x1f\x60\x17\x53\xf9\xbc\xb8\xf2\xf0\xfc\x74\x32\xd4\xa7\xd8\x53\x12\x37\x12\x51\xd7\xa4\x0a\x27\xbb\x57\x6e\x3f\x69\x64\xf5\x16\x58\x2d\x3f\xaa\xae\x07\x38\xc9\x0e\xc9\x40\xdc\x81\xb4\x8e\x21\x51\x9f\x88\x29\xc8\x99\xe0\xf3\x8a\x3b\xc0\x00\x71\xfb\x0b\x19\x69\xe0\x85\x92\x9a\x01\x6e\x63\xf6\x56\xab\xf6\x40\x49\x20\x91\x89\xfb\xe0\xfe\x75\xb2\xcd\x20\xb1\x38\xf5\x28\x34\x54\xc5\x79\x15\xfa\x02\x7a\x38\xa0\xcf\x74\x07\x18\x2c\x74\x78\x84\x44\xea\x05\x72\x98\xd8\x2b\x57\xb8\x81\x1f\x63\x22\x70\x61\x17\x37\xd0\x8a\xf4\xbc\xb9\xbf\xdf\x3b\x19\xbb\xcf\xc4\xae\xcb\xc1\xc9\xf1\xbe\x34\x92\xdb\x66\x89\x89\xef\x2d\x1d\xc8\xdf\xb3\xdb\xaf\x31\x99\xd6\x09\x19\xc9\x89\xee\xb1\x77\xeb\x12\x7a\xa8\xdd\xd1\xd9\xdf\xa5\xa1\x5f\xf9\xd3\x45\xac\xb5\x30\x58\x92\x0b\xfb\xc9\x41\x69\x8b\x77\x18\xd0\xde\x50\xc6\x71\xf8\xb5\x66\x71\x06\x5a\xb7\x0a\xfd\x7f\x7d\x79\x85\xd1\x75\x21\xe3\x1a\xfd\x82\xfb\xf9\x47\x3e\x53\xa0\x80\xb9\xb0\xa3\x54\x49\xdc\xa5\xd8\x15\x9b\x14\x6c\x1f\x5d\x90\xf2\x61\xbc\xa3\x70\x0d\x3f\xe7\x6d\xe7\x44\x79\xff\x67\x26\x16\xe5\xaa\x81\xc7\x22\x49\xb0\x25\xc8\xbd\x36\x82\x6d\xe0\x29\xc0\x23\x76\xe4\x1f\x91\xe9\x37\x27\x40\x91\xe6\xb5\x02\xfb\x31\x0a\xa6\x6a\x7a\x7d\xb9\xa2\x36\x39\x18\x05\x6f\x81\xaf\x69\x17\xbe\xf4\x64\x2e\xda\xc6\x12\xbb\xa4\x85\x2d\x28\xa4\xed\x90\xae\xd5\x70\xe2\x3e\xbe\x51\x18\x71\xfd\x50\x60\x82\x92\xf9\xa3\xa1\x95\xcb\x17\xc1\xc5\x2d\x6b\x39\x4c\xa2\xbb\xd1\xea\xeb\x62\xba\xd8\x9c\x97\x19\xa8\x03\xa9\xea\xed\xcd\xc9\x55\x84\x8f\x39\x25\x0a\xa2\xa6\x0c\xb1\x0a\xfc\x39\x18\x0d\xf1\x61\x4f\x41\x9a\x1b\x1b\x4e\xa8\x9b\x31\x7d\x6d\x7c\x8c\xe2\x94\x75\x11\x23\x4d\xba\x6a\xa6\xca\xd3\xd8\x25\x9a\x0a\xd6\x11\x31\x63\xb7\x82\x4d\xb7\x91\xbc\x87\x42\x5d\x1e\x59\x5e\x13\x06\x76\xe3\x30\x99\xbf\x77\x15\xfc\x0f\xc8\x02\xe2\x3a\xaa\xad\x78\x4a\x45\x4e\xa6\xc8\xd6\xbe\x8d\x81\x86\x52\x58\xb0\xab\xa5\x05\x18\xa2\xb4\x94\x1a\x78\xd5\x86\x1f\x24\x78\x4b\xf0\x6b\x4b\xa1\xb8\xc8\xc1\x52\xf2\x47\xa5\xe0\xec\xcd\xb4\x18\xf6\x64\xe7\xf2\xae\xeb\x49\x79\x42\x51\xbd\x52\x05\x78\x0b\xf1\xd7\x70\xa7\xf6\x02\x64\x1e\x95\xaa\xa4\x71\x72\xf4\xf0\xfc\x04\x3b\x47\xde\x2e\x1a\x1d\xbc\x16\x11\x34\x86\x7a\xc1\xad\x9f\xc5\x5e\xd7\x2e\xa6\x0e\xa5\x0e\xe0\xb5\x7a\x5a\xb8\xb7\x20\x05\xe7\xd5\x11\x33\xee\x3f\xc6\xce\x03\xb4\xb9\x43\xed\x56\xb4\x1a\xdc\xf8\x33\xb8\x60\x9d\xc1\xa2\xd1\x96\x04\x9d\x67\xc0\x6d\xcc\xd7\x2a\x3a\x09\x4b\xf1\x39\x71\x3a\xd0\x53\xe0\x29\x22\xbd\x25\xa0\xae\x97\xe3\x52\xf6\xdf\xcd\x2a\x11\xf2\xf5\xc4\x5f\x48\xea\xb5\xa5\xe1\xfa\xe9\x89\x71\xaa\x84\x4f\x5c\x0a\x79\x12\xea\x62\x46\xee\x42\xf9\xd5\xa8\x2a\xa8\xdb\x39\x53\xa8\xd7\xac\x4c\x1b\x23\x97\x92\xd0\x9a\x84\x98\x4a\xf1\xcc\x8c\xb0\xf3\xaa\x90\x38\xd6\x90\xd8\x59\x52\x55\x45\xd8\x67\x4c\x18\x84\xc8\xaa\xed\x1b\xf4\xea\x50\x5a\xb4\xf9\xff\x21\xcb\x74\xa0\xe4\xe4\xf8\x21\x6c\x5f\x86\xcb\x2a\x17\x07\x67\xab\x16\xd1\xb7\xde\x3b\xe3\x04\x8c\x47\x66\x45\x68\xd4\xa5\xc5\x90\xbf\x56\x7e\x4c\xb4\x74\x07\x5c\x9f\x49\x99\x75\xa4\xc7\x04\x1d\x9a\xb6\xe8\x9c\xc1\x00\xae\xeb\xc8\x3a\x14\xf2\xc7\xda\xdb\x2c\x18\x96\x7b\xae\xb0\x3d\xa8\x31\x3b\xc9\x35\x12\x7d\x5c\xa1\x11\x6d\xc3\x98\xac\x0b\x7b\x7f\xac\x94\x9e\xf2\x79\xbf\x93\x7f\xfc\xe9\xe5\x55\x6b\x98\x49\x20\x06\x7f\xac\x2a\x50\x67\xd1\x9f\xff\x86\x9e\x17\xdb\xfa\xfb\xf0\xcf\x4c\xbf\x25\xf0\xeb\xec\x1b\x66\xca\xa9\xa6\xef\x8a\x74\xc6\x1d\xbc\x26\x7d\x48\x96\x70\xee\xe0\xa7\x50\x44\xec\x7f\x8e\xab\x5c\xf8\x50\xbb\x1d\xfb\xe2\xfd\xac\xb6\xd3\xce\x51\x3e\x9d\x36\x62\x6c\xe8\x1b\x76\xbd\xa7\xc6\xc4\xee\xfe\xdc\x59\xcb\x98\x63\x38\x42\x73\xb2\xda\x68\x40\xc3\x4b\x18\x30\x51\x6d\x20\x16\x25\x1a\x4d\x8a\xcc\xa7\x47\xe4\x72\xf7\x79\xf7\x46\xf0\x70\x40\xe7\x55\xed\xee\x60\x9b\xb5\xb1\x0c\x66\x26\xc0\xb4\x75\x92\x30\x1d\x27\x63\xbf\x54\xe0\xb6\x94\xe6\x2e\xcf\x0f\x54\x5d\xbf\x67\x22\x68\x42\xb4\xd4\x54\x3a\xcf\x61\xf3\x1f\xca\x18\x01\xdc\x3a\xaf\x75\x26\x11\x2c\x99\x18\x76\xde\x19\x1d\x37\xcb\xf2\xd3\xf5\x31\x09\xce\x3d\x1b\x26\x04\x2d\x33\xa8\x0f\x30\x7d\xb3\xcd\x71\xb8\x9e\x99\xe6\xb3\xd7\x16\xe7\xf1\xba\xb8\x9f\x39\x58\x78\xe3\x4d\x0f\x31\x25\x3c\xce\x3d\x1a\xbf\xf3\xc8\xd2\x7e\xad\x8f\xcb\xcc\x64\x15\xc9\xfa\x0a\xa5\xaa\xf1\xb8\xbf\x7d\xe5\xbf\x83\xd2\x6c\xa0\x37\x9b\xf5\x65\xb9\x01\x49\xb9\x66\xcb\x12\x93\x4e\xd7\xc9\x4e\xde\x9e\x0a\x6f\xcc\x60\x68\x6a\xf8\xf7\x7f\x56\xff\x0d\x00\x00\xff\xff\x56\xb1\xaf\xa1\x39\x11\x00\x00")
+var _crdsBasesEdgeFarosSh_registrationsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcd\x6e\xeb\xca\x0d\xde\xfb\x29\x08\x74\x71\x5a\x20\x96\xcf\x41\x37\x85\x81\x2e\x82\xb4\x05\x82\xde\x53\x04\x89\xef\xdd\x53\x33\xb4\x34\x37\xa3\x19\x75\xc8\xf1\x3d\x6e\xd1\x77\x2f\x38\x92\x2d\xdb\x91\x82\x20\x45\x3d\xde\x68\x7e\xf8\xf3\x91\xfc\xc8\xf5\x7a\xbd\xc2\xde\xfd\x42\x89\x5d\x0c\x5b\xc0\xde\xd1\x0f\xa1\xa0\x5f\x5c\xbd\xfe\x89\x2b\x17\x37\x87\x6f\xab\x57\x17\xec\x16\x1e\x32\x4b\xec\x9e\x89\x63\x4e\x86\xfe\x42\x7b\x17\x9c\xb8\x18\x56\x1d\x09\x5a\x14\xdc\xae\x00\x30\x84\x28\xa8\xdb\xac\x9f\x00\x26\x06\x49\xd1\x7b\x4a\xeb\x86\x42\xf5\x9a\x6b\xaa\xb3\xf3\x96\x52\x11\x7e\x52\x7d\xf8\x5a\x7d\xfb\x5a\x7d\x5d\x01\x98\x44\xe5\xfd\xce\x75\xc4\x82\x5d\xbf\x85\x90\xbd\x5f\x01\x04\xec\x68\x0b\x89\x1a\xc7\x92\xca\x1d\xae\xc8\x36\x54\xed\x31\x45\xae\xb8\x5d\x71\x4f\x46\xd5\x36\x29\xe6\x7e\x0b\xd7\x87\x83\x80\xd1\xac\xc1\xa5\xe7\x0b\x59\x65\xdb\x3b\x96\xbf\xbf\x39\xfa\xc9\xb1\x94\xe3\xde\xe7\x84\xfe\xc6\x86\x72\xc2\x2e\x34\xd9\x63\xba\x3e\x5b\x01\xb0\x89\x3d\x6d\xe1\x1f\xd8\x11\xf7\x68\xc8\xae\x00\x46\xaf\x8b\x29\x6b\x40\x6b\x0b\x8e\xe8\x9f\x92\x0b\x42\xe9\x21\xfa\xdc\x9d\xf0\x5b\xc3\xaf\x1c\xc3\x13\x4a\xbb\x85\xea\x84\x74\xf5\x06\xa4\x62\xc4\x09\xa2\xfb\x86\xc6\x6f\x39\xaa\x72\x8b\x32\x6c\x0c\xc7\x87\x6f\xe8\xfb\x16\xbf\x95\x2d\x36\x2d\x75\x25\x74\xfa\x15\x7b\x0a\xf7\x4f\x8f\xbf\xfc\xf1\xe5\x6a\x1b\xc0\x12\x9b\xe4\x7a\xd5\x79\x8d\x0d\x38\x06\x69\x09\x86\x07\xb0\x8f\xa9\x7c\x5e\x5d\xb9\x7f\x7a\x3c\x0b\xea\x53\xec\x29\x89\x3b\x05\x62\x58\x17\x59\x78\xb1\x7b\xa3\xf6\x8b\x5a\x36\xdc\x02\xab\xe9\x47\x83\xea\x11\x4e\xb2\xa3\x33\x10\xf7\x20\xad\x63\x48\xd4\x27\x62\x0a\x32\x05\x78\x5a\x71\x0f\x18\x20\xd6\xbf\x92\x91\x0a\x5e\x28\xa9\x18\xe0\x36\x66\x6f\x35\x6b\x0f\x94\x04\x12\x99\xd8\x04\xf7\xaf\xb3\x6c\x06\x89\x45\xa9\x47\xa1\x31\x2b\xa6\x55\xc2\x17\xd0\xc3\x01\x7d\xa6\x3b\xc0\x60\xa1\xc3\x23\x24\x52\x2d\x90\xc3\x85\xbc\x72\x85\x2b\xf8\x1e\x13\x81\x0b\xfb\xb8\x85\x56\xa4\xe7\xed\x66\xd3\x38\x39\x55\x9f\x89\x5d\x97\x83\x93\xe3\xa6\x14\x92\xab\xb3\xc4\xc4\x1b\x4b\x07\xf2\x1b\x76\xcd\x1a\x93\x69\x9d\x90\x91\x9c\x68\x83\xbd\x5b\x17\xd3\x83\x3a\xcc\x55\x67\x7f\x97\xc6\x7a\xe5\x2f\x57\xb6\x0e\x89\xc1\x92\x5c\x68\x2e\x0e\x4a\x59\xbc\x13\x01\xad\x0d\x8d\x38\x8e\x4f\x07\x47\x27\xa0\x75\x4b\xd1\x79\xfe\xeb\xcb\x0e\x4e\xaa\x4b\x30\xae\x84\xc2\x88\xfb\xf4\x90\xa7\x10\x28\x60\x2e\xec\x49\x13\xc9\x31\xec\x53\xec\x0a\xe2\x14\x6c\x1f\x5d\x90\xf2\x61\xbc\xa3\x70\x0b\x3f\xe7\xba\x73\xa2\x71\xff\x67\x26\x16\x8d\x55\x05\x0f\x85\x92\xa0\x26\xc8\xbd\x16\x82\xad\xe0\x31\xc0\x03\x76\xe4\x1f\x90\xe9\xff\x1e\x00\x45\x9a\xd7\x0a\xec\xc7\x42\x70\xc9\xa6\xd3\x4f\xa5\x6c\x47\xd4\x2e\x0e\x4e\x84\xb7\x10\xaf\xcb\x2a\x7c\xe9\xc9\x5c\x95\x8d\x25\x76\x49\x13\x5b\x50\x48\x6b\xe6\x86\xb9\x2e\x97\xc4\x57\x0a\x27\x5c\x3f\x64\x98\xa0\x64\xfe\xa8\x69\xe5\xf2\x95\x71\xb1\x66\xad\xc8\x0b\xeb\x2e\x1f\xcc\x26\xd3\xd5\xe6\x3c\xcd\x8c\x0d\x69\xe0\xdb\x37\x27\x37\x16\x3e\xe4\x94\x28\x08\xf4\x29\x1a\x62\x25\xf8\xc9\x18\x4d\xc1\xfb\x86\x82\x54\x6f\x64\x38\xa1\x6e\x46\xf4\xad\xf0\x93\x15\x67\xaf\x0b\x19\xa9\xd3\x85\xac\x54\x09\x8e\x8e\x81\xba\x52\x76\xd1\xcf\xc8\x1d\xc0\xa6\xb7\x96\xbc\x87\xc2\xb0\x3c\xb2\xec\x12\x06\x2e\x80\x68\x33\x99\xbf\x77\x63\xfc\x4f\xc8\x02\xe2\x3a\x2a\xa1\x3a\x03\x0a\x72\x16\x45\x76\xa8\xdb\x18\x68\x4c\x85\x05\xb9\x9a\x5a\x80\x21\x4a\x4b\xa9\x82\x5d\xeb\xce\x14\x5c\x13\xfc\xd6\x52\x28\x2a\x72\xb0\x94\xfc\x51\x43\x30\x69\x33\x2d\x86\x86\xec\x9c\xdf\xc3\x7a\xd4\x38\xa1\x28\x5f\x29\x03\xbc\x86\xf8\x5b\xb8\x53\x79\x01\x32\x9f\x98\xaa\xb8\x71\x56\x74\xff\xf4\x08\x7b\x47\xde\x2e\x0a\x1d\xb5\xaa\x50\x34\x86\x7a\xc1\xda\xcf\x62\xaf\xff\x7d\x4c\x1d\xca\xd0\x80\xd7\xaa\x69\xe1\xde\x02\x15\x4c\xab\x23\x66\x6c\x3e\x16\x9d\x7b\x68\x73\x87\x5a\xad\x68\xd5\xb8\xd3\x63\x70\xc1\x3a\x83\xa2\x9e\x5b\x12\x74\x9e\x01\xeb\x98\x65\x35\x23\xb1\xfc\x15\xfa\x29\xa6\x63\x78\x0a\x3c\xa5\xab\xd5\x04\xd4\xf5\x72\xac\x3e\xeb\x55\x22\xe4\xdb\x8e\xbf\xe0\xd4\xae\x25\x75\x88\x63\x38\x8f\x18\xe7\x4c\xf8\xc2\x25\x91\x2f\x4c\x5d\x90\xa8\x0d\xfa\x92\xf9\x55\xa8\x32\xa8\xdb\x3b\x53\x42\xaf\x5e\x99\x36\x46\x2e\xb9\xa7\x39\x09\x31\x95\xe4\x99\x69\x61\xd3\x1a\x20\x71\xac\x63\x03\x3b\x4b\xca\xaa\x08\x4d\xc6\x84\x41\x88\xac\xca\x7e\x83\x9e\x4a\xad\x97\x12\x02\xfe\x47\x64\x99\x0e\x94\x9c\x1c\x3f\x84\xed\xcb\x78\x59\xe9\xe2\xe0\xec\xc0\x45\xf4\xa3\xf7\xce\x38\x01\xe3\x91\x59\x11\x3a\xf1\xd2\x82\x48\x80\xe7\x21\x3e\x26\x5a\xba\x03\x1e\xc6\xa4\xcc\xda\xd2\x63\x82\x0e\x4d\x5b\x78\xce\x60\x00\xd7\x75\x64\x1d\x0a\xf9\xe3\x50\xdb\x2c\x18\x96\x6b\x4e\x05\x99\x91\x8d\xd9\x49\x1e\x2c\xd1\xe1\x0a\x8d\x00\x1a\x13\x93\x75\xa1\xf1\x47\x05\x99\x26\x7f\xde\xaf\xe4\xef\x3f\xbf\xec\x74\x2c\x60\x12\x88\xc1\x1f\x35\xe4\x01\x5e\x0a\x5b\xfd\xf9\x6f\xe8\x99\x3e\x0f\xff\x4c\xf7\x5b\x02\xbf\x5c\x3d\xf5\x94\x73\x4e\xdf\x15\xea\x8c\x7b\xd8\x25\x1d\x24\x8b\x39\x77\xf0\x73\x28\x24\xf6\x69\xbb\xca\x85\x8f\x58\xb5\x3b\xf6\x45\xfb\xd9\x9e\xab\xca\xd1\xa2\x70\x01\xf6\x31\x56\xf4\x03\xbb\xde\x53\x65\x62\xb7\x99\x2a\x6b\x41\x05\xc0\x77\x0c\x47\xa8\xce\x52\x2b\x35\x68\x98\x21\x19\x30\x15\xff\xd9\xb1\x68\xdb\x45\x93\x22\xf3\x79\x88\x5c\xae\x3e\xef\x5e\x09\xee\x0f\xe8\xbc\xb2\xdd\x1d\xd4\x59\x0b\xcb\x60\x66\x02\x4c\xb5\x93\x84\xe9\x38\x21\x3b\x64\xa0\x8e\x83\x4c\xfb\x3c\xdf\x50\x75\xfd\x9e\x89\xa0\x0a\xd1\x52\x35\x74\xb0\xc9\x6c\xfe\x43\x69\x23\x80\xb5\xf3\x5a\x37\x12\xc1\x92\x89\x61\xef\x9d\xd1\x76\xb3\x28\xd3\x75\x7d\x4c\x82\x41\x3e\x19\x41\x1d\xc0\x74\x66\x9b\xcb\xac\xf5\x4c\x37\x9f\xbd\xb6\xd8\x8f\xd7\x25\xb1\x67\x0e\x16\x66\xbc\xcb\x43\x4c\x09\x8f\x37\x67\x65\x68\xdc\xae\xde\x4f\x34\x6d\x31\x7a\x4f\xc3\x61\x75\x18\x18\xa6\xd0\x32\xfd\x13\x60\x43\x61\x49\xe5\x0c\x4a\xb3\x86\xbe\xd9\xd4\x21\x8b\xec\x16\x24\xe5\x81\x7f\x59\x62\xd2\xee\x7a\xb1\x93\xeb\x73\xe2\x9d\x3c\x60\x41\xc9\xbc\x85\x7f\xff\x67\xf5\xdf\x01\x00\x56\xb1\xaf\xa1\x39\x11\x00\x00")
 
 func crdsBasesEdgeFarosSh_registrationsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -221,7 +227,7 @@ func crdsBasesEdgeFarosSh_registrationsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBasesPluginsFarosSh_accessesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcf\x6f\xe3\xba\x11\xbe\xfb\xaf\x18\xa0\x87\x6d\x81\x58\xde\xa0\x97\xc2\x40\x0f\x46\xda\x02\x41\xdf\x2b\x82\x4d\x76\xef\x23\x72\x6c\x71\x43\x91\x2a\x67\xe8\x5d\xb7\xe8\xff\x5e\x0c\x29\xd9\x8e\x63\x25\x41\x8b\xc7\x9b\x48\x6a\x7e\x7c\xdf\xcc\x37\x5c\x2e\x97\x0b\x1c\xdc\x37\x4a\xec\x62\x58\x03\x0e\x8e\x7e\x0a\x05\xfd\xe2\xe6\xf9\x4f\xdc\xb8\xb8\xda\xdf\x2e\x9e\x5d\xb0\x6b\xb8\xcb\x2c\xb1\xff\x42\x1c\x73\x32\xf4\x17\xda\xba\xe0\xc4\xc5\xb0\xe8\x49\xd0\xa2\xe0\x7a\x01\x80\x21\x44\x41\xdd\x66\xfd\x04\x30\x31\x48\x8a\xde\x53\x5a\xee\x28\x34\xcf\xb9\xa5\x36\x3b\x6f\x29\x15\xe3\x93\xeb\xfd\xe7\xe6\xf6\x73\xf3\x79\x01\x60\x12\x95\xff\x9f\x5c\x4f\x2c\xd8\x0f\x6b\x08\xd9\xfb\x05\x40\xc0\x9e\xd6\x80\xc6\x10\x33\x71\x33\xf8\xbc\x73\x81\x9b\x2d\xa6\xc8\x0d\x77\x0b\x1e\xc8\xa8\xd3\x5d\x8a\x79\x58\xc3\xab\xf3\x6a\x61\x8c\xab\xe6\xb4\x29\xc6\xca\x86\x77\x2c\x7f\x3f\xdb\xfc\xc5\xb1\x94\x83\xc1\xe7\x84\xfe\xe4\xb8\x6c\xb2\x0b\xbb\xec\x31\x4d\xdb\x0b\x00\x36\x71\xa0\x35\xfc\x43\x7d\x0c\x68\xc8\x2e\x00\xc6\xfc\x8a\xcf\x25\xa0\xb5\x05\x31\xf4\x0f\xc9\x05\xa1\x74\x17\x7d\xee\x27\xa4\x96\xf0\x9d\x63\x78\x40\xe9\xd6\xd0\x4c\x98\x36\xaf\xe0\x28\x77\x27\x30\x36\x3b\x1a\xbf\xe5\xa0\xce\x2d\x4a\xdd\xa8\xc7\xfb\x5b\xf4\x43\x87\xb7\x35\x64\xd3\x51\x8f\xeb\xf1\x7e\x1c\x28\x6c\x1e\xee\xbf\xfd\xf1\xf1\xc5\x36\x80\x25\x36\xc9\x0d\x52\x68\xa9\x50\x80\x63\x90\x8e\xa0\x5e\x85\x6d\x4c\xe5\xb3\x02\x0c\x9b\x87\xfb\xe3\xcf\x43\x8a\x03\x25\x71\x13\xca\x75\x9d\xd5\xd8\xd9\xee\x85\xab\x4f\x1a\x4d\xbd\x05\x56\x8b\x8b\xaa\xd3\x11\x42\xb2\x63\x02\x10\xb7\x20\x9d\x63\x48\x34\x24\x62\x0a\xb5\xdc\x5e\x18\x06\xbd\x84\x01\x62\xfb\x9d\x8c\x34\xf0\x48\x49\xcd\x00\x77\x31\x7b\xab\x35\xb9\xa7\x24\x90\xc8\xc4\x5d\x70\xff\x3a\xda\x66\x90\x58\x9c\x7a\x14\x1a\xe9\x3f\xad\x42\x59\x40\x0f\x7b\xf4\x99\x6e\x00\x83\x85\x1e\x0f\x90\x48\xbd\x40\x0e\x67\xf6\xca\x15\x6e\xe0\xd7\x98\x08\x5c\xd8\xc6\x35\x74\x22\x03\xaf\x57\xab\x9d\x93\xa9\xb7\x4c\xec\xfb\x1c\x9c\x1c\x56\xa5\x4d\x5c\x9b\x25\x26\x5e\x59\xda\x93\x5f\xb1\xdb\x2d\x31\x99\xce\x09\x19\xc9\x89\x56\x38\xb8\x65\x09\x3d\x94\xfe\x6a\x7a\xfb\xbb\x34\x76\x23\x7f\x7a\x11\x6b\x2d\x06\x96\xe4\xc2\xee\xec\xa0\xd4\xfc\x1b\x0c\x68\xf9\x2b\xd7\x38\xfe\x5a\xb3\x38\x01\xad\x5b\x8a\xce\x97\xbf\x3e\x3e\xc1\xe4\xba\x90\x71\x89\x7e\xc1\xfd\xf4\x23\x9f\x28\x50\xc0\x5c\xd8\x52\xaa\x24\x6e\x53\xec\x8b\x4d\x0a\x76\x88\x2e\x48\xf9\x30\xde\x51\xb8\x84\x9f\x73\xdb\x3b\x51\xde\xff\x99\x89\x45\xb9\x6a\xe0\xae\x08\x0e\xb4\x04\x79\xd0\xe2\xb7\x0d\xdc\x07\xb8\xc3\x9e\xfc\x1d\x32\xfd\xe6\x04\x28\xd2\xbc\x54\x60\x3f\x46\xc1\xb9\x56\x5e\x5e\xae\xa8\x9d\x1d\x4c\x82\x36\xc3\x57\x6d\xce\xc7\x81\xcc\x8b\x86\xb1\xc4\x2e\x69\x49\x0b\x0a\x69\x23\xd4\x3e\xfd\x90\x43\x41\xc9\xfc\xbe\xcb\x72\xed\x85\xd3\xd8\xb2\x12\xfc\xb6\xd7\xeb\xea\x00\x75\x4a\x54\x69\x7c\x75\x72\x11\xc0\x5d\x4e\x89\x82\xa8\x29\x8d\x44\x2b\xf2\xe8\x51\xe3\xd8\xec\x28\x48\xf3\xca\x86\x13\xea\xaf\x98\xbe\x34\x3e\x45\x71\x4c\xad\x68\x88\x66\x56\x34\xa6\xa8\xca\x54\xdc\x9a\x0a\x56\x35\xbf\x62\xb7\x62\x49\xaf\x23\x79\x0b\x85\xba\x3c\xb2\x3c\x25\x0c\xec\x26\xdd\xbf\x7e\xef\x22\xf8\x5f\x90\x05\xc4\xf5\x54\x3b\xe8\x98\x8a\x1c\x4d\x91\xad\xed\x16\x03\x8d\x4c\xcf\xd8\x05\x95\x41\x0c\x51\x3a\x4a\x0d\x3c\x69\x9f\x8e\xca\xd9\x12\xfc\xe8\x28\x14\x17\x39\x58\x4a\xfe\xa0\x14\x9c\xbc\x99\x0e\xc3\x8e\xec\xb5\xbc\xeb\xba\x57\x9e\x50\x54\x66\xb4\x71\x9f\x43\xfc\x11\x6e\xd4\x5e\x80\xcc\x93\xc0\x94\x34\x8e\x8e\x36\x0f\xf7\xb0\x75\xe4\xed\xac\xd1\xd1\x6b\xd1\x2e\x63\x68\x10\x6c\xfd\x55\xec\x75\x6d\x63\xea\x51\xea\xac\x5c\xaa\xa7\x99\x7b\x33\x1d\x7c\x5a\x3d\x31\xe3\xee\x63\xec\x6c\xa0\xcb\x3d\x06\x48\x84\x56\x83\x9b\x7e\x06\x17\xac\x33\x58\xa4\xd5\x92\xa0\xf3\x0c\xd8\xc6\x7c\x29\x7e\x67\x61\x29\x3e\x47\x4e\x47\x7a\x0a\x3c\x45\x5b\x5b\x02\xea\x07\x39\xcc\x65\xff\x6e\x56\x89\x90\x2f\x07\xf5\x4c\x52\x4f\x1d\x8d\xd7\x8f\x6f\x82\x63\x25\x7c\xe2\x52\xc8\x67\xa1\xce\x66\xe4\x5e\x08\xb6\x1a\x55\xe1\x73\x5b\x67\x0a\xf5\x9a\x95\xe9\x62\xe4\x52\x12\x5a\x93\x10\x53\x29\x9e\x2b\x93\xe7\xb4\x2a\x24\x8e\x35\x24\x76\x96\x54\x12\x11\x76\x19\x13\x06\x21\xb2\x6a\xfb\x15\x7a\x75\x96\xcc\xda\xfc\xff\x90\x65\xda\x53\x72\x72\xf8\x10\xb6\x8f\xe3\x65\x95\x8b\xbd\xb3\x55\x8b\xe8\xe7\xe0\x9d\x71\x02\xc6\x23\xb3\x22\x34\xe9\xd2\x6c\xc8\x5f\x2a\x3f\x26\x5a\xba\x01\xae\xaf\x9b\xcc\x3a\x89\x63\x82\x1e\x4d\x57\x74\xce\x60\x00\xd7\xf7\x64\x1d\x0a\xf9\x43\xed\x6d\x16\x0c\xf3\x3d\x57\xd8\x1e\xd5\x98\x9d\xe4\x1a\x89\xbe\x89\xd0\x88\xb6\x61\x4c\xd6\x85\x9d\x3f\x54\x4a\x8f\xf9\xbc\xdd\xc9\xbf\x7e\x7d\x7c\xd2\x1a\x66\x12\x88\xc1\x1f\xaa\x0a\xd4\x81\xf3\xe7\xbf\xa1\xe7\xd9\xb6\x7e\x1f\xfe\x2b\xc3\x6d\x0e\xfc\x3a\xe0\xc6\x99\x72\xac\xe9\x9b\x22\x9d\x71\x0b\x4f\x49\xdf\x7f\x25\x9c\x1b\xf8\x1a\x8a\x88\xfd\xcf\x71\x95\x0b\x1f\x6a\xb7\xc3\x50\xbc\x9f\xd4\xf6\xbc\x73\x94\x4f\xa7\x8d\x18\x1b\xfa\x89\xfd\xe0\xa9\x31\xb1\x5f\x9d\x3a\x6b\x1e\x73\x0c\x07\x68\x8e\x56\x1b\x0d\x68\x7c\xc0\x02\x26\xaa\x0d\xc4\xa2\x44\xa3\x49\x91\xf9\xf8\xf6\x9b\xef\x3e\xef\x9e\x09\x36\x7b\x74\x5e\xd5\xee\x06\xda\xac\x8d\x65\x30\x33\x01\xa6\xd6\x49\xc2\x74\x38\x1b\xfb\xa5\x02\xdb\x52\x9a\xdb\x7c\x7d\xa0\xea\xfa\x3d\x13\x41\x13\xa2\xa5\xa6\xd2\x79\x0a\x9b\xff\x50\xc6\x08\x60\xeb\xbc\xd6\x99\x44\xb0\x64\x62\xd8\x7a\x67\x74\xdc\xcc\xcb\x4f\x3f\xc4\x24\x78\xed\xd9\x70\x46\xd0\x3c\x83\xfa\x1e\xd5\x07\xd7\x35\x0e\x97\x57\xa6\xf9\xd5\x6b\xb3\xf3\x78\x59\xdc\x5f\x39\x98\x79\xc2\x9d\x1f\x62\x4a\x78\x78\xff\xdd\xf7\x6a\xb3\x3e\xe5\xd6\x20\x29\x57\xcf\x2c\x31\xe9\xa4\x3b\xdb\xc9\xed\xb1\x08\xa6\xc4\xc7\x06\x83\x7f\xff\x67\xf1\xdf\x00\x00\x00\xff\xff\xb1\xb8\xdf\x9e\x5a\x10\x00\x00")
+var _crdsBasesPluginsFarosSh_accessesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\xdb\x6e\x24\xb9\xcd\xbe\xef\xa7\x20\xf0\x5f\xcc\x1f\xc0\x5d\x1e\x67\x30\x41\xd0\x40\x2e\x0c\xef\x2e\x62\x64\x67\x61\x8c\x3d\x7b\xcf\x2a\xb1\xbb\xb4\x56\x49\x15\x91\x6a\x4f\x27\xc8\xbb\x07\x54\x1d\xfa\x54\x65\x77\x1c\x6c\xa6\x7c\x31\x2d\x51\x3c\x7c\x24\x3f\x1d\x96\xcb\xe5\x02\x5b\xfb\x2b\x45\xb6\xc1\xaf\x00\x5b\x4b\xdf\x85\xbc\xfe\xe2\xe2\xf9\xcf\x5c\xd8\x70\xbd\xbd\x59\x3c\x5b\x6f\x56\x70\x97\x58\x42\xf3\x95\x38\xa4\x58\xd1\x0f\xb4\xb6\xde\x8a\x0d\x7e\xd1\x90\xa0\x41\xc1\xd5\x02\x00\xbd\x0f\x82\x3a\xcc\xfa\x13\xa0\x0a\x5e\x62\x70\x8e\xe2\x72\x43\xbe\x78\x4e\x25\x95\xc9\x3a\x43\x31\x2b\x1f\x4c\x6f\x3f\x16\x37\x1f\x8b\x8f\x0b\x80\x2a\x52\x5e\xff\x64\x1b\x62\xc1\xa6\x5d\x81\x4f\xce\x2d\x00\x3c\x36\xb4\x02\xac\x2a\x62\x26\x2e\x5a\x97\x36\xd6\x73\xb1\xc6\x18\xb8\xe0\x7a\xc1\x2d\x55\x6a\x74\x13\x43\x6a\x57\x70\x36\xdf\x69\xe8\xfd\xea\x62\xba\xcd\xca\xf2\x80\xb3\x2c\x7f\x3b\x18\xfc\xd9\xb2\xe4\x89\xd6\xa5\x88\x6e\x6f\x38\x0f\xb2\xf5\x9b\xe4\x30\x0e\xc3\x0b\x00\xae\x42\x4b\x2b\xf8\x05\x1b\xe2\x16\x2b\x32\x0b\x80\x3e\xbe\x6c\x73\x09\x68\x4c\x46\x0c\xdd\x43\xb4\x5e\x28\xde\x05\x97\x9a\x01\xa9\x25\xfc\xc6\xc1\x3f\xa0\xd4\x2b\x28\x06\x4c\x8b\x33\x38\xb2\xf9\x01\x8c\xdb\x0d\xf5\xbf\x65\xa7\xc6\x0d\x4a\x37\xd0\x4d\x6f\x6f\xd0\xb5\x35\xde\xe4\x21\xae\x6a\x6a\x72\x92\xf4\x57\x68\xc9\xdf\x3e\xdc\xff\xfa\xe9\xf1\x68\x18\xc0\x10\x57\xd1\xb6\x6a\x73\x80\x02\x2c\x83\xd4\x04\x9d\x28\xac\x43\xcc\x3f\x3b\x80\xe1\xf6\xe1\x7e\x5c\xdc\xc6\xd0\x52\x14\x3b\xa0\xdc\x7d\x07\x35\x76\x30\x7a\x62\xea\x83\x7a\xd3\x49\x81\xd1\xe2\xa2\xce\x68\x0f\x21\x99\x3e\x00\x08\x6b\x90\xda\x32\x44\x6a\x23\x31\xf9\xae\xdc\x8e\x14\x83\x0a\xa1\x87\x50\xfe\x46\x95\x14\xf0\x48\x51\xd5\x00\xd7\x21\x39\xa3\x35\xb9\xa5\x28\x10\xa9\x0a\x1b\x6f\xff\x31\xea\x66\x90\x90\x8d\x3a\x14\xea\xd3\xbf\xff\x72\xca\x3c\x3a\xd8\xa2\x4b\x74\x05\xe8\x0d\x34\xb8\x83\x48\x6a\x05\x92\x3f\xd0\x97\x45\xb8\x80\x2f\x21\x12\x58\xbf\x0e\x2b\xa8\x45\x5a\x5e\x5d\x5f\x6f\xac\x0c\xbd\x55\x85\xa6\x49\xde\xca\xee\x3a\xb7\x89\x2d\x93\x84\xc8\xd7\x86\xb6\xe4\xae\xd9\x6e\x96\x18\xab\xda\x0a\x55\x92\x22\x5d\x63\x6b\x97\xd9\x75\xaf\x01\x73\xd1\x98\xff\x8b\x7d\x37\xf2\x87\x23\x5f\xbb\x62\x60\x89\xd6\x6f\x0e\x26\x72\xcd\xbf\x92\x01\x2d\x7f\xcd\x35\xf6\x4b\xbb\x40\xf7\x40\xeb\x90\xa2\xf3\xf5\xc7\xc7\x27\x18\x4c\xe7\x64\x1c\x29\x85\x1e\xf7\xfd\x42\xde\xa7\x40\x01\xb3\x7e\x4d\x5a\x42\x96\x61\x1d\x43\x93\x11\x27\x6f\xda\x60\xbd\xe4\x1f\x95\xb3\xe4\x4f\xe1\xe7\x54\x36\x56\x34\xef\x7f\x4f\xc4\xa2\xb9\x2a\xe0\x2e\x13\x0e\x94\x04\xa9\xd5\xe2\x37\x05\xdc\x7b\xb8\xc3\x86\xdc\x1d\x32\xfd\xee\x09\x50\xa4\x79\xa9\xc0\x5e\x96\x82\x43\xae\xdc\xff\x53\x2d\xab\x1e\xb5\x83\x89\x81\xd0\x66\xf2\xd5\x35\xe7\x63\x4b\xd5\x51\xc3\x18\x62\x1b\xb5\xa4\x05\x85\xb4\x11\xba\x3e\x3d\xd2\x33\xdd\xa7\xfa\xe1\x86\xbc\x9c\x0e\x9e\x1a\x56\x99\x81\x14\x94\x69\xd4\x8a\xfe\x3f\x2f\xbe\x02\xeb\xc7\x99\xcc\x84\xfd\xf4\x99\x52\xe8\x5d\xbb\xea\x49\x54\x55\xb6\x31\x6c\xad\x21\x03\x12\xce\xe4\x67\x30\xd5\xbf\x36\x44\xf9\x29\xc4\x17\x8c\x86\xdf\xf0\xfe\xe1\x40\x14\x30\x52\xf6\x55\x30\x6e\x28\x17\x17\x56\x35\x96\x8e\xf6\x95\x99\x83\x82\xc4\x14\xf9\x4c\x31\x40\x85\x5e\x09\x51\xb5\x81\x0b\x15\xba\xec\x0a\x4f\xb9\x6f\x85\x9a\x09\xe7\xe6\xdd\x3b\x4a\x2d\xc2\xd3\xdd\x43\xef\xe7\x84\x9b\x13\x6a\xfb\x64\x4e\xcc\xcc\xa7\xbf\x5f\xe7\x5c\x78\x21\xf3\x4d\x63\x9e\x96\x38\x71\xfa\xf6\x60\xc1\x88\x29\x35\x68\x1d\x0f\xb5\xf1\x12\xe2\x73\x57\x0d\x0d\x35\xe5\x34\x98\x47\xd6\x95\x8b\x07\x64\x7b\x5a\xee\xa2\x2f\xe0\xd6\xb9\x73\x7d\xd9\x6e\xbf\x76\x56\xb7\x5d\x03\x35\xad\xec\x8a\x19\x89\xd9\x1c\xbd\x59\x81\xc3\xd7\x89\x60\x8c\xb8\x9b\x94\xa8\x03\xcb\x45\x98\xfe\x35\xf0\xd8\x64\xba\xe8\xa0\x18\x8d\x45\xc7\x57\x60\x0b\x2a\xba\xa2\xd3\xf9\xc5\x3b\x5d\xd6\x06\xbe\xc8\xa1\x5f\x0e\x3a\x5d\x8b\x7c\xc8\xce\x7b\x0d\xab\x8e\x8b\x0c\x6b\xc7\x0e\x48\xe8\xa2\x53\x24\x66\x74\xac\x43\x6c\x50\x56\x60\xbd\x7c\xfa\xe3\x8c\x4c\x83\xdf\x6d\x93\x9a\x15\xfc\xe9\xf3\xe7\x4f\x9f\xe7\x84\xac\xef\x84\x6e\x66\x04\xba\x9c\xeb\xf9\x60\x43\x71\x42\x46\xb7\x2c\xe5\xe4\xa9\x68\x97\x30\x93\xbc\x65\x66\x92\x89\x89\x99\xdd\xe2\xad\xf2\xe3\x9a\x9c\x5b\x2d\x5e\x45\xfa\x51\x65\xf4\x7c\xb4\xb6\x9b\x14\x49\xf9\xb0\x09\x42\xdd\x5a\x60\x62\x3d\x9e\x8d\xa7\xa4\x4c\x2f\x05\x7c\xcd\x32\x67\x8a\x7b\x8b\x9a\x38\x63\x59\xc9\xca\x40\xf2\x4e\x79\x9e\x7c\xf7\xb3\xdc\x01\xfa\xe1\x90\xd9\x57\x56\x56\x3a\x6c\x21\x63\x93\x17\x8b\xff\x8c\xc3\xde\x66\xb0\xdf\x8b\xbf\x7a\xcb\x8a\x11\x0b\x46\x19\x51\xbb\x80\xb6\xde\xa0\xa7\x57\xc9\xe9\xcd\x76\x7b\x9d\x98\xf4\x24\x84\xa7\xc7\xc3\x49\xac\xee\x3a\x49\xcd\x6b\x0e\x90\x8c\x12\x81\x76\x19\x45\xac\xc4\x6e\x69\x5f\x28\x2f\x56\xea\x90\x04\x70\x52\xed\x68\xb5\x80\x1f\x68\x8d\xc9\xe5\x43\x1d\x5c\x97\xd6\x5f\x73\xfd\xbf\xc7\xa0\x2f\xcb\x0b\x30\xf8\xb1\x2f\xe0\x9c\xb7\x4b\xba\x64\x52\x65\xe7\x4d\x19\x82\x23\x3c\xbd\xc0\xe8\x67\x8d\x23\xbd\xf7\x85\x24\x17\xf8\x74\xbf\x97\x86\xca\x05\x26\x3e\xcf\x83\xf5\x6d\x92\xf1\x02\x67\x52\xcc\x77\xa7\x69\xa4\xe1\x28\x29\x37\x9f\x9b\x62\xf1\x0e\xcc\x7b\x17\x2e\x0f\xe3\xf1\x68\xc1\x40\xfb\x3d\x4f\x8f\x2e\x6b\x57\xe2\x10\xdf\xbe\x7c\x26\xd5\x83\x16\xd5\x4d\xfd\x0e\xf7\x67\x99\x76\x66\x82\x05\x25\x9d\x54\xe7\xd4\x91\x3d\x8b\x1d\x1d\xda\x43\xc9\x7a\x47\x7d\xef\xa9\xbd\x0a\xbe\x7b\x5a\x38\x9b\x39\x71\xe0\x2e\xc5\xa8\xfc\xda\xc6\xa0\x97\x07\xbd\xd1\x8d\x16\x15\xe7\x5b\x2d\xd6\x62\x71\x71\xd7\x1d\x2b\x1f\xbc\xd8\x1f\x5a\xf5\x0e\xae\x91\x1d\x24\xad\xbf\x1c\x6a\x28\x79\x14\xdd\x84\x5e\xe8\x80\x98\xca\xd9\xeb\xc4\x0f\xe0\x90\xe5\x29\xa2\xe7\x0c\x88\xd6\xd1\xb4\xdc\x89\xf3\x3f\xa3\x1e\xb1\x6c\xd3\x71\xff\x08\x28\xc8\xa8\x4a\x89\x4e\x4f\xdb\xc1\x53\xf6\x2e\xcd\x95\x5b\x2e\x38\xf4\x41\x6a\x8a\x05\x3c\xd5\x76\x7c\x79\x28\x09\x5e\x6a\xea\xf6\xb6\xe4\x0d\x45\xb7\xd3\x14\xec\xad\x55\x35\xfa\x0d\x99\xa9\xb8\xbb\xef\x5e\x77\x23\xcc\x07\x43\xbd\xf8\x3e\xfb\xf0\xe2\xaf\x54\x9f\x87\xc4\xc3\x05\x5d\x6c\x73\x60\xe8\xf6\xe1\x1e\xd6\x96\xdc\xdc\x41\x0d\x06\xab\xaa\x54\x6f\x62\xad\x28\xbb\x15\x6f\x1c\xab\xf4\xba\xbd\x54\x4b\x33\x72\x6f\x10\x83\xde\x85\x99\x71\x73\x59\x76\x6e\xa1\x4e\x0d\x7a\xbd\xf8\x18\x75\x6e\x58\x0c\xd6\x1b\x5b\xa1\x68\xe4\x86\x24\x6f\xd8\x58\x86\x24\x8b\x09\x8d\xf9\x4f\xa1\xdf\xe7\xb4\x4f\x4f\x86\x27\x3f\xe6\x94\xf4\xfa\x05\xe1\xcd\xa8\x22\x21\x9f\x3e\x74\xcd\x04\xf5\x54\x93\x06\xc4\xc1\x8f\x94\x3c\x56\xc2\x07\xce\x85\x7c\xe0\xea\x8c\x46\x7d\x97\x3a\x7c\xf0\x50\xa5\xfa\x70\x60\xd7\xb6\xd2\xb7\xb9\x1c\x55\x55\x87\xc0\xb9\x24\xb4\x26\x21\xc4\x5c\x3c\x13\x2f\x37\xfb\xaf\x83\xc4\xb2\x9e\x06\xd9\x1a\xd2\x27\x05\x84\x4d\xc2\x88\x5e\x88\x8c\xea\x3e\x43\x4f\xb5\x96\x73\x05\x01\xff\x25\xb2\x4c\x5b\x8a\x56\x76\x17\x61\xfb\xd8\x0b\x0f\x0f\x0a\x99\x8b\xe8\x7b\xeb\x6c\x65\x05\x2a\x87\xcc\x8a\xd0\xc0\x4b\x33\x2a\x01\xbe\x76\xf9\xa9\x82\xa1\x2b\xe0\xee\x1a\x9a\xdf\x02\x14\xc4\x06\xab\x3a\xf3\x9c\xbe\x02\xd8\xa6\x21\x63\x51\xc8\xed\xba\xde\x66\x41\x3f\xdf\x73\xaa\xa8\xea\xd9\x98\xad\xa4\xce\x13\x7d\x53\xc4\x4a\xf4\x41\x24\x44\x63\xfd\xc6\xed\x14\x64\xda\xc7\xf3\x7a\x27\x7f\xf9\xf6\xf8\xa4\xaf\x61\x4c\x02\xc1\xbb\x9d\xa6\xdc\xc3\x63\x66\xab\xbf\xfc\x84\x8e\xe9\xfd\xf0\x4f\x6c\x6e\x73\xe0\x67\xd1\x61\x4f\x19\x6b\xfa\x2a\x53\x67\x58\xc3\x53\xd4\xf7\xd3\xec\xce\x15\x7c\xf3\x99\xc4\xde\xed\x57\x16\xb8\xc4\xab\xa7\x5d\x9b\xad\x8f\xfe\x1c\x75\x8e\xe6\xd3\x7a\x58\x87\x50\xd0\x77\x6c\x5a\x47\x45\x15\x9a\xeb\x7d\x67\xcd\x98\x00\xf8\x82\x7e\x07\xc5\xa8\xb5\x50\x87\xba\xa7\xd3\xee\x70\x9f\x1b\x88\x45\xb7\x5d\xac\x62\x60\x1e\xdf\x4e\xe7\xbb\xcf\xd9\x67\x82\xdb\x2d\x5a\xa7\x6c\x77\x05\x65\xd2\xc6\xaa\x30\x31\x01\xc6\xd2\x4a\xc4\xb8\xdb\x23\xcb\xf9\x1d\x4a\x5f\x41\x99\xd6\x69\x7a\x43\xd5\xef\xff\x99\x08\x0a\x1f\x0c\x15\xdd\x0e\xb6\x77\x9b\xff\x90\xb7\x11\xc0\xd2\x3a\xed\x1b\x09\x60\x48\x6f\x82\xce\x56\xba\xdd\xcc\xea\xb4\x8d\xde\x53\xd1\xcb\x3b\x33\xf8\xfa\xe5\xf8\x7c\x37\x9f\x14\x9b\xdd\x8f\x97\xb9\xb0\x27\x26\x66\xcf\x76\xaf\xdd\x13\x26\x17\x9d\x0d\xea\x81\x87\xcc\x0a\x24\xa6\x8e\x0b\x59\x42\xd4\x9d\xee\x60\x24\x95\x63\x11\x0c\x81\xb3\xa0\x24\x5e\xc1\x3f\xff\xb5\xf8\xf7\x00\x53\xa7\x8a\xd0\x9a\x1b\x00\x00")
 
 func crdsBasesPluginsFarosSh_accessesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -241,7 +247,7 @@ func crdsBasesPluginsFarosSh_accessesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBasesPluginsFarosSh_containerruntimesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x8f\xe3\xb8\x11\xbd\xfb\x57\x14\x90\xc3\x24\x40\x5b\x9e\x41\x2e\x81\x81\x1c\x1a\x9d\x04\x68\x64\x37\x68\x4c\xf7\xee\xbd\x44\x96\x2d\x6e\x53\xa4\xc2\x2a\x7a\xc7\x09\xf2\xdf\x83\x22\xe5\x8f\xb6\xa5\xde\x4e\x82\x60\x2f\xd6\x4d\x22\x55\x1f\xef\xd5\xab\xaa\xe5\x72\xb9\xc0\xc1\xfd\x48\x89\x5d\x0c\x6b\xc0\xc1\xd1\x37\xa1\xa0\x6f\xdc\xbc\xfe\x81\x1b\x17\x57\xbb\x2f\x8b\x57\x17\xec\x1a\x1e\x32\x4b\xec\xbf\x12\xc7\x9c\x0c\xfd\x89\x36\x2e\x38\x71\x31\x2c\x7a\x12\xb4\x28\xb8\x5e\x00\x60\x08\x51\x50\x3f\xb3\xbe\x02\x98\x18\x24\x45\xef\x29\x2d\xb7\x14\x9a\xd7\xdc\x52\x9b\x9d\xb7\x94\x8a\xf1\x83\xeb\xdd\xe7\xe6\xcb\xe7\xe6\xf3\x02\xc0\x24\x2a\xff\xbf\xb8\x9e\x58\xb0\x1f\xd6\x10\xb2\xf7\x0b\x80\x80\x3d\xad\x8b\x41\x74\x81\x52\xca\x41\xf4\x4e\x33\xf8\xbc\x75\x81\x9b\x0d\xa6\xc8\x0d\x77\x0b\x1e\xc8\xa8\xf7\x6d\x8a\x79\x58\xc3\xd5\x79\x35\x35\x06\x38\x26\x77\xb0\xfa\xb5\x5a\x2d\x47\xde\xb1\xfc\x75\xf2\xf8\x3b\xc7\x52\xae\x0c\x3e\x27\xf4\x13\x51\x95\x53\x76\x61\x9b\x3d\xa6\xeb\xf3\x05\x00\x9b\x38\xd0\x1a\xfe\xa6\xa1\x0c\x68\xc8\x2e\x00\x46\x3c\x4a\x68\x4b\x40\x6b\x0b\xc2\xe8\x9f\x92\x0b\x42\xe9\x21\xfa\xdc\x1f\x90\x5d\xc2\x4f\x1c\xc3\x13\x4a\xb7\x86\xe6\xc0\x41\x73\x05\x5f\xb9\x7b\x00\xef\x7e\x4b\xe3\xbb\xec\xd5\xb9\x45\xa9\x1f\xea\xf1\xee\x0b\xfa\xa1\xc3\x2f\x35\x78\xd3\x51\x8f\xeb\xf1\x7e\x1c\x28\xdc\x3f\x3d\xfe\xf8\xfb\xe7\x37\x9f\x01\x2c\xb1\x49\x6e\x90\x42\xe3\x25\x4e\xe0\x18\xa4\x23\xa8\x3f\xc1\x26\xa6\xf2\x5a\x19\x81\xfb\xa7\xc7\xa3\x99\x21\xc5\x81\x92\xb8\x03\x2d\xf5\x39\xab\xce\xb3\xaf\x17\x4e\x3f\x69\x5c\xf5\x16\x58\x2d\x4b\xaa\x4e\x47\x30\xc9\x8e\xa9\x40\xdc\x80\x74\x8e\x21\xd1\x90\x88\x29\xd4\x42\x7d\x63\x18\xf4\x12\x06\x88\xed\x4f\x64\xa4\x81\x67\x4a\x6a\x06\xb8\x8b\xd9\x5b\xa5\x71\x47\x49\x20\x91\x89\xdb\xe0\xfe\x71\xb4\xcd\x20\xb1\x38\xf5\x28\x34\xd6\xc6\xe9\x29\xe4\x05\xf4\xb0\x43\x9f\xe9\x0e\x30\x58\xe8\x71\x0f\x89\xd4\x0b\xe4\x70\x66\xaf\x5c\xe1\x06\xbe\x8f\x89\xc0\x85\x4d\x5c\x43\x27\x32\xf0\x7a\xb5\xda\x3a\x39\xa8\xd2\xc4\xbe\xcf\xc1\xc9\x7e\x55\x04\xe6\xda\x2c\x31\xf1\xca\xd2\x8e\xfc\x8a\xdd\x76\x89\xc9\x74\x4e\xc8\x48\x4e\xb4\xc2\xc1\x2d\x4b\xe8\xa1\x28\xb3\xe9\xed\x6f\xd2\xa8\x63\xfe\xf4\x26\xd6\x5a\x16\x2c\xc9\x85\xed\xd9\x41\x11\xc9\x3b\x0c\xa8\x4a\x94\x6b\x1c\x7f\xad\x59\x9c\x80\xd6\x4f\x8a\xce\xd7\x3f\x3f\xbf\xc0\xc1\x75\x21\xe3\x12\xfd\x82\xfb\xe9\x47\x3e\x51\xa0\x80\xb9\xb0\xa1\x54\x49\xdc\xa4\xd8\x17\x9b\x14\xec\x10\x5d\x90\xf2\x62\xbc\xa3\x70\x09\x3f\xe7\xb6\x77\xa2\xbc\xff\x3d\x13\x8b\x72\xd5\xc0\x43\x69\x55\xd0\x12\xe4\x41\x65\x60\x1b\x78\x0c\xf0\x80\x3d\xf9\x07\x64\xfa\xbf\x13\xa0\x48\xf3\x52\x81\xfd\x18\x05\xe7\x5d\xf6\xf2\x72\x45\xed\xec\xe0\xd0\x01\x67\xf8\xba\x94\xe9\xb3\xa0\x64\x7e\x23\x9e\xd8\xb2\xe2\x6e\x81\x05\x85\x54\x15\x55\xb4\x6f\x8c\x4e\x8b\x16\x6a\xdb\xaf\xbd\xeb\xea\xe4\x32\x94\x9c\x12\x05\x51\x53\x86\x58\x1b\xe6\xc9\xa3\xc6\x71\xbf\xa5\x20\xcd\x95\x0d\x27\xd4\x4f\x98\xbe\xce\xb3\x46\x71\x4c\xad\x48\x5b\x33\x2b\xd2\x2f\x62\x3f\xd4\x9c\xa6\x82\xb5\xdd\x4e\xd8\x85\x1a\xd6\x75\x24\xef\xa1\x50\x1f\x8f\x2c\x2f\x09\x03\xbb\x43\x63\x9e\xbe\x77\x11\xfc\x77\xc8\x02\xa5\x89\x96\xc2\x3e\xa6\x22\x47\x53\x64\xab\x0a\x62\xa0\x12\x5d\xbe\x54\xd3\xe9\x91\x08\x18\xa2\x74\x94\x1a\x78\x51\xf9\x8c\x0d\xad\x25\xf8\xb9\xa3\x50\x5c\xe4\x60\x29\xf9\xbd\x52\x70\xf2\x66\x3a\x0c\x5b\xb2\x53\x79\xd7\xe7\x51\x79\x42\x51\xf5\xab\x9e\x5e\x43\xfc\x39\xdc\xa9\xbd\x00\x99\x0f\xba\x2f\x69\x1c\x1d\xdd\x3f\x3d\xc2\xc6\x91\xb7\xb3\x46\x47\xaf\xa5\xa5\x18\x43\x83\x60\xeb\x27\xb1\xd7\x67\x13\x53\x8f\x52\x87\xd9\xf2\x38\xbc\x27\x30\x98\x16\xd6\xe9\xe9\x89\x19\xb7\x1f\x63\xe7\x1e\xba\xdc\x63\x80\x44\x68\x35\xb8\xc3\xcf\xe0\x82\x75\x06\x4b\xc7\xb3\x24\xe8\x3c\x03\xb6\x31\x5f\xf6\xa4\xb3\xb0\x14\x9f\x23\xa7\x23\x3d\x05\x9e\xd2\xf2\x5a\x02\xea\x07\xd9\xcf\x65\xff\x8b\x59\x25\x42\xbe\x9c\x9f\x33\x49\xbd\x74\x34\x5e\x3f\x8e\xea\x63\x25\x7c\xe2\x52\xc8\x67\xa1\xce\x66\xe4\xde\xf4\x51\x35\xaa\xfd\xc8\x6d\x9c\x29\xd4\x6b\x56\xa6\x8b\x91\x4b\x49\x68\x4d\x42\x4c\xa5\x78\x26\x06\xc2\xe9\xa9\x90\x38\xd6\x90\xd8\x59\x4a\x64\x01\x61\x9b\x31\x61\x10\x22\xab\xb6\xaf\xd0\xab\x2d\x7e\xd6\xe6\xff\x86\x2c\xd3\x8e\x92\x93\xfd\x87\xb0\x7d\x1e\x2f\x6b\xbb\xd8\x39\x5b\x7b\x11\x7d\x1b\xbc\x33\x4e\xc0\x78\x64\x56\x84\x0e\x7d\x69\x36\xe4\xaf\x95\x1f\x13\x2d\xdd\x01\xd7\xa5\x23\xb3\x0e\xc8\x98\xa0\x47\xd3\x95\x3e\x67\x30\x80\xeb\x7b\xb2\x0e\x85\xfc\xbe\x6a\x9b\x05\xc3\xbc\xe6\x0a\xdb\x63\x37\x66\x27\xb9\x46\xa2\xab\x0a\x1a\x51\x19\xc6\x64\x5d\xd8\xfa\x7d\xa5\xf4\x98\xcf\xfb\x4a\xfe\xfe\x87\xe7\x17\xad\x61\x26\x81\x18\xfc\xbe\x76\x81\x3a\x70\xfe\xf8\x17\xf4\x3c\x2b\xeb\x5f\x86\xbf\x18\xf9\x18\xf8\x75\xc0\x8d\x33\xe5\x58\xd3\x77\xa5\x75\xc6\x0d\xbc\x24\x5d\xcb\x4a\x38\x77\xf0\x43\x28\x4d\xec\xbf\x8e\xab\x5c\xf8\x90\xdc\xf6\x43\xf1\x7e\xea\xb6\xe7\xca\x51\x3e\x9d\x0a\x31\x36\xf4\x0d\xfb\xc1\x53\x63\x62\xbf\x3a\x29\x6b\x1e\x73\x0c\x7b\x68\x8e\x56\x1b\x0d\x68\xdc\x2b\x01\x13\x55\x01\xb1\x28\xd1\x68\x52\x64\x3e\xae\x64\xf3\xea\xf3\xee\x95\xe0\x7e\x87\xce\x6b\xb7\xbb\x83\x36\xab\xb0\x0c\x66\x26\xc0\xd4\x3a\x49\x98\xf6\x67\x63\xbf\x54\x60\x5b\x4a\x73\x93\xa7\x07\xaa\x3e\xbf\x65\x22\x68\x42\xb4\xd4\x54\x3a\x4f\x61\xf3\xef\xca\x18\x01\x6c\x9d\xd7\x3a\x93\x08\x96\x4c\x0c\x1b\xef\x8c\x8e\x9b\xf9\xf6\xd3\x0f\x31\x09\x4e\xad\x0d\x67\x04\xcd\x33\xa8\x6b\xa2\x4b\x64\xa7\x38\x5c\x4e\x4c\xf3\xc9\x6b\xb3\xf3\x78\x59\xdc\x4f\x1c\xcc\xac\x72\xe7\x87\x98\x12\xee\x3f\xb4\xff\x4d\x48\xe3\xb6\x01\xde\x36\xc0\xdb\x06\x78\xdb\x00\xdf\x4d\xea\xb6\x01\xfe\x47\xc8\xde\x36\xc0\xdb\x06\x38\x77\xe1\xb6\x01\xde\x36\xc0\x5f\x6b\x03\xbc\xfa\x58\x57\xb9\x35\x48\xca\xd5\x33\x4b\x4c\x3a\xe9\xce\xbe\xe4\xf6\x58\x04\x87\xc4\x47\x81\xc1\x3f\xff\xb5\xf8\x77\x00\x00\x00\xff\xff\x85\xee\xb3\x97\x2d\x1a\x00\x00")
+var _crdsBasesPluginsFarosSh_containerruntimesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4b\x6f\x23\x37\x12\xbe\xeb\x57\x14\xb0\x87\xd9\x05\xac\xd6\x18\x7b\x59\x08\xd8\x83\xe1\x24\x80\x91\x99\xc0\xb0\x35\x73\xaf\x26\x4b\x6a\x8e\xd9\x64\x87\x55\xd4\x8c\x12\xe4\xbf\x07\x45\xb6\x1e\xb6\xa4\x81\xe1\x20\x97\x40\xa2\x2e\xcd\xc7\x57\x55\x5f\x3d\xa7\xd3\xe9\x04\x07\xf7\x99\x12\xbb\x18\xe6\x80\x83\xa3\x6f\x42\x41\xbf\xb8\x79\xfa\x1f\x37\x2e\xce\xd6\xd7\x93\x27\x17\xec\x1c\x6e\x33\x4b\xec\x1f\x88\x63\x4e\x86\x7e\xa0\xa5\x0b\x4e\x5c\x0c\x93\x9e\x04\x2d\x0a\xce\x27\x00\x18\x42\x14\xd4\x6d\xd6\x4f\x00\x13\x83\xa4\xe8\x3d\xa5\xe9\x8a\x42\xf3\x94\x5b\x6a\xb3\xf3\x96\x52\x01\xdf\x8a\x5e\xbf\x6f\xae\xdf\x37\xef\x27\x00\x26\x51\x79\xbf\x70\x3d\xb1\x60\x3f\xcc\x21\x64\xef\x27\x00\x01\x7b\x9a\x17\x40\x74\x81\x52\xca\x41\xf4\x4e\x33\xf8\xbc\x72\x81\x9b\x25\xa6\xc8\x0d\x77\x13\x1e\xc8\xa8\xf4\x55\x8a\x79\x98\xc3\xd1\x79\x85\x1a\x15\x1c\x8d\xdb\xa2\x3e\x54\xd4\x72\xe4\x1d\xcb\xcf\x27\x8f\x3f\x38\x96\x72\x65\xf0\x39\xa1\x3f\xa1\x55\x39\x65\x17\x56\xd9\x63\x3a\x3e\x9f\x00\xb0\x89\x03\xcd\xe1\x17\xec\x89\x07\x34\x64\x27\x00\x23\x1f\x45\xb5\x29\xa0\xb5\x85\x61\xf4\xf7\xc9\x05\xa1\x74\x1b\x7d\xee\xb7\xcc\x4e\xe1\x0b\xc7\x70\x8f\xd2\xcd\xa1\xd9\xfa\xa0\x39\xa2\xaf\x28\xb2\x25\xef\x66\x45\xe3\xb7\x6c\x54\xb8\x45\xa9\x1b\xf5\x78\x7d\x8d\x7e\xe8\xf0\xba\x6c\xb1\xe9\xa8\x2f\x4e\xd5\xaf\x38\x50\xb8\xb9\xbf\xfb\xfc\xdf\xc7\x67\xdb\x00\x96\xd8\x24\x37\xa8\xcc\x63\x9e\xc0\x31\x48\x47\x50\x1f\xc1\x32\xa6\xf2\x59\x3d\x02\x37\xf7\x77\x3b\x98\x21\xc5\x81\x92\xb8\xad\x5b\xea\x3a\x88\xce\x83\xdd\x17\x42\xdf\xa9\x5e\xf5\x16\x58\x0d\x4b\xaa\x42\x47\x32\xc9\x8e\xa6\x40\x5c\x82\x74\x8e\x21\xd1\x90\x88\x29\xd4\x40\x7d\x06\x0c\x7a\x09\x03\xc4\xf6\x0b\x19\x69\xe0\x91\x92\xc2\x00\x77\x31\x7b\xab\x6e\x5c\x53\x12\x48\x64\xe2\x2a\xb8\xdf\x76\xd8\x0c\x12\x8b\x50\x8f\x42\x63\x6c\xec\x57\x71\x5e\x40\x0f\x6b\xf4\x99\xae\x00\x83\x85\x1e\x37\x90\x48\xa5\x40\x0e\x07\x78\xe5\x0a\x37\xf0\x31\x26\x02\x17\x96\x71\x0e\x9d\xc8\xc0\xf3\xd9\x6c\xe5\x64\x9b\x95\x26\xf6\x7d\x0e\x4e\x36\x33\x8d\xac\xe4\xda\x2c\x31\xf1\xcc\xd2\x9a\xfc\x8c\xdd\x6a\x8a\xc9\x74\x4e\xc8\x48\x4e\x34\xc3\xc1\x4d\x8b\xea\x41\x0d\xe6\xa6\xb7\xff\x4a\x63\x1e\xf3\xbb\x67\xba\xd6\xb0\x60\x49\x2e\xac\x0e\x0e\x4a\x92\x7c\xc7\x03\x9a\x25\xea\x6b\x1c\x9f\x56\x43\xf7\x44\xeb\x96\xb2\xf3\xf0\xe3\xe3\x02\xb6\xa2\x8b\x33\x9e\x81\xc2\xc8\xfb\xfe\x21\xef\x5d\xa0\x84\xb9\xb0\x24\x0d\x21\xc7\xb0\x4c\xb1\x2f\x8c\x53\xb0\x43\x74\x41\xca\x87\xf1\x8e\xc2\x4b\xfa\x39\xb7\xbd\x13\xf5\xfb\xaf\x99\x58\xd4\x57\x0d\xdc\x96\x52\x05\x2d\x41\x1e\x34\x0d\x6c\x03\x77\x01\x6e\xb1\x27\x7f\x8b\x4c\x7f\xbb\x03\x94\x69\x9e\x2a\xb1\xaf\x73\xc1\x61\x95\xdd\xff\x14\x65\x3e\xb2\x76\x70\xb0\xad\x80\x67\xfc\xf5\x32\x4d\x1f\x05\x25\xf3\xb3\xe4\x89\x2d\x6b\xe8\x5b\x60\x41\x21\xcd\x8a\x9a\xb4\xcf\x40\x4f\x27\xed\x58\xf6\x6b\xed\x3a\x3a\x79\xa9\x4a\x4e\x89\x82\xc0\x90\xa2\x21\xd6\x82\xb9\x97\xa8\x0e\xbd\x59\x51\x90\xe6\x08\xc3\x09\xf5\x27\xa0\x8f\xed\xac\x5a\xec\x4c\x2b\xa9\xad\x96\x95\xd4\x57\x21\xb8\x8d\x39\x35\xa5\xec\xa2\x3f\x81\x0b\x55\xad\x63\x4d\xbe\xc7\x42\x5d\x1e\x59\x16\x09\x03\x17\x55\xb4\x30\x9f\xbe\xf7\x42\xf9\x0f\xc8\x02\xea\x9d\xe2\x8f\x1d\xa1\x20\x3b\x28\xb2\x35\x0b\x62\xa0\xa2\x5d\xe6\x33\xb8\xa0\xd5\x09\x43\x94\x8e\x52\x03\x8b\xce\xed\x0a\x5a\x4b\xf0\xb5\xa3\x50\x44\xe4\x60\x29\xf9\x8d\xba\x60\x2f\xcd\x74\x18\x56\x64\x4f\xd9\x5d\xd7\x9d\xfa\x09\x45\xb3\x5f\xf3\xe9\x29\xc4\xaf\xe1\x4a\xf1\x02\x64\xde\xe6\x7d\x31\x63\x27\xe8\xe6\xfe\x0e\x96\x8e\xbc\x3d\x0b\x3a\x4a\x55\x50\x34\x86\x06\xc1\xd6\x9f\xe4\x5e\xff\xcb\x98\x7a\x94\xda\xcc\xa6\xbb\xe6\x7d\xbc\xce\x24\xd6\x7e\xf5\xc4\x8c\xab\xd7\x79\xe7\x06\xba\xdc\x63\x80\x44\x68\x55\xb9\xed\x63\x70\xc1\x3a\x83\xa2\x96\x5b\x12\x74\x9e\x01\xdb\x98\x65\x72\x12\x53\xf3\xbd\xa3\x03\x9f\x8e\xee\x29\xf4\x94\x1e\xd1\x12\x50\x3f\xc8\xa6\x79\xab\x55\x89\x90\x5f\xf6\xcf\x33\x46\x2d\x3a\x52\x83\x38\x86\x5d\xab\xde\x45\xc2\x3b\x2e\x81\x7c\xa0\xea\x19\x44\x6d\x77\x87\x75\x54\x41\xb5\x1e\xb9\xa5\x33\xda\xf2\x8b\x55\xa6\x8b\x91\x4b\xec\x69\x4c\x42\x4c\x25\x78\x4e\x34\x84\xfd\xaa\x94\x38\xd6\x26\xcc\xce\x52\x22\x0b\x08\xab\x8c\x09\x83\x10\x59\xc5\x3e\x62\x4f\x51\xdb\x73\x01\x01\x7f\x91\x59\xa6\x35\x25\x27\x9b\x57\x71\xfb\x38\x5e\xd6\x72\xb1\x76\xb6\xd6\x22\xfa\x36\x78\x67\x9c\x80\xf1\xc8\xac\x0c\x6d\xeb\xd2\x19\x48\x80\x87\xea\x1f\x13\x2d\x5d\x01\xd7\xa1\x23\xb3\x36\xc8\x98\xa0\x47\xd3\x95\x3a\x67\x30\x80\xeb\x7b\xb2\x0e\x85\xfc\xa6\xe6\x36\x0b\x86\xf3\x39\xa7\x40\x66\xac\xc6\xec\x24\x57\x4d\x74\x54\x41\x23\x80\xc6\xc4\x64\x5d\x58\xf9\x8d\x92\x4c\x7b\x7b\xbe\x9f\xc9\x1f\x3f\x3d\x2e\xb4\xc9\x32\x09\xc4\xe0\x37\xea\xf2\x00\xb5\xe1\xfc\xff\x27\xf4\x4c\x6f\xa7\xbf\x80\xbc\x8e\xfc\x72\x75\xdb\x53\x76\x31\x7d\x55\x4a\x67\x5c\xc2\x22\xe9\x58\x56\xd4\xb9\x82\x4f\xa1\x14\xb1\x37\xeb\x55\x2e\xbc\x46\xab\xc5\x66\x28\xd2\x77\xfa\x3c\xcb\x1c\x4d\x0a\x17\x60\x19\x63\x43\xdf\xb0\x1f\x3c\x35\x26\xf6\xb3\x7d\x66\x9d\x11\x01\xf0\x11\xc3\x06\x9a\x1d\x6a\xa3\x0a\xd5\x89\x8c\x01\x53\xb1\x9f\x1d\x8b\xb6\x5d\x34\x29\x32\xef\x46\xb2\xf3\xd9\xe7\xdd\x13\xc1\xcd\x1a\x9d\xd7\x6a\x77\x05\x6d\xd6\xc4\x32\x98\x99\x00\x53\xeb\x24\x61\xda\xec\x99\xad\x11\xa8\xc3\x15\xd3\x32\x9f\x6e\xa8\xba\xfe\xcd\x44\xd0\x84\x68\xa9\xa9\x1d\x6c\xaf\x36\xff\xa7\xb4\x11\xc0\xd6\x79\xcd\x1b\x89\x60\xc9\xc4\xb0\xf4\xce\x68\xbb\x39\x8b\xe9\xfa\x21\x26\xc1\x20\x6f\xf4\xa0\x8e\x89\x2e\xd1\x8b\x81\xb7\xfe\xa7\x27\xba\xf9\xc9\x6b\x67\xfb\xf1\xb4\x04\xf6\x89\x83\x33\xa3\xdc\xe1\x21\xa6\x84\x9b\xc9\x2b\x1e\x9d\x4a\x8d\xcb\x04\x78\x99\x00\x2f\x13\xe0\x65\x02\xbc\x4c\x80\x97\x09\xf0\x32\x01\x5e\x26\xc0\xcb\x04\xf8\xcf\x9d\x00\x8f\x36\x75\xe0\x21\x3b\x07\x49\xb9\x4a\x66\x89\x49\x3b\xdd\xc1\x4e\x6e\x77\x41\xb0\x35\x9c\x05\x25\xf3\x1c\x7e\xff\x63\xf2\xe7\x00\x85\xee\xb3\x97\x2d\x1a\x00\x00")
 
 func crdsBasesPluginsFarosSh_containerruntimesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -261,7 +267,7 @@ func crdsBasesPluginsFarosSh_containerruntimesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBasesPluginsFarosSh_monitoringsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcf\x6f\x1b\xbb\x11\xbe\xeb\xaf\x18\xa0\x87\xb4\x80\xb5\x8a\xd1\x4b\x21\xa0\x07\xc3\x6d\x01\xa3\x49\x60\xc4\x4e\xee\xb3\xe4\x48\xcb\x98\x4b\x6e\x39\x43\x25\xea\xc3\xfb\xdf\x1f\x86\x5c\xad\x64\x59\xeb\x18\xef\xe1\xf1\xb6\x24\x77\x7e\x7c\xdf\xcc\x37\x5c\x2e\x97\x0b\x1c\xdc\x57\x4a\xec\x62\x58\x03\x0e\x8e\x7e\x08\x05\xfd\xe2\xe6\xe9\x1f\xdc\xb8\xb8\xda\x5d\x2f\x9e\x5c\xb0\x6b\xb8\xcd\x2c\xb1\xff\x4c\x1c\x73\x32\xf4\x2f\xda\xb8\xe0\xc4\xc5\xb0\xe8\x49\xd0\xa2\xe0\x7a\x01\x80\x21\x44\x41\xdd\x66\xfd\x04\x30\x31\x48\x8a\xde\x53\x5a\x6e\x29\x34\x4f\xb9\xa5\x36\x3b\x6f\x29\x15\xe3\x07\xd7\xbb\xf7\xcd\xf5\xfb\xe6\xfd\x02\xc0\x24\x2a\xff\x3f\xba\x9e\x58\xb0\x1f\xd6\x10\xb2\xf7\x0b\x80\x80\x3d\xad\xa1\x8f\xc1\x49\x4c\x2e\x6c\xb9\x19\x7c\xde\xba\xc0\xcd\x06\x53\xe4\x86\xbb\x05\x0f\x64\xd4\xef\x36\xc5\x3c\xac\xe1\xc5\x79\x35\x32\x86\x56\xd3\xfa\x38\xd9\x2b\x9b\xde\xb1\xfc\xf7\xec\xe0\x83\x63\x29\x87\x83\xcf\x09\xfd\xb3\x18\xca\x3e\xbb\xb0\xcd\x1e\xd3\xe9\xc9\x02\x80\x4d\x1c\x68\x0d\x9f\xd4\xe5\x80\x86\xec\x02\x60\xcc\xb8\x84\xb0\x04\xb4\xb6\x60\x88\xfe\x3e\xb9\x20\x94\x6e\xa3\xcf\xfd\x01\xbb\x25\x7c\xe3\x18\xee\x51\xba\x35\x34\x07\x94\x9b\x17\x00\x95\xbb\x07\x78\x6e\xb6\x34\x7e\xcb\x5e\x9d\x5b\x94\xba\x51\x8f\x77\xd7\xe8\x87\x0e\xaf\x6b\xd8\xa6\xa3\x1e\xd7\xe3\xfd\x38\x50\xb8\xb9\xbf\xfb\xfa\xf7\x87\x67\xdb\x00\x96\xd8\x24\x37\x48\x21\xea\x88\x0a\x38\x06\xe9\x08\xea\x75\xd8\xc4\x54\x3e\x2b\xe6\x70\x73\x7f\x37\x19\x18\x52\x1c\x28\x89\x3b\x00\x5f\xd7\x49\xe5\x9d\xec\x9e\xb9\x7b\xa7\x11\xd5\x5b\x60\xb5\xe4\xa8\x3a\x1d\x61\x24\x3b\x26\x01\x71\x03\xd2\x39\x86\x44\x43\x22\xa6\x50\x8b\xf0\x99\x61\xd0\x4b\x18\x20\xb6\xdf\xc8\x48\x03\x0f\x94\xd4\x0c\x70\x17\xb3\xb7\x5a\xa9\x3b\x4a\x02\x89\x4c\xdc\x06\xf7\xff\xc9\x36\x83\xc4\xe2\xd4\xa3\xd0\x58\x09\xc7\x55\x68\x0b\xe8\x61\x87\x3e\xd3\x15\x60\xb0\xd0\xe3\x1e\x12\xa9\x17\xc8\xe1\xc4\x5e\xb9\xc2\x0d\x7c\x8c\x89\xc0\x85\x4d\x5c\x43\x27\x32\xf0\x7a\xb5\xda\x3a\x39\x74\x9c\x89\x7d\x9f\x83\x93\xfd\xaa\x34\x8f\x6b\xb3\xc4\xc4\x2b\x4b\x3b\xf2\x2b\x76\xdb\x25\x26\xd3\x39\x21\x23\x39\xd1\x0a\x07\xb7\x2c\xa1\x87\xd2\x75\x4d\x6f\xff\x92\xc6\x1e\xe5\x77\xcf\x62\xad\x05\xc1\x32\xd5\x7a\x5d\xa5\x0d\x5e\x61\x40\xbb\x41\xb9\xc6\xf1\xd7\x9a\xc5\x11\x68\xdd\x52\x74\x3e\xff\xfb\xe1\x11\x0e\xae\x0b\x19\xe7\xe8\x17\xdc\x8f\x3f\xf2\x91\x02\x05\xcc\x85\x0d\xa5\x4a\xe2\x26\xc5\xbe\xd8\xa4\x60\x87\xe8\x82\x94\x0f\xe3\x1d\x85\x73\xf8\x39\xb7\xbd\x13\xe5\xfd\x7f\x99\x58\x94\xab\x06\x6e\x8b\x0c\x41\x4b\x90\x07\x6d\x00\xdb\xc0\x5d\x80\x5b\xec\xc9\xdf\x22\xd3\x9f\x4e\x80\x22\xcd\x4b\x05\xf6\x6d\x14\x9c\x2a\xe8\xf9\xe5\x8a\xda\xc9\xc1\x41\xe3\x66\xf8\xfa\x44\xf2\x3d\xa6\xa7\x87\x81\xcc\xb3\x8e\xb1\xc4\x2e\x69\x4d\x0b\x0a\x69\x27\xd4\x46\x7d\x93\x47\x41\xc9\xfc\x06\x9f\xe5\xde\x33\xaf\xb1\x65\xa5\xf8\x75\xb7\x97\xf5\x01\xea\xf4\xa8\x02\xf9\xe2\xe4\x2c\x82\xdb\x9c\x12\x05\x51\x53\x86\x58\xf5\xf8\xe8\x51\xe3\xb8\xd9\x52\x90\xe6\x85\x0d\x27\xd4\x5f\x30\x7d\x6e\xfc\x10\xc5\x94\x5a\x51\x11\xcd\xac\xa8\x4c\xd1\x95\x43\x79\x6b\x2a\x58\x35\xfd\x82\xdd\x0a\x26\xbd\x8c\xe4\x35\x14\xea\xf2\xc8\xf2\x98\x30\xb0\x3b\xa8\xff\xe5\x7b\x67\xc1\x7f\x40\x16\x10\xd7\x53\xed\xa1\x29\x15\x99\x4c\x91\xad\x0d\x17\x03\x8d\x54\xcf\xd8\x05\x15\x42\x0c\x51\x3a\x4a\x0d\x3c\x6a\xa7\x8e\xda\xd9\x12\x7c\xef\x28\x14\x17\x39\x58\x4a\x7e\xaf\x14\x1c\xbd\x99\x0e\xc3\x96\xec\xa5\xbc\xeb\xba\x53\x9e\x50\x54\x68\xb4\x75\x9f\x42\xfc\x1e\xae\xd4\x5e\x80\xcc\x07\x89\x29\x69\x4c\x8e\x6e\xee\xef\x60\xe3\xc8\xdb\x59\xa3\xa3\xd7\xa2\x5e\xc6\xd0\x20\xd8\xfa\x8b\xd8\xeb\xda\xc4\xd4\xa3\xd4\x89\xb9\x54\x4f\x33\xf7\x66\x7a\xf8\xb8\x7a\x62\xc6\xed\xdb\xd8\xb9\x81\x2e\xf7\x18\x20\x11\x5a\x0d\xee\xf0\x33\xb8\x60\x9d\xc1\x22\xae\x96\x04\x9d\x67\xc0\x36\xe6\x73\xf9\x3b\x09\x4b\xf1\x99\x38\x1d\xe9\x29\xf0\x14\x75\x6d\x09\xa8\x1f\x64\x3f\x97\xfd\x4f\xb3\x4a\x84\x7c\x3e\xaa\x67\x92\x7a\xec\x68\xbc\x3e\xbd\x0a\xa6\x4a\x78\xc7\xa5\x90\x4f\x42\x9d\xcd\xc8\x3d\x93\x6c\x35\xaa\xd2\xe7\x36\xce\x14\xea\x35\x2b\xd3\xc5\xc8\xa5\x24\xb4\x26\x21\xa6\x52\x3c\x17\x66\xcf\x71\x55\x48\x1c\x6b\x48\xec\x2c\xa9\x26\x22\x6c\x33\x26\x0c\x42\x64\xd5\xf6\x0b\xf4\xea\x34\x99\xb5\xf9\xc7\x90\x65\xda\x51\x72\xb2\x7f\x13\xb6\x0f\xe3\x65\x95\x8b\x9d\xb3\x55\x8b\xe8\xc7\xe0\x9d\x71\x02\xc6\x23\xb3\x22\x74\xd0\xa5\xd9\x90\x3f\x57\x7e\x4c\xb4\x74\x05\x5c\xdf\x37\x99\x75\x16\xc7\x04\x3d\x9a\xae\xe8\x9c\xc1\x00\xae\xef\xc9\x3a\x14\xf2\xfb\xda\xdb\x2c\x18\xe6\x7b\xae\xb0\x3d\xaa\x31\x3b\xc9\x35\x12\x7d\x15\xa1\x11\x6d\xc3\x98\xac\x0b\x5b\xbf\xaf\x94\x4e\xf9\xbc\xde\xc9\x1f\xbf\x3c\x3c\x6a\x0d\x33\x09\xc4\xe0\xf7\x55\x05\xea\xc0\xf9\xe7\x7f\xd0\xf3\x6c\x5b\xff\x1c\xfe\x0b\xd3\x6d\x0e\xfc\x3a\xe0\xc6\x99\x32\xd5\xf4\x55\x91\xce\xb8\x81\xc7\xa4\x2f\xc0\x12\xce\x15\x7c\x09\x45\xc4\x7e\x77\x5c\xe5\xc2\x9b\xda\x6d\x3f\x14\xef\x47\xb5\x3d\xed\x1c\xe5\xd3\x69\x23\xc6\x86\x7e\x60\x3f\x78\x6a\x4c\xec\x57\xc7\xce\x9a\xc7\x1c\xc3\x1e\x9a\xc9\x6a\xa3\x01\x8d\x4f\x58\xc0\x44\xb5\x81\x58\x94\x68\x34\x29\x32\x4f\xaf\xbf\xf9\xee\xf3\xee\x89\xe0\x66\x87\xce\xab\xda\x5d\x41\x9b\xb5\xb1\x0c\x66\x26\xc0\xd4\x3a\x49\x98\xf6\x27\x63\xbf\x54\x60\x5b\x4a\x73\x93\x2f\x0f\x54\x5d\x7f\x65\x22\x68\x42\xb4\xd4\x54\x3a\x8f\x61\xf3\xdf\xca\x18\x01\x6c\x9d\xd7\x3a\x93\x08\x96\x4c\x0c\x1b\xef\x8c\x8e\x9b\x79\xf9\xe9\x87\x98\x04\x2f\x3d\x1b\x4e\x08\x9a\x67\x50\x5f\xa4\xfa\xe2\xba\xc4\xe1\xf2\xc2\x34\xbf\x78\x6d\x76\x1e\x2f\x8b\xfb\x0b\x07\x33\x6f\xb8\xd3\x43\x4c\x09\xf7\x3f\x7f\xf8\xbd\xd8\xac\x4f\xb9\x35\x48\xca\xd5\x33\x4b\x4c\x3a\xe9\x4e\x76\x72\x3b\x15\xc1\x21\xf1\xb1\xc1\xe0\x97\x5f\x17\xbf\x05\x00\x00\xff\xff\xd2\x27\xa5\x96\x72\x10\x00\x00")
+var _crdsBasesPluginsFarosSh_monitoringsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\x6f\x23\xb7\x0e\xbe\xfb\x57\x10\x78\x87\x7d\x0f\x88\xc7\x1b\xbc\x4b\x61\xa0\x87\x20\x6d\x81\xa0\x9b\x45\xb0\xf1\xee\x9d\x23\xd1\x1e\x6d\x34\xd2\x54\xa4\xbc\xeb\x16\xfd\xef\x05\x35\xe3\xb1\x1d\x7b\xd2\x20\x45\x3d\xbe\x8c\xa4\xf9\x48\x7e\x24\x3f\x6a\x3e\x9f\xcf\xb0\x73\x5f\x28\xb1\x8b\x61\x09\xd8\x39\xfa\x2e\x14\xf4\x8d\xab\xa7\x1f\xb8\x72\x71\xb1\xbd\x9e\x3d\xb9\x60\x97\x70\x9b\x59\x62\xfb\x89\x38\xe6\x64\xe8\x27\x5a\xbb\xe0\xc4\xc5\x30\x6b\x49\xd0\xa2\xe0\x72\x06\x80\x21\x44\x41\x5d\x66\x7d\x05\x30\x31\x48\x8a\xde\x53\x9a\x6f\x28\x54\x4f\xb9\xa6\x3a\x3b\x6f\x29\x15\xf0\xbd\xe9\xed\xfb\xea\xfa\x7d\xf5\x7e\x06\x60\x12\x95\xef\x57\xae\x25\x16\x6c\xbb\x25\x84\xec\xfd\x0c\x20\x60\x4b\x4b\x68\x63\x70\x12\x93\x0b\x1b\xae\x3a\x9f\x37\x2e\x70\xb5\xc6\x14\xb9\xe2\x66\xc6\x1d\x19\xb5\xbb\x49\x31\x77\x4b\x38\xdb\xef\x41\x06\xd7\xfa\xb0\xee\x47\xbc\xb2\xe8\x1d\xcb\xaf\xcf\x36\x3e\x38\x96\xb2\xd9\xf9\x9c\xd0\x9f\xf8\x50\xd6\xd9\x85\x4d\xf6\x98\x8e\x77\x66\x00\x6c\x62\x47\x4b\xf8\x88\x2d\x71\x87\x86\xec\x0c\x60\x88\xb8\xb8\x30\x07\xb4\xb6\x70\x88\xfe\x21\xb9\x20\x94\x6e\xa3\xcf\xed\x9e\xbb\x39\x7c\xe5\x18\x1e\x50\x9a\x25\x54\x7b\x96\xab\x33\x82\x8a\x0b\x7b\x7a\x6e\x36\x34\xbc\xcb\x4e\x8d\x5b\x94\x7e\xa1\xdf\xde\x5e\xa3\xef\x1a\xbc\x2e\x4b\x6c\x1a\x6a\x4b\xda\xf4\x2d\x76\x14\x6e\x1e\xee\xbe\xfc\xff\xf1\x64\x19\xc0\x12\x9b\xe4\x3a\xb5\x79\xcc\x0a\x38\x06\x69\x08\xfa\xe3\xb0\x8e\xa9\xbc\xf6\x9c\xc3\xcd\xc3\xdd\x08\xd0\xa5\xd8\x51\x12\xb7\x27\xbe\x7f\x8e\x2a\xef\x68\xf5\x99\xb9\x77\xea\x51\x7f\x0a\xac\x96\x1c\xf5\x46\x07\x1a\xc9\x0e\x41\x40\x5c\x83\x34\x8e\x21\x51\x97\x88\x29\xf4\x45\x78\x02\x0c\x7a\x08\x03\xc4\xfa\x2b\x19\xa9\xe0\x91\x92\xc2\x00\x37\x31\x7b\xab\x95\xba\xa5\x24\x90\xc8\xc4\x4d\x70\xbf\x8f\xd8\x0c\x12\x8b\x51\x8f\x42\x43\x25\x1c\x9e\x92\xb6\x80\x1e\xb6\xe8\x33\x5d\x01\x06\x0b\x2d\xee\x20\x91\x5a\x81\x1c\x8e\xf0\xca\x11\xae\xe0\x3e\x26\x02\x17\xd6\x71\x09\x8d\x48\xc7\xcb\xc5\x62\xe3\x64\xdf\x71\x26\xb6\x6d\x0e\x4e\x76\x8b\xd2\x3c\xae\xce\x12\x13\x2f\x2c\x6d\xc9\x2f\xd8\x6d\xe6\x98\x4c\xe3\x84\x8c\xe4\x44\x0b\xec\xdc\xbc\xb8\x1e\x34\x60\xae\x5a\xfb\x9f\x34\xf4\x28\xbf\x3b\xf1\xb5\x2f\x08\x96\xb1\xd6\xfb\x7f\x69\x83\x17\x32\xa0\xdd\xa0\xb9\xc6\xe1\xd3\x3e\xd0\x03\xd1\xba\xa4\xec\x7c\xfa\xf9\x71\x05\x7b\xd3\x25\x19\x27\xa0\x30\xf0\x7e\xf8\x90\x0f\x29\x50\xc2\x5c\x58\x93\x96\x90\x63\x58\xa7\xd8\x16\xc6\x29\xd8\x2e\xba\x20\xe5\xc5\x78\x47\xe1\x39\xfd\x9c\xeb\xd6\x89\xe6\xfd\xb7\x4c\x2c\x9a\xab\x0a\x6e\x8b\x0c\x41\x4d\x90\x3b\x6d\x00\x5b\xc1\x5d\x80\x5b\x6c\xc9\xdf\x22\xd3\xbf\x9e\x00\x65\x9a\xe7\x4a\xec\xeb\x52\x70\xac\xa0\x87\x9f\xa2\x2c\x07\xd6\x8e\x36\xf6\x1a\x37\x91\xaf\x8f\x24\xdf\x62\x7a\x7a\xec\xc8\x9c\x74\x8c\x25\x76\x49\x6b\x5a\x50\x48\x3b\xa1\x6f\xd4\x57\x59\x14\x94\xcc\xaf\xb0\x59\xce\x9d\x58\x8d\x35\x6b\x97\xbd\x6c\xf6\xb2\x3e\x0c\xd3\xa3\x17\xc8\xb3\x9d\x67\x1e\xdc\xe6\x94\x28\x08\x74\x29\x1a\x62\xd5\xe3\x83\x45\xad\x9d\x9b\x0d\x05\xa9\xce\x30\x9c\x50\x7b\x01\xfa\x39\xf8\xde\x8b\x31\xb4\xa2\x22\x1a\x59\x51\x19\x35\x82\x03\x6d\xa0\xa1\x94\x55\xf4\x17\x70\x7b\x32\xe9\xdc\x93\x97\x58\xe8\x1f\x8f\x2c\xab\x84\x81\x0b\x21\xaa\xfe\x97\xcf\x3d\x73\xfe\x03\xb2\x80\xb8\x96\x4a\x3e\x46\x42\x41\x46\x28\xb2\x7d\xc3\xc5\x40\x43\xaa\x27\x70\x41\x85\x10\x43\x94\x86\x52\x05\xab\xc6\x8d\xda\x59\x13\x7c\x6b\x28\x14\x13\x39\x58\x4a\x7e\xa7\x29\x38\x58\x33\x0d\x86\x0d\xd9\x4b\x71\xf7\xcf\x9d\xe6\x09\x45\x85\x46\x5b\xf7\x29\xc4\x6f\xe1\x4a\xf1\x02\x64\xde\x4b\x4c\x09\x63\x34\x74\xf3\x70\x07\x6b\x47\xde\x4e\x82\x0e\x56\x15\x14\x8d\xa1\x4e\xb0\xf6\x17\xb9\xd7\xff\x3a\xa6\x16\xa5\x9f\x98\x73\xb5\x34\x71\x6e\xa2\x87\x0f\x4f\x4b\xcc\xb8\x79\x5d\x76\x6e\xa0\xc9\x2d\x06\x48\x84\x56\x9d\xdb\x7f\x0c\x2e\x58\x67\x50\x34\x72\x4b\x82\xce\x33\x60\x1d\xb3\xcc\x2e\x62\xaa\x5b\x0d\x1d\xe5\x74\x48\x4f\xa1\xa7\x8c\xa3\x9a\x80\xda\x4e\x76\xd5\x5b\xa3\x4a\x84\xfc\x7c\x54\x4f\x04\xb5\x6a\x48\x03\xe2\x18\xc6\x5b\xc1\x58\x09\xef\xb8\x14\xf2\x91\xab\x13\x88\x3a\x59\x8f\x25\x5b\x41\x55\xfa\xdc\xda\x19\xbd\x5d\x94\xa8\x4c\x13\x23\x97\xda\xd3\x9a\x84\x98\x4a\xf1\x5c\x98\x3d\x87\xa7\xa7\xc4\xb1\xce\x7b\x76\x96\x54\x13\x11\x36\x19\x13\x06\x21\xb2\x8a\x7d\xc6\x9e\xa2\xd6\x53\x05\x01\xff\x90\x59\xa6\x2d\x25\x27\xbb\x57\x71\xfb\x38\x1c\x56\xb9\xd8\x3a\xdb\x6b\x11\x7d\xef\xbc\x33\x4e\xc0\x78\x64\x56\x86\xf6\xba\x34\x01\x09\xf0\xa9\xcf\x8f\x89\x96\xae\x80\xfb\xfb\x4d\x66\x9d\xc5\x31\x41\x8b\xa6\x29\x3a\x67\x30\x80\x6b\x5b\xb2\x0e\x85\xfc\xae\xef\x6d\x16\x0c\xd3\x3d\xa7\x40\x66\x50\x63\x76\x92\x7b\x4f\xf4\x56\x84\x46\x00\x8d\x89\xc9\xba\xb0\xf1\x3b\x25\x99\x0e\xf1\xbc\xdc\xc9\xf7\x9f\x1f\x57\x3a\xcf\x99\x04\x62\xf0\x3b\x4d\x79\x80\xc7\xa2\x56\x3f\xfe\x82\x9e\xe9\xed\xf4\x5f\x98\x6e\x53\xe4\x97\xa3\xfb\x99\x32\xd6\xf4\x55\x91\xce\xb8\x86\x55\xd2\x1b\x60\x71\xe7\x0a\x3e\x87\x22\x62\x6f\xf6\xab\x1c\x78\x8d\x57\xab\x5d\x57\xac\x8f\xfe\x9c\x74\x8e\x36\x85\x0b\xb0\x8e\xb1\xa2\xef\xd8\x76\x9e\x2a\x13\xdb\xc5\xa1\xb3\x26\x4c\x00\xdc\x63\xd8\x41\x35\xa2\x56\xea\x50\x7f\xf9\x63\xc0\x54\xe2\x67\xc7\xa2\x63\x17\x4d\x8a\xcc\xe3\xed\x6f\xba\xfb\xbc\x7b\x22\xb8\xd9\xa2\xf3\xaa\x76\x57\x50\x67\x6d\x2c\x83\x99\x09\x30\xd5\x4e\x12\xa6\xdd\x81\xd9\xbe\x02\xf5\x1e\xc7\xb4\xce\x97\x07\xaa\x3e\xff\x65\x22\xa8\x42\xb4\x54\xf5\x13\xec\xe0\x36\xff\xaf\x8c\x11\xc0\xda\x79\xed\x1b\x89\x60\xc9\xc4\xb0\xf6\xce\xe8\xb8\x99\xc4\x74\x6d\x17\x93\x60\x90\x37\x66\x50\x6f\xa4\x7a\xe3\xba\x54\x59\xf3\x0b\xd3\xfc\xe2\xb1\xc9\x79\x3c\x2f\x85\x7d\x61\x63\xe2\x0e\x77\xbc\x89\x29\xe1\x6e\xf6\xb7\x1f\x9d\x2d\xea\x85\x87\xec\x12\x24\xe5\x5e\x0b\x59\x62\xd2\x49\x77\xb4\x92\xeb\xb1\x08\xf6\x81\xb3\xa0\x64\x5e\xc2\x1f\x7f\xce\xfe\x1a\x00\xd2\x27\xa5\x96\x72\x10\x00\x00")
 
 func crdsBasesPluginsFarosSh_monitoringsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -281,7 +287,7 @@ func crdsBasesPluginsFarosSh_monitoringsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBasesPluginsFarosSh_networksYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcf\x6f\x1b\xbb\x11\xbe\xeb\xaf\x18\xa0\x87\xb4\x80\xb5\x8a\xd1\x4b\x21\xa0\x07\xc3\x6d\x01\xa3\x49\x60\xc4\x4e\xee\xb3\xe4\x48\xcb\x98\x4b\x6e\x39\x43\x25\xea\xc3\xfb\xdf\x1f\x86\x5c\xad\x64\x59\xeb\x18\xef\xe1\xf1\xb6\x24\x77\x7e\x7c\xdf\xcc\x37\x5c\x2e\x97\x0b\x1c\xdc\x57\x4a\xec\x62\x58\x03\x0e\x8e\x7e\x08\x05\xfd\xe2\xe6\xe9\x1f\xdc\xb8\xb8\xda\x5d\x2f\x9e\x5c\xb0\x6b\xb8\xcd\x2c\xb1\xff\x4c\x1c\x73\x32\xf4\x2f\xda\xb8\xe0\xc4\xc5\xb0\xe8\x49\xd0\xa2\xe0\x7a\x01\x80\x21\x44\x41\xdd\x66\xfd\x04\x30\x31\x48\x8a\xde\x53\x5a\x6e\x29\x34\x4f\xb9\xa5\x36\x3b\x6f\x29\x15\xe3\x07\xd7\xbb\xf7\xcd\xf5\xfb\xe6\xfd\x02\xc0\x24\x2a\xff\x3f\xba\x9e\x58\xb0\x1f\xd6\x10\xb2\xf7\x0b\x80\x80\x3d\xad\x21\x90\x7c\x8f\xe9\x89\x9b\xc1\xe7\xad\x0b\xdc\x6c\x30\x45\x6e\xb8\x5b\xf0\x40\x46\x9d\x6e\x53\xcc\xc3\x1a\x5e\x9c\x57\x0b\x63\x5c\x35\xa7\x4f\xd5\x58\xd9\xf1\x8e\xe5\xbf\xa7\xbb\x1f\x1c\x4b\x39\x19\x7c\x4e\xe8\x8f\xae\xcb\x26\xbb\xb0\xcd\x1e\xd3\xb4\xbd\x00\x60\x13\x07\x5a\xc3\x27\x75\x33\xa0\x21\xbb\x00\x18\x53\x2c\x6e\x97\x80\xd6\x16\xd0\xd0\xdf\x27\x17\x84\xd2\x6d\xf4\xb9\x3f\x80\xb5\x84\x6f\x1c\xc3\x3d\x4a\xb7\x86\xe6\x00\x6b\xf3\x02\x91\x72\xf7\x80\xc7\xcd\x96\xc6\x6f\xd9\xab\x73\x8b\x52\x37\xea\xf1\xee\x1a\xfd\xd0\xe1\x75\x8d\xd9\x74\xd4\xe3\x7a\xbc\x1f\x07\x0a\x37\xf7\x77\x5f\xff\xfe\xf0\x6c\x1b\xc0\x12\x9b\xe4\x06\x29\xcc\x8c\x60\x80\x63\x90\x8e\xa0\xde\x85\x4d\x4c\xe5\xb3\x82\x0c\x37\xf7\x77\xd3\xdf\x43\x8a\x03\x25\x71\x07\xa4\xeb\x3a\xa9\xb3\x93\xdd\x33\x5f\xef\x34\x9c\x7a\x0b\xac\x16\x18\x55\xa7\x23\x86\x64\xc7\x0c\x20\x6e\x40\x3a\xc7\x90\x68\x48\xc4\x14\x6a\xc9\x3d\x33\x0c\x7a\x09\x03\xc4\xf6\x1b\x19\x69\xe0\x81\x92\x9a\x01\xee\x62\xf6\x56\xeb\x72\x47\x49\x20\x91\x89\xdb\xe0\xfe\x3f\xd9\x66\x90\x58\x9c\x7a\x14\x1a\x0b\xe0\xb8\x0a\x67\x01\x3d\xec\xd0\x67\xba\x02\x0c\x16\x7a\xdc\x43\x22\xf5\x02\x39\x9c\xd8\x2b\x57\xb8\x81\x8f\x31\x11\xb8\xb0\x89\x6b\xe8\x44\x06\x5e\xaf\x56\x5b\x27\x87\xfe\x32\xb1\xef\x73\x70\xb2\x5f\x95\x56\x71\x6d\x96\x98\x78\x65\x69\x47\x7e\xc5\x6e\xbb\xc4\x64\x3a\x27\x64\x24\x27\x5a\xe1\xe0\x96\x25\xf4\x50\x7a\xac\xe9\xed\x5f\xd2\xd8\x91\xfc\xee\x59\xac\xb5\x1a\x58\x92\x0b\xdb\x93\x83\x52\xf7\xaf\x30\xa0\x1d\xa0\x5c\xe3\xf8\x6b\xcd\xe2\x08\xb4\x6e\x29\x3a\x9f\xff\xfd\xf0\x08\x07\xd7\x85\x8c\x73\xf4\x0b\xee\xc7\x1f\xf9\x48\x81\x02\xe6\xc2\x86\x52\x25\x71\x93\x62\x5f\x6c\x52\xb0\x43\x74\x41\xca\x87\xf1\x8e\xc2\x39\xfc\x9c\xdb\xde\x89\xf2\xfe\xbf\x4c\x2c\xca\x55\x03\xb7\x45\x74\xa0\x25\xc8\x83\x56\xbf\x6d\xe0\x2e\xc0\x2d\xf6\xe4\x6f\x91\xe9\x4f\x27\x40\x91\xe6\xa5\x02\xfb\x36\x0a\x4e\xf5\xf2\xfc\x72\x45\xed\xe4\xe0\x20\x6a\x33\x7c\x8d\xdd\xf9\x30\x90\x79\xd6\x31\x96\xd8\x25\xad\x69\x41\x21\xed\x84\xda\xa8\x6f\xf2\x28\x28\x99\xdf\xe0\xb3\xdc\x7b\xe6\x35\xb6\xac\x14\xbf\xee\xf6\xb2\x3e\x40\x9d\x15\x55\x1d\x5f\x9c\x9c\x45\x70\x9b\x53\xa2\x20\x6a\xca\x10\xab\x12\x1f\x3d\x6a\x1c\x37\x5b\x0a\xd2\xbc\xb0\xe1\x84\xfa\x0b\xa6\xcf\x8d\x1f\xa2\x98\x52\x2b\x2a\xa2\x99\x15\x95\x29\xba\x72\x28\x6f\x4d\x05\xab\xa0\x5f\xb0\x5b\xc1\xa4\x97\x91\xbc\x86\x42\x5d\x1e\x59\x1e\x13\x06\x76\x07\xe9\xbf\x7c\xef\x2c\xf8\x0f\xc8\x02\xe2\x7a\xaa\x3d\x34\xa5\x22\x93\x29\xb2\xb5\xe1\x62\xa0\x91\xea\x19\xbb\xa0\x42\x88\x21\x4a\x47\xa9\x81\x47\xed\xd4\x51\x3b\x5b\x82\xef\x1d\x85\xe2\x22\x07\x4b\xc9\xef\x95\x82\xa3\x37\xd3\x61\xd8\x92\xbd\x94\x77\x5d\x77\xca\x13\x8a\x0a\x8d\xb6\xee\x53\x88\xdf\xc3\x95\xda\x0b\x90\xf9\x20\x31\x25\x8d\xc9\xd1\xcd\xfd\x1d\x6c\x1c\x79\x3b\x6b\x74\xf4\x5a\xd4\xcb\x18\x1a\x04\x5b\x7f\x11\x7b\x5d\x9b\x98\x7a\x94\x3a\x2e\x97\xea\x69\xe6\xde\x4c\x0f\x1f\x57\x4f\xcc\xb8\x7d\x1b\x3b\x37\xd0\xe5\x1e\x03\x24\x42\xab\xc1\x1d\x7e\x06\x17\xac\x33\x58\xc4\xd5\x92\xa0\xf3\x0c\xd8\xc6\x7c\x2e\x7f\x27\x61\x29\x3e\x13\xa7\x23\x3d\x05\x9e\xa2\xae\x2d\x01\xf5\x83\xec\xe7\xb2\xff\x69\x56\x89\x90\xcf\x47\xf5\x4c\x52\x8f\x1d\x8d\xd7\xa7\x57\xc1\x54\x09\xef\xb8\x14\xf2\x49\xa8\xb3\x19\xb9\x67\x92\xad\x46\x55\xfa\xdc\xc6\x99\x42\xbd\x66\x65\xba\x18\xb9\x94\x84\xd6\x24\xc4\x54\x8a\xe7\xc2\xec\x39\xae\x0a\x89\x63\x0d\x89\x9d\x25\xd5\x44\x84\x6d\xc6\x84\x41\x88\xac\xda\x7e\x81\x5e\x9d\x26\xb3\x36\xff\x18\xb2\x4c\x3b\x4a\x4e\xf6\x6f\xc2\xf6\x61\xbc\xac\x72\xb1\x73\xb6\x6a\x11\xfd\x18\xbc\x33\x4e\xc0\x78\x64\x56\x84\x0e\xba\x34\x1b\xf2\xe7\xca\x8f\x89\x96\xae\x80\xeb\xfb\x26\xb3\xce\xe2\x98\xa0\x47\xd3\x15\x9d\x33\x18\xc0\xf5\x3d\x59\x87\x42\x7e\x5f\x7b\x9b\x05\xc3\x7c\xcf\x15\xb6\x47\x35\x66\x27\xb9\x46\xa2\xaf\x22\x34\xa2\x6d\x18\x93\x75\x61\xeb\xf7\x95\xd2\x29\x9f\xd7\x3b\xf9\xe3\x97\x87\x47\xad\x61\x26\x81\x18\xfc\xbe\xaa\x40\x1d\x38\xff\xfc\x0f\x7a\x9e\x6d\xeb\x9f\xc3\x7f\x61\xba\xcd\x81\x5f\x07\xdc\x38\x53\xa6\x9a\xbe\x2a\xd2\x19\x37\xf0\x98\xf4\x05\x58\xc2\xb9\x82\x2f\xa1\x88\xd8\xef\x8e\xab\x5c\x78\x53\xbb\xed\x87\xe2\xfd\xa8\xb6\xa7\x9d\xa3\x7c\x3a\x6d\xc4\xd8\xd0\x0f\xec\x07\x4f\x8d\x89\xfd\xea\xd8\x59\xf3\x98\x63\xd8\x43\x33\x59\x6d\x34\xa0\xf1\x09\x0b\x98\xa8\x36\x10\x8b\x12\x8d\x26\x45\xe6\xe9\xf5\x37\xdf\x7d\xde\x3d\x11\xdc\xec\xd0\x79\x55\xbb\x2b\x68\xb3\x36\x96\xc1\xcc\x04\x98\x5a\x27\x09\xd3\xfe\x64\xec\x97\x0a\x6c\x4b\x69\x6e\xf2\xe5\x81\xaa\xeb\xaf\x4c\x04\x4d\x88\x96\x9a\x4a\xe7\x31\x6c\xfe\x5b\x19\x23\x80\xad\xf3\x5a\x67\x12\xc1\x92\x89\x61\xe3\x9d\xd1\x71\x33\x2f\x3f\xfd\x10\x93\xe0\xa5\x67\xc3\x09\x41\xf3\x0c\xea\x8b\x54\x5f\x5c\x97\x38\x5c\x5e\x98\xe6\x17\xaf\xcd\xce\xe3\x65\x71\x7f\xe1\x60\xe6\x0d\x77\x7a\x88\x29\xe1\xfe\xe7\x0f\xbf\x17\x9b\xf5\x29\xb7\x06\x49\xb9\x7a\x66\x89\x49\x27\xdd\xc9\x4e\x6e\xa7\x22\x38\x24\x3e\x36\x18\xfc\xf2\xeb\xe2\xb7\x00\x00\x00\xff\xff\x27\xd9\x6b\x08\x60\x10\x00\x00")
+var _crdsBasesPluginsFarosSh_networksYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\x6f\x23\xbd\x0d\xbd\xfb\x57\x10\xe8\x61\x5b\x20\x1e\x6f\xd0\x4b\x61\xa0\x87\x20\x6d\x81\xa0\xbb\x8b\x60\xe3\xdd\x3b\x47\xa2\x3d\xda\x68\xa4\xa9\x48\x79\xd7\x2d\xfa\xdf\x0b\x4a\xe3\xb1\x93\x78\xd2\x20\x1f\x3e\x8f\x2f\x23\x69\x1e\xc9\x47\xf2\x51\xcb\xe5\x72\x81\x83\xfb\x4e\x89\x5d\x0c\x6b\xc0\xc1\xd1\x2f\xa1\xa0\x6f\xdc\x3c\xfe\x85\x1b\x17\x57\xfb\xeb\xc5\xa3\x0b\x76\x0d\xb7\x99\x25\xf6\x5f\x89\x63\x4e\x86\xfe\x46\x5b\x17\x9c\xb8\x18\x16\x3d\x09\x5a\x14\x5c\x2f\x00\x30\x84\x28\xa8\xcb\xac\xaf\x00\x26\x06\x49\xd1\x7b\x4a\xcb\x1d\x85\xe6\x31\xb7\xd4\x66\xe7\x2d\xa5\x02\x7e\x34\xbd\xff\xd8\x5c\x7f\x6c\x3e\x2e\x00\x4c\xa2\xf2\xfd\xc6\xf5\xc4\x82\xfd\xb0\x86\x90\xbd\x5f\x00\x04\xec\x69\x0d\x81\xe4\x67\x4c\x8f\xdc\x0c\x3e\xef\x5c\xe0\x66\x8b\x29\x72\xc3\xdd\x82\x07\x32\x6a\x74\x97\x62\x1e\xd6\xf0\x62\xbf\x22\x8c\x7e\xd5\x98\xbe\x54\xb0\xb2\xe2\x1d\xcb\x3f\xcf\x57\x3f\x39\x96\xb2\x33\xf8\x9c\xd0\x9f\x4c\x97\x45\x76\x61\x97\x3d\xa6\x69\x79\x01\xc0\x26\x0e\xb4\x86\x2f\xd8\x13\x0f\x68\xc8\x2e\x00\xc6\x10\x8b\xd9\x25\xa0\xb5\x85\x34\xf4\xf7\xc9\x05\xa1\x74\x1b\x7d\xee\x8f\x64\x2d\xe1\x07\xc7\x70\x8f\xd2\xad\xa1\x39\xd2\xda\xbc\x60\xa4\xd8\x3f\xf2\x71\xb3\xa3\xf1\x5d\x0e\x6a\xdc\xa2\xd4\x85\xba\xbd\xbf\x46\x3f\x74\x78\x5d\x96\xd8\x74\xd4\x97\x3c\xe9\x5b\x1c\x28\xdc\xdc\xdf\x7d\xff\xf3\xc3\x93\x65\x00\x4b\x6c\x92\x1b\xd4\xe6\x44\x06\x38\x06\xe9\x08\xea\x59\xd8\xc6\x54\x5e\x2b\xc9\x70\x73\x7f\x37\x7d\x3d\xa4\x38\x50\x12\x77\x64\xba\x3e\x67\x75\x76\xb6\xfa\xcc\xd6\x07\x75\xa7\x9e\x02\xab\x05\x46\xd5\xe8\xc8\x21\xd9\x31\x02\x88\x5b\x90\xce\x31\x24\x1a\x12\x31\x85\x5a\x72\x4f\x80\x41\x0f\x61\x80\xd8\xfe\x20\x23\x0d\x3c\x50\x52\x18\xe0\x2e\x66\x6f\xb5\x2e\xf7\x94\x04\x12\x99\xb8\x0b\xee\xdf\x13\x36\x83\xc4\x62\xd4\xa3\xd0\x58\x00\xa7\xa7\xe4\x2c\xa0\x87\x3d\xfa\x4c\x57\x80\xc1\x42\x8f\x07\x48\xa4\x56\x20\x87\x33\xbc\x72\x84\x1b\xf8\x1c\x13\x81\x0b\xdb\xb8\x86\x4e\x64\xe0\xf5\x6a\xb5\x73\x72\xec\x2f\x13\xfb\x3e\x07\x27\x87\x55\x69\x15\xd7\x66\x89\x89\x57\x96\xf6\xe4\x57\xec\x76\x4b\x4c\xa6\x73\x42\x46\x72\xa2\x15\x0e\x6e\x59\x5c\x0f\x1a\x30\x37\xbd\xfd\x43\x1a\x3b\x92\x3f\x3c\xf1\xb5\x56\x03\x4b\x72\x61\x77\xb6\x51\xea\xfe\x95\x0c\x68\x07\x68\xae\x71\xfc\xb4\x06\x7a\x22\x5a\x97\x94\x9d\xaf\x7f\x7f\xd8\xc0\xd1\x74\x49\xc6\x13\x50\x18\x79\x3f\x7d\xc8\xa7\x14\x28\x61\x2e\x6c\x49\x4b\xc8\x31\x6c\x53\xec\x0b\xe3\x14\xec\x10\x5d\x90\xf2\x62\xbc\xa3\xf0\x9c\x7e\xce\x6d\xef\x44\xf3\xfe\xaf\x4c\x2c\x9a\xab\x06\x6e\x8b\xe8\x40\x4b\x90\x07\xad\x7e\xdb\xc0\x5d\x80\x5b\xec\xc9\xdf\x22\xd3\xef\x9e\x00\x65\x9a\x97\x4a\xec\xdb\x52\x70\xae\x97\xa7\x9f\xa2\xac\x47\xd6\xce\x36\x8e\xa2\x36\x93\xaf\xb1\x3b\x1f\x06\x32\x4f\x3a\xc6\x12\xbb\xa4\x35\x2d\x28\xa4\x9d\x50\x1b\xf5\x4d\x16\x05\x25\xf3\x1b\x6c\x96\x73\x4f\xac\xc6\x96\xb5\xcb\x5e\x37\x7b\x59\x1f\xc6\x59\x51\xd5\xf1\xc5\xce\x33\x0f\x6e\x73\x4a\x14\x04\x86\x14\x0d\xb1\x2a\xf1\xc9\xa2\xd6\xce\xcd\x8e\x82\x34\x2f\x30\x9c\x50\x7f\x01\xfa\x39\xf8\xd1\x8b\x29\xb4\xa2\x22\x1a\x59\x51\x19\x35\x82\x23\x6d\xa0\xa1\x94\x55\xf4\x17\x70\x2b\x99\xf4\xd2\x93\xd7\x58\xa8\x8f\x47\x96\x4d\xc2\xc0\x85\x10\x95\xfe\xcb\xe7\x9e\x39\xff\x09\x59\x40\x5c\x4f\x25\x1f\x13\xa1\x20\x13\x14\xd9\xda\x70\x31\xd0\x98\xea\x19\x5c\x50\x21\xc4\x10\xa5\xa3\xd4\xc0\xa6\x73\x93\x76\xb6\x04\x3f\x3b\x0a\xc5\x44\x0e\x96\x92\x3f\x68\x0a\x4e\xd6\x4c\x87\x61\x47\xf6\x52\xdc\xf5\xb9\xd3\x3c\xa1\xa8\xd0\x68\xeb\x3e\x86\xf8\x33\x5c\x29\x5e\x80\xcc\x47\x89\x29\x61\x4c\x86\x6e\xee\xef\x60\xeb\xc8\xdb\x59\xd0\xd1\xaa\x82\xa2\x31\x34\x08\xb6\xfe\x22\xf7\xfa\xdf\xc6\xd4\xa3\xd4\x71\xb9\x54\x4b\x33\xe7\x66\x7a\xf8\xf4\xf4\xc4\x8c\xbb\xb7\x65\xe7\x06\xba\xdc\x63\x80\x44\x68\xd5\xb9\xe3\xc7\xe0\x82\x75\x06\x45\x23\xb7\x24\xe8\x3c\x03\xb6\x31\xcb\xe2\x22\xa6\xba\xd5\xd1\x59\x4e\xc7\xf4\x14\x7a\xca\x38\x6a\x09\xa8\x1f\xe4\xd0\xbc\x37\xaa\x44\xc8\xcf\x47\xf5\x4c\x50\x9b\x8e\x34\x20\x8e\x61\xba\x15\x4c\x95\xf0\x81\x4b\x21\x9f\xb9\x3a\x83\xa8\x93\xf5\x5c\xb2\x15\x54\xa5\xcf\x6d\x9d\xd1\xdb\x45\x89\xca\x74\x31\x72\xa9\x3d\xad\x49\x88\xa9\x14\xcf\x85\xd9\x73\x7a\x2a\x25\x8e\x75\xde\xb3\xb3\xa4\x9a\x88\xb0\xcb\x98\x30\x08\x91\x55\xec\x17\xec\x29\x6a\x3b\x57\x10\xf0\x1b\x99\x65\xda\x53\x72\x72\x78\x13\xb7\x0f\xe3\x61\x95\x8b\xbd\xb3\x55\x8b\xe8\xd7\xe0\x9d\x71\x02\xc6\x23\xb3\x32\x74\xd4\xa5\x19\x48\x80\xaf\x35\x3f\x26\x5a\xba\x02\xae\xf7\x9b\xcc\x3a\x8b\x63\x82\x1e\x4d\x57\x74\xce\x60\x00\xd7\xf7\x64\x1d\x0a\xf9\x43\xed\x6d\x16\x0c\xf3\x3d\xa7\x40\x66\x54\x63\x76\x92\xab\x27\x7a\x2b\x42\x23\x80\xc6\xc4\x64\x5d\xd8\xf9\x83\x92\x4c\xa7\x78\x5e\xef\xe4\xcf\xdf\x1e\x36\x3a\xcf\x99\x04\x62\xf0\x07\x4d\x79\x80\x87\xa2\x56\x7f\xfd\x07\x7a\xa6\xf7\xd3\x7f\x61\xba\xcd\x91\x5f\x8e\x1e\x67\xca\x54\xd3\x57\x45\x3a\xe3\x16\x36\x49\x6f\x80\xc5\x9d\x2b\xf8\x16\x8a\x88\xbd\xdb\xaf\x72\xe0\x2d\x5e\x6d\x0e\x43\xb1\x3e\xf9\xf3\xa4\x73\xb4\x29\x5c\x80\x6d\x8c\x0d\xfd\xc2\x7e\xf0\xd4\x98\xd8\xaf\x4e\x9d\x35\x63\x02\xe0\x33\x86\x03\x34\x13\x6a\xa3\x0e\xd5\xcb\x1f\x03\xa6\x12\x3f\x3b\x16\x1d\xbb\x68\x52\x64\x9e\x6e\x7f\xf3\xdd\xe7\xdd\x23\xc1\xcd\x1e\x9d\x57\xb5\xbb\x82\x36\x6b\x63\x19\xcc\x4c\x80\xa9\x75\x92\x30\x1d\x4e\xcc\xd6\x0a\xd4\x7b\x1c\xd3\x36\x5f\x1e\xa8\xfa\xfc\x91\x89\xa0\x09\xd1\x52\x53\x27\xd8\xc9\x6d\xfe\x53\x19\x23\x80\xad\xf3\xda\x37\x12\xc1\x92\x89\x61\xeb\x9d\xd1\x71\x33\x8b\xe9\xfa\x21\x26\xc1\x20\xef\xcc\xa0\xde\x48\xf5\xc6\x75\xa9\xb2\x96\x17\xa6\xf9\xc5\x63\xb3\xf3\x78\x59\x0a\xfb\xc2\xc6\xcc\x1d\xee\x7c\x13\x53\xc2\xc3\xe2\xff\x7e\xf4\x62\x51\x2f\x3c\x64\xd7\x20\x29\x57\x2d\x64\x89\x49\x27\xdd\xd9\x4a\x6e\xa7\x22\x38\x06\xce\x82\x92\x79\x0d\xff\xf9\xef\xe2\x7f\x03\x00\x27\xd9\x6b\x08\x60\x10\x00\x00")
 
 func crdsBasesPluginsFarosSh_networksYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -301,7 +307,7 @@ func crdsBasesPluginsFarosSh_networksYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBasesPluginsFarosSh_notificationsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\x1b\x39\x12\xbd\xeb\x57\x14\xb0\x87\xec\x02\x56\x2b\xc6\x5e\x16\x02\xf6\x60\x78\x66\x00\x63\x92\xc0\x88\x9d\xdc\xab\xc9\x92\x9a\x31\x9b\xec\x61\x15\x95\x68\x06\xf3\xdf\x07\x45\xb6\x3e\x2c\xa9\x1d\x23\x83\xe1\xad\x49\x76\x7d\xbd\x7a\xaf\x38\x9f\xcf\x67\x38\xb8\xcf\x94\xd8\xc5\xb0\x04\x1c\x1c\x7d\x13\x0a\xfa\xc5\xcd\xd3\xff\xb8\x71\x71\xb1\xb9\x9e\x3d\xb9\x60\x97\x70\x9b\x59\x62\xff\x91\x38\xe6\x64\xe8\x27\x5a\xb9\xe0\xc4\xc5\x30\xeb\x49\xd0\xa2\xe0\x72\x06\x80\x21\x44\x41\xdd\x66\xfd\x04\x30\x31\x48\x8a\xde\x53\x9a\xaf\x29\x34\x4f\xb9\xa5\x36\x3b\x6f\x29\x15\xe3\x3b\xd7\x9b\xb7\xcd\xf5\xdb\xe6\xed\x0c\xc0\x24\x2a\xff\x3f\xba\x9e\x58\xb0\x1f\x96\x10\xb2\xf7\x33\x80\x80\x3d\x2d\x21\x44\x71\x2b\x67\xaa\x8f\x66\xf0\x79\xed\x02\x37\x2b\x4c\x91\x1b\xee\x66\x3c\x90\x51\xcf\xeb\x14\xf3\xb0\x84\xb3\xf3\x6a\x66\x0c\xae\x26\xf6\xe1\xc8\x62\xd9\xf6\x8e\xe5\xd7\xb3\xa3\x77\x8e\xa5\x1c\x0f\x3e\x27\xf4\x27\x91\x94\x13\x76\x61\x9d\x3d\xa6\xe7\x67\x33\x00\x36\x71\xa0\x25\x7c\x50\xd7\x03\x1a\xb2\x33\x80\x31\xf7\x12\xca\x1c\xd0\xda\x52\x4d\xf4\xf7\xc9\x05\xa1\x74\x1b\x7d\xee\x77\x55\x9c\xc3\x17\x8e\xe1\x1e\xa5\x5b\x42\xb3\xab\x77\x73\x56\xaa\x72\x77\x57\xa8\x9b\x35\x8d\xdf\xb2\x55\xe7\x16\xa5\x6e\xd4\xe3\xcd\x35\xfa\xa1\xc3\xeb\x1a\xb8\xe9\xa8\xc7\xe5\x78\x3f\x0e\x14\x6e\xee\xef\x3e\xff\xf7\xe1\xd9\x36\x80\x25\x36\xc9\x0d\x52\x20\x3b\xae\x0d\x38\x06\xe9\x08\xea\x0f\xb0\x8a\xa9\x7c\xd6\xea\xc3\xcd\xfd\xdd\xde\xc4\x90\xe2\x40\x49\xdc\x0e\x82\xba\x8e\xba\xf0\x68\xf7\xc4\xe1\x1b\x8d\xa9\xde\x02\xab\xed\x47\xd5\xe9\x58\x48\xb2\x63\x1a\x10\x57\x20\x9d\x63\x48\x34\x24\x62\x0a\x72\x80\xf6\xb0\xe2\x0a\x30\x40\x6c\xbf\x90\x91\x06\x1e\x28\xa9\x19\xe0\x2e\x66\x6f\xb5\x6b\x37\x94\x04\x12\x99\xb8\x0e\xee\xf7\xbd\x6d\x06\x89\xc5\xa9\x47\xa1\xb1\x1f\x0e\xab\x00\x17\xd0\xc3\x06\x7d\xa6\x2b\xc0\x60\xa1\xc7\x2d\x24\x52\x2f\x90\xc3\x91\xbd\x72\x85\x1b\x78\x1f\x13\x81\x0b\xab\xb8\x84\x4e\x64\xe0\xe5\x62\xb1\x76\xb2\x63\x9f\x89\x7d\x9f\x83\x93\xed\xa2\x10\xc9\xb5\x59\x62\xe2\x85\xa5\x0d\xf9\x05\xbb\xf5\x1c\x93\xe9\x9c\x90\x91\x9c\x68\x81\x83\x9b\x97\xd0\x43\x65\x47\x6f\xff\x95\x46\xbe\xf2\x9b\x67\xb1\xd6\x96\x60\x49\x2e\xac\x8f\x0e\x0a\x21\x5e\x40\x40\x59\xa1\x58\xe3\xf8\x6b\xcd\xe2\x50\x68\xdd\xd2\xea\x7c\xfc\xf9\xe1\x11\x76\xae\x0b\x18\xa7\xd5\x2f\x75\x3f\xfc\xc8\x07\x08\xb4\x60\x2e\xac\x28\x55\x10\x57\x29\xf6\xc5\x26\x05\x3b\x44\x17\xa4\x7c\x18\xef\x28\x9c\x96\x9f\x73\xdb\x3b\x51\xdc\x7f\xcb\xc4\xa2\x58\x35\x70\x5b\x24\x09\x5a\x82\x3c\x28\x05\x6c\x03\x77\x01\x6e\xb1\x27\x7f\x8b\x4c\xff\x38\x00\x5a\x69\x9e\x6b\x61\x5f\x07\xc1\xb1\x9a\x9e\x5e\xae\x55\x3b\x3a\xd8\xa9\xdd\x04\x5e\xc7\x14\x7d\x18\xc8\x3c\xa3\x8d\x25\x76\x49\x1b\x5b\x50\x48\xe9\x50\xd9\xfa\x2a\xb7\x82\x92\xf9\xb5\x8e\xcb\xe5\x67\xae\x63\xcb\x0a\xf6\xcb\xbe\x2f\x2b\x05\xd4\x99\x52\xc5\xf2\xec\xe4\x24\x8c\xdb\x9c\x12\x05\x51\x53\x86\x58\xd5\xf9\xe0\xf1\xa0\x4f\xcd\x99\x11\x27\xd4\x5f\xb0\x7d\x6a\x7d\x17\xc6\x3e\xb7\x22\x28\x9a\x5a\x15\x45\x95\x98\x5d\xa7\x6b\x2e\x58\x05\xfe\x82\xdd\x5a\x52\x3a\x8f\xe4\xa5\x32\xd4\xe5\x91\xe5\x31\x61\x60\xb7\x1b\x05\x97\xef\x9d\x04\xff\x0e\x59\x40\x5c\x4f\x95\x4e\xfb\x54\x64\x6f\x8a\x6c\xe5\x5e\x0c\x34\x02\x3e\x61\x17\x54\x13\x31\x44\xe9\x28\x35\xf0\xa8\xa4\x1d\x65\xb4\x25\xf8\xda\x51\x28\x2e\x72\xb0\x94\xfc\x56\x31\x38\x78\x33\x1d\x86\x35\xd9\x4b\x79\xd7\x75\xa7\x40\xa1\xa8\xe6\x28\x8b\x9f\x42\xfc\x1a\xae\xd4\x5e\x80\xcc\x3b\xb5\x29\x69\xec\x1d\xdd\xdc\xdf\xc1\xca\x91\xb7\x93\x46\x47\xaf\x45\xc8\x8c\xa1\x41\xb0\xf5\x17\x6b\xaf\x6b\x15\x53\x8f\x52\xc7\xe7\x5c\x3d\x4d\xdc\x9b\xa0\xf3\x61\xf5\xc4\x8c\xeb\xd7\xa1\x73\x03\x5d\xee\x31\x40\x22\xb4\x1a\xdc\xee\x67\x70\xc1\x16\x5a\x85\x35\x58\x12\x74\x9e\x01\xdb\x98\x4f\x95\xf0\x28\x2c\xad\xcf\x1e\xd3\x11\x9e\x52\x9e\x22\xb4\x2d\x01\xf5\x83\x6c\xa7\xb2\xff\x6e\x56\x89\x90\x4f\xa7\xf6\x44\x52\x8f\x1d\x8d\xd7\xf7\x0f\x84\x7d\x27\xbc\xe1\xd2\xc8\x47\xa1\x4e\x66\xe4\x9e\xa9\xb7\x1a\x55\x15\x54\xb9\x29\xd0\x6b\x56\xa6\x8b\x91\x4b\x4b\x68\x4f\x42\x4c\xa5\x79\x2e\x8c\xa1\xc3\xaa\x25\x71\xac\x21\xb1\xb3\xa4\xca\x88\xb0\xce\x98\x30\x08\x91\x55\xdb\x67\xd5\xab\x83\x65\xd2\xe6\xdf\xab\x2c\xd3\x86\x92\x93\xed\xab\x6a\xfb\x30\x5e\x56\xb9\xd8\x38\x5b\xb5\x88\xbe\x0d\xde\x19\x27\x60\x3c\x32\x1f\x1e\x6b\x71\x35\x19\xf2\xc7\x8a\x8f\x89\x96\xae\x80\xeb\x53\x27\xb3\x8e\xe5\x98\xa0\x47\xd3\x15\x9d\x33\x18\xc0\xf5\x3d\x59\x87\x42\x7e\x5b\xb9\xcd\x82\x61\x9a\x73\x05\xed\x51\x8e\xd9\x49\xae\x91\xe8\x03\x09\x8d\x28\x0d\x63\xb2\x2e\xac\xfd\xb6\x42\xba\xcf\xe7\x65\x26\xbf\xff\xf4\xf0\xa8\x3d\xcc\x24\x10\x83\xdf\x56\x15\xa8\x13\xe7\xff\xbf\xa0\xe7\x49\x5a\x7f\xbf\xfc\x17\x66\xdc\x54\xf1\xeb\x84\x1b\x87\xca\xbe\xa7\xaf\x8a\x74\xc6\x15\x3c\x26\x7d\x0c\x96\x70\xae\xe0\x53\x28\x22\xf6\xc3\x71\x95\x0b\xaf\xa2\xdb\x76\x28\xde\x0f\x6a\x7b\xcc\x1c\xc5\xd3\x29\x11\x63\x43\xdf\xb0\x1f\x3c\x35\x26\xf6\x8b\x03\xb3\xa6\x6b\x8e\x61\x0b\xcd\xde\x6a\xa3\x01\x8d\xaf\x59\xc0\x44\x95\x40\x2c\x0a\x34\x9a\x14\x99\xf7\x0f\xc1\x69\xf6\x79\xf7\x44\x70\xb3\x41\xe7\x55\xed\xae\xa0\xcd\x4a\x2c\x83\x99\x09\x30\xb5\x4e\x12\xa6\xed\xd1\xdc\x2f\x1d\xd8\x96\xd6\x5c\xe5\xcb\x03\x55\xd7\xbf\x99\x08\x9a\x10\x2d\x35\x15\xce\x43\xd8\xfc\x9f\x32\x46\x00\x5b\xe7\xb5\xcf\x24\x82\x25\x13\xc3\xca\x3b\xa3\xe3\x66\x5a\x7e\xfa\x21\x26\xc1\x20\x3f\x88\xa0\x3e\x4e\xf5\xdd\x75\x09\xc3\xf9\x85\x69\x7e\xf1\xda\xe4\x3c\x9e\x17\xf7\x17\x0e\x26\x5e\x72\xc7\x87\x98\x12\x6e\xbf\xff\xfc\x3b\xdb\xac\x6f\xb9\x25\x48\xca\xd5\x33\x4b\x4c\x3a\xe9\x8e\x76\x72\xbb\x6f\x82\x5d\xe2\x23\xc1\xe0\x8f\x3f\x67\x7f\x05\x00\x00\xff\xff\xd6\x36\xc4\xec\x89\x10\x00\x00")
+var _crdsBasesPluginsFarosSh_notificationsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\x8f\xe3\xbc\x0d\xbd\xe7\x57\x10\xe8\x61\x5b\x60\xe2\xec\xa0\x97\x22\x40\x0f\x83\x69\x0b\x0c\xba\xbb\x18\xec\x64\xf7\x4e\x4b\x74\xac\x1d\x59\x72\x45\x2a\xbb\x69\xd1\xff\x5e\x50\x76\xe2\x64\x12\x4f\x07\xf3\xe1\x8b\x73\xb1\x24\x3f\x92\x8f\xe4\xa3\x96\xcb\xe5\x02\x7b\xf7\x9d\x12\xbb\x18\xd6\x80\xbd\xa3\x5f\x42\x41\xdf\xb8\x7a\xfe\x0b\x57\x2e\xae\x76\xb7\x8b\x67\x17\xec\x1a\xee\x33\x4b\xec\xbe\x12\xc7\x9c\x0c\xfd\x8d\x1a\x17\x9c\xb8\x18\x16\x1d\x09\x5a\x14\x5c\x2f\x00\x30\x84\x28\xa8\xcb\xac\xaf\x00\x26\x06\x49\xd1\x7b\x4a\xcb\x2d\x85\xea\x39\xd7\x54\x67\xe7\x2d\xa5\x02\x7e\x30\xbd\xfb\x58\xdd\x7e\xac\x3e\x2e\x00\x4c\xa2\xf2\xfd\xc6\x75\xc4\x82\x5d\xbf\x86\x90\xbd\x5f\x00\x04\xec\x68\x0d\x21\x8a\x6b\x9c\x29\x67\xb8\xea\x7d\xde\xba\xc0\x55\x83\x29\x72\xc5\xed\x82\x7b\x32\x6a\x79\x9b\x62\xee\xd7\x70\xb1\x3f\xc0\x8c\xce\x0d\x81\x7d\x39\x41\x2c\xcb\xde\xb1\xfc\xf3\x62\xeb\x93\x63\x29\xdb\xbd\xcf\x09\xfd\x0b\x4f\xca\x0e\xbb\xb0\xcd\x1e\xd3\xf9\xde\x02\x80\x4d\xec\x69\x0d\x5f\xb0\x23\xee\xd1\x90\x5d\x00\x8c\xb1\x17\x57\x96\x80\xd6\x16\x36\xd1\x3f\x26\x17\x84\xd2\x7d\xf4\xb9\x3b\xb0\xb8\x84\x1f\x1c\xc3\x23\x4a\xbb\x86\xea\xc0\x77\x75\x41\x55\x71\xe2\x40\xd4\xdd\x96\xc6\x77\xd9\xab\x71\x8b\x32\x2c\x0c\xdb\xbb\x5b\xf4\x7d\x8b\xb7\x65\x89\x4d\x4b\x5d\x49\xa0\xbe\xc5\x9e\xc2\xdd\xe3\xc3\xf7\x3f\x3f\x9d\x2d\x03\x58\x62\x93\x5c\xaf\x36\xcf\xb9\x01\xc7\x20\x2d\xc1\xf0\x01\x34\x31\x95\xd7\x81\x7d\xb8\x7b\x7c\x38\x42\xf4\x29\xf6\x94\xc4\x1d\x52\x30\x3c\x27\x55\x78\xb2\xfa\xc2\xe0\x07\xf5\x69\x38\x05\x56\xcb\x8f\x06\xa3\x23\x91\x64\xc7\x30\x20\x36\x20\xad\x63\x48\xd4\x27\x62\x0a\x32\xa5\x76\x7a\x62\x03\x18\x20\xd6\x3f\xc8\x48\x05\x4f\x94\x14\x06\xb8\x8d\xd9\x5b\xad\xda\x1d\x25\x81\x44\x26\x6e\x83\xfb\xf7\x11\x9b\x41\x62\x31\xea\x51\x68\xac\x87\xe9\x29\x89\x0b\xe8\x61\x87\x3e\xd3\x0d\x60\xb0\xd0\xe1\x1e\x12\xa9\x15\xc8\xe1\x04\xaf\x1c\xe1\x0a\x3e\xc7\x44\xe0\x42\x13\xd7\xd0\x8a\xf4\xbc\x5e\xad\xb6\x4e\x0e\xdd\x67\x62\xd7\xe5\xe0\x64\xbf\x2a\x8d\xe4\xea\x2c\x31\xf1\xca\xd2\x8e\xfc\x8a\xdd\x76\x89\xc9\xb4\x4e\xc8\x48\x4e\xb4\xc2\xde\x2d\x8b\xeb\x41\x03\xe6\xaa\xb3\x7f\x48\x63\xbf\xf2\x87\x33\x5f\x87\x92\x60\x49\x2e\x6c\x4f\x36\x4a\x43\xbc\x92\x01\xed\x0a\xcd\x35\x8e\x9f\x0e\x81\x4e\x44\xeb\x92\xb2\xf3\xf5\xef\x4f\x1b\x38\x98\x2e\xc9\x38\x03\x85\x91\xf7\xe9\x43\x9e\x52\xa0\x84\xb9\xd0\x90\x96\x90\x63\x68\x52\xec\x0a\xe3\x14\x6c\x1f\x5d\x90\xf2\x62\xbc\xa3\xf0\x92\x7e\xce\x75\xe7\x44\xf3\xfe\xaf\x4c\x2c\x9a\xab\x0a\xee\x8b\x24\x41\x4d\x90\x7b\x6d\x01\x5b\xc1\x43\x80\x7b\xec\xc8\xdf\x23\xd3\xef\x9e\x00\x65\x9a\x97\x4a\xec\xdb\x52\x70\xaa\xa6\xd3\x4f\x51\xd6\x23\x6b\x27\x1b\x07\xb5\x9b\xc9\xd7\x69\x8b\x3e\xf5\x64\xce\xda\xc6\x12\xbb\xa4\x85\x2d\x28\xa4\x3d\x33\x74\xeb\x9b\xcc\x0a\x4a\xe6\xb7\x1a\x2e\x87\xcf\x4c\xc7\x9a\xb5\xdf\x5e\xb7\x7d\x5d\x29\xc6\x99\x32\x88\xe5\xc5\xce\x0b\x37\xee\x73\x4a\x14\x04\xfa\x14\x0d\xb1\xaa\xf3\x64\x71\xd2\xa7\xea\x02\xc4\x09\x75\x57\xb0\x5f\xa2\x1f\xdc\x38\xc6\x56\x04\x45\x43\x2b\x82\xa3\x56\x70\x24\x0f\x34\x96\xb2\x8a\xfe\x0a\xee\x40\x29\x5d\x7a\xf2\x1a\x0d\xc3\xe3\x91\x65\x93\x30\x70\x61\x44\x47\xc1\xf5\x73\x2f\x9c\xff\x84\x2c\x20\xae\xa3\x92\x90\x23\xa3\x20\x47\x28\xb2\x43\xef\xc5\x40\x63\xc2\x67\x70\x41\x35\x11\x43\x94\x96\x52\x05\x9b\xd6\x1d\x65\xb4\x26\xf8\xd9\x52\x28\x26\x72\xb0\x94\xfc\x5e\x73\x30\x59\x33\x2d\x86\x2d\xd9\x6b\x71\x0f\xcf\x83\x26\x0a\x45\x35\x47\xbb\xf8\x39\xc4\x9f\xe1\x46\xf1\x02\x64\x3e\xa8\x4d\x09\xe3\x68\xe8\xee\xf1\x01\x1a\x47\xde\xce\x82\x8e\x56\x15\x14\x8d\xa1\x5e\xb0\xf6\x57\xb9\xd7\x7f\x13\x53\x87\x32\x8c\xcf\xa5\x5a\x9a\x39\x37\xd3\xce\xd3\xd3\x11\x33\x6e\xdf\x96\x9d\x3b\x68\x73\x87\x01\x12\xa1\x55\xe7\x0e\x1f\x83\x0b\xb6\xb4\x55\xd8\x82\x25\x41\xe7\x19\xb0\x8e\x59\x16\x57\x31\xd5\xad\x96\x4e\x72\x3a\xa6\xa7\xd0\x53\x26\x53\x4d\x40\x5d\x2f\xfb\xea\xbd\x51\x25\x42\x7e\x39\xb5\x67\x82\xda\xb4\xa4\x01\x71\x0c\xc7\x0b\xc2\xb1\x12\x3e\x70\x29\xe4\x13\x57\x67\x10\x75\xc8\x9e\xaa\xb7\x82\xaa\x0a\xba\xc6\x19\xbd\x68\x94\xa8\x4c\x1b\x23\x97\xda\xd3\x9a\x84\x98\xf4\x3e\x76\x6d\x0c\x4d\xbf\x81\x12\xc7\x3a\xfa\xd9\x59\x52\x65\x44\xd8\x66\x4c\x18\x84\xc8\x2a\xf6\x05\x7b\x8a\x5a\xcf\x15\x04\xfc\x46\x66\x99\x76\x94\x9c\xec\xdf\xc4\xed\xd3\x78\x58\xe5\x62\xe7\xec\xa0\x45\xf4\xab\xf7\xce\x38\x01\xe3\x91\xf9\x28\xc8\x10\x9b\x19\x48\x80\xaf\x43\x7e\x4c\xb4\x74\x03\x3c\x5c\x75\x32\xeb\x58\x8e\x09\x3a\x34\x6d\xd1\x39\x83\x01\x5c\xd7\x91\x75\x28\xe4\xf7\x43\x6f\xb3\x60\x98\xef\x39\x05\x32\xa3\x1c\xb3\x93\x3c\x78\xa2\x17\x24\x34\x02\x68\x4c\x4c\xd6\x85\xad\xdf\x2b\xc9\x34\xc5\xf3\x7a\x27\x7f\xfe\xf6\xb4\xd1\xd1\xce\x24\x10\x83\xdf\x6b\xca\x03\x3c\x15\xb5\xfa\xeb\x3f\xd0\x33\xbd\x9f\xfe\x2b\x33\x6e\x8e\xfc\x72\xf4\x30\x54\x8e\x35\x7d\x53\xa4\x33\x36\xb0\x49\x7a\x19\x2c\xee\xdc\xc0\xb7\x50\x44\xec\xdd\x7e\x95\x03\x6f\xf1\x6a\xb3\xef\x8b\xf5\xa3\x3f\x67\x9d\xa3\x4d\xe1\x02\x34\x31\x56\xf4\x0b\xbb\xde\x53\x65\x62\xb7\x9a\x3a\x6b\xc6\x04\xc0\x67\x0c\x7b\xa8\x8e\xa8\x95\x3a\x34\xdc\x03\x19\x30\x95\xf8\xd9\xb1\xe8\xdc\x45\x93\x22\xf3\xf1\x22\x38\xdf\x7d\xde\x3d\x13\xdc\xed\xd0\x79\x55\xbb\x1b\xa8\xb3\x36\x96\xc1\xcc\x04\x98\x6a\x27\x09\xd3\x7e\x62\x76\xa8\x40\xbd\xd2\x31\x35\xf9\xfa\x40\xd5\xe7\x8f\x4c\x04\x55\x88\x96\xaa\x61\x82\x4d\x6e\xf3\x9f\xca\x18\x01\xac\x9d\xd7\xbe\x91\x08\x96\x4c\x0c\x8d\x77\x46\xc7\xcd\x2c\xa6\xeb\xfa\x98\x04\x83\xbc\x33\x83\x7a\x39\xd5\x7b\xd7\xb5\xca\x5a\x5e\x99\xe6\x57\x8f\xcd\xce\xe3\x65\x29\xec\x2b\x1b\x33\x37\xb9\xd3\x4d\x4c\x09\xf7\x8b\xff\xfb\xd1\xc5\xa2\x5e\x78\xc8\xae\x41\x52\x1e\xb4\x90\x25\x26\x9d\x74\x27\x2b\xb9\x3e\x16\xc1\x21\x70\x16\x94\xcc\x6b\xf8\xcf\x7f\x17\xff\x1b\x00\xd6\x36\xc4\xec\x89\x10\x00\x00")
 
 func crdsBasesPluginsFarosSh_notificationsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -321,7 +327,7 @@ func crdsBasesPluginsFarosSh_notificationsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBasesTenancyFarosSh_usersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4f\x6f\xeb\xb8\x11\xbf\xfb\x53\x0c\xd0\xc3\x6b\x81\x58\x79\x0f\xbd\x14\x06\x7a\x08\xb2\x5b\x20\xe8\x6e\x11\xbc\xe4\xed\x7d\x4c\x8e\x2d\x6e\x28\x52\xe5\x0c\xbd\x4f\x2d\xfa\xdd\x8b\x21\x25\xd9\xb1\xad\x24\xd8\xa2\xbc\x89\x1c\xce\xbf\xdf\xcc\x6f\xa8\xf5\x7a\xbd\xc2\xde\xfd\x42\x89\x5d\x0c\x1b\xc0\xde\xd1\x77\xa1\xa0\x5f\xdc\xbc\xfc\x85\x1b\x17\x6f\x0f\x5f\x56\x2f\x2e\xd8\x0d\xdc\x67\x96\xd8\x7d\x25\x8e\x39\x19\xfa\x81\x76\x2e\x38\x71\x31\xac\x3a\x12\xb4\x28\xb8\x59\x01\x60\x08\x51\x50\xb7\x59\x3f\x01\x4c\x0c\x92\xa2\xf7\x94\xd6\x7b\x0a\xcd\x4b\xde\xd2\x36\x3b\x6f\x29\x15\xe5\x93\xe9\xc3\xe7\xe6\xcb\xe7\xe6\xf3\x0a\xc0\x24\x2a\xf7\x9f\x5d\x47\x2c\xd8\xf5\x1b\x08\xd9\xfb\x15\x40\xc0\x8e\x36\x90\x99\x12\x37\x42\x01\x83\x19\x9a\x1d\xa6\xc8\x0d\xb7\x2b\xee\xc9\xa8\xc5\x7d\x8a\xb9\xdf\xc0\xc5\x79\xbd\x3e\x3a\x55\x03\xfa\xc6\x94\xca\xa7\x77\x2c\x7f\x9f\xb7\x7e\x72\x2c\x65\xbb\xf7\x39\xa1\x1f\x2d\x96\x1d\x76\x61\x9f\x3d\xa6\xba\xb7\x02\x60\x13\x7b\xda\xc0\xbd\xcf\x2c\x65\x63\x0c\xa8\xd8\x59\x03\x5a\x5b\x52\x84\xfe\x31\xb9\x20\x94\xee\xa3\xcf\xdd\x94\x9a\x35\xfc\xca\x31\x3c\xa2\xb4\x1b\x68\xa6\x24\x36\x17\xf1\x17\xd9\x29\xfa\xbb\x3d\x8d\xdf\x32\xa8\x65\x8b\x52\x37\xea\xf1\xe1\x0b\xfa\xbe\xc5\x2f\xd5\x5b\xd3\x52\x87\x9b\x51\x3e\xf6\x14\xee\x1e\x1f\x7e\xf9\xf3\xd3\xab\x6d\x00\x4b\x6c\x92\xeb\xa5\xe0\xa0\x09\x00\xc7\x20\x2d\x41\x15\x84\x5d\x4c\xe5\xb3\x1c\xdd\x3d\x3e\xcc\x17\xfb\x14\x7b\x4a\xe2\xa6\xac\xd6\x75\x52\x50\x27\xbb\x67\x66\x3e\xa9\x27\x55\x0a\xac\x56\x12\x55\x93\x63\xfa\xc8\x8e\xce\x43\xdc\x81\xb4\x8e\x21\x51\x9f\x88\x29\xd4\xda\x7a\xa5\x18\x54\x08\x03\xc4\xed\xaf\x64\xa4\x81\x27\x4a\xaa\x06\xb8\x8d\xd9\x5b\x2d\xc0\x03\x25\x81\x44\x26\xee\x83\xfb\xd7\xac\x9b\x41\x62\x31\xea\x51\x68\x84\xfc\xb8\x0a\x5c\x01\x3d\x1c\xd0\x67\xba\x01\x0c\x16\x3a\x1c\x20\x91\x5a\x81\x1c\x4e\xf4\x15\x11\x6e\xe0\xe7\x98\x08\x5c\xd8\xc5\x0d\xb4\x22\x3d\x6f\x6e\x6f\xf7\x4e\xa6\x46\x32\xb1\xeb\x72\x70\x32\xdc\x96\x9e\x70\xdb\x2c\x31\xf1\xad\xa5\x03\xf9\x5b\x76\xfb\x35\x26\xd3\x3a\x21\x23\x39\xd1\x2d\xf6\x6e\x5d\x5c\x0f\xa5\x99\x9a\xce\xfe\x21\x8d\xad\xc7\x9f\x5e\xf9\x5a\x0b\x81\x25\xb9\xb0\x3f\x39\x28\x35\xfe\x06\x02\x5a\xf0\x8a\x34\x8e\x57\x6b\x14\xc7\x44\xeb\x96\x66\xe7\xeb\x8f\x4f\xcf\x30\x99\x2e\x60\x9c\x67\xbf\xe4\xfd\x78\x91\x8f\x10\x68\xc2\x5c\xd8\x51\xaa\x20\xee\x52\xec\x8a\x4e\x0a\xb6\x8f\x2e\x48\xf9\x30\xde\x51\x38\x4f\x3f\xe7\x6d\xe7\x44\x71\xff\x67\x26\x16\xc5\xaa\x81\xfb\xc2\x2e\xb0\x25\xc8\xbd\x16\xbe\x6d\xe0\x21\xc0\x3d\x76\xe4\xef\x91\xe9\xff\x0e\x80\x66\x9a\xd7\x9a\xd8\x8f\x41\x70\x4a\x8c\xe7\xc2\x35\x6b\x27\x07\x13\x81\x2d\xe0\xa5\xdd\xf7\xd4\x93\x79\xd5\x2e\x96\xd8\x25\x2d\x68\x41\x21\x6d\x83\x3c\x51\xda\xb4\xae\xf7\x68\xd1\xee\xb8\xf7\x38\xfc\x43\x79\xe3\xec\xe8\xcc\xf4\x0f\x47\xc9\x89\x1a\xd4\xce\x27\x9e\x74\x14\xf2\xb9\xd0\xb1\x90\x14\x5d\xd4\xa1\xf3\xef\x58\xfd\x51\x65\x26\x7b\xe5\x82\xd2\x69\x22\xe6\x4a\x0a\x74\x19\xec\x3b\x56\xfb\x14\x0f\xce\x52\x7a\xc7\xf0\xe3\x28\x36\xd9\x76\x56\x4b\x40\x86\xf9\xfe\xef\xb3\xbf\x04\xbb\xa0\x64\x7e\x0f\xf8\x22\xf4\x0a\xfa\xb8\x65\x6d\xb2\x13\xec\xbf\x7d\x1c\x7b\x13\x43\x1d\x4c\x17\x27\x67\xc6\xef\x73\x4a\x14\x44\x55\x19\x62\x1d\x7f\x47\x7b\xea\xc5\xdd\x9e\x82\x34\x17\x3a\x9c\x50\x77\x45\xf5\xb9\xf2\xc9\x8b\x39\xb0\xc2\xe2\x1a\x57\x61\xf9\xc2\xeb\x13\xbd\x68\x28\x58\x67\xe9\x15\xbd\x35\x8f\x74\xe9\xc9\x5b\x59\xa8\xcb\x23\xcb\x73\xc2\xc0\x6e\x9a\xba\xd7\xe5\xce\x9c\xff\x09\x59\x40\x5c\x47\x95\xc3\xe6\x50\x64\x56\x45\xb6\x12\x5e\x0c\x34\xa2\xbc\xa0\x17\x74\x10\x61\x88\xd2\x52\x6a\xe0\x59\x99\x72\x9c\x5d\x5b\x82\xdf\x5a\x0a\xb5\xda\x82\xa5\xe4\x07\x85\xe0\x68\xcd\xb4\x18\xf6\x64\xaf\xc5\x5d\xd7\x83\xe2\x84\xa2\xb5\xac\xd4\xf9\x12\xe2\x6f\xe1\x46\xf5\x05\xc8\x3c\x51\x7c\x09\x63\x36\x74\xf7\xf8\x00\x3b\x47\xde\x2e\x2a\x1d\xad\x96\xe9\x61\x0c\xf5\x82\x5b\x7f\x35\xf7\xba\x76\x31\x75\x28\xf5\xa5\xb2\x56\x4b\x0b\x72\x6f\x34\x6e\x5d\x1d\x31\xe3\xfe\x63\xe8\xdc\x41\x9b\x3b\x0c\x90\x08\xad\x3a\x37\x5d\x06\x17\xac\x33\x58\x86\x9b\x25\x41\xe7\x19\x70\x1b\xf3\xf9\xf8\x39\x71\x4b\xf3\x33\x63\x3a\xc2\x53\xd2\x53\xa6\xdb\x56\xb9\xa9\x97\x61\x29\xfa\x77\xa3\x4a\x84\x7c\xfe\x54\x5a\x08\xea\xb9\xa5\x51\x7c\x7e\x93\xcd\x95\xf0\x89\x4b\x21\x9f\xb8\xba\x18\x91\x7b\x35\x32\x55\xa9\x8e\x1e\xb7\x73\xa6\x40\xaf\x51\x99\x36\x46\x2e\x25\xa1\x35\x09\x31\x95\xe2\xb9\x32\xfb\x8f\xab\xa6\xc4\xb1\xba\xc4\xca\x92\x64\x01\x61\x9f\x31\x61\x10\x22\xab\xba\x2f\xb2\x57\xa7\xf9\xa2\xce\xff\x2d\xb3\x4c\x07\x4a\x4e\x86\x0f\xe5\xf6\x69\x14\x9e\x48\xbe\x70\x11\x7d\xef\xbd\x33\x4e\xc0\x78\x64\xd6\x0c\x4d\xbc\xb4\xe8\xf2\xd7\x8a\x8f\x89\x96\x6e\x80\xe3\x3c\x27\x58\x93\xd8\xa1\x69\x0b\xcf\x19\x0c\xe0\xba\x8e\xac\x43\x21\x3f\xd4\xde\x66\xc1\xb0\xdc\x73\x05\xed\x91\x8d\xd9\x49\xae\x9e\xe8\xab\x14\x8d\x68\x1b\xc6\x64\x5d\xd8\xfb\xa1\x42\x3a\xc7\xf3\x76\x27\xff\xfc\xed\xe9\x59\x6b\x98\x49\x20\x06\x3f\x54\x16\xa8\xe3\xe6\xaf\x7f\x43\xcf\x8b\x6d\xfd\x7e\xfa\xaf\x0c\xb6\xa5\xe4\xd7\xf1\x36\xce\x94\xb9\xa6\x6f\x0a\x75\xc6\x1d\x3c\x27\x7d\x81\x17\x77\x6e\xe0\x5b\x28\x24\xf6\xbb\xfd\x2a\x02\x1f\x6a\xb7\xa1\x2f\xd6\x8f\x6c\x7b\xda\x39\x8a\xa7\xd3\x46\x8c\x0d\x7d\xc7\xae\xf7\xd4\x98\xd8\xdd\x1e\x3b\x6b\x39\xe7\x18\x06\x68\x66\xad\x8d\x3a\x34\xfe\x42\x00\x26\xaa\x0d\xc4\xa2\x40\xa3\x49\x91\x79\x7e\x7d\x2f\x77\x9f\x77\x2f\x04\x77\x07\x74\x5e\xd9\xee\x06\xb6\x59\x1b\xcb\x60\x66\x02\x4c\x5b\x27\x09\xd3\x70\x32\xf6\x4b\x05\x6e\x4b\x69\xee\xf2\xf5\x81\xaa\xeb\x8f\x4c\x04\x4d\x88\x96\x9a\x0a\xe7\xd1\x6d\xfe\x53\x19\x23\x80\x5b\xe7\xb5\xce\x24\x82\x25\x13\xc3\xce\x3b\xa3\xe3\x66\x99\x7e\xba\x3e\x26\xc1\x6b\xcf\x86\x13\x80\x96\x11\xd4\x3f\x02\x7d\xf4\x5e\xc3\x70\x7d\x65\x9a\x5f\x15\x5b\x9c\xc7\xeb\x62\xfe\xca\xc1\xc2\xf3\xed\xf4\x10\x53\xc2\xe1\xfd\x37\xdf\xc5\x66\x7d\xc8\x6d\x40\x52\xae\x96\x59\x62\xd2\x49\x77\xb2\x93\xb7\x73\x11\x4c\x81\x8f\x0d\x06\xff\xfe\xcf\xea\xbf\x01\x00\x00\xff\xff\x20\x5e\x6b\xb0\xc9\x11\x00\x00")
+var _crdsBasesTenancyFarosSh_usersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4b\x6f\x23\xb9\x11\xbe\xeb\x57\x14\x90\xc3\x24\x80\xd5\x9e\x41\x2e\x81\x80\x1c\x0c\xef\x06\x30\xb2\x13\x18\x63\xcd\xde\xab\xc9\x92\x9a\x6b\x36\xd9\x61\x15\x35\xd3\x09\xf2\xdf\x83\x62\x3f\xf4\xb0\xda\x9e\x38\x58\xb7\x2e\x7c\xd5\xe3\xfb\xea\xe5\xf5\x7a\xbd\xc2\xce\xfd\x4a\x89\x5d\x0c\x1b\xc0\xce\xd1\x77\xa1\xa0\x2b\xae\x9e\xff\xc2\x95\x8b\xb7\x87\x4f\xab\x67\x17\xec\x06\xee\x33\x4b\x6c\xbf\x10\xc7\x9c\x0c\xfd\x44\x3b\x17\x9c\xb8\x18\x56\x2d\x09\x5a\x14\xdc\xac\x00\x30\x84\x28\xa8\xdb\xac\x4b\x00\x13\x83\xa4\xe8\x3d\xa5\xf5\x9e\x42\xf5\x9c\x6b\xaa\xb3\xf3\x96\x52\x11\x3e\xa9\x3e\x7c\xac\x3e\x7d\xac\x3e\xae\x00\x4c\xa2\xf2\x7e\xeb\x5a\x62\xc1\xb6\xdb\x40\xc8\xde\xaf\x00\x02\xb6\xb4\x81\xcc\x94\xb8\x12\x0a\x18\x4c\x5f\xed\x30\x45\xae\xb8\x59\x71\x47\x46\x35\xee\x53\xcc\xdd\x06\x5e\x9c\x0f\xcf\x47\xa3\x06\x87\xbe\x32\xa5\xb2\xf4\x8e\xe5\xef\xf3\xd6\x2f\x8e\xa5\x6c\x77\x3e\x27\xf4\xa3\xc6\xb2\xc3\x2e\xec\xb3\xc7\x34\xec\xad\x00\xd8\xc4\x8e\x36\x70\xef\x33\x4b\xd9\x18\x1d\x2a\x7a\xd6\x80\xd6\x16\x88\xd0\x3f\x26\x17\x84\xd2\x7d\xf4\xb9\x9d\xa0\x59\xc3\x6f\x1c\xc3\x23\x4a\xb3\x81\x6a\x02\xb1\x7a\xe1\x7f\xd1\x3c\x79\x7f\xb7\xa7\x71\x2d\xbd\x6a\xb6\x28\xc3\xc6\x70\x7c\xf8\x84\xbe\x6b\xf0\x53\xd9\x62\xd3\x50\x5b\x58\xd1\x55\xec\x28\xdc\x3d\x3e\xfc\xfa\xe7\xa7\xb3\x6d\x00\x4b\x6c\x92\xeb\x54\xe7\x80\x09\x38\x06\x69\x08\x86\x8b\xb0\x8b\xa9\x2c\xcb\xd1\xdd\xe3\xc3\xfc\xb0\x4b\xb1\xa3\x24\x6e\x42\x75\xf8\x4e\x02\xea\x64\xf7\x42\xcd\x07\xb5\x64\xb8\x05\x56\x23\x89\x06\x95\x23\x7c\x64\x47\xe3\x21\xee\x40\x1a\xc7\x90\xa8\x4b\xc4\x14\x86\xd8\x3a\x13\x0c\x7a\x09\x03\xc4\xfa\x37\x32\x52\xc1\x13\x25\x15\x03\xdc\xc4\xec\xad\x06\xe0\x81\x92\x40\x22\x13\xf7\xc1\xfd\x6b\x96\xcd\x20\xb1\x28\xf5\x28\x34\x52\x7e\xfc\x0a\x5d\x01\x3d\x1c\xd0\x67\xba\x01\x0c\x16\x5a\xec\x21\x91\x6a\x81\x1c\x4e\xe4\x95\x2b\x5c\xc1\xe7\x98\x08\x5c\xd8\xc5\x0d\x34\x22\x1d\x6f\x6e\x6f\xf7\x4e\xa6\x44\x32\xb1\x6d\x73\x70\xd2\xdf\x96\x9c\x70\x75\x96\x98\xf8\xd6\xd2\x81\xfc\x2d\xbb\xfd\x1a\x93\x69\x9c\x90\x91\x9c\xe8\x16\x3b\xb7\x2e\xa6\x07\x75\x98\xab\xd6\xfe\x21\x8d\xa9\xc7\x1f\xce\x6c\x1d\x02\x81\x25\xb9\xb0\x3f\x39\x28\x31\xfe\x0a\x03\x1a\xf0\xca\x34\x8e\x4f\x07\x47\x8f\x40\xeb\x96\xa2\xf3\xe5\xe7\xa7\x2d\x4c\xaa\x0b\x19\x67\x42\x61\xc4\xfd\xf8\x90\x8f\x14\x28\x60\x2e\xec\x48\x03\xc8\x31\xec\x52\x6c\x0b\xe2\x14\x6c\x17\x5d\x90\xb2\x30\xde\x51\xb8\x84\x9f\x73\xdd\x3a\x51\xde\xff\x99\x89\x45\xb9\xaa\xe0\xbe\x54\x17\xa8\x09\x72\xa7\x81\x6f\x2b\x78\x08\x70\x8f\x2d\xf9\x7b\x64\xfa\xdd\x09\x50\xa4\x79\xad\xc0\xfe\x18\x05\xa7\x85\xf1\xf8\xa7\x52\x36\x23\x6a\x27\x07\x53\x01\x5b\xe0\x4b\xb3\xef\xa9\x23\x73\x96\x2e\x96\xd8\x25\x0d\x68\x41\x21\x4d\x83\xb1\x2c\x01\xbc\x9e\xa3\xfa\x59\xc7\x9d\xc7\xfe\x1f\x5a\x37\x2e\x8e\x2e\x54\xff\x74\xbc\x39\x95\x06\xd5\xf3\x81\x27\x19\xa5\x36\xbd\x90\xb1\x00\x8a\xfe\xa8\x45\xe7\xdf\xd0\xfa\xb3\xde\x99\xf4\x95\x07\x5a\x4e\x13\x31\xab\xa3\x93\x11\xff\x8b\xd6\x2e\xc5\x83\xb3\x94\xde\x50\xfc\x38\x5e\x9b\x74\x3b\xab\x21\x20\xfd\xfc\xfe\x7d\xfa\x97\x68\x17\x94\x7c\x41\xce\x99\x39\x85\xf8\x72\xe9\x8c\xfa\x58\xb3\xd6\xb9\x13\xee\xe7\x76\xf6\x36\xf7\x26\x86\xa1\x31\xf1\x1b\x58\xdc\xe7\x94\x28\x88\xba\x6e\x88\xb5\xfd\x1d\xf5\x29\x04\x77\x7b\x0a\x52\xbd\x90\xe1\x84\xda\x2b\xa2\x2f\x85\x4f\x56\xcc\x8e\x95\x2a\xae\x7e\x95\x2a\xaf\x4a\x70\x44\x0c\xd4\x95\xb2\x8b\xfe\x8a\x5c\x18\xcc\x7a\x69\xc9\x6b\x28\x0c\x9f\x47\x96\x6d\xc2\xc0\x05\x10\xed\xba\xd7\xef\x5d\x18\xff\x0b\xb2\x80\xb8\x96\x0a\x1b\x33\xa0\x20\xb3\x28\xb2\x43\xc1\x8b\x81\x46\x96\x17\xe4\x82\x36\x22\x0c\x51\x1a\x4a\x15\x6c\x1b\x37\xf7\xae\x9a\xe0\x5b\x43\xa1\xa8\xc8\xc1\x52\xf2\xbd\x52\x70\xd4\x66\x1a\x0c\x7b\xb2\xd7\xfc\x1e\xbe\x07\xe5\x09\x45\x63\x59\x4b\xe7\x73\x88\xdf\xc2\x8d\xca\x0b\x90\x79\x2a\xf1\xc5\x8d\x59\xd1\xdd\xe3\x03\xec\x1c\x79\xbb\x28\x74\xd4\xaa\x42\xd1\x18\xea\x04\x6b\x7f\x15\x7b\xfd\xed\x62\x6a\x51\x86\x49\x65\xad\x9a\x16\xee\xbd\x92\xb8\x53\x35\x65\xc6\xfd\x8f\xb1\x73\x07\x4d\x6e\x31\x40\x22\xb4\x6a\xdc\xf4\x18\x5c\xb0\xce\xa0\xa8\xe7\x96\x04\x9d\x67\xc0\x3a\x66\x59\x5d\x95\xa9\x66\x35\x74\xc2\xe9\x48\x4f\x81\xa7\x8c\x03\xb5\xd6\xa6\x4e\xfa\xea\xbd\x5e\x25\x42\xbe\x1c\x95\x16\x9c\xda\x36\xa4\x0e\x71\x0c\xf3\x4c\x36\x47\xc2\x07\x2e\x81\x7c\x62\xea\x82\x44\x9d\x6c\x4e\x5b\xa6\x0a\xd5\xd6\xe3\x76\xce\x14\xea\xd5\x2b\xd3\xc4\xc8\x25\xf6\x34\x26\x21\xa6\x12\x3c\x57\x7a\xff\xf1\x1b\x20\x71\xac\xf3\x16\x6b\x95\x25\x0b\x08\xfb\x8c\x09\x83\x10\x59\x95\xfd\x02\x3d\x95\x5a\x2f\x05\x04\xfc\x9f\xc8\x32\x1d\x28\x39\xe9\x7f\x08\xdb\xa7\xf1\xf2\x54\xe4\x19\x30\x00\x7d\xef\xbc\x33\x4e\xc0\x78\x64\x56\x84\xa6\xba\xb4\x20\x12\xe0\xcb\xc0\x8f\x89\x96\x6e\x80\xe3\xdc\x27\x58\x41\x6c\xd1\x34\xa5\xce\x19\x0c\xe0\xda\x96\xac\x43\x21\xdf\x0f\xb9\xcd\x82\x61\x39\xe7\x54\x90\x19\xab\x31\x3b\xc9\x83\x25\x3a\x95\xa2\x11\x40\x63\x62\xb2\x2e\xec\x7d\xaf\x20\xd3\xd1\x9f\xd7\x33\xf9\xf3\xd7\xa7\xad\xce\x53\x4c\x02\x31\xf8\x5e\x29\x0f\xf0\x54\xaa\xd5\x5f\xff\x86\x9e\xe9\xfd\xf0\x5f\x69\x6c\x4b\xe0\x97\xab\x53\x4f\x99\x63\xfa\xa6\x94\xce\xb8\x83\x6d\xd2\x09\xbc\x98\x73\x03\x5f\x43\x29\x62\xef\xb6\xab\x5c\xf8\x11\xab\xb6\x7d\x57\xb4\xcf\xf6\x9c\x65\x8e\xf2\xe9\x02\xec\x62\xac\xe8\x3b\xb6\x9d\xa7\xca\xc4\xf6\xf6\x98\x59\x0b\x2a\x00\x3e\x63\xe8\xa1\x9a\xa5\x56\x6a\xd0\x30\x7c\x33\x60\x2a\xfe\xb3\x63\xd1\xb6\x8b\x26\x45\xe6\x79\xfa\x5e\xce\x3e\xef\x9e\x09\xee\x0e\xe8\xbc\x56\xbb\x1b\xa8\xb3\x26\x96\xc1\xcc\x04\x98\x6a\x27\x09\x53\x7f\x44\x76\x88\x40\x9d\xa3\x99\x76\xf9\x7a\x43\xd5\xef\x8f\x4c\x04\x55\x88\x96\xaa\xa1\x83\x1d\xcd\xe6\x3f\x95\x36\x02\x58\x3b\xaf\x79\x23\x11\x2c\x99\x18\x76\xde\x19\x6d\x37\x8b\x32\x5d\xdb\xc5\x24\x18\xe4\x9d\x0c\xea\x7f\x04\x3a\xf4\x5e\x8b\xac\xf5\x95\x6e\x7e\xf5\xda\x62\x3f\x5e\x97\xc0\xbe\x72\xb0\x30\xbe\x9d\x1e\x62\x4a\xd8\xaf\xde\x7c\xf4\x62\x53\x07\x1e\xb2\x1b\x90\x94\x87\x5a\xc8\x12\x93\x76\xba\x93\x9d\x5c\xcf\x41\x30\x39\xce\x82\x92\x79\x03\xff\xfe\xcf\xea\xbf\x03\x00\x20\x5e\x6b\xb0\xc9\x11\x00\x00")
 
 func crdsBasesTenancyFarosSh_usersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -341,7 +347,7 @@ func crdsBasesTenancyFarosSh_usersYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsBasesTenancyFarosSh_workspacesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\xcf\x6f\xeb\xb8\x11\xbe\xfb\xaf\x18\xa0\x87\xd7\x02\xb1\xf2\x82\x5e\x0a\x03\x3d\x04\xd9\x16\x08\xba\xaf\x08\x5e\xb2\xbb\xe7\x11\x35\xb6\xb8\xa1\x48\x95\x33\x74\x9e\x5b\xf4\x7f\x2f\x86\x94\x64\xd9\xb1\xf2\xdc\x16\xcb\x9b\x48\x7a\x7e\x7c\xdf\xcc\x37\xf4\x7a\xbd\x5e\x61\x6f\x7f\xa6\xc8\x36\xf8\x0d\x60\x6f\xe9\x9b\x90\xd7\x2f\xae\x5e\xff\xc4\x95\x0d\xb7\xfb\xbb\xd5\xab\xf5\xcd\x06\x1e\x12\x4b\xe8\xbe\x12\x87\x14\x0d\xfd\x40\x5b\xeb\xad\xd8\xe0\x57\x1d\x09\x36\x28\xb8\x59\x01\xa0\xf7\x41\x50\xb7\x59\x3f\x01\x4c\xf0\x12\x83\x73\x14\xd7\x3b\xf2\xd5\x6b\xaa\xa9\x4e\xd6\x35\x14\xb3\xf1\xd1\xf5\xfe\x73\x75\xf7\xb9\xfa\xbc\x02\x30\x91\xf2\xef\x5f\x6c\x47\x2c\xd8\xf5\x1b\xf0\xc9\xb9\x15\x80\xc7\x8e\x36\xf0\x16\xe2\x2b\xf7\x68\x88\x2b\x21\x8f\xde\x1c\xaa\x2d\xc6\xc0\x15\xb7\x2b\xee\xc9\xa8\xdb\x5d\x0c\xa9\xdf\xc0\xbb\xf3\x62\x63\x88\xac\x64\xf5\xcb\x68\x2e\xef\x39\xcb\xf2\xb7\xd3\xfd\x1f\x2d\x4b\x3e\xeb\x5d\x8a\xe8\xe6\x01\xe4\x6d\xb6\x7e\x97\x1c\xc6\xd9\xc1\x0a\x80\x4d\xe8\x69\x03\x7f\x57\x77\xba\xd5\xac\x00\x86\x64\xb3\xfb\x35\x60\xd3\x64\xf8\xd0\x3d\x45\xeb\x85\xe2\x43\x70\xa9\x1b\x61\x5b\xc3\xaf\x1c\xfc\x13\x4a\xbb\x81\x6a\x04\xb8\x7a\x87\x4d\xbe\x3b\x22\x73\xbf\xa3\xe1\x5b\x0e\xea\xbc\x41\x29\x1b\xe5\x78\x7f\x87\xae\x6f\xf1\xae\x44\x6d\x5a\xea\x70\x33\xdc\x0f\x3d\xf9\xfb\xa7\xc7\x9f\xff\xf8\x7c\xb2\x0d\xd0\x10\x9b\x68\x7b\xc9\x1c\x4d\x90\x80\x65\x90\x96\xa0\xdc\x86\x6d\x88\xf9\xf3\x78\x7e\xff\xf4\x38\x99\xe8\x63\xe8\x29\x8a\x1d\x61\x2f\x6b\x56\x76\xb3\xdd\x33\x87\x9f\x34\xa6\x72\x0b\x1a\xad\x37\x2a\x7e\x07\x20\xa9\x19\xd2\x80\xb0\x05\x69\x2d\x43\xa4\x3e\x12\x93\x2f\x15\x78\x62\x18\xf4\x12\x7a\x08\xf5\xaf\x64\xa4\x82\x67\x8a\x6a\x06\xb8\x0d\xc9\x35\x5a\xa6\x7b\x8a\x02\x91\x4c\xd8\x79\xfb\xcf\xc9\x36\x83\x84\xec\xd4\xa1\xd0\x50\x09\xc7\x95\x89\xf3\xe8\x60\x8f\x2e\xd1\x0d\xa0\x6f\xa0\xc3\x03\x44\x52\x2f\x90\xfc\xcc\x5e\xbe\xc2\x15\x7c\x09\x91\xc0\xfa\x6d\xd8\x40\x2b\xd2\xf3\xe6\xf6\x76\x67\x65\x6c\x37\x13\xba\x2e\x79\x2b\x87\xdb\xdc\x39\xb6\x4e\x12\x22\xdf\x36\xb4\x27\x77\xcb\x76\xb7\xc6\x68\x5a\x2b\x64\x24\x45\xba\xc5\xde\xae\x73\xe8\x3e\xb7\x5c\xd5\x35\xbf\x8b\x43\x83\xf2\xa7\x93\x58\x4b\x49\xb0\x44\xeb\x77\xb3\x83\xdc\x04\x1f\x30\xa0\xcd\xa0\x74\xe3\xf0\xd3\x92\xc5\x11\x68\xdd\x52\x74\xbe\xfe\xe5\xf9\x05\x46\xd7\x99\x8c\x73\xf4\x33\xee\xc7\x1f\xf2\x91\x02\x05\xcc\xfa\x2d\xc5\x42\xe2\x36\x86\x2e\xdb\x24\xdf\xf4\xc1\x7a\xc9\x1f\xc6\x59\xf2\xe7\xf0\x73\xaa\x3b\x2b\xca\xfb\x3f\x12\xb1\x28\x57\x15\x3c\x64\x0d\x82\x9a\x20\xf5\xda\x02\x4d\x05\x8f\x1e\x1e\xb0\x23\xf7\x80\x4c\xbf\x39\x01\x8a\x34\xaf\x15\xd8\xeb\x28\x98\xcb\xe7\xf9\xe5\x82\xda\xec\x60\x54\xb8\x05\xbe\xa6\x16\x7c\xee\xc9\x9c\xf4\x4c\x43\x6c\xa3\x56\xb5\xa0\x90\xf6\xc2\xdb\x89\xf0\x8d\xeb\x72\xb7\x9e\xfb\x39\x3b\x3a\x0b\xe2\x87\xe3\x47\x29\x9d\xc4\x14\x21\x12\x36\x58\x3b\x9a\xdf\x2d\x8d\x4b\x0b\xb1\x7c\x80\x59\xc1\xad\xab\x29\xbe\x8b\xf3\x2c\x98\x2f\xe5\x56\x09\x44\xe5\x5d\x7d\xe6\x80\xa8\x43\xeb\x18\xde\xda\x00\x18\x69\x34\x37\x6a\xc9\x3b\xab\xf0\x41\x94\x56\xa8\xbb\x10\xc8\x87\xe1\x8f\x87\x18\x23\x1e\xae\xa2\x5e\x50\x12\x5f\x45\x7e\xbe\x79\x42\x7f\xa8\x59\xbb\x6d\xc6\xff\x2f\xff\x25\xff\x26\xf8\x32\xb0\xbe\x87\xf8\x43\x8a\x91\xbc\xa8\x29\x43\xac\xe3\xf1\xe8\x54\x43\xb9\xdf\x91\x97\xea\x7a\x0c\x4f\x8d\x8f\x51\x4c\xd9\x65\x4d\xd7\xe4\x70\xac\x28\x1c\xc5\x46\x53\xc1\x32\x63\x2f\xd8\x2d\x88\xd2\xfb\x48\x3e\x42\xa1\x2c\x87\x2c\x2f\x11\x3d\xdb\x71\x1a\x5f\xbe\x77\x16\xfc\x8f\xc8\x02\x62\x3b\x2a\x8a\x36\xa5\x22\x93\x29\x6a\x8a\xfc\x05\x4f\x03\xdf\x0b\x76\x41\xc7\x12\xfa\x20\x2d\xc5\x0a\x5e\x54\x37\x87\x49\x56\x13\xbc\xb5\xe4\xb3\x8b\xe4\x1b\x8a\xee\xa0\x14\x1c\xbd\x99\x16\xfd\x8e\x9a\x4b\x79\x97\xf5\xa8\x3c\xa1\x68\xcb\xa8\x90\xbe\xfa\xf0\xe6\x6f\xd4\x9e\x87\xc4\xa3\xe0\xe7\x34\x26\x47\xf7\x4f\x8f\xb0\xb5\xe4\x9a\x45\xa3\x83\xd7\xdc\x87\xc6\x50\x2f\xaa\x05\x4b\x31\x6c\x43\xec\x50\xca\x0b\x66\xad\x9e\x16\xee\x7d\xd8\x5e\x90\x35\x82\x19\x77\xd7\xb1\x73\x0f\x6d\xea\xd0\x1f\x85\x6a\xf8\x31\x58\xdf\x58\x83\x79\xd4\x35\x24\x59\x34\xb0\x0e\xe9\x7c\x18\xcd\xc2\x52\x7c\x26\x4e\x07\x7a\x32\x3c\x79\xd6\xd5\x04\xd4\xf5\x72\x58\xca\xfe\xbb\x59\x45\x42\xbe\xa4\xc2\x17\x92\x7a\x69\x69\xb8\x3e\x3d\xd3\xa6\x4a\xf8\xc4\xb9\x90\x67\xa1\x2e\x66\x64\x4f\x06\xa8\x1a\xd5\x41\x64\xb7\xd6\x64\xea\x35\x2b\xd3\x86\xc0\xb9\x24\xb4\x26\x21\xc4\x5c\x3c\x0b\x52\x5a\x56\x81\xc4\xb2\x86\xc4\xb6\x21\x9d\x4f\x08\xbb\x84\x11\xbd\x10\x35\x6a\xfb\x1d\x7a\x65\xb6\x2f\xda\xfc\xff\x90\x65\xda\x53\xb4\x72\xb8\x0a\xdb\xe7\xe1\xb2\xca\xc5\xde\x36\x45\x8b\xe8\x5b\xef\xac\xb1\x02\xc6\x21\xb3\x22\x34\xea\xd2\x62\xc8\x5f\x0b\x3f\x26\x34\x74\x03\x5c\x5e\x9b\x3a\xa1\x58\x41\xec\xd0\xb4\x59\xe7\x0c\x7a\xb0\x5d\x47\x8d\x45\x21\x77\x28\xbd\xcd\x82\x7e\xb9\xe7\x32\xdb\x83\x1a\xb3\x95\x54\x22\xd1\x37\x2a\x1a\xd1\x36\x0c\xb1\xb1\x7e\xe7\x0e\x85\xd2\x29\x9f\x8f\x3b\xf9\xcb\x4f\xcf\x2f\x5a\xc3\x4c\x02\xc1\xbb\x43\x51\x81\x32\x73\xfe\xfc\x57\x74\xbc\xd8\xd6\xdf\x87\xff\xc2\x88\x5b\x02\xbf\xcc\xb8\x61\xa6\x4c\x35\x7d\x93\xa5\x33\x6c\xe1\x25\xea\x7b\x3c\x87\x73\x03\x3f\xf9\x2c\x62\xff\x73\x5c\xf9\xc2\x55\xed\x76\xe8\xb3\xf7\xa3\xda\xce\x3b\x47\xf9\xb4\xda\x88\xa1\xa2\x6f\xd8\xf5\x8e\x2a\x13\xba\xdb\x63\x67\x2d\x63\x8e\xfe\x00\xd5\x64\xb5\xd2\x80\x86\x3f\x14\xf9\xf1\x92\x1b\x88\x45\x89\x46\x13\x03\xf3\xf4\x16\x5f\xee\x3e\x67\x5f\x09\xee\xf7\x68\x9d\xaa\xdd\x0d\xd4\x49\x1b\xcb\x60\x62\x02\x8c\xb5\x95\x88\xf1\x30\x1b\xfb\xb9\x02\xeb\x5c\x9a\xdb\x74\x79\xa0\xea\xfa\x3d\x13\x41\xe5\x43\x43\x55\xa1\xf3\x18\x36\xff\x21\x8f\x11\xc0\xda\x3a\xad\x33\x09\xd0\x90\x09\x7e\xeb\xac\xd1\x71\xb3\x2c\x3f\x5d\x1f\xa2\xe0\xa5\x67\xc3\x8c\xa0\x65\x06\xf5\xff\x81\xbe\x7e\x2f\x71\xb8\xbe\x30\xcd\x2f\x5e\x5b\x9c\xc7\xeb\xec\x7e\xf1\xf5\xf7\xee\x21\x37\x3f\xbc\xf2\xf5\xf7\x6e\xb3\xbc\xe6\x36\x20\x31\x15\xcf\x2c\x21\xea\xa4\x9b\xed\xa4\x7a\x2a\x82\x31\xf1\xa1\xc1\xe0\x5f\xff\x5e\xfd\x27\x00\x00\xff\xff\x30\x66\xc6\x8a\xfd\x11\x00\x00")
+var _crdsBasesTenancyFarosSh_workspacesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5b\x6f\xe3\xba\x11\x7e\xf7\xaf\x18\xa0\x0f\xdb\x02\xb1\xb2\x8b\xbe\x14\x06\xfa\x10\xe4\xb4\x40\xd0\xb3\x45\xb0\xc9\x39\x7d\x1e\x91\x63\x8b\x27\x14\xa9\x72\x86\xce\xba\x45\xff\x7b\x31\xd4\xc5\x72\x62\x79\x8d\x14\x8d\xfc\x22\x72\x34\x97\xef\x9b\x5b\xd6\xeb\xf5\x0a\x3b\xf7\x2b\x25\x76\x31\x6c\x00\x3b\x47\xdf\x85\x82\xbe\x71\xf5\xf2\x27\xae\x5c\xbc\xdd\x7f\x59\xbd\xb8\x60\x37\x70\x9f\x59\x62\xfb\x8d\x38\xe6\x64\xe8\x27\xda\xba\xe0\xc4\xc5\xb0\x6a\x49\xd0\xa2\xe0\x66\x05\x80\x21\x44\x41\x3d\x66\x7d\x05\x30\x31\x48\x8a\xde\x53\x5a\xef\x28\x54\x2f\xb9\xa6\x3a\x3b\x6f\x29\x15\xe5\xa3\xe9\xfd\xe7\xea\xcb\xe7\xea\xf3\x0a\xc0\x24\x2a\xdf\x3f\xbb\x96\x58\xb0\xed\x36\x10\xb2\xf7\x2b\x80\x80\x2d\x6d\xe0\x35\xa6\x17\xee\xd0\x10\x57\x42\x01\x83\x39\x54\x5b\x4c\x91\x2b\x6e\x56\xdc\x91\x51\xb3\xbb\x14\x73\xb7\x81\x77\xf7\xbd\x8e\xc1\xb3\x3e\xaa\x7f\x8c\xea\xca\x99\x77\x2c\x7f\x3b\x3d\xff\xd9\xb1\x94\xbb\xce\xe7\x84\x7e\xee\x40\x39\x66\x17\x76\xd9\x63\x9a\x5d\xac\x00\xd8\xc4\x8e\x36\xf0\x77\x6c\xa9\x1c\xd9\x15\xc0\x10\x6c\x31\xbf\x06\xb4\xb6\xc0\x87\xfe\x31\xb9\x20\x94\xee\xa3\xcf\xed\x08\xdb\x1a\x7e\xe3\x18\x1e\x51\x9a\x0d\x54\x23\xc0\xd5\x3b\x6c\x8a\x07\x23\x32\x77\x3b\x1a\xde\xe5\xa0\xc6\x2d\x4a\x7f\xd0\x5f\xef\xbf\xa0\xef\x1a\xfc\x52\x8e\xd8\x34\xd4\x16\xc6\xf4\x2d\x76\x14\xee\x1e\x1f\x7e\xfd\xe3\xd3\xc9\x31\x80\x25\x36\xc9\x75\x6a\x73\x06\x09\x38\x06\x69\x08\x7a\x69\xd8\xc6\x54\x5e\x8f\xf7\x77\x8f\x0f\x93\x8a\x2e\xc5\x8e\x92\xb8\x11\xf6\xfe\x99\xa5\xdd\xec\xf4\x8d\xc1\x4f\xea\x53\x2f\x05\x56\xf3\x8d\x7a\xbb\x03\x90\x64\x87\x30\x20\x6e\x41\x1a\xc7\x90\xa8\x4b\xc4\x14\xfa\x0c\x3c\x51\x0c\x2a\x84\x01\x62\xfd\x1b\x19\xa9\xe0\x89\x92\xaa\x01\x6e\x62\xf6\x56\xd3\x74\x4f\x49\x20\x91\x89\xbb\xe0\xfe\x35\xe9\x66\x90\x58\x8c\x7a\x14\x1a\x32\xe1\xf8\x14\xe2\x02\x7a\xd8\xa3\xcf\x74\x03\x18\x2c\xb4\x78\x80\x44\x6a\x05\x72\x98\xe9\x2b\x22\x5c\xc1\xd7\x98\x08\x5c\xd8\xc6\x0d\x34\x22\x1d\x6f\x6e\x6f\x77\x4e\xc6\x72\x33\xb1\x6d\x73\x70\x72\xb8\x2d\x95\xe3\xea\x2c\x31\xf1\xad\xa5\x3d\xf9\x5b\x76\xbb\x35\x26\xd3\x38\x21\x23\x39\xd1\x2d\x76\x6e\x5d\x5c\x0f\x1a\x30\x57\xad\xfd\x5d\x1a\x0a\x94\x3f\x9d\xf8\xda\xa7\x04\x4b\x72\x61\x37\xbb\x28\x45\x70\x81\x01\x2d\x06\xa5\x1b\x87\x4f\xfb\x40\x8f\x40\xeb\x91\xa2\xf3\xed\x2f\x4f\xcf\x30\x9a\x2e\x64\x9c\x28\x85\x01\xf7\xe3\x87\x7c\xa4\x40\x01\x73\x61\x4b\x9a\x45\x8e\x61\x9b\x62\x5b\x10\xa7\x60\xbb\xe8\x82\x94\x17\xe3\x1d\x85\xb7\xf0\x73\xae\x5b\x27\xca\xfb\x3f\x33\xb1\x28\x57\x15\xdc\x97\x1e\x04\x35\x41\xee\xb4\x04\x6c\x05\x0f\x01\xee\xb1\x25\x7f\x8f\x4c\xff\x77\x02\x14\x69\x5e\x2b\xb0\xd7\x51\x30\x6f\x9f\xc7\x3f\xd5\xb2\x19\x50\x9b\x5d\x8c\x1d\x6e\x81\xaf\xa9\x04\x9f\x3a\x32\x27\x35\x63\x89\x5d\xd2\xac\x16\x14\xd2\x5a\x98\x77\x2b\x80\xcb\xd5\xfa\xd6\xce\x9b\xab\x37\x4e\xfc\x74\x7c\xe9\x53\x27\x33\x25\x48\x84\x16\x6b\x4f\x73\x59\xf5\x43\xc9\x3d\xef\xcb\x05\xcc\xf4\xd7\x52\x5b\x53\xe2\x1f\x38\xf3\xb5\x97\xea\x1d\xd1\xf6\xae\x36\x8b\x43\xd4\xa2\xf3\x0c\xaf\x4d\x04\x4c\x34\xaa\x1b\x7b\xc9\x3b\xad\x70\xc1\x4b\x27\xd4\x9e\x71\xe4\xa2\xfb\xe3\x25\xa6\x84\x87\xab\xa8\x17\x94\xfc\xc6\xca\x02\xf9\x45\xf2\x84\xfe\x58\xb3\x36\xbc\x19\xff\x93\xf4\x95\xfc\x9b\x18\xfa\x81\xf5\x23\xc4\xef\x73\x4a\x14\x44\x55\x19\x62\x1d\x8f\x47\xa3\x4a\xf6\xdd\x8e\x82\x54\xd7\x63\x78\xaa\x7c\xf4\x62\x8a\xae\xf4\x74\x0d\x0e\xc7\x8c\xc2\x01\x3b\xd0\x54\x2e\xa7\xe8\xcf\xe8\xed\x11\xa5\xf7\x9e\x5c\x42\xa1\x7f\x3c\xb2\x3c\x27\x0c\x5c\x00\xd1\x69\x7c\x5e\xee\x8d\xf3\x3f\x23\x0b\x88\x6b\xa9\x50\x32\x01\x0a\x32\xa9\x22\xdb\xb7\xbf\x18\x68\xe0\x7b\x41\x2f\xe8\x58\xc2\x10\xa5\xa1\x54\xc1\x73\xe3\xa6\x49\x56\x13\xbc\x36\x14\x8a\x89\x1c\x2c\x25\x7f\x50\x0a\x8e\xd6\x4c\x83\x61\x47\xf6\x5c\xdc\xfd\xf3\xa0\x3c\xa1\x68\xc9\x68\x23\x7d\x09\xf1\x35\xdc\xa8\xbe\x00\x99\xc7\x86\x5f\xc2\x98\x0c\xdd\x3d\x3e\xc0\xd6\x91\xb7\x8b\x4a\x07\xab\xaa\x14\x8d\xa1\x4e\xb4\x17\x2c\xf9\xb0\x8d\xa9\x45\xe9\x37\x98\xb5\x5a\x5a\x90\xbb\x58\x5e\xfa\x6b\x89\x19\x77\xd7\xb1\x73\x07\x4d\x6e\x31\x1c\x1b\xd5\xf0\x31\xb8\x60\x9d\x41\xd1\xc8\x2d\x49\x69\x1a\x58\xc7\x2c\xab\xb3\x3a\xd5\xad\x86\x66\x9c\x0e\xf4\x14\x78\xca\x72\x50\x13\x50\xdb\xc9\xa1\xfa\x68\x54\x89\x90\x63\xb8\x2a\xa8\xe7\x86\x34\x20\x8e\x61\x5a\xd3\xa6\x4c\xf8\xc4\x25\x91\x67\xae\x2e\x68\xd4\x3d\x67\x3e\x40\x55\xa9\x0e\x22\xb7\x75\xa6\x50\xaf\x51\x99\x26\x46\x2e\xb9\xa7\x39\x09\x31\x95\xe4\x59\x68\xa5\x03\xcd\x05\x12\xc7\xba\x7d\xb1\xb3\xa4\xf3\x09\x61\x97\x31\x61\x10\x22\xab\xba\xdf\xa1\xa7\x5a\xeb\xa5\x84\x80\xff\x11\x59\xa6\x3d\x25\x27\x87\xab\xb0\x7d\x1a\x84\xb5\x5d\xec\x9d\xed\x7b\x11\x7d\xef\xbc\x33\x4e\xc0\x78\x64\x56\x84\xc6\xbe\xb4\xa0\x12\xe0\x5b\xcf\x8f\x89\x96\x6e\x80\xfb\x6d\x53\x27\x14\x2b\x88\x2d\x9a\xa6\xf4\x39\x83\x01\x5c\xdb\x92\x75\x28\xe4\x0f\x7d\x6d\xb3\x60\x58\xae\x39\x55\x64\x86\x6e\xcc\x4e\x72\xef\x89\xee\xa8\x68\x04\xd0\x98\x98\xac\x0b\x3b\x7f\x50\x90\xe9\x18\xcf\xe5\x4a\xfe\xfa\xcb\xd3\xb3\x6e\x57\x4c\x02\x31\xf8\x83\x52\x1e\xe0\xa9\x74\xab\x3f\xff\x15\x3d\xd3\xc7\xe1\x3f\x33\xe2\x96\xc0\x2f\xa2\xe3\x4c\x99\x72\xfa\xa6\xb4\xce\xb8\x85\xe7\xa4\xfb\x78\x71\xe7\x06\x7e\x09\xa5\x89\x7d\xd8\xaf\x22\x70\x8d\x57\xcf\x87\xae\x58\x9f\xfc\x39\xa9\x1c\xe5\xd3\x05\xd8\xc6\x58\xd1\x77\x6c\x3b\x4f\x95\x89\xed\xed\xb1\xb2\x16\x4c\x00\x7c\xc5\x70\x80\x6a\xd2\x5a\xa9\x43\xfd\x2a\xce\x65\x79\x29\x05\xc4\xa2\x63\x17\x4d\x8a\xcc\xd3\x2e\xbe\x5c\x7d\xde\xbd\x10\xdc\xed\xd1\x79\x6d\xc5\x37\x50\x67\x2d\x2c\x83\x99\x09\x30\xd5\x4e\x12\xa6\xc3\x11\xd9\x3e\x03\x75\xab\x66\xda\xe6\xf3\x03\x55\x9f\xdf\x33\x11\x54\x21\x5a\xaa\xfa\x09\x76\x74\x9b\xff\x50\xc6\x08\x60\xed\xbc\xd6\x8d\x44\xb0\x64\x62\xd8\x7a\x67\x74\xdc\x2c\xea\x74\x6d\x17\x93\x60\x90\x0f\x32\xa8\xff\x1f\xe8\xf6\x7b\x2e\xb3\xd6\x67\xa6\xf9\x59\xb1\xc5\x79\xbc\x2e\x89\x7d\xe6\x62\x61\x91\xfb\xc0\xf6\xf7\xee\x50\x17\x1e\xb2\x1b\x90\x94\xfb\x5e\xc8\x12\x93\x4e\xba\xd9\x49\xae\xa7\x24\x18\x03\x67\x41\xc9\xbc\x81\x7f\xff\x67\xf5\xdf\x01\x00\x30\x66\xc6\x8a\xfd\x11\x00\x00")
 
 func crdsBasesTenancyFarosSh_workspacesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -361,7 +367,7 @@ func crdsBasesTenancyFarosSh_workspacesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsEdgeFarosSh_agentsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4b\x8f\xdb\xbe\x11\xbf\xfb\x53\x0c\xd0\x43\x5a\x60\x2d\x67\xd1\x4b\x61\xa0\x07\x63\xdb\x02\x8b\xfe\x53\x2c\xb2\x9b\xdc\x47\xe4\x58\x62\x96\x22\x55\x0e\xe9\xc4\x2d\xfa\xdd\x8b\x21\x25\xd9\xde\x95\xb2\x6e\x9a\xf0\x26\x3e\xe6\xf5\xfb\xcd\x43\xeb\xf5\x7a\x85\xbd\xf9\x4c\x81\x8d\x77\x5b\xc0\xde\xd0\xb7\x48\x4e\xbe\xb8\x7a\xfe\x13\x57\xc6\x6f\x0e\xb7\xab\x67\xe3\xf4\x16\xee\x12\x47\xdf\x7d\x24\xf6\x29\x28\xfa\x0b\xed\x8d\x33\xd1\x78\xb7\xea\x28\xa2\xc6\x88\xdb\x15\x00\x3a\xe7\x23\xca\x36\xcb\x27\x80\xf2\x2e\x06\x6f\x2d\x85\x75\x43\xae\x7a\x4e\x35\xd5\xc9\x58\x4d\x21\x0b\x1f\x55\x1f\xde\x57\xb7\xef\xab\xf7\x2b\x00\x15\x28\xbf\x7f\x32\x1d\x71\xc4\xae\xdf\x82\x4b\xd6\xae\x00\x1c\x76\xb4\x05\x6c\xc8\x45\xae\x48\x37\x54\xed\x31\x78\xae\xb8\x5d\x71\x4f\x4a\xf4\x35\xc1\xa7\x7e\x0b\x97\x87\xe5\xe5\x60\x4f\xf1\x65\x27\x42\xf2\xb7\x35\x1c\xff\x7e\xda\xfb\xcd\x70\xd9\xef\x6d\x0a\x68\x47\x75\x79\x8b\x8d\x6b\x92\xc5\x30\x6c\xae\x00\x58\xf9\x9e\xb6\xf0\x0f\x11\xdf\xa3\x22\xbd\x02\x18\x5c\xca\xea\xd6\x80\x5a\xe7\x20\xa1\x7d\x08\xc6\x45\x0a\x77\xde\xa6\x6e\x0c\xce\x1a\xbe\xb0\x77\x0f\x18\xdb\x2d\x54\x63\x18\xab\x57\x11\xc8\x77\x47\xff\x77\x0d\x0d\xdf\xf1\x28\xca\x35\xc6\xb2\x51\x8e\x0f\xb7\x68\xfb\x16\x6f\x8b\xc5\xaa\xa5\x0e\xb7\xc3\x7d\xdf\x93\xdb\x3d\xdc\x7f\xfe\xe3\xe3\xc5\x36\x80\x26\x56\xc1\xf4\x31\x23\x91\xc3\x00\x86\x21\xb6\x04\xe5\x26\xec\x7d\xc8\x9f\xe5\x6c\xf7\x70\x3f\x3d\xed\x83\xef\x29\x44\x33\x86\xb7\xac\x33\x52\x9d\xed\xbe\x50\xf4\x4e\x6c\x29\xb7\x40\x0b\x9b\xa8\xe8\x1c\x02\x48\x7a\x30\x1f\xfc\x1e\x62\x6b\x18\x02\xf5\x81\x98\x5c\xe1\xd7\x85\x60\x90\x4b\xe8\xc0\xd7\x5f\x48\xc5\x0a\x1e\x29\x88\x18\xe0\xd6\x27\xab\x85\x84\x07\x0a\x11\x02\x29\xdf\x38\xf3\xaf\x49\x36\x43\xf4\x59\xa9\xc5\x48\x03\xf2\xa7\x95\x01\x73\x68\xe1\x80\x36\xd1\x0d\xa0\xd3\xd0\xe1\x11\x02\x89\x16\x48\xee\x4c\x5e\xbe\xc2\x15\x7c\xf0\x81\xc0\xb8\xbd\xdf\x42\x1b\x63\xcf\xdb\xcd\xa6\x31\x71\x4c\x26\xe5\xbb\x2e\x39\x13\x8f\x9b\x9c\x17\xa6\x4e\xd1\x07\xde\x68\x3a\x90\xdd\xb0\x69\xd6\x18\x54\x6b\x22\xa9\x98\x02\x6d\xb0\x37\xeb\x6c\xba\xcb\x09\x55\x75\xfa\x77\x61\x48\x3f\x7e\x77\x61\x6b\xa1\x02\xc7\x60\x5c\x73\x76\x90\xc9\xfe\x1d\x04\x84\xf8\x02\x35\x0e\x4f\x8b\x17\xa7\x40\xcb\x96\x44\xe7\xe3\x5f\x1f\x9f\x60\x54\x9d\xc1\x78\x19\xfd\x1c\xf7\xd3\x43\x3e\x41\x20\x01\x33\x6e\x4f\xa1\x80\xb8\x0f\xbe\xcb\x32\xc9\xe9\xde\x1b\x17\xf3\x87\xb2\x66\x4c\xc8\xd3\xe2\x54\x77\x26\x0a\xee\xff\x4c\xc4\x51\xb0\xaa\xe0\x2e\x57\x18\xa8\x09\x52\x2f\xd4\xd7\x15\xdc\x3b\xb8\xc3\x8e\xec\x1d\x32\xfd\x72\x00\x24\xd2\xbc\x96\xc0\x5e\x07\xc1\x79\x71\x7c\x79\xb9\x44\xed\xec\x60\x2c\x63\x0b\x78\xe5\xf4\x7b\xec\x49\x5d\xe4\x8b\x26\x36\x41\x18\x1d\x31\x52\xce\x83\xe6\x65\x2c\xe7\xb3\x14\x4a\x99\x6b\x8c\x7b\xb5\x0d\x60\x22\x75\x33\xdb\x2f\x2c\x7a\xc8\xcf\xdf\x36\xa9\xa8\x99\x91\xb6\x6c\x59\x59\xca\xbb\xbd\x69\xe6\xcf\x5e\xd8\x72\x97\xaf\x96\x6a\x41\xcb\x1a\x61\x19\xab\xd3\xca\xb5\xf4\x1a\xa5\x52\xfa\x7f\x8e\xca\xc3\x5c\xc1\x5c\xd0\x3a\x96\xcd\x9f\xa0\x78\x81\x89\xe7\x87\x18\x02\x1e\xaf\xa2\x6f\xc4\x98\xf8\x4d\x02\xe7\x5b\x17\x7c\xf1\x35\x4b\xb5\x38\x23\xcc\xee\x7f\xe0\xb0\xf2\xae\x34\xd9\x19\x0e\x5d\x32\x24\x85\x20\x0d\xac\x0f\x5e\x11\x4b\x3b\x3f\x29\x9c\xda\x5b\xf5\x83\xa9\x70\x37\x5a\x31\x79\x96\xfb\x91\x38\x96\xfb\x55\xce\xcc\xb1\x50\x8a\x2b\x58\xe6\x82\x59\xd8\xb2\x59\xaf\x2d\x79\x3b\x5f\x2c\x72\x7c\x0a\xe8\xd8\x8c\x13\xc4\x55\x84\xfa\x0d\x39\x42\x34\x1d\x95\x6a\x3c\xb9\x12\x27\x51\xa4\x4b\xe9\xf6\x8e\x06\x9c\x17\xe4\x82\xb4\x54\x74\x3e\xb6\x14\x2a\x78\x92\x9a\x3f\x74\xe1\x9a\xe0\x6b\x4b\x2e\xab\x48\x4e\x53\xb0\x47\x81\xe0\xa4\x4d\xb5\xe8\x1a\xd2\x73\x7e\x97\x75\x2f\x38\x61\x9e\x4e\xa4\x09\x3c\x3b\xff\xd5\xdd\x88\x3c\x07\x89\xc7\x66\x95\xdd\x98\x14\xed\x1e\xee\x61\x6f\xc8\xea\x45\xa1\x83\xd6\xdc\x07\x95\xa2\x3e\x62\x6d\x67\x63\x2f\x6b\xef\x43\x87\xb1\x4c\x5d\x6b\xd1\xf4\xa3\xe9\xde\x11\x33\x36\xd7\xa1\xb3\x83\x36\x75\xe8\x20\x10\x6a\x31\x6e\x7c\x0c\xc6\x69\xa3\x30\xb7\x69\x4d\x11\x8d\x65\xc0\xda\xa7\xd7\x89\x3c\x99\x25\xf1\x99\x30\x1d\xe0\xc9\xe1\xc9\x7d\xba\x26\xa0\xae\x8f\xc7\x25\xef\xdf\xf4\x2a\x10\xf2\x95\x35\xec\xa9\xa5\xe1\xfa\x34\x5e\x4e\x4c\x78\xc7\x99\xc8\x67\xa6\x2e\x7a\x64\x2e\x9a\xbf\x08\x95\x26\x6a\xf6\x46\x65\xe8\xc5\x2b\xd5\x7a\xcf\x99\x12\xc2\x49\xf0\x21\x93\x67\x66\x8a\x39\xad\x12\x12\xc3\x62\x12\x1b\x4d\xd2\xcc\x10\x9a\x84\x01\x5d\x24\xd2\x22\xfb\x55\xf4\xca\x5c\xb2\x28\xf3\xff\x8b\x2c\xd3\x81\x82\x89\xc7\xab\x62\xfb\x38\x5c\x96\x72\x71\x30\xba\xd4\x22\xfa\xd6\x5b\xa3\x4c\x04\x65\x91\x59\x22\x34\xd6\xa5\x45\x93\x3f\x16\x7c\x94\xd7\x74\x03\x5c\x26\xe5\xc4\x32\xd5\xf9\x00\x1d\xaa\x36\xd7\x39\x85\x0e\x4c\xd7\x91\x36\x18\xc9\x1e\x4b\x6e\x73\x44\xb7\x9c\x73\x19\xed\xa1\x1a\xb3\x89\xa9\x58\x22\xf3\x35\xaa\x28\x69\xe8\x83\x36\xae\xb1\xc7\x02\xe9\xe4\xcf\xf7\x33\xf9\xc3\xa7\xc7\x27\xe1\x30\x53\x04\xef\xec\xb1\x54\x81\xd2\x6f\xfe\xfc\x37\xb4\xbc\x98\xd6\x6f\x87\x7f\xa6\xb5\x2d\x05\xbf\xf4\xb7\xa1\xa7\x4c\x9c\xbe\xc9\xa5\xd3\xef\xe1\x29\xc8\xbf\x44\x36\xe7\x06\x3e\xb9\x5c\xc4\x7e\xd8\xae\x7c\xe1\xaa\x74\x3b\xf6\x59\xfb\xa9\xda\x9e\x67\x8e\xe0\x69\x24\x11\x7d\x45\xdf\xb0\xeb\x2d\x55\xca\x77\x9b\x53\x66\x2d\xc7\x1c\xdd\x11\xaa\x49\x6a\x25\x06\x0d\x3f\x43\x80\x81\x4a\x02\x71\x14\xa0\x51\x05\xcf\x3c\xfd\x47\x2c\x67\x9f\x35\xcf\x04\xbb\x03\x1a\x2b\xd5\xee\x06\xea\x24\x89\xa5\x30\x31\x01\x86\xda\xc4\x80\xe1\x78\xd6\xf6\x33\x03\xeb\x4c\xcd\x7d\x9a\x6f\xa8\xb2\x7e\xcf\x44\x50\x39\xaf\xa9\x2a\x70\x9e\xcc\xe6\x3f\xe4\x36\x02\x58\x1b\x2b\x3c\x8b\x1e\x34\xc9\xec\x69\x8d\x92\x76\xb3\x5c\x7e\xba\xde\x87\x88\x73\x63\xc3\x19\x40\xcb\x08\xca\xbf\x8d\x8c\xca\x73\x18\xae\x67\xba\xf9\xec\xb5\xc5\x7e\xbc\xce\xea\x7f\xe9\xd4\xf7\x6a\xb3\x4c\x72\x5b\x88\x21\x15\xcd\x1c\x7d\x90\x4e\x77\xb6\x93\xea\x89\x04\xa3\xe3\x43\x82\xc1\xbf\xff\xb3\xfa\x6f\x00\x00\x00\xff\xff\x21\xe2\x45\x3d\x97\x12\x00\x00")
+var _crdsEdgeFarosSh_agentsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x4b\x6f\x23\xb9\xf1\xbf\xeb\x53\x14\xf0\x3f\xcc\x3f\x80\xd5\x9e\x41\x2e\x81\x80\x1c\x0c\x27\x41\x8c\xcc\x04\xc6\xd8\xbb\xf7\x6a\xb2\x24\x71\xcd\x26\x3b\xac\xa2\x76\x94\x20\xdf\x3d\x28\xb2\x5b\x0f\xbb\xdb\xa3\xf5\x38\x87\xa8\x75\x61\x91\x5d\x8f\x5f\x3d\xd9\xcb\xe5\x72\x81\xbd\xfb\x99\x12\xbb\x18\x56\x80\xbd\xa3\x6f\x42\x41\x57\xdc\x3c\xfd\x81\x1b\x17\xaf\x77\x9f\x16\x4f\x2e\xd8\x15\xdc\x66\x96\xd8\x7d\x25\x8e\x39\x19\xfa\x13\xad\x5d\x70\xe2\x62\x58\x74\x24\x68\x51\x70\xb5\x00\xc0\x10\xa2\xa0\x92\x59\x97\x00\x26\x06\x49\xd1\x7b\x4a\xcb\x0d\x85\xe6\x29\xb7\xd4\x66\xe7\x2d\xa5\xc2\x7c\x14\xbd\xfb\xd8\x7c\xfa\xd8\x7c\x5c\x00\x98\x44\xe5\xfd\x47\xd7\x11\x0b\x76\xfd\x0a\x42\xf6\x7e\x01\x10\xb0\xa3\x15\xe0\x86\x82\x70\x43\x76\x43\xcd\x1a\x53\xe4\x86\xb7\x0b\xee\xc9\xa8\xbc\x4d\x8a\xb9\x5f\xc1\xf9\x66\x7d\x73\xd0\xa7\xda\x72\xa3\x4c\xca\xda\x3b\x96\xbf\x1d\x69\x9f\x1d\x57\x7a\xef\x73\x42\x3f\x8a\x2b\x24\x76\x61\x93\x3d\xa6\x81\xb8\x00\x60\x13\x7b\x5a\xc1\xdf\xb1\x23\xee\xd1\x90\x5d\x00\x0c\x26\x15\x71\x4b\x40\x6b\x0b\x48\xe8\xef\x93\x0b\x42\xe9\x36\xfa\xdc\x8d\xe0\x2c\xe1\x17\x8e\xe1\x1e\x65\xbb\x82\x66\x84\xb1\x79\x81\x40\x91\x3e\xda\x7f\xb3\xa1\x61\x2d\x7b\x15\x6e\x51\x2a\xa1\x6e\xef\x3e\xa1\xef\xb7\xf8\xa9\x90\xd8\x6c\xa9\x2b\x7e\xd1\x55\xec\x29\xdc\xdc\xdf\xfd\xfc\xfb\x87\x33\x32\x80\x25\x36\xc9\xf5\x2a\x73\x80\x01\x1c\x83\x6c\x09\xea\x49\x58\xc7\x54\x96\x75\xef\xe6\xfe\xee\xf0\x6a\x9f\x62\x4f\x49\xdc\x08\x6f\x7d\x4e\x82\xea\x84\xfa\x4c\xd0\x07\xd5\xa5\x9e\x02\xab\xd1\x44\x55\xe6\x00\x20\xd9\x41\x7d\x88\x6b\x90\xad\x63\x48\xd4\x27\x62\x0a\x35\xbe\xce\x18\x83\x1e\xc2\x00\xb1\xfd\x85\x8c\x34\xf0\x40\x49\xd9\x00\x6f\x63\xf6\x56\x83\x70\x47\x49\x20\x91\x89\x9b\xe0\xfe\x79\xe0\xcd\x20\xb1\x08\xf5\x28\x34\x78\xfe\xf8\x14\x87\x05\xf4\xb0\x43\x9f\xe9\x0a\x30\x58\xe8\x70\x0f\x89\x54\x0a\xe4\x70\xc2\xaf\x1c\xe1\x06\xbe\xc4\x44\xe0\xc2\x3a\xae\x60\x2b\xd2\xf3\xea\xfa\x7a\xe3\x64\x4c\x26\x13\xbb\x2e\x07\x27\xfb\xeb\x92\x17\xae\xcd\x12\x13\x5f\x5b\xda\x91\xbf\x66\xb7\x59\x62\x32\x5b\x27\x64\x24\x27\xba\xc6\xde\x2d\x8b\xea\x41\x0d\xe6\xa6\xb3\xff\x97\x86\xf4\xe3\x0f\x67\xba\xd6\x50\x60\x49\x2e\x6c\x4e\x36\x4a\xb0\xbf\xe2\x01\x0d\x7c\x75\x35\x0e\xaf\x56\x43\x8f\x40\x2b\x49\xd1\xf9\xfa\xe7\x87\x47\x18\x45\x17\x67\x9c\x31\x85\x01\xf7\xe3\x8b\x7c\x74\x81\x02\xe6\xc2\x9a\x34\x82\x1c\xc3\x3a\xc5\xae\x20\x4e\xc1\xf6\xd1\x05\x29\x0b\xe3\xdd\x98\x90\xc7\x1f\xe7\xb6\x73\xa2\x7e\xff\x47\x26\x16\xf5\x55\x03\xb7\xa5\xc2\x40\x4b\x90\x7b\x0d\x7d\xdb\xc0\x5d\x80\x5b\xec\xc8\xdf\x22\xd3\x7f\xdd\x01\x8a\x34\x2f\x15\xd8\xcb\x5c\x70\x5a\x1c\x8f\x3f\xe5\xb2\x1a\x50\x3b\xd9\x18\xcb\xd8\x8c\xbf\x4a\xfa\x3d\xf4\x64\xce\xf2\xc5\x12\xbb\xa4\x11\x2d\x28\x54\xf2\xe0\x50\xdc\x5e\xcf\xd2\xa1\xcc\x6d\xdc\x58\x8e\x4e\x7f\x4e\xa8\x9b\x20\x3f\xd3\xe8\xde\xe7\x8d\x0b\xdf\x57\xa9\x8a\x99\xe0\x36\xaf\x59\x7d\x4c\x0c\x6b\xb7\x99\xde\x7b\xa6\xcb\x6d\x39\xaa\xd2\x34\xa2\x66\x25\xbe\xe2\xab\xe3\x53\x6a\xe9\x25\x42\xb5\xf4\xbf\x8f\xc8\xa1\xec\x5d\x64\xea\x58\x36\xdf\x41\xf0\x4c\x24\x9e\x6e\x62\x4a\xb8\x5f\x5c\xf0\x12\x0b\x4a\x7e\xe6\xc8\x33\xbd\x6b\x00\x97\x53\x67\xf1\x12\x5b\xd6\x82\x7d\x12\x30\xc7\x06\xfd\xfd\x48\x31\x31\xd4\x26\xfb\x62\xe7\x99\xf8\xdb\x9c\x92\x36\xb0\x3e\x45\x43\xac\xed\xfc\x28\xf0\xd0\xde\x9a\x37\xa6\xc2\xed\xa8\xc5\xc1\xb2\xd2\x8f\xd4\xb0\xd2\xaf\x54\x08\x0e\x98\x81\x9a\x52\xa8\xe8\x27\xf8\x56\x24\xa9\x79\x43\xbe\x78\x64\x79\x4c\x18\xb8\x00\xa2\x13\xc4\xf4\xb9\x67\xca\x7f\x46\x16\x10\xd7\x51\x71\xc7\x01\x50\x90\x03\x2b\xb2\xb5\x74\xc7\x40\x83\x9f\x67\xf8\x82\xb6\x54\x0c\x51\xb6\x94\x1a\x78\xdc\xba\x43\x17\x6e\x09\x7e\xdd\x52\x28\x22\x72\xb0\x94\xfc\x5e\x5d\x70\x94\x66\xb6\x18\x36\x64\xa7\xec\xae\xcf\x9d\xfa\x09\x45\x5b\x96\x36\x81\xa7\x10\x7f\x0d\x57\xca\x2f\x40\xe6\xb1\x59\x15\x33\x0e\x82\x6e\xee\xef\x60\xed\xc8\xdb\x59\xa6\x83\x54\x65\x8a\xc6\x50\x2f\xd8\xfa\x49\xec\xf5\xbf\x8e\xa9\x43\xa9\x53\xd7\x52\x25\xbd\x2d\xeb\xb4\x2f\x30\xe3\xe6\x32\xef\xdc\xc0\x36\x77\x18\x20\x11\x5a\x55\x6e\x7c\x19\x5c\xb0\xce\xa0\xa8\xe5\x96\x04\x9d\x67\xc0\x36\x66\x59\x4c\x70\x2c\x7f\x85\xfe\xe8\xd3\xc1\x3d\x05\x9e\x32\xd8\xb4\x04\xd4\xf5\xb2\x6f\xde\x6a\x55\x22\xe4\x0b\x6b\xd8\xe3\x96\xd4\x20\x8e\xe1\x30\x5e\x1e\x22\xe1\x03\x97\x40\x3e\x51\x75\x86\xa3\xce\x68\xa7\xcd\x5f\x99\x6a\x13\x75\x6b\x67\x8a\xeb\xd5\x2a\xb3\x8d\x91\x4b\xec\x69\x4c\x42\x4c\x25\x78\x26\xa6\x98\xe3\x53\x21\x71\xac\x93\x23\x3b\x4b\xda\x5f\x11\x36\x19\x13\x06\x21\xb2\xca\xfb\x05\x7a\xca\xb5\x9d\x0b\x08\xf8\x41\x64\x99\x76\x94\x9c\xec\x2f\xc2\xf6\x61\x38\x0c\x7d\x8a\x3b\x67\x6b\x2d\xa2\x6f\xbd\x77\xc6\x09\x18\x8f\xcc\x8a\xd0\x58\x97\x66\x58\x02\x7c\xad\xfe\x31\xd1\xd2\x15\x70\x9d\x94\x33\xeb\x54\x17\x13\x74\x68\xb6\xa5\xce\x19\x0c\xe0\xba\x8e\xac\x43\x21\xbf\xaf\xb9\xcd\x82\x61\x3e\xe7\x94\x91\x19\xaa\x31\x3b\xc9\x55\x13\x9d\xaf\xd1\x08\xa0\x31\x31\x59\x17\x36\x7e\xaf\x20\xd3\xd1\x9e\xd7\x33\xf9\xcb\x4f\x0f\x8f\x3a\x19\x32\x09\xc4\xe0\xf7\xea\xf2\x00\x0f\xa5\x5a\xfd\xf1\x2f\xe8\x99\xde\x0e\xff\x44\x6b\x9b\x03\xbf\x1c\x1d\x7b\xca\x21\xa6\xaf\x4a\xe9\x8c\x6b\x78\x4c\x7a\x97\x28\xea\x5c\xc1\x4f\xa1\x14\xb1\x37\xeb\x55\x0e\x5c\xa2\xd5\xe3\xbe\x2f\xd2\x0f\xfa\x9c\x65\x8e\x26\x85\x0b\xb0\x8e\xb1\xa1\x6f\xd8\xf5\x9e\x1a\x13\xbb\xeb\x63\x66\xcd\x88\x00\xf8\x82\x61\x0f\xcd\x81\x6b\xa3\x0a\xd5\x6b\x04\x03\xa6\x62\x3f\x3b\x16\x6d\xbb\x68\x52\x64\x3e\xdc\x23\xe6\xb3\xcf\xbb\x27\x82\x9b\x1d\x3a\xaf\xd5\xee\x0a\xda\xac\x89\x65\x30\x33\x01\xa6\xd6\x49\xc2\xb4\x3f\x22\x5b\x23\x50\x6f\x04\x4c\xeb\x3c\xdd\x50\xf5\xf9\x7f\x26\x82\x26\x44\x4b\x4d\xed\x60\x47\xb5\xf9\x77\xa5\x8d\x00\xb6\xce\x6b\xde\x48\x04\x4b\x3a\x7b\x7a\x67\xb4\xdd\xcc\xf2\x74\x5d\x1f\x93\x60\x90\x37\x7a\x50\xef\x36\x3a\x2a\x4f\x45\xd6\x72\xa2\x9b\x4f\x1e\x9b\xed\xc7\xcb\x12\xd8\xef\x35\xf5\xd5\xe9\xe2\xaf\x84\x49\x5a\x42\x99\x1e\x2e\xce\x82\xee\xf3\xf3\xf3\xe3\x77\x05\x7f\x36\x6f\x94\xcf\x29\x7a\x79\x8c\x49\xc8\x82\x9b\x41\x1c\xbd\xdb\xd1\xe2\xb7\x77\xe4\x57\x5c\x30\x7b\xfd\x39\x33\xa3\xde\x72\x6a\x3c\xbf\x1c\x57\x0f\xa9\x3e\x30\x1b\x97\xe3\x47\xa2\xb7\x0c\x93\xc3\xbd\xea\x92\x49\x19\xe7\xc7\xff\xef\x0d\x8b\xbf\x65\x06\xf9\x32\x8c\x1c\x15\x98\x76\x50\xe7\x6c\x7c\xfe\x9f\xbb\x72\x15\xed\x2f\x92\xf9\xf0\x7e\x76\xfe\xf0\x3d\x0f\x52\x0e\x61\x8e\xfb\x0f\x16\x1c\x75\xc2\xfb\x95\x8b\x59\x53\x5f\x33\x72\x3a\x6d\x66\xad\x9a\x54\xed\x05\xb1\x26\xcd\x0a\x24\xe5\x5a\x1f\x58\x62\xd2\xd0\x3f\xa1\xe4\xf6\xd0\x99\x46\x8d\x59\x50\x32\xaf\xe0\x5f\xff\x5e\xfc\x67\x00\x5a\x00\x54\x74\x2c\x17\x00\x00")
 
 func crdsEdgeFarosSh_agentsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -381,7 +387,7 @@ func crdsEdgeFarosSh_agentsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsEdgeFarosSh_registrationsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\x23\xb9\x11\xbd\xeb\x57\x14\x90\xc3\x24\x80\xd5\x9e\x41\x2e\x81\x80\x1c\x0c\x27\x01\x8c\xec\x06\xc6\xd8\xbb\xf7\x12\x59\x52\x73\xcd\x26\x3b\xac\xa2\x76\x94\x20\xff\x3d\x28\xb2\x5b\x6a\x49\xdd\x1e\x23\xc1\xf2\xd6\x24\xbb\x3e\xde\xab\x7a\xc5\xf5\x7a\xbd\xc2\xde\xfd\x4c\x89\x5d\x0c\x1b\xc0\xde\xd1\x37\xa1\xa0\x5f\xdc\xbc\xfd\x89\x1b\x17\xef\x0f\x5f\x56\x6f\x2e\xd8\x0d\x3c\x66\x96\xd8\x7d\x25\x8e\x39\x19\xfa\x0b\xed\x5c\x70\xe2\x62\x58\x75\x24\x68\x51\x70\xb3\x02\xc0\x10\xa2\xa0\x6e\xb3\x7e\x02\x98\x18\x24\x45\xef\x29\xad\xf7\x14\x9a\xb7\xbc\xa5\x6d\x76\xde\x52\x2a\xc6\x47\xd7\x87\xcf\xcd\x97\xcf\xcd\xe7\x15\x80\x49\x54\xfe\x7f\x75\x1d\xb1\x60\xd7\x6f\x20\x64\xef\x57\x00\x01\x3b\xda\x40\xa2\xbd\x63\x49\xd5\x47\x43\x76\x4f\xcd\x0e\x53\xe4\x86\xdb\x15\xf7\x64\xd4\xed\x3e\xc5\xdc\x6f\xe0\xf2\xb0\x1a\x18\xc2\xaa\x29\x7d\x9d\xd8\x2a\xdb\xde\xb1\xfc\xfd\xe6\xe8\x07\xc7\x52\x8e\x7b\x9f\x13\xfa\xab\x18\xca\x09\xbb\xb0\xcf\x1e\xd3\xe5\xd9\x0a\x80\x4d\xec\x69\x03\xff\x50\xd7\x3d\x1a\xb2\x2b\x80\x21\xeb\x12\xca\x1a\xd0\xda\x82\x23\xfa\xe7\xe4\x82\x50\x7a\x8c\x3e\x77\x23\x7e\x6b\xf8\x85\x63\x78\x46\x69\x37\xd0\x8c\x48\x37\x37\x20\x95\xbb\x23\x44\x0f\x7b\x1a\xbe\xe5\xa8\xce\x2d\x4a\xdd\xa8\xc7\x87\x2f\xe8\xfb\x16\xbf\xd4\xc0\x4d\x4b\x1d\x6e\x86\xfb\xb1\xa7\xf0\xf0\xfc\xf4\xf3\x1f\x5f\x2e\xb6\x01\x2c\xb1\x49\xae\x97\x42\xd6\x14\x1b\x70\x0c\xd2\x12\xd4\x1f\x60\x17\x53\xf9\xbc\xb8\xf2\xf0\xfc\x74\x32\xd4\xa7\xd8\x53\x12\x37\x12\x51\xd7\xa4\x0a\x27\xbb\x57\x6e\x3f\x69\x64\xf5\x16\x58\x2d\x3f\xaa\xae\x07\x38\xc9\x0e\xc9\x40\xdc\x81\xb4\x8e\x21\x51\x9f\x88\x29\xc8\x99\xe0\xf3\x8a\x3b\xc0\x00\x71\xfb\x0b\x19\x69\xe0\x85\x92\x9a\x01\x6e\x63\xf6\x56\xab\xf6\x40\x49\x20\x91\x89\xfb\xe0\xfe\x75\xb2\xcd\x20\xb1\x38\xf5\x28\x34\x54\xc5\x79\x15\xfa\x02\x7a\x38\xa0\xcf\x74\x07\x18\x2c\x74\x78\x84\x44\xea\x05\x72\x98\xd8\x2b\x57\xb8\x81\x1f\x63\x22\x70\x61\x17\x37\xd0\x8a\xf4\xbc\xb9\xbf\xdf\x3b\x19\xbb\xcf\xc4\xae\xcb\xc1\xc9\xf1\xbe\x34\x92\xdb\x66\x89\x89\xef\x2d\x1d\xc8\xdf\xb3\xdb\xaf\x31\x99\xd6\x09\x19\xc9\x89\xee\xb1\x77\xeb\x12\x7a\xa8\xdd\xd1\xd9\xdf\xa5\xa1\x5f\xf9\xd3\x45\xac\xb5\x30\x58\x92\x0b\xfb\xc9\x41\x69\x8b\x77\x18\xd0\xde\x50\xc6\x71\xf8\xb5\x66\x71\x06\x5a\xb7\x0a\xfd\x7f\x7d\x79\x85\xd1\x75\x21\xe3\x1a\xfd\x82\xfb\xf9\x47\x3e\x53\xa0\x80\xb9\xb0\xa3\x54\x49\xdc\xa5\xd8\x15\x9b\x14\x6c\x1f\x5d\x90\xf2\x61\xbc\xa3\x70\x0d\x3f\xe7\x6d\xe7\x44\x79\xff\x67\x26\x16\xe5\xaa\x81\xc7\x22\x49\xb0\x25\xc8\xbd\x36\x82\x6d\xe0\x29\xc0\x23\x76\xe4\x1f\x91\xe9\x37\x27\x40\x91\xe6\xb5\x02\xfb\x31\x0a\xa6\x6a\x7a\x7d\xb9\xa2\x36\x39\x18\x05\x6f\x81\xaf\x69\x17\xbe\xf4\x64\x2e\xda\xc6\x12\xbb\xa4\x85\x2d\x28\xa4\xed\x90\xae\xd5\x70\xe2\x3e\xbe\x51\x18\x71\xfd\x50\x60\x82\x92\xf9\xa3\xa1\x95\xcb\x17\xc1\xc5\x2d\x6b\x39\x4c\xa2\xbb\xd1\xea\xeb\x62\xba\xd8\x9c\x97\x19\xa8\x03\xa9\xea\xed\xcd\xc9\x55\x84\x8f\x39\x25\x0a\xa2\xa6\x0c\xb1\x0a\xfc\x39\x18\x0d\xf1\x61\x4f\x41\x9a\x1b\x1b\x4e\xa8\x9b\x31\x7d\x6d\x7c\x8c\xe2\x94\x75\x11\x23\x4d\xba\x6a\xa6\xca\xd3\xd8\x25\x9a\x0a\xd6\x11\x31\x63\xb7\x82\x4d\xb7\x91\xbc\x87\x42\x5d\x1e\x59\x5e\x13\x06\x76\xe3\x30\x99\xbf\x77\x15\xfc\x0f\xc8\x02\xe2\x3a\xaa\xad\x78\x4a\x45\x4e\xa6\xc8\xd6\xbe\x8d\x81\x86\x52\x58\xb0\xab\xa5\x05\x18\xa2\xb4\x94\x1a\x78\xd5\x86\x1f\x24\x78\x4b\xf0\x6b\x4b\xa1\xb8\xc8\xc1\x52\xf2\x47\xa5\xe0\xec\xcd\xb4\x18\xf6\x64\xe7\xf2\xae\xeb\x49\x79\x42\x51\xbd\x52\x05\x78\x0b\xf1\xd7\x70\xa7\xf6\x02\x64\x1e\x95\xaa\xa4\x71\x72\xf4\xf0\xfc\x04\x3b\x47\xde\x2e\x1a\x1d\xbc\x16\x11\x34\x86\x7a\xc1\xad\x9f\xc5\x5e\xd7\x2e\xa6\x0e\xa5\x0e\xe0\xb5\x7a\x5a\xb8\xb7\x20\x05\xe7\xd5\x11\x33\xee\x3f\xc6\xce\x03\xb4\xb9\x43\xed\x56\xb4\x1a\xdc\xf8\x33\xb8\x60\x9d\xc1\xa2\xd1\x96\x04\x9d\x67\xc0\x6d\xcc\xd7\x2a\x3a\x09\x4b\xf1\x39\x71\x3a\xd0\x53\xe0\x29\x22\xbd\x25\xa0\xae\x97\xe3\x52\xf6\xdf\xcd\x2a\x11\xf2\xf5\xc4\x5f\x48\xea\xb5\xa5\xe1\xfa\xe9\x89\x71\xaa\x84\x4f\x5c\x0a\x79\x12\xea\x62\x46\xee\x42\xf9\xd5\xa8\x2a\xa8\xdb\x39\x53\xa8\xd7\xac\x4c\x1b\x23\x97\x92\xd0\x9a\x84\x98\x4a\xf1\xcc\x8c\xb0\xf3\xaa\x90\x38\xd6\x90\xd8\x59\x52\x55\x45\xd8\x67\x4c\x18\x84\xc8\xaa\xed\x1b\xf4\xea\x50\x5a\xb4\xf9\xff\x21\xcb\x74\xa0\xe4\xe4\xf8\x21\x6c\x5f\x86\xcb\x2a\x17\x07\x67\xab\x16\xd1\xb7\xde\x3b\xe3\x04\x8c\x47\x66\x45\x68\xd4\xa5\xc5\x90\xbf\x56\x7e\x4c\xb4\x74\x07\x5c\x9f\x49\x99\x75\xa4\xc7\x04\x1d\x9a\xb6\xe8\x9c\xc1\x00\xae\xeb\xc8\x3a\x14\xf2\xc7\xda\xdb\x2c\x18\x96\x7b\xae\xb0\x3d\xa8\x31\x3b\xc9\x35\x12\x7d\x5c\xa1\x11\x6d\xc3\x98\xac\x0b\x7b\x7f\xac\x94\x9e\xf2\x79\xbf\x93\x7f\xfc\xe9\xe5\x55\x6b\x98\x49\x20\x06\x7f\xac\x2a\x50\x67\xd1\x9f\xff\x86\x9e\x17\xdb\xfa\xfb\xf0\xcf\x4c\xbf\x25\xf0\xeb\xec\x1b\x66\xca\xa9\xa6\xef\x8a\x74\xc6\x1d\xbc\x26\x7d\x48\x96\x70\xee\xe0\xa7\x50\x44\xec\x7f\x8e\xab\x5c\xf8\x50\xbb\x1d\xfb\xe2\xfd\xac\xb6\xd3\xce\x51\x3e\x9d\x36\x62\x6c\xe8\x1b\x76\xbd\xa7\xc6\xc4\xee\xfe\xdc\x59\xcb\x98\x63\x38\x42\x73\xb2\xda\x68\x40\xc3\x4b\x18\x30\x51\x6d\x20\x16\x25\x1a\x4d\x8a\xcc\xa7\x47\xe4\x72\xf7\x79\xf7\x46\xf0\x70\x40\xe7\x55\xed\xee\x60\x9b\xb5\xb1\x0c\x66\x26\xc0\xb4\x75\x92\x30\x1d\x27\x63\xbf\x54\xe0\xb6\x94\xe6\x2e\xcf\x0f\x54\x5d\xbf\x67\x22\x68\x42\xb4\xd4\x54\x3a\xcf\x61\xf3\x1f\xca\x18\x01\xdc\x3a\xaf\x75\x26\x11\x2c\x99\x18\x76\xde\x19\x1d\x37\xcb\xf2\xd3\xf5\x31\x09\xce\x3d\x1b\x26\x04\x2d\x33\xa8\x0f\x30\x7d\xb3\xcd\x71\xb8\x9e\x99\xe6\xb3\xd7\x16\xe7\xf1\xba\xb8\x9f\x39\x58\x78\xe3\x4d\x0f\x31\x25\x3c\xce\x3d\x1a\xbf\xf3\xc8\xd2\x7e\xad\x8f\xcb\xcc\x64\x15\xc9\xfa\x0a\xa5\xaa\xf1\xb8\xbf\x7d\xe5\xbf\x83\xd2\x6c\xa0\x37\x9b\xf5\x65\xb9\x01\x49\xb9\x66\xcb\x12\x93\x4e\xd7\xc9\x4e\xde\x9e\x0a\x6f\xcc\x60\x68\x6a\xf8\xf7\x7f\x56\xff\x0d\x00\x00\xff\xff\x56\xb1\xaf\xa1\x39\x11\x00\x00")
+var _crdsEdgeFarosSh_registrationsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcd\x6e\xeb\xca\x0d\xde\xfb\x29\x08\x74\x71\x5a\x20\x96\xcf\x41\x37\x85\x81\x2e\x82\xb4\x05\x82\xde\x53\x04\x89\xef\xdd\x53\x33\xb4\x34\x37\xa3\x19\x75\xc8\xf1\x3d\x6e\xd1\x77\x2f\x38\x92\x2d\xdb\x91\x82\x20\x45\x3d\xde\x68\x7e\xf8\xf3\x91\xfc\xc8\xf5\x7a\xbd\xc2\xde\xfd\x42\x89\x5d\x0c\x5b\xc0\xde\xd1\x0f\xa1\xa0\x5f\x5c\xbd\xfe\x89\x2b\x17\x37\x87\x6f\xab\x57\x17\xec\x16\x1e\x32\x4b\xec\x9e\x89\x63\x4e\x86\xfe\x42\x7b\x17\x9c\xb8\x18\x56\x1d\x09\x5a\x14\xdc\xae\x00\x30\x84\x28\xa8\xdb\xac\x9f\x00\x26\x06\x49\xd1\x7b\x4a\xeb\x86\x42\xf5\x9a\x6b\xaa\xb3\xf3\x96\x52\x11\x7e\x52\x7d\xf8\x5a\x7d\xfb\x5a\x7d\x5d\x01\x98\x44\xe5\xfd\xce\x75\xc4\x82\x5d\xbf\x85\x90\xbd\x5f\x01\x04\xec\x68\x0b\x89\x1a\xc7\x92\xca\x1d\xae\xc8\x36\x54\xed\x31\x45\xae\xb8\x5d\x71\x4f\x46\xd5\x36\x29\xe6\x7e\x0b\xd7\x87\x83\x80\xd1\xac\xc1\xa5\xe7\x0b\x59\x65\xdb\x3b\x96\xbf\xbf\x39\xfa\xc9\xb1\x94\xe3\xde\xe7\x84\xfe\xc6\x86\x72\xc2\x2e\x34\xd9\x63\xba\x3e\x5b\x01\xb0\x89\x3d\x6d\xe1\x1f\xd8\x11\xf7\x68\xc8\xae\x00\x46\xaf\x8b\x29\x6b\x40\x6b\x0b\x8e\xe8\x9f\x92\x0b\x42\xe9\x21\xfa\xdc\x9d\xf0\x5b\xc3\xaf\x1c\xc3\x13\x4a\xbb\x85\xea\x84\x74\xf5\x06\xa4\x62\xc4\x09\xa2\xfb\x86\xc6\x6f\x39\xaa\x72\x8b\x32\x6c\x0c\xc7\x87\x6f\xe8\xfb\x16\xbf\x95\x2d\x36\x2d\x75\x25\x74\xfa\x15\x7b\x0a\xf7\x4f\x8f\xbf\xfc\xf1\xe5\x6a\x1b\xc0\x12\x9b\xe4\x7a\xd5\x79\x8d\x0d\x38\x06\x69\x09\x86\x07\xb0\x8f\xa9\x7c\x5e\x5d\xb9\x7f\x7a\x3c\x0b\xea\x53\xec\x29\x89\x3b\x05\x62\x58\x17\x59\x78\xb1\x7b\xa3\xf6\x8b\x5a\x36\xdc\x02\xab\xe9\x47\x83\xea\x11\x4e\xb2\xa3\x33\x10\xf7\x20\xad\x63\x48\xd4\x27\x62\x0a\x32\x05\x78\x5a\x71\x0f\x18\x20\xd6\xbf\x92\x91\x0a\x5e\x28\xa9\x18\xe0\x36\x66\x6f\x35\x6b\x0f\x94\x04\x12\x99\xd8\x04\xf7\xaf\xb3\x6c\x06\x89\x45\xa9\x47\xa1\x31\x2b\xa6\x55\xc2\x17\xd0\xc3\x01\x7d\xa6\x3b\xc0\x60\xa1\xc3\x23\x24\x52\x2d\x90\xc3\x85\xbc\x72\x85\x2b\xf8\x1e\x13\x81\x0b\xfb\xb8\x85\x56\xa4\xe7\xed\x66\xd3\x38\x39\x55\x9f\x89\x5d\x97\x83\x93\xe3\xa6\x14\x92\xab\xb3\xc4\xc4\x1b\x4b\x07\xf2\x1b\x76\xcd\x1a\x93\x69\x9d\x90\x91\x9c\x68\x83\xbd\x5b\x17\xd3\x83\x3a\xcc\x55\x67\x7f\x97\xc6\x7a\xe5\x2f\x57\xb6\x0e\x89\xc1\x92\x5c\x68\x2e\x0e\x4a\x59\xbc\x13\x01\xad\x0d\x8d\x38\x8e\x4f\x07\x47\x27\xa0\x75\x4b\xd1\x79\xfe\xeb\xcb\x0e\x4e\xaa\x4b\x30\xae\x84\xc2\x88\xfb\xf4\x90\xa7\x10\x28\x60\x2e\xec\x49\x13\xc9\x31\xec\x53\xec\x0a\xe2\x14\x6c\x1f\x5d\x90\xf2\x61\xbc\xa3\x70\x0b\x3f\xe7\xba\x73\xa2\x71\xff\x67\x26\x16\x8d\x55\x05\x0f\x85\x92\xa0\x26\xc8\xbd\x16\x82\xad\xe0\x31\xc0\x03\x76\xe4\x1f\x90\xe9\xff\x1e\x00\x45\x9a\xd7\x0a\xec\xc7\x42\x70\xc9\xa6\xd3\x4f\xa5\x6c\x47\xd4\x2e\x0e\x4e\x84\xb7\x10\xaf\xcb\x2a\x7c\xe9\xc9\x5c\x95\x8d\x25\x76\x49\x13\x5b\x50\x48\x6b\xe6\x86\xb9\x2e\x97\xc4\x57\x0a\x27\x5c\x3f\x64\x98\xa0\x64\xfe\xa8\x69\xe5\xf2\x95\x71\xb1\x66\xad\xc8\x0b\xeb\x2e\x1f\xcc\x26\xd3\xd5\xe6\x3c\xcd\x8c\x0d\x69\xe0\xdb\x37\x27\x37\x16\x3e\xe4\x94\x28\x08\xf4\x29\x1a\x62\x25\xf8\xc9\x18\x4d\xc1\xfb\x86\x82\x54\x6f\x64\x38\xa1\x6e\x46\xf4\xad\xf0\x93\x15\x67\xaf\x0b\x19\xa9\xd3\x85\xac\x54\x09\x8e\x8e\x81\xba\x52\x76\xd1\xcf\xc8\x1d\xc0\xa6\xb7\x96\xbc\x87\xc2\xb0\x3c\xb2\xec\x12\x06\x2e\x80\x68\x33\x99\xbf\x77\x63\xfc\x4f\xc8\x02\xe2\x3a\x2a\xa1\x3a\x03\x0a\x72\x16\x45\x76\xa8\xdb\x18\x68\x4c\x85\x05\xb9\x9a\x5a\x80\x21\x4a\x4b\xa9\x82\x5d\xeb\xce\x14\x5c\x13\xfc\xd6\x52\x28\x2a\x72\xb0\x94\xfc\x51\x43\x30\x69\x33\x2d\x86\x86\xec\x9c\xdf\xc3\x7a\xd4\x38\xa1\x28\x5f\x29\x03\xbc\x86\xf8\x5b\xb8\x53\x79\x01\x32\x9f\x98\xaa\xb8\x71\x56\x74\xff\xf4\x08\x7b\x47\xde\x2e\x0a\x1d\xb5\xaa\x50\x34\x86\x7a\xc1\xda\xcf\x62\xaf\xff\x7d\x4c\x1d\xca\xd0\x80\xd7\xaa\x69\xe1\xde\x02\x15\x4c\xab\x23\x66\x6c\x3e\x16\x9d\x7b\x68\x73\x87\x5a\xad\x68\xd5\xb8\xd3\x63\x70\xc1\x3a\x83\xa2\x9e\x5b\x12\x74\x9e\x01\xeb\x98\x65\x35\x23\xb1\xfc\x15\xfa\x29\xa6\x63\x78\x0a\x3c\xa5\xab\xd5\x04\xd4\xf5\x72\xac\x3e\xeb\x55\x22\xe4\xdb\x8e\xbf\xe0\xd4\xae\x25\x75\x88\x63\x38\x8f\x18\xe7\x4c\xf8\xc2\x25\x91\x2f\x4c\x5d\x90\xa8\x0d\xfa\x92\xf9\x55\xa8\x32\xa8\xdb\x3b\x53\x42\xaf\x5e\x99\x36\x46\x2e\xb9\xa7\x39\x09\x31\x95\xe4\x99\x69\x61\xd3\x1a\x20\x71\xac\x63\x03\x3b\x4b\xca\xaa\x08\x4d\xc6\x84\x41\x88\xac\xca\x7e\x83\x9e\x4a\xad\x97\x12\x02\xfe\x47\x64\x99\x0e\x94\x9c\x1c\x3f\x84\xed\xcb\x78\x59\xe9\xe2\xe0\xec\xc0\x45\xf4\xa3\xf7\xce\x38\x01\xe3\x91\x59\x11\x3a\xf1\xd2\x82\x48\x80\xe7\x21\x3e\x26\x5a\xba\x03\x1e\xc6\xa4\xcc\xda\xd2\x63\x82\x0e\x4d\x5b\x78\xce\x60\x00\xd7\x75\x64\x1d\x0a\xf9\xe3\x50\xdb\x2c\x18\x96\x6b\x4e\x05\x99\x91\x8d\xd9\x49\x1e\x2c\xd1\xe1\x0a\x8d\x00\x1a\x13\x93\x75\xa1\xf1\x47\x05\x99\x26\x7f\xde\xaf\xe4\xef\x3f\xbf\xec\x74\x2c\x60\x12\x88\xc1\x1f\x35\xe4\x01\x5e\x0a\x5b\xfd\xf9\x6f\xe8\x99\x3e\x0f\xff\x4c\xf7\x5b\x02\xbf\x5c\x3d\xf5\x94\x73\x4e\xdf\x15\xea\x8c\x7b\xd8\x25\x1d\x24\x8b\x39\x77\xf0\x73\x28\x24\xf6\x69\xbb\xca\x85\x8f\x58\xb5\x3b\xf6\x45\xfb\xd9\x9e\xab\xca\xd1\xa2\x70\x01\xf6\x31\x56\xf4\x03\xbb\xde\x53\x65\x62\xb7\x99\x2a\x6b\x41\x05\xc0\x77\x0c\x47\xa8\xce\x52\x2b\x35\x68\x98\x21\x19\x30\x15\xff\xd9\xb1\x68\xdb\x45\x93\x22\xf3\x79\x88\x5c\xae\x3e\xef\x5e\x09\xee\x0f\xe8\xbc\xb2\xdd\x1d\xd4\x59\x0b\xcb\x60\x66\x02\x4c\xb5\x93\x84\xe9\x38\x21\x3b\x64\xa0\x8e\x83\x4c\xfb\x3c\xdf\x50\x75\xfd\x9e\x89\xa0\x0a\xd1\x52\x35\x74\xb0\xc9\x6c\xfe\x43\x69\x23\x80\xb5\xf3\x5a\x37\x12\xc1\x92\x89\x61\xef\x9d\xd1\x76\xb3\x28\xd3\x75\x7d\x4c\x82\x41\x3e\x19\x41\x1d\xc0\x74\x66\x9b\xcb\xac\xf5\x4c\x37\x9f\xbd\xb6\xd8\x8f\xd7\x25\xb1\x67\x0e\x16\x66\xbc\xcb\x43\x4c\x09\x8f\x37\x67\x65\x68\xdc\xae\xde\x4f\x34\x6d\x31\x7a\x4f\xc3\x61\x75\x18\x18\xa6\xd0\x32\xfd\x13\x60\x43\x61\x49\xe5\x0c\x4a\xb3\x86\xbe\xd9\xd4\x21\x8b\xec\x16\x24\xe5\x81\x7f\x59\x62\xd2\xee\x7a\xb1\x93\xeb\x73\xe2\x9d\x3c\x60\x41\xc9\xbc\x85\x7f\xff\x67\xf5\xdf\x01\x00\x56\xb1\xaf\xa1\x39\x11\x00\x00")
 
 func crdsEdgeFarosSh_registrationsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -401,7 +407,7 @@ func crdsEdgeFarosSh_registrationsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsKustomizationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd1\xb1\x4e\xc3\x40\x0c\x06\xe0\x3d\x4f\x61\xa9\x2b\x0d\x3b\x6f\xc0\xce\x8e\xdc\xbb\x3f\x89\xd5\xc4\x0e\xb6\x0f\x54\x9e\x1e\x11\x10\x2d\x43\xba\x9d\xf4\x7f\xf2\x7f\x77\x3e\xd0\xcb\x24\x41\xe7\x16\x69\x8b\x7c\x72\x8a\x69\x7f\xe1\x65\x26\x09\x52\x4b\x12\x4d\x68\x45\xa5\x34\x3a\x81\xbc\x29\x9d\x2e\x24\x19\x98\x87\x87\xee\x40\x21\x5a\x40\x92\x54\xb1\x42\x6b\x90\x29\x05\xfc\x5d\x0a\x48\x79\x01\xb1\xd6\xed\x10\x2b\x17\x50\x4e\x9c\xc4\x0e\xb2\x96\x64\x03\xe5\x6d\x3b\x68\xe5\x72\xe6\x11\x7d\x77\xa0\xe7\xa4\x98\xac\xcd\xf5\xa6\xb6\x98\x0e\x32\x3e\x56\x0c\xdc\xe6\xec\x1c\x61\xcd\x0b\xe2\xa9\x3b\x12\x97\x82\x88\x7e\x60\xb7\xe8\x63\x7a\x75\xbc\x35\x44\xc6\xf6\x9a\xee\x48\xa8\x23\xae\x29\x8f\xd0\xbd\xcc\x31\x4a\xa4\x6f\x7f\xf1\x47\xd6\xb9\x8d\xa2\x37\xf3\x7f\xfa\xb0\x0f\x8a\x69\xb2\x28\xdc\x9b\xa6\x2c\x77\xe4\x62\x2a\x69\x2e\x3a\xee\x1b\x45\x7e\x98\x9f\xef\x00\x4b\x19\xa4\xfc\xbf\x75\x42\x59\xcb\xe5\xaa\xb6\x19\xdf\x9b\xd8\x27\x2d\xe0\xbf\xe9\x57\x00\x00\x00\xff\xff\x23\xeb\x02\x2e\x1e\x02\x00\x00")
+var _crdsKustomizationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd2\x3d\x6e\xe4\x30\x0c\x05\xe0\xde\xa7\x20\x30\xed\x8e\xb7\xdf\x1b\x6c\xbf\xfd\x82\x23\x3d\xcb\xc4\x58\xa4\x23\x52\x09\x9c\xd3\x07\x71\x82\xcc\xa4\xf0\x20\x9d\x00\x7e\x78\x4f\x7f\x27\xfa\x37\x8b\xd3\xb5\x7b\x58\x95\x57\x0e\x31\x1d\x37\xae\x0b\x89\x93\x5a\x90\x68\x40\x33\x32\x85\xd1\x05\xd4\xba\xd2\x65\x23\x09\xc7\x32\xfd\x1a\x4e\xe4\xa2\x09\x24\x41\x19\x2b\x34\x3b\x99\x92\xa3\x3d\x4b\x02\x29\x57\x10\x6b\xde\x17\xbe\x72\x02\xc5\xcc\x41\xdc\x40\xd6\x83\x6c\xa2\xb8\x6f\x07\xad\x9c\xae\x5c\x30\x0e\x27\xfa\x1b\xe4\xb3\xf5\x25\xdf\xd5\x26\xd3\x49\xca\xef\x8c\x89\xfb\x12\x43\x83\x5b\x6f\x09\xfe\x67\x38\x13\xa7\x04\xf7\x71\xe2\x66\x3e\xfa\xfc\xbf\xe1\xa9\xc3\xc3\xf7\xd3\x0c\x67\x42\x2e\xb8\x4d\xb9\x40\x8f\x66\x0d\x45\x3c\xda\x7e\x17\x5f\x64\x5d\x7a\x11\xbd\xcb\xff\xe8\xc3\x31\x48\xa6\xc1\xa2\x68\xad\x6b\x48\x7d\x20\xab\xa9\x84\x35\xd1\x72\x6c\x14\xf1\x62\xed\xfa\x00\x58\xc8\x24\xe9\xfb\xae\x03\xca\x9a\xb6\x9b\xda\x33\xde\x5f\xe2\x98\x74\x47\xfb\x41\x40\xa0\xae\x0b\xc7\x83\x20\x6b\x85\xf5\xf3\x4f\x1d\xab\x00\x57\x1f\x37\xae\xcb\xf0\x36\x00\x78\x8c\x88\xd4\x8d\x02\x00\x00")
 
 func crdsKustomizationYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -421,7 +427,7 @@ func crdsKustomizationYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsPluginsFarosSh_accessesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcf\x6f\xe3\xba\x11\xbe\xfb\xaf\x18\xa0\x87\x6d\x81\x58\xde\xa0\x97\xc2\x40\x0f\x46\xda\x02\x41\xdf\x2b\x82\x4d\x76\xef\x23\x72\x6c\x71\x43\x91\x2a\x67\xe8\x5d\xb7\xe8\xff\x5e\x0c\x29\xd9\x8e\x63\x25\x41\x8b\xc7\x9b\x48\x6a\x7e\x7c\xdf\xcc\x37\x5c\x2e\x97\x0b\x1c\xdc\x37\x4a\xec\x62\x58\x03\x0e\x8e\x7e\x0a\x05\xfd\xe2\xe6\xf9\x4f\xdc\xb8\xb8\xda\xdf\x2e\x9e\x5d\xb0\x6b\xb8\xcb\x2c\xb1\xff\x42\x1c\x73\x32\xf4\x17\xda\xba\xe0\xc4\xc5\xb0\xe8\x49\xd0\xa2\xe0\x7a\x01\x80\x21\x44\x41\xdd\x66\xfd\x04\x30\x31\x48\x8a\xde\x53\x5a\xee\x28\x34\xcf\xb9\xa5\x36\x3b\x6f\x29\x15\xe3\x93\xeb\xfd\xe7\xe6\xf6\x73\xf3\x79\x01\x60\x12\x95\xff\x9f\x5c\x4f\x2c\xd8\x0f\x6b\x08\xd9\xfb\x05\x40\xc0\x9e\xd6\x80\xc6\x10\x33\x71\x33\xf8\xbc\x73\x81\x9b\x2d\xa6\xc8\x0d\x77\x0b\x1e\xc8\xa8\xd3\x5d\x8a\x79\x58\xc3\xab\xf3\x6a\x61\x8c\xab\xe6\xb4\x29\xc6\xca\x86\x77\x2c\x7f\x3f\xdb\xfc\xc5\xb1\x94\x83\xc1\xe7\x84\xfe\xe4\xb8\x6c\xb2\x0b\xbb\xec\x31\x4d\xdb\x0b\x00\x36\x71\xa0\x35\xfc\x43\x7d\x0c\x68\xc8\x2e\x00\xc6\xfc\x8a\xcf\x25\xa0\xb5\x05\x31\xf4\x0f\xc9\x05\xa1\x74\x17\x7d\xee\x27\xa4\x96\xf0\x9d\x63\x78\x40\xe9\xd6\xd0\x4c\x98\x36\xaf\xe0\x28\x77\x27\x30\x36\x3b\x1a\xbf\xe5\xa0\xce\x2d\x4a\xdd\xa8\xc7\xfb\x5b\xf4\x43\x87\xb7\x35\x64\xd3\x51\x8f\xeb\xf1\x7e\x1c\x28\x6c\x1e\xee\xbf\xfd\xf1\xf1\xc5\x36\x80\x25\x36\xc9\x0d\x52\x68\xa9\x50\x80\x63\x90\x8e\xa0\x5e\x85\x6d\x4c\xe5\xb3\x02\x0c\x9b\x87\xfb\xe3\xcf\x43\x8a\x03\x25\x71\x13\xca\x75\x9d\xd5\xd8\xd9\xee\x85\xab\x4f\x1a\x4d\xbd\x05\x56\x8b\x8b\xaa\xd3\x11\x42\xb2\x63\x02\x10\xb7\x20\x9d\x63\x48\x34\x24\x62\x0a\xb5\xdc\x5e\x18\x06\xbd\x84\x01\x62\xfb\x9d\x8c\x34\xf0\x48\x49\xcd\x00\x77\x31\x7b\xab\x35\xb9\xa7\x24\x90\xc8\xc4\x5d\x70\xff\x3a\xda\x66\x90\x58\x9c\x7a\x14\x1a\xe9\x3f\xad\x42\x59\x40\x0f\x7b\xf4\x99\x6e\x00\x83\x85\x1e\x0f\x90\x48\xbd\x40\x0e\x67\xf6\xca\x15\x6e\xe0\xd7\x98\x08\x5c\xd8\xc6\x35\x74\x22\x03\xaf\x57\xab\x9d\x93\xa9\xb7\x4c\xec\xfb\x1c\x9c\x1c\x56\xa5\x4d\x5c\x9b\x25\x26\x5e\x59\xda\x93\x5f\xb1\xdb\x2d\x31\x99\xce\x09\x19\xc9\x89\x56\x38\xb8\x65\x09\x3d\x94\xfe\x6a\x7a\xfb\xbb\x34\x76\x23\x7f\x7a\x11\x6b\x2d\x06\x96\xe4\xc2\xee\xec\xa0\xd4\xfc\x1b\x0c\x68\xf9\x2b\xd7\x38\xfe\x5a\xb3\x38\x01\xad\x5b\x8a\xce\x97\xbf\x3e\x3e\xc1\xe4\xba\x90\x71\x89\x7e\xc1\xfd\xf4\x23\x9f\x28\x50\xc0\x5c\xd8\x52\xaa\x24\x6e\x53\xec\x8b\x4d\x0a\x76\x88\x2e\x48\xf9\x30\xde\x51\xb8\x84\x9f\x73\xdb\x3b\x51\xde\xff\x99\x89\x45\xb9\x6a\xe0\xae\x08\x0e\xb4\x04\x79\xd0\xe2\xb7\x0d\xdc\x07\xb8\xc3\x9e\xfc\x1d\x32\xfd\xe6\x04\x28\xd2\xbc\x54\x60\x3f\x46\xc1\xb9\x56\x5e\x5e\xae\xa8\x9d\x1d\x4c\x82\x36\xc3\x57\x6d\xce\xc7\x81\xcc\x8b\x86\xb1\xc4\x2e\x69\x49\x0b\x0a\x69\x23\xd4\x3e\xfd\x90\x43\x41\xc9\xfc\xbe\xcb\x72\xed\x85\xd3\xd8\xb2\x12\xfc\xb6\xd7\xeb\xea\x00\x75\x4a\x54\x69\x7c\x75\x72\x11\xc0\x5d\x4e\x89\x82\xa8\x29\x8d\x44\x2b\xf2\xe8\x51\xe3\xd8\xec\x28\x48\xf3\xca\x86\x13\xea\xaf\x98\xbe\x34\x3e\x45\x71\x4c\xad\x68\x88\x66\x56\x34\xa6\xa8\xca\x54\xdc\x9a\x0a\x56\x35\xbf\x62\xb7\x62\x49\xaf\x23\x79\x0b\x85\xba\x3c\xb2\x3c\x25\x0c\xec\x26\xdd\xbf\x7e\xef\x22\xf8\x5f\x90\x05\xc4\xf5\x54\x3b\xe8\x98\x8a\x1c\x4d\x91\xad\xed\x16\x03\x8d\x4c\xcf\xd8\x05\x95\x41\x0c\x51\x3a\x4a\x0d\x3c\x69\x9f\x8e\xca\xd9\x12\xfc\xe8\x28\x14\x17\x39\x58\x4a\xfe\xa0\x14\x9c\xbc\x99\x0e\xc3\x8e\xec\xb5\xbc\xeb\xba\x57\x9e\x50\x54\x66\xb4\x71\x9f\x43\xfc\x11\x6e\xd4\x5e\x80\xcc\x93\xc0\x94\x34\x8e\x8e\x36\x0f\xf7\xb0\x75\xe4\xed\xac\xd1\xd1\x6b\xd1\x2e\x63\x68\x10\x6c\xfd\x55\xec\x75\x6d\x63\xea\x51\xea\xac\x5c\xaa\xa7\x99\x7b\x33\x1d\x7c\x5a\x3d\x31\xe3\xee\x63\xec\x6c\xa0\xcb\x3d\x06\x48\x84\x56\x83\x9b\x7e\x06\x17\xac\x33\x58\xa4\xd5\x92\xa0\xf3\x0c\xd8\xc6\x7c\x29\x7e\x67\x61\x29\x3e\x47\x4e\x47\x7a\x0a\x3c\x45\x5b\x5b\x02\xea\x07\x39\xcc\x65\xff\x6e\x56\x89\x90\x2f\x07\xf5\x4c\x52\x4f\x1d\x8d\xd7\x8f\x6f\x82\x63\x25\x7c\xe2\x52\xc8\x67\xa1\xce\x66\xe4\x5e\x08\xb6\x1a\x55\xe1\x73\x5b\x67\x0a\xf5\x9a\x95\xe9\x62\xe4\x52\x12\x5a\x93\x10\x53\x29\x9e\x2b\x93\xe7\xb4\x2a\x24\x8e\x35\x24\x76\x96\x54\x12\x11\x76\x19\x13\x06\x21\xb2\x6a\xfb\x15\x7a\x75\x96\xcc\xda\xfc\xff\x90\x65\xda\x53\x72\x72\xf8\x10\xb6\x8f\xe3\x65\x95\x8b\xbd\xb3\x55\x8b\xe8\xe7\xe0\x9d\x71\x02\xc6\x23\xb3\x22\x34\xe9\xd2\x6c\xc8\x5f\x2a\x3f\x26\x5a\xba\x01\xae\xaf\x9b\xcc\x3a\x89\x63\x82\x1e\x4d\x57\x74\xce\x60\x00\xd7\xf7\x64\x1d\x0a\xf9\x43\xed\x6d\x16\x0c\xf3\x3d\x57\xd8\x1e\xd5\x98\x9d\xe4\x1a\x89\xbe\x89\xd0\x88\xb6\x61\x4c\xd6\x85\x9d\x3f\x54\x4a\x8f\xf9\xbc\xdd\xc9\xbf\x7e\x7d\x7c\xd2\x1a\x66\x12\x88\xc1\x1f\xaa\x0a\xd4\x81\xf3\xe7\xbf\xa1\xe7\xd9\xb6\x7e\x1f\xfe\x2b\xc3\x6d\x0e\xfc\x3a\xe0\xc6\x99\x72\xac\xe9\x9b\x22\x9d\x71\x0b\x4f\x49\xdf\x7f\x25\x9c\x1b\xf8\x1a\x8a\x88\xfd\xcf\x71\x95\x0b\x1f\x6a\xb7\xc3\x50\xbc\x9f\xd4\xf6\xbc\x73\x94\x4f\xa7\x8d\x18\x1b\xfa\x89\xfd\xe0\xa9\x31\xb1\x5f\x9d\x3a\x6b\x1e\x73\x0c\x07\x68\x8e\x56\x1b\x0d\x68\x7c\xc0\x02\x26\xaa\x0d\xc4\xa2\x44\xa3\x49\x91\xf9\xf8\xf6\x9b\xef\x3e\xef\x9e\x09\x36\x7b\x74\x5e\xd5\xee\x06\xda\xac\x8d\x65\x30\x33\x01\xa6\xd6\x49\xc2\x74\x38\x1b\xfb\xa5\x02\xdb\x52\x9a\xdb\x7c\x7d\xa0\xea\xfa\x3d\x13\x41\x13\xa2\xa5\xa6\xd2\x79\x0a\x9b\xff\x50\xc6\x08\x60\xeb\xbc\xd6\x99\x44\xb0\x64\x62\xd8\x7a\x67\x74\xdc\xcc\xcb\x4f\x3f\xc4\x24\x78\xed\xd9\x70\x46\xd0\x3c\x83\xfa\x1e\xd5\x07\xd7\x35\x0e\x97\x57\xa6\xf9\xd5\x6b\xb3\xf3\x78\x59\xdc\x5f\x39\x98\x79\xc2\x9d\x1f\x62\x4a\x78\x78\xff\xdd\xf7\x6a\xb3\x3e\xe5\xd6\x20\x29\x57\xcf\x2c\x31\xe9\xa4\x3b\xdb\xc9\xed\xb1\x08\xa6\xc4\xc7\x06\x83\x7f\xff\x67\xf1\xdf\x00\x00\x00\xff\xff\xb1\xb8\xdf\x9e\x5a\x10\x00\x00")
+var _crdsPluginsFarosSh_accessesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\xdb\x6e\x24\xb9\xcd\xbe\xef\xa7\x20\xf0\x5f\xcc\x1f\xc0\x5d\x1e\x67\x30\x41\xd0\x40\x2e\x0c\xef\x2e\x62\x64\x67\x61\x8c\x3d\x7b\xcf\x2a\xb1\xbb\xb4\x56\x49\x15\x91\x6a\x4f\x27\xc8\xbb\x07\x54\x1d\xfa\x54\x65\x77\x1c\x6c\xa6\x7c\x31\x2d\x51\x3c\x7c\x24\x3f\x1d\x96\xcb\xe5\x02\x5b\xfb\x2b\x45\xb6\xc1\xaf\x00\x5b\x4b\xdf\x85\xbc\xfe\xe2\xe2\xf9\xcf\x5c\xd8\x70\xbd\xbd\x59\x3c\x5b\x6f\x56\x70\x97\x58\x42\xf3\x95\x38\xa4\x58\xd1\x0f\xb4\xb6\xde\x8a\x0d\x7e\xd1\x90\xa0\x41\xc1\xd5\x02\x00\xbd\x0f\x82\x3a\xcc\xfa\x13\xa0\x0a\x5e\x62\x70\x8e\xe2\x72\x43\xbe\x78\x4e\x25\x95\xc9\x3a\x43\x31\x2b\x1f\x4c\x6f\x3f\x16\x37\x1f\x8b\x8f\x0b\x80\x2a\x52\x5e\xff\x64\x1b\x62\xc1\xa6\x5d\x81\x4f\xce\x2d\x00\x3c\x36\xb4\x02\xac\x2a\x62\x26\x2e\x5a\x97\x36\xd6\x73\xb1\xc6\x18\xb8\xe0\x7a\xc1\x2d\x55\x6a\x74\x13\x43\x6a\x57\x70\x36\xdf\x69\xe8\xfd\xea\x62\xba\xcd\xca\xf2\x80\xb3\x2c\x7f\x3b\x18\xfc\xd9\xb2\xe4\x89\xd6\xa5\x88\x6e\x6f\x38\x0f\xb2\xf5\x9b\xe4\x30\x0e\xc3\x0b\x00\xae\x42\x4b\x2b\xf8\x05\x1b\xe2\x16\x2b\x32\x0b\x80\x3e\xbe\x6c\x73\x09\x68\x4c\x46\x0c\xdd\x43\xb4\x5e\x28\xde\x05\x97\x9a\x01\xa9\x25\xfc\xc6\xc1\x3f\xa0\xd4\x2b\x28\x06\x4c\x8b\x33\x38\xb2\xf9\x01\x8c\xdb\x0d\xf5\xbf\x65\xa7\xc6\x0d\x4a\x37\xd0\x4d\x6f\x6f\xd0\xb5\x35\xde\xe4\x21\xae\x6a\x6a\x72\x92\xf4\x57\x68\xc9\xdf\x3e\xdc\xff\xfa\xe9\xf1\x68\x18\xc0\x10\x57\xd1\xb6\x6a\x73\x80\x02\x2c\x83\xd4\x04\x9d\x28\xac\x43\xcc\x3f\x3b\x80\xe1\xf6\xe1\x7e\x5c\xdc\xc6\xd0\x52\x14\x3b\xa0\xdc\x7d\x07\x35\x76\x30\x7a\x62\xea\x83\x7a\xd3\x49\x81\xd1\xe2\xa2\xce\x68\x0f\x21\x99\x3e\x00\x08\x6b\x90\xda\x32\x44\x6a\x23\x31\xf9\xae\xdc\x8e\x14\x83\x0a\xa1\x87\x50\xfe\x46\x95\x14\xf0\x48\x51\xd5\x00\xd7\x21\x39\xa3\x35\xb9\xa5\x28\x10\xa9\x0a\x1b\x6f\xff\x31\xea\x66\x90\x90\x8d\x3a\x14\xea\xd3\xbf\xff\x72\xca\x3c\x3a\xd8\xa2\x4b\x74\x05\xe8\x0d\x34\xb8\x83\x48\x6a\x05\x92\x3f\xd0\x97\x45\xb8\x80\x2f\x21\x12\x58\xbf\x0e\x2b\xa8\x45\x5a\x5e\x5d\x5f\x6f\xac\x0c\xbd\x55\x85\xa6\x49\xde\xca\xee\x3a\xb7\x89\x2d\x93\x84\xc8\xd7\x86\xb6\xe4\xae\xd9\x6e\x96\x18\xab\xda\x0a\x55\x92\x22\x5d\x63\x6b\x97\xd9\x75\xaf\x01\x73\xd1\x98\xff\x8b\x7d\x37\xf2\x87\x23\x5f\xbb\x62\x60\x89\xd6\x6f\x0e\x26\x72\xcd\xbf\x92\x01\x2d\x7f\xcd\x35\xf6\x4b\xbb\x40\xf7\x40\xeb\x90\xa2\xf3\xf5\xc7\xc7\x27\x18\x4c\xe7\x64\x1c\x29\x85\x1e\xf7\xfd\x42\xde\xa7\x40\x01\xb3\x7e\x4d\x5a\x42\x96\x61\x1d\x43\x93\x11\x27\x6f\xda\x60\xbd\xe4\x1f\x95\xb3\xe4\x4f\xe1\xe7\x54\x36\x56\x34\xef\x7f\x4f\xc4\xa2\xb9\x2a\xe0\x2e\x13\x0e\x94\x04\xa9\xd5\xe2\x37\x05\xdc\x7b\xb8\xc3\x86\xdc\x1d\x32\xfd\xee\x09\x50\xa4\x79\xa9\xc0\x5e\x96\x82\x43\xae\xdc\xff\x53\x2d\xab\x1e\xb5\x83\x89\x81\xd0\x66\xf2\xd5\x35\xe7\x63\x4b\xd5\x51\xc3\x18\x62\x1b\xb5\xa4\x05\x85\xb4\x11\xba\x3e\x3d\xd2\x33\xdd\xa7\xfa\xe1\x86\xbc\x9c\x0e\x9e\x1a\x56\x99\x81\x14\x94\x69\xd4\x8a\xfe\x3f\x2f\xbe\x02\xeb\xc7\x99\xcc\x84\xfd\xf4\x99\x52\xe8\x5d\xbb\xea\x49\x54\x55\xb6\x31\x6c\xad\x21\x03\x12\xce\xe4\x67\x30\xd5\xbf\x36\x44\xf9\x29\xc4\x17\x8c\x86\xdf\xf0\xfe\xe1\x40\x14\x30\x52\xf6\x55\x30\x6e\x28\x17\x17\x56\x35\x96\x8e\xf6\x95\x99\x83\x82\xc4\x14\xf9\x4c\x31\x40\x85\x5e\x09\x51\xb5\x81\x0b\x15\xba\xec\x0a\x4f\xb9\x6f\x85\x9a\x09\xe7\xe6\xdd\x3b\x4a\x2d\xc2\xd3\xdd\x43\xef\xe7\x84\x9b\x13\x6a\xfb\x64\x4e\xcc\xcc\xa7\xbf\x5f\xe7\x5c\x78\x21\xf3\x4d\x63\x9e\x96\x38\x71\xfa\xf6\x60\xc1\x88\x29\x35\x68\x1d\x0f\xb5\xf1\x12\xe2\x73\x57\x0d\x0d\x35\xe5\x34\x98\x47\xd6\x95\x8b\x07\x64\x7b\x5a\xee\xa2\x2f\xe0\xd6\xb9\x73\x7d\xd9\x6e\xbf\x76\x56\xb7\x5d\x03\x35\xad\xec\x8a\x19\x89\xd9\x1c\xbd\x59\x81\xc3\xd7\x89\x60\x8c\xb8\x9b\x94\xa8\x03\xcb\x45\x98\xfe\x35\xf0\xd8\x64\xba\xe8\xa0\x18\x8d\x45\xc7\x57\x60\x0b\x2a\xba\xa2\xd3\xf9\xc5\x3b\x5d\xd6\x06\xbe\xc8\xa1\x5f\x0e\x3a\x5d\x8b\x7c\xc8\xce\x7b\x0d\xab\x8e\x8b\x0c\x6b\xc7\x0e\x48\xe8\xa2\x53\x24\x66\x74\xac\x43\x6c\x50\x56\x60\xbd\x7c\xfa\xe3\x8c\x4c\x83\xdf\x6d\x93\x9a\x15\xfc\xe9\xf3\xe7\x4f\x9f\xe7\x84\xac\xef\x84\x6e\x66\x04\xba\x9c\xeb\xf9\x60\x43\x71\x42\x46\xb7\x2c\xe5\xe4\xa9\x68\x97\x30\x93\xbc\x65\x66\x92\x89\x89\x99\xdd\xe2\xad\xf2\xe3\x9a\x9c\x5b\x2d\x5e\x45\xfa\x51\x65\xf4\x7c\xb4\xb6\x9b\x14\x49\xf9\xb0\x09\x42\xdd\x5a\x60\x62\x3d\x9e\x8d\xa7\xa4\x4c\x2f\x05\x7c\xcd\x32\x67\x8a\x7b\x8b\x9a\x38\x63\x59\xc9\xca\x40\xf2\x4e\x79\x9e\x7c\xf7\xb3\xdc\x01\xfa\xe1\x90\xd9\x57\x56\x56\x3a\x6c\x21\x63\x93\x17\x8b\xff\x8c\xc3\xde\x66\xb0\xdf\x8b\xbf\x7a\xcb\x8a\x11\x0b\x46\x19\x51\xbb\x80\xb6\xde\xa0\xa7\x57\xc9\xe9\xcd\x76\x7b\x9d\x98\xf4\x24\x84\xa7\xc7\xc3\x49\xac\xee\x3a\x49\xcd\x6b\x0e\x90\x8c\x12\x81\x76\x19\x45\xac\xc4\x6e\x69\x5f\x28\x2f\x56\xea\x90\x04\x70\x52\xed\x68\xb5\x80\x1f\x68\x8d\xc9\xe5\x43\x1d\x5c\x97\xd6\x5f\x73\xfd\xbf\xc7\xa0\x2f\xcb\x0b\x30\xf8\xb1\x2f\xe0\x9c\xb7\x4b\xba\x64\x52\x65\xe7\x4d\x19\x82\x23\x3c\xbd\xc0\xe8\x67\x8d\x23\xbd\xf7\x85\x24\x17\xf8\x74\xbf\x97\x86\xca\x05\x26\x3e\xcf\x83\xf5\x6d\x92\xf1\x02\x67\x52\xcc\x77\xa7\x69\xa4\xe1\x28\x29\x37\x9f\x9b\x62\xf1\x0e\xcc\x7b\x17\x2e\x0f\xe3\xf1\x68\xc1\x40\xfb\x3d\x4f\x8f\x2e\x6b\x57\xe2\x10\xdf\xbe\x7c\x26\xd5\x83\x16\xd5\x4d\xfd\x0e\xf7\x67\x99\x76\x66\x82\x05\x25\x9d\x54\xe7\xd4\x91\x3d\x8b\x1d\x1d\xda\x43\xc9\x7a\x47\x7d\xef\xa9\xbd\x0a\xbe\x7b\x5a\x38\x9b\x39\x71\xe0\x2e\xc5\xa8\xfc\xda\xc6\xa0\x97\x07\xbd\xd1\x8d\x16\x15\xe7\x5b\x2d\xd6\x62\x71\x71\xd7\x1d\x2b\x1f\xbc\xd8\x1f\x5a\xf5\x0e\xae\x91\x1d\x24\xad\xbf\x1c\x6a\x28\x79\x14\xdd\x84\x5e\xe8\x80\x98\xca\xd9\xeb\xc4\x0f\xe0\x90\xe5\x29\xa2\xe7\x0c\x88\xd6\xd1\xb4\xdc\x89\xf3\x3f\xa3\x1e\xb1\x6c\xd3\x71\xff\x08\x28\xc8\xa8\x4a\x89\x4e\x4f\xdb\xc1\x53\xf6\x2e\xcd\x95\x5b\x2e\x38\xf4\x41\x6a\x8a\x05\x3c\xd5\x76\x7c\x79\x28\x09\x5e\x6a\xea\xf6\xb6\xe4\x0d\x45\xb7\xd3\x14\xec\xad\x55\x35\xfa\x0d\x99\xa9\xb8\xbb\xef\x5e\x77\x23\xcc\x07\x43\xbd\xf8\x3e\xfb\xf0\xe2\xaf\x54\x9f\x87\xc4\xc3\x05\x5d\x6c\x73\x60\xe8\xf6\xe1\x1e\xd6\x96\xdc\xdc\x41\x0d\x06\xab\xaa\x54\x6f\x62\xad\x28\xbb\x15\x6f\x1c\xab\xf4\xba\xbd\x54\x4b\x33\x72\x6f\x10\x83\xde\x85\x99\x71\x73\x59\x76\x6e\xa1\x4e\x0d\x7a\xbd\xf8\x18\x75\x6e\x58\x0c\xd6\x1b\x5b\xa1\x68\xe4\x86\x24\x6f\xd8\x58\x86\x24\x8b\x09\x8d\xf9\x4f\xa1\xdf\xe7\xb4\x4f\x4f\x86\x27\x3f\xe6\x94\xf4\xfa\x05\xe1\xcd\xa8\x22\x21\x9f\x3e\x74\xcd\x04\xf5\x54\x93\x06\xc4\xc1\x8f\x94\x3c\x56\xc2\x07\xce\x85\x7c\xe0\xea\x8c\x46\x7d\x97\x3a\x7c\xf0\x50\xa5\xfa\x70\x60\xd7\xb6\xd2\xb7\xb9\x1c\x55\x55\x87\xc0\xb9\x24\xb4\x26\x21\xc4\x5c\x3c\x13\x2f\x37\xfb\xaf\x83\xc4\xb2\x9e\x06\xd9\x1a\xd2\x27\x05\x84\x4d\xc2\x88\x5e\x88\x8c\xea\x3e\x43\x4f\xb5\x96\x73\x05\x01\xff\x25\xb2\x4c\x5b\x8a\x56\x76\x17\x61\xfb\xd8\x0b\x0f\x0f\x0a\x99\x8b\xe8\x7b\xeb\x6c\x65\x05\x2a\x87\xcc\x8a\xd0\xc0\x4b\x33\x2a\x01\xbe\x76\xf9\xa9\x82\xa1\x2b\xe0\xee\x1a\x9a\xdf\x02\x14\xc4\x06\xab\x3a\xf3\x9c\xbe\x02\xd8\xa6\x21\x63\x51\xc8\xed\xba\xde\x66\x41\x3f\xdf\x73\xaa\xa8\xea\xd9\x98\xad\xa4\xce\x13\x7d\x53\xc4\x4a\xf4\x41\x24\x44\x63\xfd\xc6\xed\x14\x64\xda\xc7\xf3\x7a\x27\x7f\xf9\xf6\xf8\xa4\xaf\x61\x4c\x02\xc1\xbb\x9d\xa6\xdc\xc3\x63\x66\xab\xbf\xfc\x84\x8e\xe9\xfd\xf0\x4f\x6c\x6e\x73\xe0\x67\xd1\x61\x4f\x19\x6b\xfa\x2a\x53\x67\x58\xc3\x53\xd4\xf7\xd3\xec\xce\x15\x7c\xf3\x99\xc4\xde\xed\x57\x16\xb8\xc4\xab\xa7\x5d\x9b\xad\x8f\xfe\x1c\x75\x8e\xe6\xd3\x7a\x58\x87\x50\xd0\x77\x6c\x5a\x47\x45\x15\x9a\xeb\x7d\x67\xcd\x98\x00\xf8\x82\x7e\x07\xc5\xa8\xb5\x50\x87\xba\xa7\xd3\xee\x70\x9f\x1b\x88\x45\xb7\x5d\xac\x62\x60\x1e\xdf\x4e\xe7\xbb\xcf\xd9\x67\x82\xdb\x2d\x5a\xa7\x6c\x77\x05\x65\xd2\xc6\xaa\x30\x31\x01\xc6\xd2\x4a\xc4\xb8\xdb\x23\xcb\xf9\x1d\x4a\x5f\x41\x99\xd6\x69\x7a\x43\xd5\xef\xff\x99\x08\x0a\x1f\x0c\x15\xdd\x0e\xb6\x77\x9b\xff\x90\xb7\x11\xc0\xd2\x3a\xed\x1b\x09\x60\x48\x6f\x82\xce\x56\xba\xdd\xcc\xea\xb4\x8d\xde\x53\xd1\xcb\x3b\x33\xf8\xfa\xe5\xf8\x7c\x37\x9f\x14\x9b\xdd\x8f\x97\xb9\xb0\x27\x26\x66\xcf\x76\xaf\xdd\x13\x26\x17\x9d\x0d\xea\x81\x87\xcc\x0a\x24\xa6\x8e\x0b\x59\x42\xd4\x9d\xee\x60\x24\x95\x63\x11\x0c\x81\xb3\xa0\x24\x5e\xc1\x3f\xff\xb5\xf8\xf7\x00\x53\xa7\x8a\xd0\x9a\x1b\x00\x00")
 
 func crdsPluginsFarosSh_accessesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -441,7 +447,7 @@ func crdsPluginsFarosSh_accessesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsPluginsFarosSh_containerruntimesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x8f\xe3\xb8\x11\xbd\xfb\x57\x14\x90\xc3\x24\x40\x5b\x9e\x41\x2e\x81\x81\x1c\x1a\x9d\x04\x68\x64\x37\x68\x4c\xf7\xee\xbd\x44\x96\x2d\x6e\x53\xa4\xc2\x2a\x7a\xc7\x09\xf2\xdf\x83\x22\xe5\x8f\xb6\xa5\xde\x4e\x82\x60\x2f\xd6\x4d\x22\x55\x1f\xef\xd5\xab\xaa\xe5\x72\xb9\xc0\xc1\xfd\x48\x89\x5d\x0c\x6b\xc0\xc1\xd1\x37\xa1\xa0\x6f\xdc\xbc\xfe\x81\x1b\x17\x57\xbb\x2f\x8b\x57\x17\xec\x1a\x1e\x32\x4b\xec\xbf\x12\xc7\x9c\x0c\xfd\x89\x36\x2e\x38\x71\x31\x2c\x7a\x12\xb4\x28\xb8\x5e\x00\x60\x08\x51\x50\x3f\xb3\xbe\x02\x98\x18\x24\x45\xef\x29\x2d\xb7\x14\x9a\xd7\xdc\x52\x9b\x9d\xb7\x94\x8a\xf1\x83\xeb\xdd\xe7\xe6\xcb\xe7\xe6\xf3\x02\xc0\x24\x2a\xff\xbf\xb8\x9e\x58\xb0\x1f\xd6\x10\xb2\xf7\x0b\x80\x80\x3d\xad\x8b\x41\x74\x81\x52\xca\x41\xf4\x4e\x33\xf8\xbc\x75\x81\x9b\x0d\xa6\xc8\x0d\x77\x0b\x1e\xc8\xa8\xf7\x6d\x8a\x79\x58\xc3\xd5\x79\x35\x35\x06\x38\x26\x77\xb0\xfa\xb5\x5a\x2d\x47\xde\xb1\xfc\x75\xf2\xf8\x3b\xc7\x52\xae\x0c\x3e\x27\xf4\x13\x51\x95\x53\x76\x61\x9b\x3d\xa6\xeb\xf3\x05\x00\x9b\x38\xd0\x1a\xfe\xa6\xa1\x0c\x68\xc8\x2e\x00\x46\x3c\x4a\x68\x4b\x40\x6b\x0b\xc2\xe8\x9f\x92\x0b\x42\xe9\x21\xfa\xdc\x1f\x90\x5d\xc2\x4f\x1c\xc3\x13\x4a\xb7\x86\xe6\xc0\x41\x73\x05\x5f\xb9\x7b\x00\xef\x7e\x4b\xe3\xbb\xec\xd5\xb9\x45\xa9\x1f\xea\xf1\xee\x0b\xfa\xa1\xc3\x2f\x35\x78\xd3\x51\x8f\xeb\xf1\x7e\x1c\x28\xdc\x3f\x3d\xfe\xf8\xfb\xe7\x37\x9f\x01\x2c\xb1\x49\x6e\x90\x42\xe3\x25\x4e\xe0\x18\xa4\x23\xa8\x3f\xc1\x26\xa6\xf2\x5a\x19\x81\xfb\xa7\xc7\xa3\x99\x21\xc5\x81\x92\xb8\x03\x2d\xf5\x39\xab\xce\xb3\xaf\x17\x4e\x3f\x69\x5c\xf5\x16\x58\x2d\x4b\xaa\x4e\x47\x30\xc9\x8e\xa9\x40\xdc\x80\x74\x8e\x21\xd1\x90\x88\x29\xd4\x42\x7d\x63\x18\xf4\x12\x06\x88\xed\x4f\x64\xa4\x81\x67\x4a\x6a\x06\xb8\x8b\xd9\x5b\xa5\x71\x47\x49\x20\x91\x89\xdb\xe0\xfe\x71\xb4\xcd\x20\xb1\x38\xf5\x28\x34\xd6\xc6\xe9\x29\xe4\x05\xf4\xb0\x43\x9f\xe9\x0e\x30\x58\xe8\x71\x0f\x89\xd4\x0b\xe4\x70\x66\xaf\x5c\xe1\x06\xbe\x8f\x89\xc0\x85\x4d\x5c\x43\x27\x32\xf0\x7a\xb5\xda\x3a\x39\xa8\xd2\xc4\xbe\xcf\xc1\xc9\x7e\x55\x04\xe6\xda\x2c\x31\xf1\xca\xd2\x8e\xfc\x8a\xdd\x76\x89\xc9\x74\x4e\xc8\x48\x4e\xb4\xc2\xc1\x2d\x4b\xe8\xa1\x28\xb3\xe9\xed\x6f\xd2\xa8\x63\xfe\xf4\x26\xd6\x5a\x16\x2c\xc9\x85\xed\xd9\x41\x11\xc9\x3b\x0c\xa8\x4a\x94\x6b\x1c\x7f\xad\x59\x9c\x80\xd6\x4f\x8a\xce\xd7\x3f\x3f\xbf\xc0\xc1\x75\x21\xe3\x12\xfd\x82\xfb\xe9\x47\x3e\x51\xa0\x80\xb9\xb0\xa1\x54\x49\xdc\xa4\xd8\x17\x9b\x14\xec\x10\x5d\x90\xf2\x62\xbc\xa3\x70\x09\x3f\xe7\xb6\x77\xa2\xbc\xff\x3d\x13\x8b\x72\xd5\xc0\x43\x69\x55\xd0\x12\xe4\x41\x65\x60\x1b\x78\x0c\xf0\x80\x3d\xf9\x07\x64\xfa\xbf\x13\xa0\x48\xf3\x52\x81\xfd\x18\x05\xe7\x5d\xf6\xf2\x72\x45\xed\xec\xe0\xd0\x01\x67\xf8\xba\x94\xe9\xb3\xa0\x64\x7e\x23\x9e\xd8\xb2\xe2\x6e\x81\x05\x85\x54\x15\x55\xb4\x6f\x8c\x4e\x8b\x16\x6a\xdb\xaf\xbd\xeb\xea\xe4\x32\x94\x9c\x12\x05\x51\x53\x86\x58\x1b\xe6\xc9\xa3\xc6\x71\xbf\xa5\x20\xcd\x95\x0d\x27\xd4\x4f\x98\xbe\xce\xb3\x46\x71\x4c\xad\x48\x5b\x33\x2b\xd2\x2f\x62\x3f\xd4\x9c\xa6\x82\xb5\xdd\x4e\xd8\x85\x1a\xd6\x75\x24\xef\xa1\x50\x1f\x8f\x2c\x2f\x09\x03\xbb\x43\x63\x9e\xbe\x77\x11\xfc\x77\xc8\x02\xa5\x89\x96\xc2\x3e\xa6\x22\x47\x53\x64\xab\x0a\x62\xa0\x12\x5d\xbe\x54\xd3\xe9\x91\x08\x18\xa2\x74\x94\x1a\x78\x51\xf9\x8c\x0d\xad\x25\xf8\xb9\xa3\x50\x5c\xe4\x60\x29\xf9\xbd\x52\x70\xf2\x66\x3a\x0c\x5b\xb2\x53\x79\xd7\xe7\x51\x79\x42\x51\xf5\xab\x9e\x5e\x43\xfc\x39\xdc\xa9\xbd\x00\x99\x0f\xba\x2f\x69\x1c\x1d\xdd\x3f\x3d\xc2\xc6\x91\xb7\xb3\x46\x47\xaf\xa5\xa5\x18\x43\x83\x60\xeb\x27\xb1\xd7\x67\x13\x53\x8f\x52\x87\xd9\xf2\x38\xbc\x27\x30\x98\x16\xd6\xe9\xe9\x89\x19\xb7\x1f\x63\xe7\x1e\xba\xdc\x63\x80\x44\x68\x35\xb8\xc3\xcf\xe0\x82\x75\x06\x4b\xc7\xb3\x24\xe8\x3c\x03\xb6\x31\x5f\xf6\xa4\xb3\xb0\x14\x9f\x23\xa7\x23\x3d\x05\x9e\xd2\xf2\x5a\x02\xea\x07\xd9\xcf\x65\xff\x8b\x59\x25\x42\xbe\x9c\x9f\x33\x49\xbd\x74\x34\x5e\x3f\x8e\xea\x63\x25\x7c\xe2\x52\xc8\x67\xa1\xce\x66\xe4\xde\xf4\x51\x35\xaa\xfd\xc8\x6d\x9c\x29\xd4\x6b\x56\xa6\x8b\x91\x4b\x49\x68\x4d\x42\x4c\xa5\x78\x26\x06\xc2\xe9\xa9\x90\x38\xd6\x90\xd8\x59\x4a\x64\x01\x61\x9b\x31\x61\x10\x22\xab\xb6\xaf\xd0\xab\x2d\x7e\xd6\xe6\xff\x86\x2c\xd3\x8e\x92\x93\xfd\x87\xb0\x7d\x1e\x2f\x6b\xbb\xd8\x39\x5b\x7b\x11\x7d\x1b\xbc\x33\x4e\xc0\x78\x64\x56\x84\x0e\x7d\x69\x36\xe4\xaf\x95\x1f\x13\x2d\xdd\x01\xd7\xa5\x23\xb3\x0e\xc8\x98\xa0\x47\xd3\x95\x3e\x67\x30\x80\xeb\x7b\xb2\x0e\x85\xfc\xbe\x6a\x9b\x05\xc3\xbc\xe6\x0a\xdb\x63\x37\x66\x27\xb9\x46\xa2\xab\x0a\x1a\x51\x19\xc6\x64\x5d\xd8\xfa\x7d\xa5\xf4\x98\xcf\xfb\x4a\xfe\xfe\x87\xe7\x17\xad\x61\x26\x81\x18\xfc\xbe\x76\x81\x3a\x70\xfe\xf8\x17\xf4\x3c\x2b\xeb\x5f\x86\xbf\x18\xf9\x18\xf8\x75\xc0\x8d\x33\xe5\x58\xd3\x77\xa5\x75\xc6\x0d\xbc\x24\x5d\xcb\x4a\x38\x77\xf0\x43\x28\x4d\xec\xbf\x8e\xab\x5c\xf8\x90\xdc\xf6\x43\xf1\x7e\xea\xb6\xe7\xca\x51\x3e\x9d\x0a\x31\x36\xf4\x0d\xfb\xc1\x53\x63\x62\xbf\x3a\x29\x6b\x1e\x73\x0c\x7b\x68\x8e\x56\x1b\x0d\x68\xdc\x2b\x01\x13\x55\x01\xb1\x28\xd1\x68\x52\x64\x3e\xae\x64\xf3\xea\xf3\xee\x95\xe0\x7e\x87\xce\x6b\xb7\xbb\x83\x36\xab\xb0\x0c\x66\x26\xc0\xd4\x3a\x49\x98\xf6\x67\x63\xbf\x54\x60\x5b\x4a\x73\x93\xa7\x07\xaa\x3e\xbf\x65\x22\x68\x42\xb4\xd4\x54\x3a\x4f\x61\xf3\xef\xca\x18\x01\x6c\x9d\xd7\x3a\x93\x08\x96\x4c\x0c\x1b\xef\x8c\x8e\x9b\xf9\xf6\xd3\x0f\x31\x09\x4e\xad\x0d\x67\x04\xcd\x33\xa8\x6b\xa2\x4b\x64\xa7\x38\x5c\x4e\x4c\xf3\xc9\x6b\xb3\xf3\x78\x59\xdc\x4f\x1c\xcc\xac\x72\xe7\x87\x98\x12\xee\x3f\xb4\xff\x4d\x48\xe3\xb6\x01\xde\x36\xc0\xdb\x06\x78\xdb\x00\xdf\x4d\xea\xb6\x01\xfe\x47\xc8\xde\x36\xc0\xdb\x06\x38\x77\xe1\xb6\x01\xde\x36\xc0\x5f\x6b\x03\xbc\xfa\x58\x57\xb9\x35\x48\xca\xd5\x33\x4b\x4c\x3a\xe9\xce\xbe\xe4\xf6\x58\x04\x87\xc4\x47\x81\xc1\x3f\xff\xb5\xf8\x77\x00\x00\x00\xff\xff\x85\xee\xb3\x97\x2d\x1a\x00\x00")
+var _crdsPluginsFarosSh_containerruntimesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4b\x6f\x23\x37\x12\xbe\xeb\x57\x14\xb0\x87\xd9\x05\xac\xd6\x18\x7b\x59\x08\xd8\x83\xe1\x24\x80\x91\x99\xc0\xb0\x35\x73\xaf\x26\x4b\x6a\x8e\xd9\x64\x87\x55\xd4\x8c\x12\xe4\xbf\x07\x45\xb6\x1e\xb6\xa4\x81\xe1\x20\x97\x40\xa2\x2e\xcd\xc7\x57\x55\x5f\x3d\xa7\xd3\xe9\x04\x07\xf7\x99\x12\xbb\x18\xe6\x80\x83\xa3\x6f\x42\x41\xbf\xb8\x79\xfa\x1f\x37\x2e\xce\xd6\xd7\x93\x27\x17\xec\x1c\x6e\x33\x4b\xec\x1f\x88\x63\x4e\x86\x7e\xa0\xa5\x0b\x4e\x5c\x0c\x93\x9e\x04\x2d\x0a\xce\x27\x00\x18\x42\x14\xd4\x6d\xd6\x4f\x00\x13\x83\xa4\xe8\x3d\xa5\xe9\x8a\x42\xf3\x94\x5b\x6a\xb3\xf3\x96\x52\x01\xdf\x8a\x5e\xbf\x6f\xae\xdf\x37\xef\x27\x00\x26\x51\x79\xbf\x70\x3d\xb1\x60\x3f\xcc\x21\x64\xef\x27\x00\x01\x7b\x9a\x17\x40\x74\x81\x52\xca\x41\xf4\x4e\x33\xf8\xbc\x72\x81\x9b\x25\xa6\xc8\x0d\x77\x13\x1e\xc8\xa8\xf4\x55\x8a\x79\x98\xc3\xd1\x79\x85\x1a\x15\x1c\x8d\xdb\xa2\x3e\x54\xd4\x72\xe4\x1d\xcb\xcf\x27\x8f\x3f\x38\x96\x72\x65\xf0\x39\xa1\x3f\xa1\x55\x39\x65\x17\x56\xd9\x63\x3a\x3e\x9f\x00\xb0\x89\x03\xcd\xe1\x17\xec\x89\x07\x34\x64\x27\x00\x23\x1f\x45\xb5\x29\xa0\xb5\x85\x61\xf4\xf7\xc9\x05\xa1\x74\x1b\x7d\xee\xb7\xcc\x4e\xe1\x0b\xc7\x70\x8f\xd2\xcd\xa1\xd9\xfa\xa0\x39\xa2\xaf\x28\xb2\x25\xef\x66\x45\xe3\xb7\x6c\x54\xb8\x45\xa9\x1b\xf5\x78\x7d\x8d\x7e\xe8\xf0\xba\x6c\xb1\xe9\xa8\x2f\x4e\xd5\xaf\x38\x50\xb8\xb9\xbf\xfb\xfc\xdf\xc7\x67\xdb\x00\x96\xd8\x24\x37\xa8\xcc\x63\x9e\xc0\x31\x48\x47\x50\x1f\xc1\x32\xa6\xf2\x59\x3d\x02\x37\xf7\x77\x3b\x98\x21\xc5\x81\x92\xb8\xad\x5b\xea\x3a\x88\xce\x83\xdd\x17\x42\xdf\xa9\x5e\xf5\x16\x58\x0d\x4b\xaa\x42\x47\x32\xc9\x8e\xa6\x40\x5c\x82\x74\x8e\x21\xd1\x90\x88\x29\xd4\x40\x7d\x06\x0c\x7a\x09\x03\xc4\xf6\x0b\x19\x69\xe0\x91\x92\xc2\x00\x77\x31\x7b\xab\x6e\x5c\x53\x12\x48\x64\xe2\x2a\xb8\xdf\x76\xd8\x0c\x12\x8b\x50\x8f\x42\x63\x6c\xec\x57\x71\x5e\x40\x0f\x6b\xf4\x99\xae\x00\x83\x85\x1e\x37\x90\x48\xa5\x40\x0e\x07\x78\xe5\x0a\x37\xf0\x31\x26\x02\x17\x96\x71\x0e\x9d\xc8\xc0\xf3\xd9\x6c\xe5\x64\x9b\x95\x26\xf6\x7d\x0e\x4e\x36\x33\x8d\xac\xe4\xda\x2c\x31\xf1\xcc\xd2\x9a\xfc\x8c\xdd\x6a\x8a\xc9\x74\x4e\xc8\x48\x4e\x34\xc3\xc1\x4d\x8b\xea\x41\x0d\xe6\xa6\xb7\xff\x4a\x63\x1e\xf3\xbb\x67\xba\xd6\xb0\x60\x49\x2e\xac\x0e\x0e\x4a\x92\x7c\xc7\x03\x9a\x25\xea\x6b\x1c\x9f\x56\x43\xf7\x44\xeb\x96\xb2\xf3\xf0\xe3\xe3\x02\xb6\xa2\x8b\x33\x9e\x81\xc2\xc8\xfb\xfe\x21\xef\x5d\xa0\x84\xb9\xb0\x24\x0d\x21\xc7\xb0\x4c\xb1\x2f\x8c\x53\xb0\x43\x74\x41\xca\x87\xf1\x8e\xc2\x4b\xfa\x39\xb7\xbd\x13\xf5\xfb\xaf\x99\x58\xd4\x57\x0d\xdc\x96\x52\x05\x2d\x41\x1e\x34\x0d\x6c\x03\x77\x01\x6e\xb1\x27\x7f\x8b\x4c\x7f\xbb\x03\x94\x69\x9e\x2a\xb1\xaf\x73\xc1\x61\x95\xdd\xff\x14\x65\x3e\xb2\x76\x70\xb0\xad\x80\x67\xfc\xf5\x32\x4d\x1f\x05\x25\xf3\xb3\xe4\x89\x2d\x6b\xe8\x5b\x60\x41\x21\xcd\x8a\x9a\xb4\xcf\x40\x4f\x27\xed\x58\xf6\x6b\xed\x3a\x3a\x79\xa9\x4a\x4e\x89\x82\xc0\x90\xa2\x21\xd6\x82\xb9\x97\xa8\x0e\xbd\x59\x51\x90\xe6\x08\xc3\x09\xf5\x27\xa0\x8f\xed\xac\x5a\xec\x4c\x2b\xa9\xad\x96\x95\xd4\x57\x21\xb8\x8d\x39\x35\xa5\xec\xa2\x3f\x81\x0b\x55\xad\x63\x4d\xbe\xc7\x42\x5d\x1e\x59\x16\x09\x03\x17\x55\xb4\x30\x9f\xbe\xf7\x42\xf9\x0f\xc8\x02\xea\x9d\xe2\x8f\x1d\xa1\x20\x3b\x28\xb2\x35\x0b\x62\xa0\xa2\x5d\xe6\x33\xb8\xa0\xd5\x09\x43\x94\x8e\x52\x03\x8b\xce\xed\x0a\x5a\x4b\xf0\xb5\xa3\x50\x44\xe4\x60\x29\xf9\x8d\xba\x60\x2f\xcd\x74\x18\x56\x64\x4f\xd9\x5d\xd7\x9d\xfa\x09\x45\xb3\x5f\xf3\xe9\x29\xc4\xaf\xe1\x4a\xf1\x02\x64\xde\xe6\x7d\x31\x63\x27\xe8\xe6\xfe\x0e\x96\x8e\xbc\x3d\x0b\x3a\x4a\x55\x50\x34\x86\x06\xc1\xd6\x9f\xe4\x5e\xff\xcb\x98\x7a\x94\xda\xcc\xa6\xbb\xe6\x7d\xbc\xce\x24\xd6\x7e\xf5\xc4\x8c\xab\xd7\x79\xe7\x06\xba\xdc\x63\x80\x44\x68\x55\xb9\xed\x63\x70\xc1\x3a\x83\xa2\x96\x5b\x12\x74\x9e\x01\xdb\x98\x65\x72\x12\x53\xf3\xbd\xa3\x03\x9f\x8e\xee\x29\xf4\x94\x1e\xd1\x12\x50\x3f\xc8\xa6\x79\xab\x55\x89\x90\x5f\xf6\xcf\x33\x46\x2d\x3a\x52\x83\x38\x86\x5d\xab\xde\x45\xc2\x3b\x2e\x81\x7c\xa0\xea\x19\x44\x6d\x77\x87\x75\x54\x41\xb5\x1e\xb9\xa5\x33\xda\xf2\x8b\x55\xa6\x8b\x91\x4b\xec\x69\x4c\x42\x4c\x25\x78\x4e\x34\x84\xfd\xaa\x94\x38\xd6\x26\xcc\xce\x52\x22\x0b\x08\xab\x8c\x09\x83\x10\x59\xc5\x3e\x62\x4f\x51\xdb\x73\x01\x01\x7f\x91\x59\xa6\x35\x25\x27\x9b\x57\x71\xfb\x38\x5e\xd6\x72\xb1\x76\xb6\xd6\x22\xfa\x36\x78\x67\x9c\x80\xf1\xc8\xac\x0c\x6d\xeb\xd2\x19\x48\x80\x87\xea\x1f\x13\x2d\x5d\x01\xd7\xa1\x23\xb3\x36\xc8\x98\xa0\x47\xd3\x95\x3a\x67\x30\x80\xeb\x7b\xb2\x0e\x85\xfc\xa6\xe6\x36\x0b\x86\xf3\x39\xa7\x40\x66\xac\xc6\xec\x24\x57\x4d\x74\x54\x41\x23\x80\xc6\xc4\x64\x5d\x58\xf9\x8d\x92\x4c\x7b\x7b\xbe\x9f\xc9\x1f\x3f\x3d\x2e\xb4\xc9\x32\x09\xc4\xe0\x37\xea\xf2\x00\xb5\xe1\xfc\xff\x27\xf4\x4c\x6f\xa7\xbf\x80\xbc\x8e\xfc\x72\x75\xdb\x53\x76\x31\x7d\x55\x4a\x67\x5c\xc2\x22\xe9\x58\x56\xd4\xb9\x82\x4f\xa1\x14\xb1\x37\xeb\x55\x2e\xbc\x46\xab\xc5\x66\x28\xd2\x77\xfa\x3c\xcb\x1c\x4d\x0a\x17\x60\x19\x63\x43\xdf\xb0\x1f\x3c\x35\x26\xf6\xb3\x7d\x66\x9d\x11\x01\xf0\x11\xc3\x06\x9a\x1d\x6a\xa3\x0a\xd5\x89\x8c\x01\x53\xb1\x9f\x1d\x8b\xb6\x5d\x34\x29\x32\xef\x46\xb2\xf3\xd9\xe7\xdd\x13\xc1\xcd\x1a\x9d\xd7\x6a\x77\x05\x6d\xd6\xc4\x32\x98\x99\x00\x53\xeb\x24\x61\xda\xec\x99\xad\x11\xa8\xc3\x15\xd3\x32\x9f\x6e\xa8\xba\xfe\xcd\x44\xd0\x84\x68\xa9\xa9\x1d\x6c\xaf\x36\xff\xa7\xb4\x11\xc0\xd6\x79\xcd\x1b\x89\x60\xc9\xc4\xb0\xf4\xce\x68\xbb\x39\x8b\xe9\xfa\x21\x26\xc1\x20\x6f\xf4\xa0\x8e\x89\x2e\xd1\x8b\x81\xb7\xfe\xa7\x27\xba\xf9\xc9\x6b\x67\xfb\xf1\xb4\x04\xf6\x89\x83\x33\xa3\xdc\xe1\x21\xa6\x84\x9b\xc9\x2b\x1e\x9d\x4a\x8d\xcb\x04\x78\x99\x00\x2f\x13\xe0\x65\x02\xbc\x4c\x80\x97\x09\xf0\x32\x01\x5e\x26\xc0\xcb\x04\xf8\xcf\x9d\x00\x8f\x36\x75\xe0\x21\x3b\x07\x49\xb9\x4a\x66\x89\x49\x3b\xdd\xc1\x4e\x6e\x77\x41\xb0\x35\x9c\x05\x25\xf3\x1c\x7e\xff\x63\xf2\xe7\x00\x85\xee\xb3\x97\x2d\x1a\x00\x00")
 
 func crdsPluginsFarosSh_containerruntimesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -461,7 +467,7 @@ func crdsPluginsFarosSh_containerruntimesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsPluginsFarosSh_monitoringsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcf\x6f\x1b\xbb\x11\xbe\xeb\xaf\x18\xa0\x87\xb4\x80\xb5\x8a\xd1\x4b\x21\xa0\x07\xc3\x6d\x01\xa3\x49\x60\xc4\x4e\xee\xb3\xe4\x48\xcb\x98\x4b\x6e\x39\x43\x25\xea\xc3\xfb\xdf\x1f\x86\x5c\xad\x64\x59\xeb\x18\xef\xe1\xf1\xb6\x24\x77\x7e\x7c\xdf\xcc\x37\x5c\x2e\x97\x0b\x1c\xdc\x57\x4a\xec\x62\x58\x03\x0e\x8e\x7e\x08\x05\xfd\xe2\xe6\xe9\x1f\xdc\xb8\xb8\xda\x5d\x2f\x9e\x5c\xb0\x6b\xb8\xcd\x2c\xb1\xff\x4c\x1c\x73\x32\xf4\x2f\xda\xb8\xe0\xc4\xc5\xb0\xe8\x49\xd0\xa2\xe0\x7a\x01\x80\x21\x44\x41\xdd\x66\xfd\x04\x30\x31\x48\x8a\xde\x53\x5a\x6e\x29\x34\x4f\xb9\xa5\x36\x3b\x6f\x29\x15\xe3\x07\xd7\xbb\xf7\xcd\xf5\xfb\xe6\xfd\x02\xc0\x24\x2a\xff\x3f\xba\x9e\x58\xb0\x1f\xd6\x10\xb2\xf7\x0b\x80\x80\x3d\xad\xa1\x8f\xc1\x49\x4c\x2e\x6c\xb9\x19\x7c\xde\xba\xc0\xcd\x06\x53\xe4\x86\xbb\x05\x0f\x64\xd4\xef\x36\xc5\x3c\xac\xe1\xc5\x79\x35\x32\x86\x56\xd3\xfa\x38\xd9\x2b\x9b\xde\xb1\xfc\xf7\xec\xe0\x83\x63\x29\x87\x83\xcf\x09\xfd\xb3\x18\xca\x3e\xbb\xb0\xcd\x1e\xd3\xe9\xc9\x02\x80\x4d\x1c\x68\x0d\x9f\xd4\xe5\x80\x86\xec\x02\x60\xcc\xb8\x84\xb0\x04\xb4\xb6\x60\x88\xfe\x3e\xb9\x20\x94\x6e\xa3\xcf\xfd\x01\xbb\x25\x7c\xe3\x18\xee\x51\xba\x35\x34\x07\x94\x9b\x17\x00\x95\xbb\x07\x78\x6e\xb6\x34\x7e\xcb\x5e\x9d\x5b\x94\xba\x51\x8f\x77\xd7\xe8\x87\x0e\xaf\x6b\xd8\xa6\xa3\x1e\xd7\xe3\xfd\x38\x50\xb8\xb9\xbf\xfb\xfa\xf7\x87\x67\xdb\x00\x96\xd8\x24\x37\x48\x21\xea\x88\x0a\x38\x06\xe9\x08\xea\x75\xd8\xc4\x54\x3e\x2b\xe6\x70\x73\x7f\x37\x19\x18\x52\x1c\x28\x89\x3b\x00\x5f\xd7\x49\xe5\x9d\xec\x9e\xb9\x7b\xa7\x11\xd5\x5b\x60\xb5\xe4\xa8\x3a\x1d\x61\x24\x3b\x26\x01\x71\x03\xd2\x39\x86\x44\x43\x22\xa6\x50\x8b\xf0\x99\x61\xd0\x4b\x18\x20\xb6\xdf\xc8\x48\x03\x0f\x94\xd4\x0c\x70\x17\xb3\xb7\x5a\xa9\x3b\x4a\x02\x89\x4c\xdc\x06\xf7\xff\xc9\x36\x83\xc4\xe2\xd4\xa3\xd0\x58\x09\xc7\x55\x68\x0b\xe8\x61\x87\x3e\xd3\x15\x60\xb0\xd0\xe3\x1e\x12\xa9\x17\xc8\xe1\xc4\x5e\xb9\xc2\x0d\x7c\x8c\x89\xc0\x85\x4d\x5c\x43\x27\x32\xf0\x7a\xb5\xda\x3a\x39\x74\x9c\x89\x7d\x9f\x83\x93\xfd\xaa\x34\x8f\x6b\xb3\xc4\xc4\x2b\x4b\x3b\xf2\x2b\x76\xdb\x25\x26\xd3\x39\x21\x23\x39\xd1\x0a\x07\xb7\x2c\xa1\x87\xd2\x75\x4d\x6f\xff\x92\xc6\x1e\xe5\x77\xcf\x62\xad\x05\xc1\x32\xd5\x7a\x5d\xa5\x0d\x5e\x61\x40\xbb\x41\xb9\xc6\xf1\xd7\x9a\xc5\x11\x68\xdd\x52\x74\x3e\xff\xfb\xe1\x11\x0e\xae\x0b\x19\xe7\xe8\x17\xdc\x8f\x3f\xf2\x91\x02\x05\xcc\x85\x0d\xa5\x4a\xe2\x26\xc5\xbe\xd8\xa4\x60\x87\xe8\x82\x94\x0f\xe3\x1d\x85\x73\xf8\x39\xb7\xbd\x13\xe5\xfd\x7f\x99\x58\x94\xab\x06\x6e\x8b\x0c\x41\x4b\x90\x07\x6d\x00\xdb\xc0\x5d\x80\x5b\xec\xc9\xdf\x22\xd3\x9f\x4e\x80\x22\xcd\x4b\x05\xf6\x6d\x14\x9c\x2a\xe8\xf9\xe5\x8a\xda\xc9\xc1\x41\xe3\x66\xf8\xfa\x44\xf2\x3d\xa6\xa7\x87\x81\xcc\xb3\x8e\xb1\xc4\x2e\x69\x4d\x0b\x0a\x69\x27\xd4\x46\x7d\x93\x47\x41\xc9\xfc\x06\x9f\xe5\xde\x33\xaf\xb1\x65\xa5\xf8\x75\xb7\x97\xf5\x01\xea\xf4\xa8\x02\xf9\xe2\xe4\x2c\x82\xdb\x9c\x12\x05\x51\x53\x86\x58\xf5\xf8\xe8\x51\xe3\xb8\xd9\x52\x90\xe6\x85\x0d\x27\xd4\x5f\x30\x7d\x6e\xfc\x10\xc5\x94\x5a\x51\x11\xcd\xac\xa8\x4c\xd1\x95\x43\x79\x6b\x2a\x58\x35\xfd\x82\xdd\x0a\x26\xbd\x8c\xe4\x35\x14\xea\xf2\xc8\xf2\x98\x30\xb0\x3b\xa8\xff\xe5\x7b\x67\xc1\x7f\x40\x16\x10\xd7\x53\xed\xa1\x29\x15\x99\x4c\x91\xad\x0d\x17\x03\x8d\x54\xcf\xd8\x05\x15\x42\x0c\x51\x3a\x4a\x0d\x3c\x6a\xa7\x8e\xda\xd9\x12\x7c\xef\x28\x14\x17\x39\x58\x4a\x7e\xaf\x14\x1c\xbd\x99\x0e\xc3\x96\xec\xa5\xbc\xeb\xba\x53\x9e\x50\x54\x68\xb4\x75\x9f\x42\xfc\x1e\xae\xd4\x5e\x80\xcc\x07\x89\x29\x69\x4c\x8e\x6e\xee\xef\x60\xe3\xc8\xdb\x59\xa3\xa3\xd7\xa2\x5e\xc6\xd0\x20\xd8\xfa\x8b\xd8\xeb\xda\xc4\xd4\xa3\xd4\x89\xb9\x54\x4f\x33\xf7\x66\x7a\xf8\xb8\x7a\x62\xc6\xed\xdb\xd8\xb9\x81\x2e\xf7\x18\x20\x11\x5a\x0d\xee\xf0\x33\xb8\x60\x9d\xc1\x22\xae\x96\x04\x9d\x67\xc0\x36\xe6\x73\xf9\x3b\x09\x4b\xf1\x99\x38\x1d\xe9\x29\xf0\x14\x75\x6d\x09\xa8\x1f\x64\x3f\x97\xfd\x4f\xb3\x4a\x84\x7c\x3e\xaa\x67\x92\x7a\xec\x68\xbc\x3e\xbd\x0a\xa6\x4a\x78\xc7\xa5\x90\x4f\x42\x9d\xcd\xc8\x3d\x93\x6c\x35\xaa\xd2\xe7\x36\xce\x14\xea\x35\x2b\xd3\xc5\xc8\xa5\x24\xb4\x26\x21\xa6\x52\x3c\x17\x66\xcf\x71\x55\x48\x1c\x6b\x48\xec\x2c\xa9\x26\x22\x6c\x33\x26\x0c\x42\x64\xd5\xf6\x0b\xf4\xea\x34\x99\xb5\xf9\xc7\x90\x65\xda\x51\x72\xb2\x7f\x13\xb6\x0f\xe3\x65\x95\x8b\x9d\xb3\x55\x8b\xe8\xc7\xe0\x9d\x71\x02\xc6\x23\xb3\x22\x74\xd0\xa5\xd9\x90\x3f\x57\x7e\x4c\xb4\x74\x05\x5c\xdf\x37\x99\x75\x16\xc7\x04\x3d\x9a\xae\xe8\x9c\xc1\x00\xae\xef\xc9\x3a\x14\xf2\xfb\xda\xdb\x2c\x18\xe6\x7b\xae\xb0\x3d\xaa\x31\x3b\xc9\x35\x12\x7d\x15\xa1\x11\x6d\xc3\x98\xac\x0b\x5b\xbf\xaf\x94\x4e\xf9\xbc\xde\xc9\x1f\xbf\x3c\x3c\x6a\x0d\x33\x09\xc4\xe0\xf7\x55\x05\xea\xc0\xf9\xe7\x7f\xd0\xf3\x6c\x5b\xff\x1c\xfe\x0b\xd3\x6d\x0e\xfc\x3a\xe0\xc6\x99\x32\xd5\xf4\x55\x91\xce\xb8\x81\xc7\xa4\x2f\xc0\x12\xce\x15\x7c\x09\x45\xc4\x7e\x77\x5c\xe5\xc2\x9b\xda\x6d\x3f\x14\xef\x47\xb5\x3d\xed\x1c\xe5\xd3\x69\x23\xc6\x86\x7e\x60\x3f\x78\x6a\x4c\xec\x57\xc7\xce\x9a\xc7\x1c\xc3\x1e\x9a\xc9\x6a\xa3\x01\x8d\x4f\x58\xc0\x44\xb5\x81\x58\x94\x68\x34\x29\x32\x4f\xaf\xbf\xf9\xee\xf3\xee\x89\xe0\x66\x87\xce\xab\xda\x5d\x41\x9b\xb5\xb1\x0c\x66\x26\xc0\xd4\x3a\x49\x98\xf6\x27\x63\xbf\x54\x60\x5b\x4a\x73\x93\x2f\x0f\x54\x5d\x7f\x65\x22\x68\x42\xb4\xd4\x54\x3a\x8f\x61\xf3\xdf\xca\x18\x01\x6c\x9d\xd7\x3a\x93\x08\x96\x4c\x0c\x1b\xef\x8c\x8e\x9b\x79\xf9\xe9\x87\x98\x04\x2f\x3d\x1b\x4e\x08\x9a\x67\x50\x5f\xa4\xfa\xe2\xba\xc4\xe1\xf2\xc2\x34\xbf\x78\x6d\x76\x1e\x2f\x8b\xfb\x0b\x07\x33\x6f\xb8\xd3\x43\x4c\x09\xf7\x3f\x7f\xf8\xbd\xd8\xac\x4f\xb9\x35\x48\xca\xd5\x33\x4b\x4c\x3a\xe9\x4e\x76\x72\x3b\x15\xc1\x21\xf1\xb1\xc1\xe0\x97\x5f\x17\xbf\x05\x00\x00\xff\xff\xd2\x27\xa5\x96\x72\x10\x00\x00")
+var _crdsPluginsFarosSh_monitoringsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\x6f\x23\xb7\x0e\xbe\xfb\x57\x10\x78\x87\x7d\x0f\x88\xc7\x1b\xbc\x4b\x61\xa0\x87\x20\x6d\x81\xa0\x9b\x45\xb0\xf1\xee\x9d\x23\xd1\x1e\x6d\x34\xd2\x54\xa4\xbc\xeb\x16\xfd\xef\x05\x35\xe3\xb1\x1d\x7b\xd2\x20\x45\x3d\xbe\x8c\xa4\xf9\x48\x7e\x24\x3f\x6a\x3e\x9f\xcf\xb0\x73\x5f\x28\xb1\x8b\x61\x09\xd8\x39\xfa\x2e\x14\xf4\x8d\xab\xa7\x1f\xb8\x72\x71\xb1\xbd\x9e\x3d\xb9\x60\x97\x70\x9b\x59\x62\xfb\x89\x38\xe6\x64\xe8\x27\x5a\xbb\xe0\xc4\xc5\x30\x6b\x49\xd0\xa2\xe0\x72\x06\x80\x21\x44\x41\x5d\x66\x7d\x05\x30\x31\x48\x8a\xde\x53\x9a\x6f\x28\x54\x4f\xb9\xa6\x3a\x3b\x6f\x29\x15\xf0\xbd\xe9\xed\xfb\xea\xfa\x7d\xf5\x7e\x06\x60\x12\x95\xef\x57\xae\x25\x16\x6c\xbb\x25\x84\xec\xfd\x0c\x20\x60\x4b\x4b\x68\x63\x70\x12\x93\x0b\x1b\xae\x3a\x9f\x37\x2e\x70\xb5\xc6\x14\xb9\xe2\x66\xc6\x1d\x19\xb5\xbb\x49\x31\x77\x4b\x38\xdb\xef\x41\x06\xd7\xfa\xb0\xee\x47\xbc\xb2\xe8\x1d\xcb\xaf\xcf\x36\x3e\x38\x96\xb2\xd9\xf9\x9c\xd0\x9f\xf8\x50\xd6\xd9\x85\x4d\xf6\x98\x8e\x77\x66\x00\x6c\x62\x47\x4b\xf8\x88\x2d\x71\x87\x86\xec\x0c\x60\x88\xb8\xb8\x30\x07\xb4\xb6\x70\x88\xfe\x21\xb9\x20\x94\x6e\xa3\xcf\xed\x9e\xbb\x39\x7c\xe5\x18\x1e\x50\x9a\x25\x54\x7b\x96\xab\x33\x82\x8a\x0b\x7b\x7a\x6e\x36\x34\xbc\xcb\x4e\x8d\x5b\x94\x7e\xa1\xdf\xde\x5e\xa3\xef\x1a\xbc\x2e\x4b\x6c\x1a\x6a\x4b\xda\xf4\x2d\x76\x14\x6e\x1e\xee\xbe\xfc\xff\xf1\x64\x19\xc0\x12\x9b\xe4\x3a\xb5\x79\xcc\x0a\x38\x06\x69\x08\xfa\xe3\xb0\x8e\xa9\xbc\xf6\x9c\xc3\xcd\xc3\xdd\x08\xd0\xa5\xd8\x51\x12\xb7\x27\xbe\x7f\x8e\x2a\xef\x68\xf5\x99\xb9\x77\xea\x51\x7f\x0a\xac\x96\x1c\xf5\x46\x07\x1a\xc9\x0e\x41\x40\x5c\x83\x34\x8e\x21\x51\x97\x88\x29\xf4\x45\x78\x02\x0c\x7a\x08\x03\xc4\xfa\x2b\x19\xa9\xe0\x91\x92\xc2\x00\x37\x31\x7b\xab\x95\xba\xa5\x24\x90\xc8\xc4\x4d\x70\xbf\x8f\xd8\x0c\x12\x8b\x51\x8f\x42\x43\x25\x1c\x9e\x92\xb6\x80\x1e\xb6\xe8\x33\x5d\x01\x06\x0b\x2d\xee\x20\x91\x5a\x81\x1c\x8e\xf0\xca\x11\xae\xe0\x3e\x26\x02\x17\xd6\x71\x09\x8d\x48\xc7\xcb\xc5\x62\xe3\x64\xdf\x71\x26\xb6\x6d\x0e\x4e\x76\x8b\xd2\x3c\xae\xce\x12\x13\x2f\x2c\x6d\xc9\x2f\xd8\x6d\xe6\x98\x4c\xe3\x84\x8c\xe4\x44\x0b\xec\xdc\xbc\xb8\x1e\x34\x60\xae\x5a\xfb\x9f\x34\xf4\x28\xbf\x3b\xf1\xb5\x2f\x08\x96\xb1\xd6\xfb\x7f\x69\x83\x17\x32\xa0\xdd\xa0\xb9\xc6\xe1\xd3\x3e\xd0\x03\xd1\xba\xa4\xec\x7c\xfa\xf9\x71\x05\x7b\xd3\x25\x19\x27\xa0\x30\xf0\x7e\xf8\x90\x0f\x29\x50\xc2\x5c\x58\x93\x96\x90\x63\x58\xa7\xd8\x16\xc6\x29\xd8\x2e\xba\x20\xe5\xc5\x78\x47\xe1\x39\xfd\x9c\xeb\xd6\x89\xe6\xfd\xb7\x4c\x2c\x9a\xab\x0a\x6e\x8b\x0c\x41\x4d\x90\x3b\x6d\x00\x5b\xc1\x5d\x80\x5b\x6c\xc9\xdf\x22\xd3\xbf\x9e\x00\x65\x9a\xe7\x4a\xec\xeb\x52\x70\xac\xa0\x87\x9f\xa2\x2c\x07\xd6\x8e\x36\xf6\x1a\x37\x91\xaf\x8f\x24\xdf\x62\x7a\x7a\xec\xc8\x9c\x74\x8c\x25\x76\x49\x6b\x5a\x50\x48\x3b\xa1\x6f\xd4\x57\x59\x14\x94\xcc\xaf\xb0\x59\xce\x9d\x58\x8d\x35\x6b\x97\xbd\x6c\xf6\xb2\x3e\x0c\xd3\xa3\x17\xc8\xb3\x9d\x67\x1e\xdc\xe6\x94\x28\x08\x74\x29\x1a\x62\xd5\xe3\x83\x45\xad\x9d\x9b\x0d\x05\xa9\xce\x30\x9c\x50\x7b\x01\xfa\x39\xf8\xde\x8b\x31\xb4\xa2\x22\x1a\x59\x51\x19\x35\x82\x03\x6d\xa0\xa1\x94\x55\xf4\x17\x70\x7b\x32\xe9\xdc\x93\x97\x58\xe8\x1f\x8f\x2c\xab\x84\x81\x0b\x21\xaa\xfe\x97\xcf\x3d\x73\xfe\x03\xb2\x80\xb8\x96\x4a\x3e\x46\x42\x41\x46\x28\xb2\x7d\xc3\xc5\x40\x43\xaa\x27\x70\x41\x85\x10\x43\x94\x86\x52\x05\xab\xc6\x8d\xda\x59\x13\x7c\x6b\x28\x14\x13\x39\x58\x4a\x7e\xa7\x29\x38\x58\x33\x0d\x86\x0d\xd9\x4b\x71\xf7\xcf\x9d\xe6\x09\x45\x85\x46\x5b\xf7\x29\xc4\x6f\xe1\x4a\xf1\x02\x64\xde\x4b\x4c\x09\x63\x34\x74\xf3\x70\x07\x6b\x47\xde\x4e\x82\x0e\x56\x15\x14\x8d\xa1\x4e\xb0\xf6\x17\xb9\xd7\xff\x3a\xa6\x16\xa5\x9f\x98\x73\xb5\x34\x71\x6e\xa2\x87\x0f\x4f\x4b\xcc\xb8\x79\x5d\x76\x6e\xa0\xc9\x2d\x06\x48\x84\x56\x9d\xdb\x7f\x0c\x2e\x58\x67\x50\x34\x72\x4b\x82\xce\x33\x60\x1d\xb3\xcc\x2e\x62\xaa\x5b\x0d\x1d\xe5\x74\x48\x4f\xa1\xa7\x8c\xa3\x9a\x80\xda\x4e\x76\xd5\x5b\xa3\x4a\x84\xfc\x7c\x54\x4f\x04\xb5\x6a\x48\x03\xe2\x18\xc6\x5b\xc1\x58\x09\xef\xb8\x14\xf2\x91\xab\x13\x88\x3a\x59\x8f\x25\x5b\x41\x55\xfa\xdc\xda\x19\xbd\x5d\x94\xa8\x4c\x13\x23\x97\xda\xd3\x9a\x84\x98\x4a\xf1\x5c\x98\x3d\x87\xa7\xa7\xc4\xb1\xce\x7b\x76\x96\x54\x13\x11\x36\x19\x13\x06\x21\xb2\x8a\x7d\xc6\x9e\xa2\xd6\x53\x05\x01\xff\x90\x59\xa6\x2d\x25\x27\xbb\x57\x71\xfb\x38\x1c\x56\xb9\xd8\x3a\xdb\x6b\x11\x7d\xef\xbc\x33\x4e\xc0\x78\x64\x56\x86\xf6\xba\x34\x01\x09\xf0\xa9\xcf\x8f\x89\x96\xae\x80\xfb\xfb\x4d\x66\x9d\xc5\x31\x41\x8b\xa6\x29\x3a\x67\x30\x80\x6b\x5b\xb2\x0e\x85\xfc\xae\xef\x6d\x16\x0c\xd3\x3d\xa7\x40\x66\x50\x63\x76\x92\x7b\x4f\xf4\x56\x84\x46\x00\x8d\x89\xc9\xba\xb0\xf1\x3b\x25\x99\x0e\xf1\xbc\xdc\xc9\xf7\x9f\x1f\x57\x3a\xcf\x99\x04\x62\xf0\x3b\x4d\x79\x80\xc7\xa2\x56\x3f\xfe\x82\x9e\xe9\xed\xf4\x5f\x98\x6e\x53\xe4\x97\xa3\xfb\x99\x32\xd6\xf4\x55\x91\xce\xb8\x86\x55\xd2\x1b\x60\x71\xe7\x0a\x3e\x87\x22\x62\x6f\xf6\xab\x1c\x78\x8d\x57\xab\x5d\x57\xac\x8f\xfe\x9c\x74\x8e\x36\x85\x0b\xb0\x8e\xb1\xa2\xef\xd8\x76\x9e\x2a\x13\xdb\xc5\xa1\xb3\x26\x4c\x00\xdc\x63\xd8\x41\x35\xa2\x56\xea\x50\x7f\xf9\x63\xc0\x54\xe2\x67\xc7\xa2\x63\x17\x4d\x8a\xcc\xe3\xed\x6f\xba\xfb\xbc\x7b\x22\xb8\xd9\xa2\xf3\xaa\x76\x57\x50\x67\x6d\x2c\x83\x99\x09\x30\xd5\x4e\x12\xa6\xdd\x81\xd9\xbe\x02\xf5\x1e\xc7\xb4\xce\x97\x07\xaa\x3e\xff\x65\x22\xa8\x42\xb4\x54\xf5\x13\xec\xe0\x36\xff\xaf\x8c\x11\xc0\xda\x79\xed\x1b\x89\x60\xc9\xc4\xb0\xf6\xce\xe8\xb8\x99\xc4\x74\x6d\x17\x93\x60\x90\x37\x66\x50\x6f\xa4\x7a\xe3\xba\x54\x59\xf3\x0b\xd3\xfc\xe2\xb1\xc9\x79\x3c\x2f\x85\x7d\x61\x63\xe2\x0e\x77\xbc\x89\x29\xe1\x6e\xf6\xb7\x1f\x9d\x2d\xea\x85\x87\xec\x12\x24\xe5\x5e\x0b\x59\x62\xd2\x49\x77\xb4\x92\xeb\xb1\x08\xf6\x81\xb3\xa0\x64\x5e\xc2\x1f\x7f\xce\xfe\x1a\x00\xd2\x27\xa5\x96\x72\x10\x00\x00")
 
 func crdsPluginsFarosSh_monitoringsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -481,7 +487,7 @@ func crdsPluginsFarosSh_monitoringsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsPluginsFarosSh_networksYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcf\x6f\x1b\xbb\x11\xbe\xeb\xaf\x18\xa0\x87\xb4\x80\xb5\x8a\xd1\x4b\x21\xa0\x07\xc3\x6d\x01\xa3\x49\x60\xc4\x4e\xee\xb3\xe4\x48\xcb\x98\x4b\x6e\x39\x43\x25\xea\xc3\xfb\xdf\x1f\x86\x5c\xad\x64\x59\xeb\x18\xef\xe1\xf1\xb6\x24\x77\x7e\x7c\xdf\xcc\x37\x5c\x2e\x97\x0b\x1c\xdc\x57\x4a\xec\x62\x58\x03\x0e\x8e\x7e\x08\x05\xfd\xe2\xe6\xe9\x1f\xdc\xb8\xb8\xda\x5d\x2f\x9e\x5c\xb0\x6b\xb8\xcd\x2c\xb1\xff\x4c\x1c\x73\x32\xf4\x2f\xda\xb8\xe0\xc4\xc5\xb0\xe8\x49\xd0\xa2\xe0\x7a\x01\x80\x21\x44\x41\xdd\x66\xfd\x04\x30\x31\x48\x8a\xde\x53\x5a\x6e\x29\x34\x4f\xb9\xa5\x36\x3b\x6f\x29\x15\xe3\x07\xd7\xbb\xf7\xcd\xf5\xfb\xe6\xfd\x02\xc0\x24\x2a\xff\x3f\xba\x9e\x58\xb0\x1f\xd6\x10\xb2\xf7\x0b\x80\x80\x3d\xad\x21\x90\x7c\x8f\xe9\x89\x9b\xc1\xe7\xad\x0b\xdc\x6c\x30\x45\x6e\xb8\x5b\xf0\x40\x46\x9d\x6e\x53\xcc\xc3\x1a\x5e\x9c\x57\x0b\x63\x5c\x35\xa7\x4f\xd5\x58\xd9\xf1\x8e\xe5\xbf\xa7\xbb\x1f\x1c\x4b\x39\x19\x7c\x4e\xe8\x8f\xae\xcb\x26\xbb\xb0\xcd\x1e\xd3\xb4\xbd\x00\x60\x13\x07\x5a\xc3\x27\x75\x33\xa0\x21\xbb\x00\x18\x53\x2c\x6e\x97\x80\xd6\x16\xd0\xd0\xdf\x27\x17\x84\xd2\x6d\xf4\xb9\x3f\x80\xb5\x84\x6f\x1c\xc3\x3d\x4a\xb7\x86\xe6\x00\x6b\xf3\x02\x91\x72\xf7\x80\xc7\xcd\x96\xc6\x6f\xd9\xab\x73\x8b\x52\x37\xea\xf1\xee\x1a\xfd\xd0\xe1\x75\x8d\xd9\x74\xd4\xe3\x7a\xbc\x1f\x07\x0a\x37\xf7\x77\x5f\xff\xfe\xf0\x6c\x1b\xc0\x12\x9b\xe4\x06\x29\xcc\x8c\x60\x80\x63\x90\x8e\xa0\xde\x85\x4d\x4c\xe5\xb3\x82\x0c\x37\xf7\x77\xd3\xdf\x43\x8a\x03\x25\x71\x07\xa4\xeb\x3a\xa9\xb3\x93\xdd\x33\x5f\xef\x34\x9c\x7a\x0b\xac\x16\x18\x55\xa7\x23\x86\x64\xc7\x0c\x20\x6e\x40\x3a\xc7\x90\x68\x48\xc4\x14\x6a\xc9\x3d\x33\x0c\x7a\x09\x03\xc4\xf6\x1b\x19\x69\xe0\x81\x92\x9a\x01\xee\x62\xf6\x56\xeb\x72\x47\x49\x20\x91\x89\xdb\xe0\xfe\x3f\xd9\x66\x90\x58\x9c\x7a\x14\x1a\x0b\xe0\xb8\x0a\x67\x01\x3d\xec\xd0\x67\xba\x02\x0c\x16\x7a\xdc\x43\x22\xf5\x02\x39\x9c\xd8\x2b\x57\xb8\x81\x8f\x31\x11\xb8\xb0\x89\x6b\xe8\x44\x06\x5e\xaf\x56\x5b\x27\x87\xfe\x32\xb1\xef\x73\x70\xb2\x5f\x95\x56\x71\x6d\x96\x98\x78\x65\x69\x47\x7e\xc5\x6e\xbb\xc4\x64\x3a\x27\x64\x24\x27\x5a\xe1\xe0\x96\x25\xf4\x50\x7a\xac\xe9\xed\x5f\xd2\xd8\x91\xfc\xee\x59\xac\xb5\x1a\x58\x92\x0b\xdb\x93\x83\x52\xf7\xaf\x30\xa0\x1d\xa0\x5c\xe3\xf8\x6b\xcd\xe2\x08\xb4\x6e\x29\x3a\x9f\xff\xfd\xf0\x08\x07\xd7\x85\x8c\x73\xf4\x0b\xee\xc7\x1f\xf9\x48\x81\x02\xe6\xc2\x86\x52\x25\x71\x93\x62\x5f\x6c\x52\xb0\x43\x74\x41\xca\x87\xf1\x8e\xc2\x39\xfc\x9c\xdb\xde\x89\xf2\xfe\xbf\x4c\x2c\xca\x55\x03\xb7\x45\x74\xa0\x25\xc8\x83\x56\xbf\x6d\xe0\x2e\xc0\x2d\xf6\xe4\x6f\x91\xe9\x4f\x27\x40\x91\xe6\xa5\x02\xfb\x36\x0a\x4e\xf5\xf2\xfc\x72\x45\xed\xe4\xe0\x20\x6a\x33\x7c\x8d\xdd\xf9\x30\x90\x79\xd6\x31\x96\xd8\x25\xad\x69\x41\x21\xed\x84\xda\xa8\x6f\xf2\x28\x28\x99\xdf\xe0\xb3\xdc\x7b\xe6\x35\xb6\xac\x14\xbf\xee\xf6\xb2\x3e\x40\x9d\x15\x55\x1d\x5f\x9c\x9c\x45\x70\x9b\x53\xa2\x20\x6a\xca\x10\xab\x12\x1f\x3d\x6a\x1c\x37\x5b\x0a\xd2\xbc\xb0\xe1\x84\xfa\x0b\xa6\xcf\x8d\x1f\xa2\x98\x52\x2b\x2a\xa2\x99\x15\x95\x29\xba\x72\x28\x6f\x4d\x05\xab\xa0\x5f\xb0\x5b\xc1\xa4\x97\x91\xbc\x86\x42\x5d\x1e\x59\x1e\x13\x06\x76\x07\xe9\xbf\x7c\xef\x2c\xf8\x0f\xc8\x02\xe2\x7a\xaa\x3d\x34\xa5\x22\x93\x29\xb2\xb5\xe1\x62\xa0\x91\xea\x19\xbb\xa0\x42\x88\x21\x4a\x47\xa9\x81\x47\xed\xd4\x51\x3b\x5b\x82\xef\x1d\x85\xe2\x22\x07\x4b\xc9\xef\x95\x82\xa3\x37\xd3\x61\xd8\x92\xbd\x94\x77\x5d\x77\xca\x13\x8a\x0a\x8d\xb6\xee\x53\x88\xdf\xc3\x95\xda\x0b\x90\xf9\x20\x31\x25\x8d\xc9\xd1\xcd\xfd\x1d\x6c\x1c\x79\x3b\x6b\x74\xf4\x5a\xd4\xcb\x18\x1a\x04\x5b\x7f\x11\x7b\x5d\x9b\x98\x7a\x94\x3a\x2e\x97\xea\x69\xe6\xde\x4c\x0f\x1f\x57\x4f\xcc\xb8\x7d\x1b\x3b\x37\xd0\xe5\x1e\x03\x24\x42\xab\xc1\x1d\x7e\x06\x17\xac\x33\x58\xc4\xd5\x92\xa0\xf3\x0c\xd8\xc6\x7c\x2e\x7f\x27\x61\x29\x3e\x13\xa7\x23\x3d\x05\x9e\xa2\xae\x2d\x01\xf5\x83\xec\xe7\xb2\xff\x69\x56\x89\x90\xcf\x47\xf5\x4c\x52\x8f\x1d\x8d\xd7\xa7\x57\xc1\x54\x09\xef\xb8\x14\xf2\x49\xa8\xb3\x19\xb9\x67\x92\xad\x46\x55\xfa\xdc\xc6\x99\x42\xbd\x66\x65\xba\x18\xb9\x94\x84\xd6\x24\xc4\x54\x8a\xe7\xc2\xec\x39\xae\x0a\x89\x63\x0d\x89\x9d\x25\xd5\x44\x84\x6d\xc6\x84\x41\x88\xac\xda\x7e\x81\x5e\x9d\x26\xb3\x36\xff\x18\xb2\x4c\x3b\x4a\x4e\xf6\x6f\xc2\xf6\x61\xbc\xac\x72\xb1\x73\xb6\x6a\x11\xfd\x18\xbc\x33\x4e\xc0\x78\x64\x56\x84\x0e\xba\x34\x1b\xf2\xe7\xca\x8f\x89\x96\xae\x80\xeb\xfb\x26\xb3\xce\xe2\x98\xa0\x47\xd3\x15\x9d\x33\x18\xc0\xf5\x3d\x59\x87\x42\x7e\x5f\x7b\x9b\x05\xc3\x7c\xcf\x15\xb6\x47\x35\x66\x27\xb9\x46\xa2\xaf\x22\x34\xa2\x6d\x18\x93\x75\x61\xeb\xf7\x95\xd2\x29\x9f\xd7\x3b\xf9\xe3\x97\x87\x47\xad\x61\x26\x81\x18\xfc\xbe\xaa\x40\x1d\x38\xff\xfc\x0f\x7a\x9e\x6d\xeb\x9f\xc3\x7f\x61\xba\xcd\x81\x5f\x07\xdc\x38\x53\xa6\x9a\xbe\x2a\xd2\x19\x37\xf0\x98\xf4\x05\x58\xc2\xb9\x82\x2f\xa1\x88\xd8\xef\x8e\xab\x5c\x78\x53\xbb\xed\x87\xe2\xfd\xa8\xb6\xa7\x9d\xa3\x7c\x3a\x6d\xc4\xd8\xd0\x0f\xec\x07\x4f\x8d\x89\xfd\xea\xd8\x59\xf3\x98\x63\xd8\x43\x33\x59\x6d\x34\xa0\xf1\x09\x0b\x98\xa8\x36\x10\x8b\x12\x8d\x26\x45\xe6\xe9\xf5\x37\xdf\x7d\xde\x3d\x11\xdc\xec\xd0\x79\x55\xbb\x2b\x68\xb3\x36\x96\xc1\xcc\x04\x98\x5a\x27\x09\xd3\xfe\x64\xec\x97\x0a\x6c\x4b\x69\x6e\xf2\xe5\x81\xaa\xeb\xaf\x4c\x04\x4d\x88\x96\x9a\x4a\xe7\x31\x6c\xfe\x5b\x19\x23\x80\xad\xf3\x5a\x67\x12\xc1\x92\x89\x61\xe3\x9d\xd1\x71\x33\x2f\x3f\xfd\x10\x93\xe0\xa5\x67\xc3\x09\x41\xf3\x0c\xea\x8b\x54\x5f\x5c\x97\x38\x5c\x5e\x98\xe6\x17\xaf\xcd\xce\xe3\x65\x71\x7f\xe1\x60\xe6\x0d\x77\x7a\x88\x29\xe1\xfe\xe7\x0f\xbf\x17\x9b\xf5\x29\xb7\x06\x49\xb9\x7a\x66\x89\x49\x27\xdd\xc9\x4e\x6e\xa7\x22\x38\x24\x3e\x36\x18\xfc\xf2\xeb\xe2\xb7\x00\x00\x00\xff\xff\x27\xd9\x6b\x08\x60\x10\x00\x00")
+var _crdsPluginsFarosSh_networksYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\x6f\x23\xbd\x0d\xbd\xfb\x57\x10\xe8\x61\x5b\x20\x1e\x6f\xd0\x4b\x61\xa0\x87\x20\x6d\x81\xa0\xbb\x8b\x60\xe3\xdd\x3b\x47\xa2\x3d\xda\x68\xa4\xa9\x48\x79\xd7\x2d\xfa\xdf\x0b\x4a\xe3\xb1\x93\x78\xd2\x20\x1f\x3e\x8f\x2f\x23\x69\x1e\xc9\x47\xf2\x51\xcb\xe5\x72\x81\x83\xfb\x4e\x89\x5d\x0c\x6b\xc0\xc1\xd1\x2f\xa1\xa0\x6f\xdc\x3c\xfe\x85\x1b\x17\x57\xfb\xeb\xc5\xa3\x0b\x76\x0d\xb7\x99\x25\xf6\x5f\x89\x63\x4e\x86\xfe\x46\x5b\x17\x9c\xb8\x18\x16\x3d\x09\x5a\x14\x5c\x2f\x00\x30\x84\x28\xa8\xcb\xac\xaf\x00\x26\x06\x49\xd1\x7b\x4a\xcb\x1d\x85\xe6\x31\xb7\xd4\x66\xe7\x2d\xa5\x02\x7e\x34\xbd\xff\xd8\x5c\x7f\x6c\x3e\x2e\x00\x4c\xa2\xf2\xfd\xc6\xf5\xc4\x82\xfd\xb0\x86\x90\xbd\x5f\x00\x04\xec\x69\x0d\x81\xe4\x67\x4c\x8f\xdc\x0c\x3e\xef\x5c\xe0\x66\x8b\x29\x72\xc3\xdd\x82\x07\x32\x6a\x74\x97\x62\x1e\xd6\xf0\x62\xbf\x22\x8c\x7e\xd5\x98\xbe\x54\xb0\xb2\xe2\x1d\xcb\x3f\xcf\x57\x3f\x39\x96\xb2\x33\xf8\x9c\xd0\x9f\x4c\x97\x45\x76\x61\x97\x3d\xa6\x69\x79\x01\xc0\x26\x0e\xb4\x86\x2f\xd8\x13\x0f\x68\xc8\x2e\x00\xc6\x10\x8b\xd9\x25\xa0\xb5\x85\x34\xf4\xf7\xc9\x05\xa1\x74\x1b\x7d\xee\x8f\x64\x2d\xe1\x07\xc7\x70\x8f\xd2\xad\xa1\x39\xd2\xda\xbc\x60\xa4\xd8\x3f\xf2\x71\xb3\xa3\xf1\x5d\x0e\x6a\xdc\xa2\xd4\x85\xba\xbd\xbf\x46\x3f\x74\x78\x5d\x96\xd8\x74\xd4\x97\x3c\xe9\x5b\x1c\x28\xdc\xdc\xdf\x7d\xff\xf3\xc3\x93\x65\x00\x4b\x6c\x92\x1b\xd4\xe6\x44\x06\x38\x06\xe9\x08\xea\x59\xd8\xc6\x54\x5e\x2b\xc9\x70\x73\x7f\x37\x7d\x3d\xa4\x38\x50\x12\x77\x64\xba\x3e\x67\x75\x76\xb6\xfa\xcc\xd6\x07\x75\xa7\x9e\x02\xab\x05\x46\xd5\xe8\xc8\x21\xd9\x31\x02\x88\x5b\x90\xce\x31\x24\x1a\x12\x31\x85\x5a\x72\x4f\x80\x41\x0f\x61\x80\xd8\xfe\x20\x23\x0d\x3c\x50\x52\x18\xe0\x2e\x66\x6f\xb5\x2e\xf7\x94\x04\x12\x99\xb8\x0b\xee\xdf\x13\x36\x83\xc4\x62\xd4\xa3\xd0\x58\x00\xa7\xa7\xe4\x2c\xa0\x87\x3d\xfa\x4c\x57\x80\xc1\x42\x8f\x07\x48\xa4\x56\x20\x87\x33\xbc\x72\x84\x1b\xf8\x1c\x13\x81\x0b\xdb\xb8\x86\x4e\x64\xe0\xf5\x6a\xb5\x73\x72\xec\x2f\x13\xfb\x3e\x07\x27\x87\x55\x69\x15\xd7\x66\x89\x89\x57\x96\xf6\xe4\x57\xec\x76\x4b\x4c\xa6\x73\x42\x46\x72\xa2\x15\x0e\x6e\x59\x5c\x0f\x1a\x30\x37\xbd\xfd\x43\x1a\x3b\x92\x3f\x3c\xf1\xb5\x56\x03\x4b\x72\x61\x77\xb6\x51\xea\xfe\x95\x0c\x68\x07\x68\xae\x71\xfc\xb4\x06\x7a\x22\x5a\x97\x94\x9d\xaf\x7f\x7f\xd8\xc0\xd1\x74\x49\xc6\x13\x50\x18\x79\x3f\x7d\xc8\xa7\x14\x28\x61\x2e\x6c\x49\x4b\xc8\x31\x6c\x53\xec\x0b\xe3\x14\xec\x10\x5d\x90\xf2\x62\xbc\xa3\xf0\x9c\x7e\xce\x6d\xef\x44\xf3\xfe\xaf\x4c\x2c\x9a\xab\x06\x6e\x8b\xe8\x40\x4b\x90\x07\xad\x7e\xdb\xc0\x5d\x80\x5b\xec\xc9\xdf\x22\xd3\xef\x9e\x00\x65\x9a\x97\x4a\xec\xdb\x52\x70\xae\x97\xa7\x9f\xa2\xac\x47\xd6\xce\x36\x8e\xa2\x36\x93\xaf\xb1\x3b\x1f\x06\x32\x4f\x3a\xc6\x12\xbb\xa4\x35\x2d\x28\xa4\x9d\x50\x1b\xf5\x4d\x16\x05\x25\xf3\x1b\x6c\x96\x73\x4f\xac\xc6\x96\xb5\xcb\x5e\x37\x7b\x59\x1f\xc6\x59\x51\xd5\xf1\xc5\xce\x33\x0f\x6e\x73\x4a\x14\x04\x86\x14\x0d\xb1\x2a\xf1\xc9\xa2\xd6\xce\xcd\x8e\x82\x34\x2f\x30\x9c\x50\x7f\x01\xfa\x39\xf8\xd1\x8b\x29\xb4\xa2\x22\x1a\x59\x51\x19\x35\x82\x23\x6d\xa0\xa1\x94\x55\xf4\x17\x70\x2b\x99\xf4\xd2\x93\xd7\x58\xa8\x8f\x47\x96\x4d\xc2\xc0\x85\x10\x95\xfe\xcb\xe7\x9e\x39\xff\x09\x59\x40\x5c\x4f\x25\x1f\x13\xa1\x20\x13\x14\xd9\xda\x70\x31\xd0\x98\xea\x19\x5c\x50\x21\xc4\x10\xa5\xa3\xd4\xc0\xa6\x73\x93\x76\xb6\x04\x3f\x3b\x0a\xc5\x44\x0e\x96\x92\x3f\x68\x0a\x4e\xd6\x4c\x87\x61\x47\xf6\x52\xdc\xf5\xb9\xd3\x3c\xa1\xa8\xd0\x68\xeb\x3e\x86\xf8\x33\x5c\x29\x5e\x80\xcc\x47\x89\x29\x61\x4c\x86\x6e\xee\xef\x60\xeb\xc8\xdb\x59\xd0\xd1\xaa\x82\xa2\x31\x34\x08\xb6\xfe\x22\xf7\xfa\xdf\xc6\xd4\xa3\xd4\x71\xb9\x54\x4b\x33\xe7\x66\x7a\xf8\xf4\xf4\xc4\x8c\xbb\xb7\x65\xe7\x06\xba\xdc\x63\x80\x44\x68\xd5\xb9\xe3\xc7\xe0\x82\x75\x06\x45\x23\xb7\x24\xe8\x3c\x03\xb6\x31\xcb\xe2\x22\xa6\xba\xd5\xd1\x59\x4e\xc7\xf4\x14\x7a\xca\x38\x6a\x09\xa8\x1f\xe4\xd0\xbc\x37\xaa\x44\xc8\xcf\x47\xf5\x4c\x50\x9b\x8e\x34\x20\x8e\x61\xba\x15\x4c\x95\xf0\x81\x4b\x21\x9f\xb9\x3a\x83\xa8\x93\xf5\x5c\xb2\x15\x54\xa5\xcf\x6d\x9d\xd1\xdb\x45\x89\xca\x74\x31\x72\xa9\x3d\xad\x49\x88\xa9\x14\xcf\x85\xd9\x73\x7a\x2a\x25\x8e\x75\xde\xb3\xb3\xa4\x9a\x88\xb0\xcb\x98\x30\x08\x91\x55\xec\x17\xec\x29\x6a\x3b\x57\x10\xf0\x1b\x99\x65\xda\x53\x72\x72\x78\x13\xb7\x0f\xe3\x61\x95\x8b\xbd\xb3\x55\x8b\xe8\xd7\xe0\x9d\x71\x02\xc6\x23\xb3\x32\x74\xd4\xa5\x19\x48\x80\xaf\x35\x3f\x26\x5a\xba\x02\xae\xf7\x9b\xcc\x3a\x8b\x63\x82\x1e\x4d\x57\x74\xce\x60\x00\xd7\xf7\x64\x1d\x0a\xf9\x43\xed\x6d\x16\x0c\xf3\x3d\xa7\x40\x66\x54\x63\x76\x92\xab\x27\x7a\x2b\x42\x23\x80\xc6\xc4\x64\x5d\xd8\xf9\x83\x92\x4c\xa7\x78\x5e\xef\xe4\xcf\xdf\x1e\x36\x3a\xcf\x99\x04\x62\xf0\x07\x4d\x79\x80\x87\xa2\x56\x7f\xfd\x07\x7a\xa6\xf7\xd3\x7f\x61\xba\xcd\x91\x5f\x8e\x1e\x67\xca\x54\xd3\x57\x45\x3a\xe3\x16\x36\x49\x6f\x80\xc5\x9d\x2b\xf8\x16\x8a\x88\xbd\xdb\xaf\x72\xe0\x2d\x5e\x6d\x0e\x43\xb1\x3e\xf9\xf3\xa4\x73\xb4\x29\x5c\x80\x6d\x8c\x0d\xfd\xc2\x7e\xf0\xd4\x98\xd8\xaf\x4e\x9d\x35\x63\x02\xe0\x33\x86\x03\x34\x13\x6a\xa3\x0e\xd5\xcb\x1f\x03\xa6\x12\x3f\x3b\x16\x1d\xbb\x68\x52\x64\x9e\x6e\x7f\xf3\xdd\xe7\xdd\x23\xc1\xcd\x1e\x9d\x57\xb5\xbb\x82\x36\x6b\x63\x19\xcc\x4c\x80\xa9\x75\x92\x30\x1d\x4e\xcc\xd6\x0a\xd4\x7b\x1c\xd3\x36\x5f\x1e\xa8\xfa\xfc\x91\x89\xa0\x09\xd1\x52\x53\x27\xd8\xc9\x6d\xfe\x53\x19\x23\x80\xad\xf3\xda\x37\x12\xc1\x92\x89\x61\xeb\x9d\xd1\x71\x33\x8b\xe9\xfa\x21\x26\xc1\x20\xef\xcc\xa0\xde\x48\xf5\xc6\x75\xa9\xb2\x96\x17\xa6\xf9\xc5\x63\xb3\xf3\x78\x59\x0a\xfb\xc2\xc6\xcc\x1d\xee\x7c\x13\x53\xc2\xc3\xe2\xff\x7e\xf4\x62\x51\x2f\x3c\x64\xd7\x20\x29\x57\x2d\x64\x89\x49\x27\xdd\xd9\x4a\x6e\xa7\x22\x38\x06\xce\x82\x92\x79\x0d\xff\xf9\xef\xe2\x7f\x03\x00\x27\xd9\x6b\x08\x60\x10\x00\x00")
 
 func crdsPluginsFarosSh_networksYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -501,7 +507,7 @@ func crdsPluginsFarosSh_networksYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsPluginsFarosSh_notificationsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\x1b\x39\x12\xbd\xeb\x57\x14\xb0\x87\xec\x02\x56\x2b\xc6\x5e\x16\x02\xf6\x60\x78\x66\x00\x63\x92\xc0\x88\x9d\xdc\xab\xc9\x92\x9a\x31\x9b\xec\x61\x15\x95\x68\x06\xf3\xdf\x07\x45\xb6\x3e\x2c\xa9\x1d\x23\x83\xe1\xad\x49\x76\x7d\xbd\x7a\xaf\x38\x9f\xcf\x67\x38\xb8\xcf\x94\xd8\xc5\xb0\x04\x1c\x1c\x7d\x13\x0a\xfa\xc5\xcd\xd3\xff\xb8\x71\x71\xb1\xb9\x9e\x3d\xb9\x60\x97\x70\x9b\x59\x62\xff\x91\x38\xe6\x64\xe8\x27\x5a\xb9\xe0\xc4\xc5\x30\xeb\x49\xd0\xa2\xe0\x72\x06\x80\x21\x44\x41\xdd\x66\xfd\x04\x30\x31\x48\x8a\xde\x53\x9a\xaf\x29\x34\x4f\xb9\xa5\x36\x3b\x6f\x29\x15\xe3\x3b\xd7\x9b\xb7\xcd\xf5\xdb\xe6\xed\x0c\xc0\x24\x2a\xff\x3f\xba\x9e\x58\xb0\x1f\x96\x10\xb2\xf7\x33\x80\x80\x3d\x2d\x21\x44\x71\x2b\x67\xaa\x8f\x66\xf0\x79\xed\x02\x37\x2b\x4c\x91\x1b\xee\x66\x3c\x90\x51\xcf\xeb\x14\xf3\xb0\x84\xb3\xf3\x6a\x66\x0c\xae\x26\xf6\xe1\xc8\x62\xd9\xf6\x8e\xe5\xd7\xb3\xa3\x77\x8e\xa5\x1c\x0f\x3e\x27\xf4\x27\x91\x94\x13\x76\x61\x9d\x3d\xa6\xe7\x67\x33\x00\x36\x71\xa0\x25\x7c\x50\xd7\x03\x1a\xb2\x33\x80\x31\xf7\x12\xca\x1c\xd0\xda\x52\x4d\xf4\xf7\xc9\x05\xa1\x74\x1b\x7d\xee\x77\x55\x9c\xc3\x17\x8e\xe1\x1e\xa5\x5b\x42\xb3\xab\x77\x73\x56\xaa\x72\x77\x57\xa8\x9b\x35\x8d\xdf\xb2\x55\xe7\x16\xa5\x6e\xd4\xe3\xcd\x35\xfa\xa1\xc3\xeb\x1a\xb8\xe9\xa8\xc7\xe5\x78\x3f\x0e\x14\x6e\xee\xef\x3e\xff\xf7\xe1\xd9\x36\x80\x25\x36\xc9\x0d\x52\x20\x3b\xae\x0d\x38\x06\xe9\x08\xea\x0f\xb0\x8a\xa9\x7c\xd6\xea\xc3\xcd\xfd\xdd\xde\xc4\x90\xe2\x40\x49\xdc\x0e\x82\xba\x8e\xba\xf0\x68\xf7\xc4\xe1\x1b\x8d\xa9\xde\x02\xab\xed\x47\xd5\xe9\x58\x48\xb2\x63\x1a\x10\x57\x20\x9d\x63\x48\x34\x24\x62\x0a\x72\x80\xf6\xb0\xe2\x0a\x30\x40\x6c\xbf\x90\x91\x06\x1e\x28\xa9\x19\xe0\x2e\x66\x6f\xb5\x6b\x37\x94\x04\x12\x99\xb8\x0e\xee\xf7\xbd\x6d\x06\x89\xc5\xa9\x47\xa1\xb1\x1f\x0e\xab\x00\x17\xd0\xc3\x06\x7d\xa6\x2b\xc0\x60\xa1\xc7\x2d\x24\x52\x2f\x90\xc3\x91\xbd\x72\x85\x1b\x78\x1f\x13\x81\x0b\xab\xb8\x84\x4e\x64\xe0\xe5\x62\xb1\x76\xb2\x63\x9f\x89\x7d\x9f\x83\x93\xed\xa2\x10\xc9\xb5\x59\x62\xe2\x85\xa5\x0d\xf9\x05\xbb\xf5\x1c\x93\xe9\x9c\x90\x91\x9c\x68\x81\x83\x9b\x97\xd0\x43\x65\x47\x6f\xff\x95\x46\xbe\xf2\x9b\x67\xb1\xd6\x96\x60\x49\x2e\xac\x8f\x0e\x0a\x21\x5e\x40\x40\x59\xa1\x58\xe3\xf8\x6b\xcd\xe2\x50\x68\xdd\xd2\xea\x7c\xfc\xf9\xe1\x11\x76\xae\x0b\x18\xa7\xd5\x2f\x75\x3f\xfc\xc8\x07\x08\xb4\x60\x2e\xac\x28\x55\x10\x57\x29\xf6\xc5\x26\x05\x3b\x44\x17\xa4\x7c\x18\xef\x28\x9c\x96\x9f\x73\xdb\x3b\x51\xdc\x7f\xcb\xc4\xa2\x58\x35\x70\x5b\x24\x09\x5a\x82\x3c\x28\x05\x6c\x03\x77\x01\x6e\xb1\x27\x7f\x8b\x4c\xff\x38\x00\x5a\x69\x9e\x6b\x61\x5f\x07\xc1\xb1\x9a\x9e\x5e\xae\x55\x3b\x3a\xd8\xa9\xdd\x04\x5e\xc7\x14\x7d\x18\xc8\x3c\xa3\x8d\x25\x76\x49\x1b\x5b\x50\x48\xe9\x50\xd9\xfa\x2a\xb7\x82\x92\xf9\xb5\x8e\xcb\xe5\x67\xae\x63\xcb\x0a\xf6\xcb\xbe\x2f\x2b\x05\xd4\x99\x52\xc5\xf2\xec\xe4\x24\x8c\xdb\x9c\x12\x05\x51\x53\x86\x58\xd5\xf9\xe0\xf1\xa0\x4f\xcd\x99\x11\x27\xd4\x5f\xb0\x7d\x6a\x7d\x17\xc6\x3e\xb7\x22\x28\x9a\x5a\x15\x45\x95\x98\x5d\xa7\x6b\x2e\x58\x05\xfe\x82\xdd\x5a\x52\x3a\x8f\xe4\xa5\x32\xd4\xe5\x91\xe5\x31\x61\x60\xb7\x1b\x05\x97\xef\x9d\x04\xff\x0e\x59\x40\x5c\x4f\x95\x4e\xfb\x54\x64\x6f\x8a\x6c\xe5\x5e\x0c\x34\x02\x3e\x61\x17\x54\x13\x31\x44\xe9\x28\x35\xf0\xa8\xa4\x1d\x65\xb4\x25\xf8\xda\x51\x28\x2e\x72\xb0\x94\xfc\x56\x31\x38\x78\x33\x1d\x86\x35\xd9\x4b\x79\xd7\x75\xa7\x40\xa1\xa8\xe6\x28\x8b\x9f\x42\xfc\x1a\xae\xd4\x5e\x80\xcc\x3b\xb5\x29\x69\xec\x1d\xdd\xdc\xdf\xc1\xca\x91\xb7\x93\x46\x47\xaf\x45\xc8\x8c\xa1\x41\xb0\xf5\x17\x6b\xaf\x6b\x15\x53\x8f\x52\xc7\xe7\x5c\x3d\x4d\xdc\x9b\xa0\xf3\x61\xf5\xc4\x8c\xeb\xd7\xa1\x73\x03\x5d\xee\x31\x40\x22\xb4\x1a\xdc\xee\x67\x70\xc1\x16\x5a\x85\x35\x58\x12\x74\x9e\x01\xdb\x98\x4f\x95\xf0\x28\x2c\xad\xcf\x1e\xd3\x11\x9e\x52\x9e\x22\xb4\x2d\x01\xf5\x83\x6c\xa7\xb2\xff\x6e\x56\x89\x90\x4f\xa7\xf6\x44\x52\x8f\x1d\x8d\xd7\xf7\x0f\x84\x7d\x27\xbc\xe1\xd2\xc8\x47\xa1\x4e\x66\xe4\x9e\xa9\xb7\x1a\x55\x15\x54\xb9\x29\xd0\x6b\x56\xa6\x8b\x91\x4b\x4b\x68\x4f\x42\x4c\xa5\x79\x2e\x8c\xa1\xc3\xaa\x25\x71\xac\x21\xb1\xb3\xa4\xca\x88\xb0\xce\x98\x30\x08\x91\x55\xdb\x67\xd5\xab\x83\x65\xd2\xe6\xdf\xab\x2c\xd3\x86\x92\x93\xed\xab\x6a\xfb\x30\x5e\x56\xb9\xd8\x38\x5b\xb5\x88\xbe\x0d\xde\x19\x27\x60\x3c\x32\x1f\x1e\x6b\x71\x35\x19\xf2\xc7\x8a\x8f\x89\x96\xae\x80\xeb\x53\x27\xb3\x8e\xe5\x98\xa0\x47\xd3\x15\x9d\x33\x18\xc0\xf5\x3d\x59\x87\x42\x7e\x5b\xb9\xcd\x82\x61\x9a\x73\x05\xed\x51\x8e\xd9\x49\xae\x91\xe8\x03\x09\x8d\x28\x0d\x63\xb2\x2e\xac\xfd\xb6\x42\xba\xcf\xe7\x65\x26\xbf\xff\xf4\xf0\xa8\x3d\xcc\x24\x10\x83\xdf\x56\x15\xa8\x13\xe7\xff\xbf\xa0\xe7\x49\x5a\x7f\xbf\xfc\x17\x66\xdc\x54\xf1\xeb\x84\x1b\x87\xca\xbe\xa7\xaf\x8a\x74\xc6\x15\x3c\x26\x7d\x0c\x96\x70\xae\xe0\x53\x28\x22\xf6\xc3\x71\x95\x0b\xaf\xa2\xdb\x76\x28\xde\x0f\x6a\x7b\xcc\x1c\xc5\xd3\x29\x11\x63\x43\xdf\xb0\x1f\x3c\x35\x26\xf6\x8b\x03\xb3\xa6\x6b\x8e\x61\x0b\xcd\xde\x6a\xa3\x01\x8d\xaf\x59\xc0\x44\x95\x40\x2c\x0a\x34\x9a\x14\x99\xf7\x0f\xc1\x69\xf6\x79\xf7\x44\x70\xb3\x41\xe7\x55\xed\xae\xa0\xcd\x4a\x2c\x83\x99\x09\x30\xb5\x4e\x12\xa6\xed\xd1\xdc\x2f\x1d\xd8\x96\xd6\x5c\xe5\xcb\x03\x55\xd7\xbf\x99\x08\x9a\x10\x2d\x35\x15\xce\x43\xd8\xfc\x9f\x32\x46\x00\x5b\xe7\xb5\xcf\x24\x82\x25\x13\xc3\xca\x3b\xa3\xe3\x66\x5a\x7e\xfa\x21\x26\xc1\x20\x3f\x88\xa0\x3e\x4e\xf5\xdd\x75\x09\xc3\xf9\x85\x69\x7e\xf1\xda\xe4\x3c\x9e\x17\xf7\x17\x0e\x26\x5e\x72\xc7\x87\x98\x12\x6e\xbf\xff\xfc\x3b\xdb\xac\x6f\xb9\x25\x48\xca\xd5\x33\x4b\x4c\x3a\xe9\x8e\x76\x72\xbb\x6f\x82\x5d\xe2\x23\xc1\xe0\x8f\x3f\x67\x7f\x05\x00\x00\xff\xff\xd6\x36\xc4\xec\x89\x10\x00\x00")
+var _crdsPluginsFarosSh_notificationsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\x8f\xe3\xbc\x0d\xbd\xe7\x57\x10\xe8\x61\x5b\x60\xe2\xec\xa0\x97\x22\x40\x0f\x83\x69\x0b\x0c\xba\xbb\x18\xec\x64\xf7\x4e\x4b\x74\xac\x1d\x59\x72\x45\x2a\xbb\x69\xd1\xff\x5e\x50\x76\xe2\x64\x12\x4f\x07\xf3\xe1\x8b\x73\xb1\x24\x3f\x92\x8f\xe4\xa3\x96\xcb\xe5\x02\x7b\xf7\x9d\x12\xbb\x18\xd6\x80\xbd\xa3\x5f\x42\x41\xdf\xb8\x7a\xfe\x0b\x57\x2e\xae\x76\xb7\x8b\x67\x17\xec\x1a\xee\x33\x4b\xec\xbe\x12\xc7\x9c\x0c\xfd\x8d\x1a\x17\x9c\xb8\x18\x16\x1d\x09\x5a\x14\x5c\x2f\x00\x30\x84\x28\xa8\xcb\xac\xaf\x00\x26\x06\x49\xd1\x7b\x4a\xcb\x2d\x85\xea\x39\xd7\x54\x67\xe7\x2d\xa5\x02\x7e\x30\xbd\xfb\x58\xdd\x7e\xac\x3e\x2e\x00\x4c\xa2\xf2\xfd\xc6\x75\xc4\x82\x5d\xbf\x86\x90\xbd\x5f\x00\x04\xec\x68\x0d\x21\x8a\x6b\x9c\x29\x67\xb8\xea\x7d\xde\xba\xc0\x55\x83\x29\x72\xc5\xed\x82\x7b\x32\x6a\x79\x9b\x62\xee\xd7\x70\xb1\x3f\xc0\x8c\xce\x0d\x81\x7d\x39\x41\x2c\xcb\xde\xb1\xfc\xf3\x62\xeb\x93\x63\x29\xdb\xbd\xcf\x09\xfd\x0b\x4f\xca\x0e\xbb\xb0\xcd\x1e\xd3\xf9\xde\x02\x80\x4d\xec\x69\x0d\x5f\xb0\x23\xee\xd1\x90\x5d\x00\x8c\xb1\x17\x57\x96\x80\xd6\x16\x36\xd1\x3f\x26\x17\x84\xd2\x7d\xf4\xb9\x3b\xb0\xb8\x84\x1f\x1c\xc3\x23\x4a\xbb\x86\xea\xc0\x77\x75\x41\x55\x71\xe2\x40\xd4\xdd\x96\xc6\x77\xd9\xab\x71\x8b\x32\x2c\x0c\xdb\xbb\x5b\xf4\x7d\x8b\xb7\x65\x89\x4d\x4b\x5d\x49\xa0\xbe\xc5\x9e\xc2\xdd\xe3\xc3\xf7\x3f\x3f\x9d\x2d\x03\x58\x62\x93\x5c\xaf\x36\xcf\xb9\x01\xc7\x20\x2d\xc1\xf0\x01\x34\x31\x95\xd7\x81\x7d\xb8\x7b\x7c\x38\x42\xf4\x29\xf6\x94\xc4\x1d\x52\x30\x3c\x27\x55\x78\xb2\xfa\xc2\xe0\x07\xf5\x69\x38\x05\x56\xcb\x8f\x06\xa3\x23\x91\x64\xc7\x30\x20\x36\x20\xad\x63\x48\xd4\x27\x62\x0a\x32\xa5\x76\x7a\x62\x03\x18\x20\xd6\x3f\xc8\x48\x05\x4f\x94\x14\x06\xb8\x8d\xd9\x5b\xad\xda\x1d\x25\x81\x44\x26\x6e\x83\xfb\xf7\x11\x9b\x41\x62\x31\xea\x51\x68\xac\x87\xe9\x29\x89\x0b\xe8\x61\x87\x3e\xd3\x0d\x60\xb0\xd0\xe1\x1e\x12\xa9\x15\xc8\xe1\x04\xaf\x1c\xe1\x0a\x3e\xc7\x44\xe0\x42\x13\xd7\xd0\x8a\xf4\xbc\x5e\xad\xb6\x4e\x0e\xdd\x67\x62\xd7\xe5\xe0\x64\xbf\x2a\x8d\xe4\xea\x2c\x31\xf1\xca\xd2\x8e\xfc\x8a\xdd\x76\x89\xc9\xb4\x4e\xc8\x48\x4e\xb4\xc2\xde\x2d\x8b\xeb\x41\x03\xe6\xaa\xb3\x7f\x48\x63\xbf\xf2\x87\x33\x5f\x87\x92\x60\x49\x2e\x6c\x4f\x36\x4a\x43\xbc\x92\x01\xed\x0a\xcd\x35\x8e\x9f\x0e\x81\x4e\x44\xeb\x92\xb2\xf3\xf5\xef\x4f\x1b\x38\x98\x2e\xc9\x38\x03\x85\x91\xf7\xe9\x43\x9e\x52\xa0\x84\xb9\xd0\x90\x96\x90\x63\x68\x52\xec\x0a\xe3\x14\x6c\x1f\x5d\x90\xf2\x62\xbc\xa3\xf0\x92\x7e\xce\x75\xe7\x44\xf3\xfe\xaf\x4c\x2c\x9a\xab\x0a\xee\x8b\x24\x41\x4d\x90\x7b\x6d\x01\x5b\xc1\x43\x80\x7b\xec\xc8\xdf\x23\xd3\xef\x9e\x00\x65\x9a\x97\x4a\xec\xdb\x52\x70\xaa\xa6\xd3\x4f\x51\xd6\x23\x6b\x27\x1b\x07\xb5\x9b\xc9\xd7\x69\x8b\x3e\xf5\x64\xce\xda\xc6\x12\xbb\xa4\x85\x2d\x28\xa4\x3d\x33\x74\xeb\x9b\xcc\x0a\x4a\xe6\xb7\x1a\x2e\x87\xcf\x4c\xc7\x9a\xb5\xdf\x5e\xb7\x7d\x5d\x29\xc6\x99\x32\x88\xe5\xc5\xce\x0b\x37\xee\x73\x4a\x14\x04\xfa\x14\x0d\xb1\xaa\xf3\x64\x71\xd2\xa7\xea\x02\xc4\x09\x75\x57\xb0\x5f\xa2\x1f\xdc\x38\xc6\x56\x04\x45\x43\x2b\x82\xa3\x56\x70\x24\x0f\x34\x96\xb2\x8a\xfe\x0a\xee\x40\x29\x5d\x7a\xf2\x1a\x0d\xc3\xe3\x91\x65\x93\x30\x70\x61\x44\x47\xc1\xf5\x73\x2f\x9c\xff\x84\x2c\x20\xae\xa3\x92\x90\x23\xa3\x20\x47\x28\xb2\x43\xef\xc5\x40\x63\xc2\x67\x70\x41\x35\x11\x43\x94\x96\x52\x05\x9b\xd6\x1d\x65\xb4\x26\xf8\xd9\x52\x28\x26\x72\xb0\x94\xfc\x5e\x73\x30\x59\x33\x2d\x86\x2d\xd9\x6b\x71\x0f\xcf\x83\x26\x0a\x45\x35\x47\xbb\xf8\x39\xc4\x9f\xe1\x46\xf1\x02\x64\x3e\xa8\x4d\x09\xe3\x68\xe8\xee\xf1\x01\x1a\x47\xde\xce\x82\x8e\x56\x15\x14\x8d\xa1\x5e\xb0\xf6\x57\xb9\xd7\x7f\x13\x53\x87\x32\x8c\xcf\xa5\x5a\x9a\x39\x37\xd3\xce\xd3\xd3\x11\x33\x6e\xdf\x96\x9d\x3b\x68\x73\x87\x01\x12\xa1\x55\xe7\x0e\x1f\x83\x0b\xb6\xb4\x55\xd8\x82\x25\x41\xe7\x19\xb0\x8e\x59\x16\x57\x31\xd5\xad\x96\x4e\x72\x3a\xa6\xa7\xd0\x53\x26\x53\x4d\x40\x5d\x2f\xfb\xea\xbd\x51\x25\x42\x7e\x39\xb5\x67\x82\xda\xb4\xa4\x01\x71\x0c\xc7\x0b\xc2\xb1\x12\x3e\x70\x29\xe4\x13\x57\x67\x10\x75\xc8\x9e\xaa\xb7\x82\xaa\x0a\xba\xc6\x19\xbd\x68\x94\xa8\x4c\x1b\x23\x97\xda\xd3\x9a\x84\x98\xf4\x3e\x76\x6d\x0c\x4d\xbf\x81\x12\xc7\x3a\xfa\xd9\x59\x52\x65\x44\xd8\x66\x4c\x18\x84\xc8\x2a\xf6\x05\x7b\x8a\x5a\xcf\x15\x04\xfc\x46\x66\x99\x76\x94\x9c\xec\xdf\xc4\xed\xd3\x78\x58\xe5\x62\xe7\xec\xa0\x45\xf4\xab\xf7\xce\x38\x01\xe3\x91\xf9\x28\xc8\x10\x9b\x19\x48\x80\xaf\x43\x7e\x4c\xb4\x74\x03\x3c\x5c\x75\x32\xeb\x58\x8e\x09\x3a\x34\x6d\xd1\x39\x83\x01\x5c\xd7\x91\x75\x28\xe4\xf7\x43\x6f\xb3\x60\x98\xef\x39\x05\x32\xa3\x1c\xb3\x93\x3c\x78\xa2\x17\x24\x34\x02\x68\x4c\x4c\xd6\x85\xad\xdf\x2b\xc9\x34\xc5\xf3\x7a\x27\x7f\xfe\xf6\xb4\xd1\xd1\xce\x24\x10\x83\xdf\x6b\xca\x03\x3c\x15\xb5\xfa\xeb\x3f\xd0\x33\xbd\x9f\xfe\x2b\x33\x6e\x8e\xfc\x72\xf4\x30\x54\x8e\x35\x7d\x53\xa4\x33\x36\xb0\x49\x7a\x19\x2c\xee\xdc\xc0\xb7\x50\x44\xec\xdd\x7e\x95\x03\x6f\xf1\x6a\xb3\xef\x8b\xf5\xa3\x3f\x67\x9d\xa3\x4d\xe1\x02\x34\x31\x56\xf4\x0b\xbb\xde\x53\x65\x62\xb7\x9a\x3a\x6b\xc6\x04\xc0\x67\x0c\x7b\xa8\x8e\xa8\x95\x3a\x34\xdc\x03\x19\x30\x95\xf8\xd9\xb1\xe8\xdc\x45\x93\x22\xf3\xf1\x22\x38\xdf\x7d\xde\x3d\x13\xdc\xed\xd0\x79\x55\xbb\x1b\xa8\xb3\x36\x96\xc1\xcc\x04\x98\x6a\x27\x09\xd3\x7e\x62\x76\xa8\x40\xbd\xd2\x31\x35\xf9\xfa\x40\xd5\xe7\x8f\x4c\x04\x55\x88\x96\xaa\x61\x82\x4d\x6e\xf3\x9f\xca\x18\x01\xac\x9d\xd7\xbe\x91\x08\x96\x4c\x0c\x8d\x77\x46\xc7\xcd\x2c\xa6\xeb\xfa\x98\x04\x83\xbc\x33\x83\x7a\x39\xd5\x7b\xd7\xb5\xca\x5a\x5e\x99\xe6\x57\x8f\xcd\xce\xe3\x65\x29\xec\x2b\x1b\x33\x37\xb9\xd3\x4d\x4c\x09\xf7\x8b\xff\xfb\xd1\xc5\xa2\x5e\x78\xc8\xae\x41\x52\x1e\xb4\x90\x25\x26\x9d\x74\x27\x2b\xb9\x3e\x16\xc1\x21\x70\x16\x94\xcc\x6b\xf8\xcf\x7f\x17\xff\x1b\x00\xd6\x36\xc4\xec\x89\x10\x00\x00")
 
 func crdsPluginsFarosSh_notificationsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -521,7 +527,47 @@ func crdsPluginsFarosSh_notificationsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsTenancyFarosSh_usersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4f\x6f\xeb\xb8\x11\xbf\xfb\x53\x0c\xd0\xc3\x6b\x81\x58\x79\x0f\xbd\x14\x06\x7a\x08\xb2\x5b\x20\xe8\x6e\x11\xbc\xe4\xed\x7d\x4c\x8e\x2d\x6e\x28\x52\xe5\x0c\xbd\x4f\x2d\xfa\xdd\x8b\x21\x25\xd9\xb1\xad\x24\xd8\xa2\xbc\x89\x1c\xce\xbf\xdf\xcc\x6f\xa8\xf5\x7a\xbd\xc2\xde\xfd\x42\x89\x5d\x0c\x1b\xc0\xde\xd1\x77\xa1\xa0\x5f\xdc\xbc\xfc\x85\x1b\x17\x6f\x0f\x5f\x56\x2f\x2e\xd8\x0d\xdc\x67\x96\xd8\x7d\x25\x8e\x39\x19\xfa\x81\x76\x2e\x38\x71\x31\xac\x3a\x12\xb4\x28\xb8\x59\x01\x60\x08\x51\x50\xb7\x59\x3f\x01\x4c\x0c\x92\xa2\xf7\x94\xd6\x7b\x0a\xcd\x4b\xde\xd2\x36\x3b\x6f\x29\x15\xe5\x93\xe9\xc3\xe7\xe6\xcb\xe7\xe6\xf3\x0a\xc0\x24\x2a\xf7\x9f\x5d\x47\x2c\xd8\xf5\x1b\x08\xd9\xfb\x15\x40\xc0\x8e\x36\x90\x99\x12\x37\x42\x01\x83\x19\x9a\x1d\xa6\xc8\x0d\xb7\x2b\xee\xc9\xa8\xc5\x7d\x8a\xb9\xdf\xc0\xc5\x79\xbd\x3e\x3a\x55\x03\xfa\xc6\x94\xca\xa7\x77\x2c\x7f\x9f\xb7\x7e\x72\x2c\x65\xbb\xf7\x39\xa1\x1f\x2d\x96\x1d\x76\x61\x9f\x3d\xa6\xba\xb7\x02\x60\x13\x7b\xda\xc0\xbd\xcf\x2c\x65\x63\x0c\xa8\xd8\x59\x03\x5a\x5b\x52\x84\xfe\x31\xb9\x20\x94\xee\xa3\xcf\xdd\x94\x9a\x35\xfc\xca\x31\x3c\xa2\xb4\x1b\x68\xa6\x24\x36\x17\xf1\x17\xd9\x29\xfa\xbb\x3d\x8d\xdf\x32\xa8\x65\x8b\x52\x37\xea\xf1\xe1\x0b\xfa\xbe\xc5\x2f\xd5\x5b\xd3\x52\x87\x9b\x51\x3e\xf6\x14\xee\x1e\x1f\x7e\xf9\xf3\xd3\xab\x6d\x00\x4b\x6c\x92\xeb\xa5\xe0\xa0\x09\x00\xc7\x20\x2d\x41\x15\x84\x5d\x4c\xe5\xb3\x1c\xdd\x3d\x3e\xcc\x17\xfb\x14\x7b\x4a\xe2\xa6\xac\xd6\x75\x52\x50\x27\xbb\x67\x66\x3e\xa9\x27\x55\x0a\xac\x56\x12\x55\x93\x63\xfa\xc8\x8e\xce\x43\xdc\x81\xb4\x8e\x21\x51\x9f\x88\x29\xd4\xda\x7a\xa5\x18\x54\x08\x03\xc4\xed\xaf\x64\xa4\x81\x27\x4a\xaa\x06\xb8\x8d\xd9\x5b\x2d\xc0\x03\x25\x81\x44\x26\xee\x83\xfb\xd7\xac\x9b\x41\x62\x31\xea\x51\x68\x84\xfc\xb8\x0a\x5c\x01\x3d\x1c\xd0\x67\xba\x01\x0c\x16\x3a\x1c\x20\x91\x5a\x81\x1c\x4e\xf4\x15\x11\x6e\xe0\xe7\x98\x08\x5c\xd8\xc5\x0d\xb4\x22\x3d\x6f\x6e\x6f\xf7\x4e\xa6\x46\x32\xb1\xeb\x72\x70\x32\xdc\x96\x9e\x70\xdb\x2c\x31\xf1\xad\xa5\x03\xf9\x5b\x76\xfb\x35\x26\xd3\x3a\x21\x23\x39\xd1\x2d\xf6\x6e\x5d\x5c\x0f\xa5\x99\x9a\xce\xfe\x21\x8d\xad\xc7\x9f\x5e\xf9\x5a\x0b\x81\x25\xb9\xb0\x3f\x39\x28\x35\xfe\x06\x02\x5a\xf0\x8a\x34\x8e\x57\x6b\x14\xc7\x44\xeb\x96\x66\xe7\xeb\x8f\x4f\xcf\x30\x99\x2e\x60\x9c\x67\xbf\xe4\xfd\x78\x91\x8f\x10\x68\xc2\x5c\xd8\x51\xaa\x20\xee\x52\xec\x8a\x4e\x0a\xb6\x8f\x2e\x48\xf9\x30\xde\x51\x38\x4f\x3f\xe7\x6d\xe7\x44\x71\xff\x67\x26\x16\xc5\xaa\x81\xfb\xc2\x2e\xb0\x25\xc8\xbd\x16\xbe\x6d\xe0\x21\xc0\x3d\x76\xe4\xef\x91\xe9\xff\x0e\x80\x66\x9a\xd7\x9a\xd8\x8f\x41\x70\x4a\x8c\xe7\xc2\x35\x6b\x27\x07\x13\x81\x2d\xe0\xa5\xdd\xf7\xd4\x93\x79\xd5\x2e\x96\xd8\x25\x2d\x68\x41\x21\x6d\x83\x3c\x51\xda\xb4\xae\xf7\x68\xd1\xee\xb8\xf7\x38\xfc\x43\x79\xe3\xec\xe8\xcc\xf4\x0f\x47\xc9\x89\x1a\xd4\xce\x27\x9e\x74\x14\xf2\xb9\xd0\xb1\x90\x14\x5d\xd4\xa1\xf3\xef\x58\xfd\x51\x65\x26\x7b\xe5\x82\xd2\x69\x22\xe6\x4a\x0a\x74\x19\xec\x3b\x56\xfb\x14\x0f\xce\x52\x7a\xc7\xf0\xe3\x28\x36\xd9\x76\x56\x4b\x40\x86\xf9\xfe\xef\xb3\xbf\x04\xbb\xa0\x64\x7e\x0f\xf8\x22\xf4\x0a\xfa\xb8\x65\x6d\xb2\x13\xec\xbf\x7d\x1c\x7b\x13\x43\x1d\x4c\x17\x27\x67\xc6\xef\x73\x4a\x14\x44\x55\x19\x62\x1d\x7f\x47\x7b\xea\xc5\xdd\x9e\x82\x34\x17\x3a\x9c\x50\x77\x45\xf5\xb9\xf2\xc9\x8b\x39\xb0\xc2\xe2\x1a\x57\x61\xf9\xc2\xeb\x13\xbd\x68\x28\x58\x67\xe9\x15\xbd\x35\x8f\x74\xe9\xc9\x5b\x59\xa8\xcb\x23\xcb\x73\xc2\xc0\x6e\x9a\xba\xd7\xe5\xce\x9c\xff\x09\x59\x40\x5c\x47\x95\xc3\xe6\x50\x64\x56\x45\xb6\x12\x5e\x0c\x34\xa2\xbc\xa0\x17\x74\x10\x61\x88\xd2\x52\x6a\xe0\x59\x99\x72\x9c\x5d\x5b\x82\xdf\x5a\x0a\xb5\xda\x82\xa5\xe4\x07\x85\xe0\x68\xcd\xb4\x18\xf6\x64\xaf\xc5\x5d\xd7\x83\xe2\x84\xa2\xb5\xac\xd4\xf9\x12\xe2\x6f\xe1\x46\xf5\x05\xc8\x3c\x51\x7c\x09\x63\x36\x74\xf7\xf8\x00\x3b\x47\xde\x2e\x2a\x1d\xad\x96\xe9\x61\x0c\xf5\x82\x5b\x7f\x35\xf7\xba\x76\x31\x75\x28\xf5\xa5\xb2\x56\x4b\x0b\x72\x6f\x34\x6e\x5d\x1d\x31\xe3\xfe\x63\xe8\xdc\x41\x9b\x3b\x0c\x90\x08\xad\x3a\x37\x5d\x06\x17\xac\x33\x58\x86\x9b\x25\x41\xe7\x19\x70\x1b\xf3\xf9\xf8\x39\x71\x4b\xf3\x33\x63\x3a\xc2\x53\xd2\x53\xa6\xdb\x56\xb9\xa9\x97\x61\x29\xfa\x77\xa3\x4a\x84\x7c\xfe\x54\x5a\x08\xea\xb9\xa5\x51\x7c\x7e\x93\xcd\x95\xf0\x89\x4b\x21\x9f\xb8\xba\x18\x91\x7b\x35\x32\x55\xa9\x8e\x1e\xb7\x73\xa6\x40\xaf\x51\x99\x36\x46\x2e\x25\xa1\x35\x09\x31\x95\xe2\xb9\x32\xfb\x8f\xab\xa6\xc4\xb1\xba\xc4\xca\x92\x64\x01\x61\x9f\x31\x61\x10\x22\xab\xba\x2f\xb2\x57\xa7\xf9\xa2\xce\xff\x2d\xb3\x4c\x07\x4a\x4e\x86\x0f\xe5\xf6\x69\x14\x9e\x48\xbe\x70\x11\x7d\xef\xbd\x33\x4e\xc0\x78\x64\xd6\x0c\x4d\xbc\xb4\xe8\xf2\xd7\x8a\x8f\x89\x96\x6e\x80\xe3\x3c\x27\x58\x93\xd8\xa1\x69\x0b\xcf\x19\x0c\xe0\xba\x8e\xac\x43\x21\x3f\xd4\xde\x66\xc1\xb0\xdc\x73\x05\xed\x91\x8d\xd9\x49\xae\x9e\xe8\xab\x14\x8d\x68\x1b\xc6\x64\x5d\xd8\xfb\xa1\x42\x3a\xc7\xf3\x76\x27\xff\xfc\xed\xe9\x59\x6b\x98\x49\x20\x06\x3f\x54\x16\xa8\xe3\xe6\xaf\x7f\x43\xcf\x8b\x6d\xfd\x7e\xfa\xaf\x0c\xb6\xa5\xe4\xd7\xf1\x36\xce\x94\xb9\xa6\x6f\x0a\x75\xc6\x1d\x3c\x27\x7d\x81\x17\x77\x6e\xe0\x5b\x28\x24\xf6\xbb\xfd\x2a\x02\x1f\x6a\xb7\xa1\x2f\xd6\x8f\x6c\x7b\xda\x39\x8a\xa7\xd3\x46\x8c\x0d\x7d\xc7\xae\xf7\xd4\x98\xd8\xdd\x1e\x3b\x6b\x39\xe7\x18\x06\x68\x66\xad\x8d\x3a\x34\xfe\x42\x00\x26\xaa\x0d\xc4\xa2\x40\xa3\x49\x91\x79\x7e\x7d\x2f\x77\x9f\x77\x2f\x04\x77\x07\x74\x5e\xd9\xee\x06\xb6\x59\x1b\xcb\x60\x66\x02\x4c\x5b\x27\x09\xd3\x70\x32\xf6\x4b\x05\x6e\x4b\x69\xee\xf2\xf5\x81\xaa\xeb\x8f\x4c\x04\x4d\x88\x96\x9a\x0a\xe7\xd1\x6d\xfe\x53\x19\x23\x80\x5b\xe7\xb5\xce\x24\x82\x25\x13\xc3\xce\x3b\xa3\xe3\x66\x99\x7e\xba\x3e\x26\xc1\x6b\xcf\x86\x13\x80\x96\x11\xd4\x3f\x02\x7d\xf4\x5e\xc3\x70\x7d\x65\x9a\x5f\x15\x5b\x9c\xc7\xeb\x62\xfe\xca\xc1\xc2\xf3\xed\xf4\x10\x53\xc2\xe1\xfd\x37\xdf\xc5\x66\x7d\xc8\x6d\x40\x52\xae\x96\x59\x62\xd2\x49\x77\xb2\x93\xb7\x73\x11\x4c\x81\x8f\x0d\x06\xff\xfe\xcf\xea\xbf\x01\x00\x00\xff\xff\x20\x5e\x6b\xb0\xc9\x11\x00\x00")
+var _crdsTenancyFarosSh_organizationsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x4b\x6f\xdc\xc8\x11\xbe\xcf\xaf\x28\x20\x07\x27\x80\x86\xb2\x91\x4b\x30\x40\x0e\x82\x36\x01\x8c\xac\x77\x0d\x4b\xbb\xf7\x62\x77\x71\xd8\xab\x66\x37\xd3\x55\x2d\x99\x1b\xe4\xbf\x07\xd5\x24\x67\x38\x0f\xca\x8a\x83\x88\xbc\xb0\x1f\x5f\x55\x7d\xf5\xd4\x6c\xb7\xdb\x0d\xf6\xee\x57\x4a\xec\x62\xd8\x01\xf6\x8e\xbe\x0a\x05\xfd\xe2\xea\xe9\x2f\x5c\xb9\x78\xfb\xfc\x61\xf3\xe4\x82\xdd\xc1\x7d\x66\x89\xdd\x17\xe2\x98\x93\xa1\x1f\xa8\x71\xc1\x89\x8b\x61\xd3\x91\xa0\x45\xc1\xdd\x06\x00\x43\x88\x82\xba\xcc\xfa\x09\x60\x62\x90\x14\xbd\xa7\xb4\xdd\x53\xa8\x9e\x72\x4d\x75\x76\xde\x52\x2a\xe0\xb3\xe8\xe7\xf7\xd5\x87\xf7\xd5\xfb\x0d\x80\x49\x54\xee\x3f\xba\x8e\x58\xb0\xeb\x77\x10\xb2\xf7\x1b\x80\x80\x1d\xed\x20\xa6\x3d\x06\xf7\x7b\x39\xc3\x95\x50\xc0\x60\x86\xaa\xc1\x14\xb9\xe2\x76\xc3\x3d\x19\x95\xbc\x4f\x31\xf7\x3b\xb8\xd8\x1f\x61\x26\xe5\x46\xc3\x7e\x5e\x20\x96\x65\xef\x58\xfe\x71\xb1\xf5\xa3\x63\x29\xdb\xbd\xcf\x09\xfd\x99\x26\x65\x87\x5d\xd8\x67\x8f\xe9\x74\x6f\x03\xc0\x26\xf6\xb4\x83\x7b\x9f\x59\x28\x6d\x00\x26\xc3\x8b\x1e\x5b\x40\x6b\x0b\x95\xe8\x3f\x27\x17\x84\xd2\x7d\xf4\xb9\x9b\x29\xdc\xc2\x6f\x1c\xc3\x67\x94\x76\x07\x95\xda\x57\x59\xc7\xbd\xc7\xe1\x27\xec\xa8\xc8\x9d\xb9\xf9\x61\x5c\x87\xc5\x86\x0c\x2a\x98\x25\xb9\xb0\xbf\x44\x9b\x5d\x57\x5d\xb0\x7e\x82\x7b\xb7\x3f\x85\xb3\x28\xe3\xc2\x28\xf6\xf9\x03\xfa\xbe\xc5\x0f\x65\x89\x4d\x4b\x5d\x89\x05\xfd\x8a\x3d\x85\xbb\xcf\x1f\x7f\xfd\xf3\xc3\xc9\x32\x80\x25\x36\xc9\xf5\x2a\xf3\x94\x66\x88\x2f\x81\xe1\x25\xa6\x27\xee\xd1\x10\x03\xb7\x98\xc8\x42\x3d\x80\x13\x06\x21\xec\xf8\x06\x5c\x60\x21\xb4\x10\x9b\x03\x22\x00\x16\x07\x78\x82\xcc\x85\xe4\xb2\x08\x7d\x8a\x3d\x25\x71\xb3\xd3\xa7\xb3\xc7\xb8\x5f\xac\x9e\xe9\xf5\x4e\x55\x1f\x3d\x05\x56\x03\x9e\x18\xa4\xa5\xd9\x7b\x64\x27\x6b\x21\x36\x20\xad\x63\x48\xd4\x27\x62\x0a\x72\x0c\xa6\xe3\x13\x1b\xc0\x00\xb1\xfe\x8d\x8c\x54\xf0\x40\x49\x61\x80\xdb\x98\xbd\xd5\x3c\x79\xa6\x24\x90\xc8\xc4\x7d\x70\xbf\x1f\xb0\x19\x24\x16\xa1\x1e\x85\xa6\x08\x3c\x3e\x25\x5a\x02\x7a\x78\x46\x9f\xe9\x06\x30\x58\xe8\x70\x80\x44\x2a\x05\x72\x58\xe0\x95\x23\x5c\xc1\xa7\x98\x08\x5c\x68\xe2\x0e\x5a\x91\x9e\x77\xb7\xb7\x7b\x27\x73\xbe\x9b\xd8\x75\x39\x38\x19\x6e\x4b\xea\xba\x3a\x4b\x4c\x7c\x6b\xe9\x99\xfc\x2d\xbb\xfd\x16\x93\x69\x9d\x90\x91\x9c\xe8\x16\x7b\xb7\x2d\xaa\x07\x35\x98\xab\xce\xfe\x21\x4d\x15\x82\xdf\x9d\xe8\x7a\x11\x88\xe3\x5b\x52\xf0\x15\x0f\x68\x1e\x82\x63\xf5\x6d\xb9\x3a\x1a\x7a\x24\x5a\x97\x94\x9d\x2f\x7f\x7b\x78\x84\x59\x74\x71\xc6\x09\x28\x4c\xbc\x1f\x2f\xf2\xd1\x05\x4a\x98\x0b\x0d\xa5\x72\x0f\x9a\x14\xbb\xc2\x38\x05\xdb\x47\x17\xa4\x7c\x18\xef\x28\x9c\xd3\xcf\xb9\xee\x34\x2a\x13\xfd\x33\x13\x6b\x78\xc6\x0a\xee\x4b\x11\x84\x9a\x20\xf7\x9a\x29\xb6\x82\x8f\x01\xee\xb1\x23\x7f\x8f\x4c\xff\x77\x07\x28\xd3\xbc\x55\x62\xdf\xe6\x82\x65\xfd\x3e\xfe\x29\xca\x6e\x62\x6d\xb1\x31\xd7\xd7\x15\x7f\x2d\x33\xf9\xa1\x27\x73\x92\x36\x96\xd8\x69\x2a\xb3\xa0\x90\xe6\xcc\x59\x95\x04\x78\x3d\x67\xf5\x59\x94\xbe\xf3\xad\x33\x55\xa6\x62\xa8\xb5\x50\x03\x48\x7d\xb8\x94\xf7\x8e\x67\xac\x52\xe4\x2e\xb0\x56\xc8\xd2\x37\xbe\x04\x4a\xfc\x0d\xf1\x3f\x97\x43\x2a\x19\x4b\x43\x51\x7b\xb5\x2e\x01\x75\xe8\x3c\xc3\x4b\x1b\xa1\xc3\x80\x7b\x2a\xdc\x94\xba\x76\x81\xa8\x0d\xd5\x2e\x8b\x61\x6c\x2e\x0c\xa9\xe0\xb1\xa5\x01\x30\x11\xa0\xed\x5c\x28\x87\xd0\xfb\x52\x2e\x8f\x57\xab\x0b\x70\x27\xd4\x5d\xb1\xe2\x55\xd3\xe7\x4d\x4c\x09\x87\x37\xc5\x8b\xa0\xe4\x33\x29\xa7\x3c\x2d\x4c\x79\x28\x87\x4f\x62\x26\xd6\xac\x85\x72\x11\x34\xcb\x0b\x6f\x0c\x1a\x13\xc3\xd8\x60\xbf\xe5\xb4\xfb\x9c\x12\x05\x51\x28\x43\xac\xdd\xe4\x28\x57\xbd\xb4\x94\xfd\x5f\x10\x7a\x2a\x63\x56\xe6\x60\x67\xe9\x0a\x6a\xe6\xd4\x00\x1b\xc0\x89\x48\xd0\x34\x28\xab\xe8\xaf\xe0\x8e\xf4\xd2\xa5\x26\xaf\x91\x31\x3e\x1e\x59\x1e\x13\x06\x2e\xbc\x68\xdb\xbf\x7e\xee\x4c\xf9\x1f\x91\x05\xc4\x75\x63\xd0\x1e\x78\x05\x39\x40\x91\x1d\x0b\x68\x0c\x34\x39\x7f\x05\x17\xb4\xb1\x61\x88\xd2\x52\xd2\x18\x76\x87\x5e\x58\x13\xbc\xb4\x14\x8a\x88\x1c\x2c\x25\x3f\xa8\x27\x8e\xd2\x4c\x8b\x61\x4f\xf6\x9a\xdd\xe3\xf3\x51\xdd\x85\xa2\xd9\xa7\xa5\xf8\x29\xc4\x97\x70\xa3\x78\x01\x32\xcf\x2d\xa3\x98\x71\x10\x74\xf7\xf9\x23\x34\x8e\xbc\x5d\x05\x9d\xa4\x2a\x28\x1a\x43\xbd\x60\xed\xaf\x72\xaf\x6f\x13\x53\x87\x32\x8e\x4a\x5b\x95\xb4\x72\xee\xd5\x5c\xd3\xb7\x23\x66\xdc\xbf\xcd\x3b\x77\xd0\xe6\x0e\x03\x24\x42\xab\xca\xcd\x97\xc1\x05\xeb\x0c\x8a\x5a\x6e\x49\x4a\xfd\xc1\x3a\x66\xd9\x5c\xc5\x54\xb5\x5a\x5a\xf8\x74\x72\x4f\xa1\xa7\x8c\x17\x35\x01\x75\xbd\x0c\xd5\xf7\x5a\x95\x08\xf9\x7c\xf4\x5a\x31\xea\xb1\x25\x35\x88\x63\x80\x26\xa6\xd3\xb8\x7b\xc7\x25\x90\x17\xaa\xae\x20\xea\xa4\xb4\x6c\xc1\x0a\xaa\xad\xcc\x35\xce\x14\xd7\xab\x55\xa6\x8d\x91\x4b\xec\x69\x4c\x42\x4c\x25\x78\xae\xcc\x12\xc7\x67\xa4\xc4\xb1\xce\x6f\xec\x2c\x69\x7b\x43\xd8\x67\x4c\x18\x84\xc8\x2a\xf6\x05\x7b\x8a\x5a\xaf\x05\x04\xfc\x8f\xcc\x32\x3d\x53\x72\x32\xbc\x89\xdb\x87\xe9\xb0\x96\x8b\x67\x67\xc7\x5a\x44\x5f\x7b\xef\x8c\x13\x30\x1e\x99\x95\xa1\xb9\x2e\xad\x40\x02\x7c\x19\xfd\x63\xa2\xa5\x1b\xe0\x71\x5e\xd5\x66\xc7\x4a\x62\x87\xa6\x2d\x75\xce\x60\x00\xd7\x75\x64\x1d\x0a\xf9\x61\xcc\x6d\x16\x0c\xeb\x39\xa7\x40\x66\x2a\xca\xec\x24\x8f\x9a\x68\x53\x44\x23\x80\xc6\xc4\x64\x75\xe6\x1f\x94\x64\x3a\xda\xf3\x7a\x26\x7f\xfa\xe5\xe1\x11\x6a\x02\x26\x81\x18\xfc\xa0\x2e\x0f\x30\x76\x9f\xbf\xfe\x1d\x3d\xd3\xf7\xd3\x7f\xa5\xdf\xad\x91\x5f\x8e\xce\xad\xe5\x10\xd3\x37\xa5\x74\xc6\x06\x1e\x93\x4e\xf4\x45\x9d\x1b\xf8\x25\x94\x22\xf6\xdd\x7a\x95\x03\x6f\xd1\xea\x71\xe8\x8b\xf4\x83\x3e\x27\x99\xa3\xfe\x74\x01\x9a\x18\x2b\xfa\x8a\x5d\xef\xa9\x32\xb1\xbb\x3d\x66\xd6\x8a\x08\x80\x4f\x18\x06\xa8\x0e\xa8\x95\x2a\x34\x0e\xf3\x5c\x66\x97\x92\x40\x2c\xda\x7d\xd1\xa4\xc8\x7c\x98\xe6\xd7\xb3\xcf\xbb\x27\x82\xbb\x67\x74\x5e\xab\xdd\x0d\xd4\x59\x13\xcb\x60\x66\x02\x4c\xb5\x93\x84\x69\x38\x32\x3b\x46\xa0\xce\xe5\x4c\x4d\xbe\xde\x50\xf5\xf9\x23\x13\x41\x15\xa2\xa5\x6a\xec\x60\x47\xb5\xf9\x4f\xa5\x8d\x00\xd6\xce\x6b\xde\x48\x04\x4b\x26\x86\xc6\x3b\xa3\xed\x66\x15\xd3\x75\x7d\x4c\x82\x41\xbe\xd3\x83\xfa\x1f\x86\x0e\xcf\xd7\x22\x6b\x7b\xa5\x9b\x5f\x3d\xb6\xda\x8f\xb7\x25\xb0\xaf\x6c\xac\x4c\x75\xaf\x8f\x82\xd3\x6f\x2c\x3a\xb1\xee\x36\xaf\x06\xdb\x4f\xf3\xb9\x79\x42\x3f\x5c\x9c\xd3\x62\x31\xfb\x6a\xd6\xaf\x8d\xc9\x57\xe6\x62\x8d\x5b\x45\x28\x3f\x01\x2d\x47\xe1\xcd\x9b\xa9\xbf\x6a\xfd\xc5\xa2\x4e\x6e\x64\x77\x20\x29\x8f\x45\x9d\x25\x26\x6d\xd9\x8b\x95\x5c\x1f\xa2\x79\xa6\x84\x05\x25\xf3\x0e\xfe\xf5\xef\xcd\x7f\x06\x00\x36\xcb\xa1\x2e\x89\x13\x00\x00")
+
+func crdsTenancyFarosSh_organizationsYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_crdsTenancyFarosSh_organizationsYaml,
+		"crds/tenancy.faros.sh_organizations.yaml",
+	)
+}
+
+func crdsTenancyFarosSh_organizationsYaml() (*asset, error) {
+	bytes, err := crdsTenancyFarosSh_organizationsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "crds/tenancy.faros.sh_organizations.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _crdsTenancyFarosSh_teamsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\x1b\x39\x0f\xbe\xfb\x57\x10\x78\x0f\xbd\xd4\xe3\x06\xef\x65\x31\xb7\x22\xed\xa1\xd8\xed\x22\x48\x82\xec\x99\x1e\xd1\x63\x36\xfa\x98\x25\x25\x67\xdd\x5f\xbf\xa0\x66\x26\xfe\x6a\x93\xec\xa1\xf1\x5c\x44\x49\xe4\xc3\xe7\x21\xa9\x2c\x97\xcb\x05\x0e\xfc\x40\xa2\x9c\x62\x0b\x38\x30\xfd\x93\x29\xda\x4a\x9b\xc7\xdf\xb4\xe1\xb4\xda\x5d\x2d\x1e\x39\xba\x16\xae\x8b\xe6\x14\x6e\x49\x53\x91\x8e\x3e\xd1\x86\x23\x67\x4e\x71\x11\x28\xa3\xc3\x8c\xed\x02\x00\x63\x4c\x19\xcd\xac\xb6\x04\xe8\x52\xcc\x92\xbc\x27\x59\xf6\x14\x9b\xc7\xb2\xa6\x75\x61\xef\x48\xaa\xf3\x39\xf4\xee\x43\x73\xf5\xa1\xf9\xb0\x00\xe8\x84\xea\xfd\x7b\x0e\xa4\x19\xc3\xd0\x42\x2c\xde\x2f\x00\x22\x06\x6a\x21\x13\x06\x6d\x32\x45\x8c\xdd\xbe\xd9\xa0\x24\x6d\x74\xbb\xd0\x81\x3a\x8b\xd8\x4b\x2a\x43\x0b\x17\xfb\xe3\xf5\x09\xd4\x98\xd0\x3d\x61\xa8\x4b\xcf\x9a\x7f\x7f\x36\xfd\xc1\x9a\xab\x79\xf0\x45\xd0\x4f\x11\xab\x45\x39\xf6\xc5\xa3\x8c\xb6\x05\x80\x76\x69\xa0\x16\xfe\xc4\x40\x3a\x60\x47\x6e\x01\x30\xe5\x54\x43\x2d\x01\x9d\xab\x2c\xa1\xbf\x11\x8e\x99\xe4\x3a\xf9\x12\x66\x76\x96\xf0\x4d\x53\xbc\xc1\xbc\x6d\xa1\xb1\x14\x1a\x49\x9e\x6a\xac\x39\xdf\xdb\x83\x21\xef\x2d\x98\x66\xe1\xd8\x5f\x5e\x9f\x65\x68\x2e\x18\x3c\xf1\xf7\xb1\x3f\x75\xe7\x30\x8f\x86\x31\xdc\xee\x0a\xfd\xb0\xc5\xab\x6a\xd2\x6e\x4b\xa1\xea\x6a\xab\x34\x50\xfc\x78\xf3\xe5\xe1\xff\x77\x27\x66\x00\x47\xda\x09\x0f\x16\x73\x64\x15\x7a\xc1\x98\x15\x38\x2b\x04\x0a\x6b\x12\x05\x04\x4b\x0d\x38\xc2\x53\x92\xc7\xca\x96\x42\xda\x40\xde\x12\x24\xe9\x31\xf2\xf7\xaa\xfb\xb3\x57\x80\xa7\x6d\x52\xaa\xb0\xeb\x71\xe0\x0c\xac\xc0\x87\x23\x83\xa4\x81\x24\xf3\xac\xeb\xf8\x3b\x2a\xe9\x23\xeb\x19\xcc\x77\x96\xc9\x78\x0a\x9c\xd5\x32\x69\x85\x32\xa9\x47\x6e\x4a\x7e\x84\xc8\x0a\x42\x83\x90\x52\xcc\xe7\x28\xed\x4b\x1b\xc0\x08\x69\xfd\x8d\xba\xdc\xc0\x1d\x89\xb9\x01\xdd\xa6\xe2\x9d\xb5\xc0\x8e\x24\x83\x50\x97\xfa\xc8\xdf\x9f\x7d\x2b\xe4\x54\x83\x7a\xcc\x34\x15\xdd\xe1\x57\xab\x25\xa2\x87\x1d\xfa\x42\xef\x01\xa3\x83\x80\x7b\x10\xb2\x28\x50\xe2\x91\xbf\x7a\x44\x1b\xf8\x9a\x84\x80\xe3\x26\xb5\xb0\xcd\x79\xd0\x76\xb5\xea\x39\xcf\xad\xdc\xa5\x10\x4a\xe4\xbc\x5f\xd5\xae\xe4\x75\xc9\x49\x74\xe5\x68\x47\x7e\xa5\xdc\x2f\x51\xba\x2d\x67\xea\x72\x11\x5a\xe1\xc0\xcb\x0a\x3d\x5a\xc2\xda\x04\xf7\x3f\x99\x9a\x5f\xdf\x9d\x60\xbd\xa8\xcb\xf1\xab\x5d\xf6\x82\x02\xd6\x72\x26\x28\x4e\x57\xc7\x44\x0f\x44\x9b\xc9\xd8\xb9\xfd\x7c\x77\x0f\x73\xe8\x2a\xc6\x89\x53\x98\x78\x3f\x5c\xd4\x83\x04\x46\x18\xc7\x0d\x49\xbd\x07\x1b\x49\xa1\x32\x4e\xd1\x0d\x89\x63\xae\x8b\xce\x33\xc5\x73\xfa\xb5\xac\x83\xd5\xaf\xd0\xdf\x85\x34\x9b\x56\x0d\x5c\xd7\xf9\x06\x6b\x82\x32\x58\xe3\xb8\x06\xbe\x44\xb8\xc6\x40\xfe\x1a\x95\x7e\xb9\x00\xc6\xb4\x2e\x8d\xd8\xb7\x49\x70\x3c\x9a\x0f\x7f\xe6\xa5\x9d\x58\x3b\xda\x98\x47\xe8\x4f\xf4\xb2\xc6\xbe\x1b\xa8\x3b\x69\x17\x47\xca\x62\x05\x9d\x31\x53\xed\x95\x79\xa8\xbe\xdc\xa3\xe7\xde\xcf\xb6\xce\x42\x7f\x3a\x2c\xc6\x82\x29\x4a\x02\x42\xe8\x70\xed\xe9\xf8\xec\x3c\x51\xa6\xf9\x0c\xf0\x06\x92\xec\x9b\xa6\xd4\x2b\x38\xbe\x4e\xb3\xac\x62\xb0\x57\xc3\xc2\x55\x2c\x14\x90\xbd\xda\xc4\x02\x14\x7a\x1e\x7a\x69\x73\xe1\x10\x7e\x8e\x8f\x33\x85\x0b\x96\x5e\x01\x3e\x6f\xa2\x08\xee\xcf\xf6\x6c\xe0\xbe\x92\x91\xbd\x2e\x46\xa9\x61\xb2\xe3\x96\xd0\x0c\x9e\x63\x85\x7a\x31\xae\x2f\x3c\xc2\x8f\xd3\xa1\x58\xc2\x65\xf8\x25\x7c\x74\x81\xe3\x0f\xec\x9f\x1d\x9f\x37\xa1\x1d\x7f\x60\x7a\x5a\xfc\x07\x46\x0e\x78\x5f\xc9\xfd\xaf\x43\x62\xa6\x99\xe5\x5a\xdf\x9a\xb9\x86\xde\xfc\x4e\xcd\xbf\x67\x12\x59\xc7\x17\x90\x1c\x70\x7c\x0f\xe8\xfd\xe4\x21\x00\x6f\x80\xc2\x90\xf7\xbf\x58\x7c\x9b\x5a\xd6\x98\xa7\x0e\x97\x70\xf4\xef\xc5\x0b\xb3\xe0\xc2\xa8\xf6\xa2\xb9\x16\xb2\x94\xf1\xba\xe6\x24\xd8\x53\x0b\x59\x0a\x2d\xfe\x1d\x00\x6b\x43\x09\x79\x49\x0a\x00\x00")
+
+func crdsTenancyFarosSh_teamsYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_crdsTenancyFarosSh_teamsYaml,
+		"crds/tenancy.faros.sh_teams.yaml",
+	)
+}
+
+func crdsTenancyFarosSh_teamsYaml() (*asset, error) {
+	bytes, err := crdsTenancyFarosSh_teamsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "crds/tenancy.faros.sh_teams.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _crdsTenancyFarosSh_usersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5f\x6f\xe4\xb8\x0d\x7f\x9f\x4f\x41\xa0\x0f\xdb\x02\x19\x67\xb7\xf7\x52\x0c\xd0\x87\x20\x77\x2d\x16\xdd\x2d\x82\x4d\xf6\xfa\x4c\xcb\x9c\xb1\x2e\xb2\xe4\x8a\xd4\xec\x4e\x8b\x7e\xf7\x82\x92\x3d\x7f\xed\x49\x2e\x87\x36\x9e\x87\x58\xa2\x7e\xfc\x4f\x8a\x5e\x2e\x97\x0b\xec\xed\xcf\x14\xd9\x06\xbf\x02\xec\x2d\x7d\x17\xf2\xfa\xc6\xd5\xf3\x9f\xb8\xb2\xe1\x76\xfb\x61\xf1\x6c\x7d\xb3\x82\xfb\xc4\x12\xba\x2f\xc4\x21\x45\x43\x3f\xd2\xda\x7a\x2b\x36\xf8\x45\x47\x82\x0d\x0a\xae\x16\x00\xe8\x7d\x10\xd4\x65\xd6\x57\x00\x13\xbc\xc4\xe0\x1c\xc5\xe5\x86\x7c\xf5\x9c\x6a\xaa\x93\x75\x0d\xc5\x0c\x3e\xb2\xde\xbe\xaf\x3e\xbc\xaf\xde\x2f\x00\x4c\xa4\x7c\xfe\xc9\x76\xc4\x82\x5d\xbf\x02\x9f\x9c\x5b\x00\x78\xec\x68\x05\x89\x29\x72\x25\xe4\xd1\x9b\x5d\xb5\xc6\x18\xb8\xe2\x76\xc1\x3d\x19\xe5\xb8\x89\x21\xf5\x2b\xb8\xd8\x2f\xc7\x07\xa1\x8a\x42\x5f\x99\x62\x7e\x75\x96\xe5\x6f\xfb\xa5\x4f\x96\x25\x2f\xf7\x2e\x45\x74\x03\xc7\xbc\xc2\xd6\x6f\x92\xc3\x58\xd6\x16\x00\x6c\x42\x4f\x2b\xb8\x77\x89\x25\x2f\x0c\x0a\x65\x3e\x4b\xc0\xa6\xc9\x26\x42\xf7\x10\xad\x17\x8a\xf7\xc1\xa5\x6e\x34\xcd\x12\x7e\xe1\xe0\x1f\x50\xda\x15\x54\x2a\x7f\x45\x1d\x5a\xd5\x54\x1f\x15\x77\x05\x3f\x1d\xad\xc8\x4e\x79\xb1\x44\xeb\x37\x33\x00\x8d\x65\xac\x1d\x35\x27\x18\x3f\x9e\x2e\x16\x98\x3a\x04\x47\xe8\x2f\x71\x46\x6f\x56\x17\x8e\x38\x01\xbd\xdb\xd0\x09\x5e\x83\x52\x16\x8a\xdc\xdb\x0f\xe8\xfa\x16\x3f\xe4\x25\x36\x2d\x75\x39\x3c\xf4\x2d\xf4\xe4\xef\x1e\x3e\xfe\xfc\xc3\xe3\xc9\x32\x40\x43\x6c\xa2\xed\x95\x67\x71\x0e\x58\x06\x69\x09\x0a\x21\xac\x43\xcc\xaf\x79\xeb\xee\xe1\xe3\xfe\x60\x1f\x43\x4f\x51\xec\xe8\xde\xf2\x1c\x45\xf6\xd1\xea\x19\x9b\x77\x2a\x49\xa1\x82\x46\x43\x9a\x0a\xcb\xc1\x8f\xd4\x0c\xc2\x43\x58\x83\xb4\x96\x21\x52\x1f\x89\xc9\x97\x20\x3f\x01\x06\x25\x42\x0f\xa1\xfe\x85\x8c\x54\xf0\x48\x51\x61\x80\xdb\x90\x5c\xa3\x99\xb0\xa5\x28\x10\xc9\x84\x8d\xb7\xff\xda\x63\x33\x48\xc8\x4c\x1d\x0a\x0d\xb1\x77\x78\x72\xdc\x78\x74\xb0\x45\x97\xe8\x06\xd0\x37\xd0\xe1\x0e\x22\x29\x17\x48\xfe\x08\x2f\x93\x70\x05\x9f\x43\x24\xb0\x7e\x1d\x56\xd0\x8a\xf4\xbc\xba\xbd\xdd\x58\x19\x33\xda\x84\xae\x4b\xde\xca\xee\x36\x27\xa7\xad\x93\x84\xc8\xb7\x0d\x6d\xc9\xdd\xb2\xdd\x2c\x31\x9a\xd6\x0a\x19\x49\x91\x6e\xb1\xb7\xcb\x2c\xba\x57\x85\xb9\xea\x9a\xdf\xc5\xa1\x06\xf0\xbb\x13\x59\x2f\xe2\xb3\xfc\x72\xb2\x5d\xf1\x80\x66\x9e\x7a\x1a\x87\xa3\x45\xd1\x83\xa1\x75\x49\xad\xf3\xe5\xa7\xc7\x27\x18\x59\x67\x67\x9c\x80\xc2\x60\xf7\xc3\x41\x3e\xb8\x40\x0d\x66\xfd\x9a\x34\x80\x2c\xc3\x3a\x86\x2e\x5b\x9c\x7c\xd3\x07\xeb\x25\xbf\x18\x67\xc9\x9f\x9b\x9f\x53\xdd\x59\x51\xbf\xff\x33\x11\x8b\xfa\xaa\x82\xfb\x5c\xe6\xa0\x26\x48\xbd\x06\x7e\x53\xc1\x47\x0f\xf7\xd8\x91\xbb\x47\xa6\xff\xb9\x03\xd4\xd2\xbc\x54\xc3\xbe\xce\x05\xc7\x15\xfa\xf0\xa7\x28\xab\xc1\x6a\x47\x1b\x63\x25\x9d\xf1\x97\x66\xdf\x63\x4f\xe6\x24\x5d\x1a\x62\x1b\x35\xa0\x05\x85\x34\x0d\x86\xfa\x08\x70\x3d\x47\xf5\x19\x6b\xd6\xf9\xfa\x19\xdf\xb1\x8a\x41\xed\x82\x79\x66\x70\x61\x63\xbd\xb2\x52\xd7\x29\xbb\x9c\x19\x91\xba\xb0\x2d\x42\x75\xd9\xcd\x17\xa0\xa0\x7b\xd0\x51\x57\x6b\x60\x68\xc6\x3a\x07\xdf\x42\x7c\xe6\x1e\x0d\xf1\x0d\x7c\xb3\xd2\x86\x24\xd0\x90\xa3\x1c\x7c\xe8\x77\xd2\x9e\x1a\x74\xae\x92\x1e\xfe\x1a\xcb\xbd\xc3\xdd\xdf\xb5\x1a\xbe\xa8\xd8\x48\x39\x16\x3c\x55\xe7\x1d\x8f\x18\xb9\xe2\x5e\x60\xcc\xb8\x5a\x7f\xb9\x8d\xbc\xc0\x35\x37\x96\x91\x5f\x3e\xa0\xdd\x2a\x12\xf3\xb1\x4d\x7f\x0d\xd7\xdc\x79\xf9\x05\xb6\x7f\xcd\x44\x80\x91\x32\x8f\x72\xe6\xc4\x8b\xd6\xe7\x1d\xdb\x68\xbc\xcb\x0e\xfa\x18\xb6\xb6\xa1\x78\x73\x01\x0c\x80\xe3\x51\x1b\xc1\x21\x4b\x09\x8a\x0b\x42\x2b\xd4\x4d\x08\x76\x55\x9b\x71\x13\x63\xc4\xdd\xd9\x9e\xb3\x5a\x12\x5e\xd0\xf4\x53\x26\x82\xb0\xa5\x18\x6d\x33\xe4\x49\x9b\x6a\xcd\x1b\x4c\x4e\xc0\x0d\x04\x57\xac\x3d\x9f\x34\xfa\xe0\x86\xbc\xf0\x03\xc5\x7f\x8c\xd1\x3b\x45\x75\x26\xd6\xdd\xc5\xa1\x31\x08\x3a\xfc\x6e\xbb\xd4\x81\x4f\x9a\x1a\x2a\x57\x61\x00\x13\x16\x1d\xe2\x0c\x4d\x7b\x48\x9d\xab\x9a\xe8\x6f\x1d\x62\x87\xb2\x02\xeb\xe5\x87\x3f\x4e\x52\x14\x93\x6b\xc7\xdb\x4c\x62\x8c\x45\xf8\x57\xea\xfc\x65\xe2\xd8\xbc\xd6\x26\x78\x93\x62\xbc\x6c\x05\xe3\x1f\x1a\x43\x7c\xd4\x11\xac\xff\xff\x5b\x62\xcf\x8b\x5f\xa1\xff\x5e\x69\x9e\xd7\xfa\x00\x78\x5d\x74\x00\x83\xbe\x5c\xd2\x69\x31\xb1\xfb\x9b\x75\x9b\xe9\x4a\x43\x3a\xe4\x52\xb0\x5a\x5c\x55\xf7\x61\x20\x1b\x95\xbd\x28\x25\x6f\xad\x70\x12\xd1\xf3\x9a\xe2\x53\x78\x41\x82\xa7\x3d\xe1\x28\x43\xa9\xb0\x47\x7c\xf3\x3f\x47\x46\x2f\x5b\x17\xb0\x30\xb4\xb6\x48\x7b\xee\xda\x67\x25\xc0\xb7\x96\xfc\x01\xcd\x72\xe9\x57\xd4\x54\xc7\xee\x3e\x3b\x37\x01\x5f\xae\x9e\x36\xc2\xda\x46\x16\x08\xd2\x6a\x27\x35\x62\xb7\x63\x93\x04\xbb\x06\xea\x7a\xd9\x95\xbb\xe7\xc0\x46\x57\x95\x96\x54\x43\x1f\x3c\x55\xaf\xb7\xe4\x8c\x8b\x59\x50\xd2\x59\x3c\x9f\x98\x35\x5f\x3d\x32\xd1\xc9\xe5\x23\xd4\xac\x37\xed\xa3\xdb\xc7\x7e\xb2\x7b\xb9\x90\x9a\xe0\xcb\x8c\xc6\x2f\xf8\xf4\xbe\xd4\x04\x0d\x22\x4d\x7f\xbd\x18\xec\xf9\xa9\x1b\x72\x61\xad\x5e\xdf\x7b\x4e\xc1\x47\x29\xf6\x8a\xe5\x39\x42\xf5\xca\x73\x86\x46\x2c\x0e\x16\x03\x55\x25\xaf\xa2\x5b\x9c\x40\x0e\x4f\x16\xeb\x52\x92\x97\xda\x09\xe4\x16\x9a\x43\x37\x1b\x44\xe7\xbe\x69\xba\x33\xe1\x3f\x69\xe7\x15\xdb\x95\x96\xbe\x37\x68\x09\xd8\xfc\x2f\x35\xf9\x2e\x06\xc1\x53\x76\x52\xe2\x19\x5c\xd0\x51\x08\x7d\x8e\xc2\x0a\x9e\x5a\xbb\x9f\x9e\x6a\x3a\x8a\x78\xdf\x50\x74\x3b\x75\xc1\x81\x9b\x69\xd1\x6f\xa8\x99\xd2\xbb\x3c\x1f\x35\x60\x51\x4a\xbc\x0a\x3c\xfb\xf0\xcd\xdf\xa8\xc8\x1e\x12\x8f\x43\x46\x56\x63\xcf\xe8\xee\xe1\x23\xac\x2d\xb9\x66\x31\x81\x97\x7f\x03\x57\x05\xd5\xb6\xd0\x8b\x4e\xda\x73\x32\x8c\xa5\x51\x47\x86\xa5\x72\x9a\xa1\xbb\x52\x82\xc6\xfb\x3c\x33\x6e\x5e\xe7\x9d\x3b\x68\x53\x87\x1e\x22\x61\xa3\xc2\x8d\x87\xc1\xfa\xc6\x1a\xcc\x37\xdc\x86\x04\xad\x63\xc0\x3a\x24\x59\x4c\x62\xaa\x58\xed\x50\x4c\xb2\xc1\x07\xf7\x64\xf3\xe4\x81\xb4\xd6\x2a\xd7\xcb\xae\x7a\xab\x56\x91\x90\xcf\x87\xf5\x19\xa5\x9e\x5a\x52\x85\x38\xf8\xfd\x57\x81\x7d\x24\xbc\xe3\x1c\xc8\x47\xa2\xce\x20\xea\x6c\x7d\x3c\xb4\x29\xa8\x0e\x3f\x76\x6d\x4d\x76\xbd\x6a\x65\xda\x10\x38\xc7\x9e\xc6\x24\x84\x98\x83\x67\x62\xfa\x3c\x3c\xc5\x24\x96\x75\xe2\x67\xed\x57\xd4\x00\xc2\x26\x61\x44\x2f\x44\x8d\x62\x5f\x58\x4f\x51\xeb\xb9\x80\x80\xdf\x68\x59\xa6\x2d\x45\x2b\xbb\x57\xd9\xf6\x71\x20\x1e\xdb\x25\x03\x7a\xa0\xef\xbd\xb3\xc6\x0a\x18\x87\xcc\x6a\xa1\xb1\x2e\xcd\x40\x02\x7c\x29\xfe\x31\xa1\xa1\x1b\xe0\xb0\xef\x55\xac\x46\xec\xd0\xb4\xb9\xce\xe9\x6d\xc2\x76\x1d\x35\x16\x85\xdc\xae\xe4\x36\x0b\xfa\xf9\x9c\x53\xa0\xe1\x86\x06\x6c\x25\x15\x49\xb4\x37\xa1\x11\x40\x63\x42\x6c\xac\xdf\xb8\x9d\x1a\x99\x0e\xfa\x5c\xcf\xe4\xcf\x5f\x1f\x9f\x74\xa2\x67\x12\x08\xde\xed\xd4\xe5\x1e\x1e\x73\xb5\xfa\xf3\x5f\xd0\x31\xbd\xdd\xfc\x13\x8d\x6d\xce\xf8\x99\x74\xec\x29\xfb\x98\xbe\xc9\xa5\x33\xac\xe1\x29\xea\x37\xa0\x2c\xce\x0d\x7c\xf5\xb9\x88\xbd\x59\xae\x4c\xf0\x1a\xa9\x9e\x76\x3d\x0d\x57\xe3\xa1\xda\x1e\x67\x8e\xfa\xd3\x7a\x58\x87\x50\xd1\x77\xec\x7a\x47\x95\x09\xdd\xed\x21\xb3\x66\x58\x00\x7c\x46\xbf\x83\x6a\x8f\x5a\xa9\x40\xe5\xf3\x4f\xb9\xba\xe4\x04\x62\x51\x47\xa3\x89\x21\x5f\xba\x87\x4f\x4f\xb3\x98\xce\x3e\x13\xdc\x6d\xd1\x3a\xad\x76\x37\x50\x27\x4d\x2c\x83\x89\x09\x30\xd6\x56\x22\xc6\xdd\x41\x13\xce\xf7\x59\xfd\x92\xc3\xb4\x4e\xd3\x0d\x55\x9f\xdf\x33\x11\x54\x3e\x34\x54\x95\x0e\x76\x10\x9b\xff\x90\xdb\x08\x60\x6d\x9d\xe6\x8d\x04\x68\xc8\x04\xbf\x76\xd6\x68\xbb\x99\xc5\xb4\x5d\x1f\xa2\xa0\x97\x37\x7a\x50\x27\x10\xfd\xec\x32\x15\x59\xcb\x89\x6e\x3e\x49\x36\xdb\x8f\x97\x39\xb0\x27\x36\xae\xdc\xd0\xe7\xa7\xe6\xc9\x43\x17\x8b\x7a\xe1\xa1\x66\x05\x12\x53\xa9\x85\x2c\x21\x6a\xa7\x3b\x5a\x49\xf5\x3e\x08\x46\xc5\x59\x50\x12\xaf\xe0\xdf\xff\x59\xfc\x77\x00\xbc\xf3\x8c\xa3\xd4\x18\x00\x00")
 
 func crdsTenancyFarosSh_usersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -541,7 +587,7 @@ func crdsTenancyFarosSh_usersYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsTenancyFarosSh_workspacesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4d\x6f\xe3\xbc\x11\xbe\xfb\x57\x0c\xd0\xc3\xb6\x40\x2c\xef\xa2\x97\xc2\x40\x0f\x41\xde\x16\x08\xba\x5b\x04\x9b\xec\xbb\xe7\x11\x35\xb6\xf8\x86\x22\x55\xce\xd0\x59\xb7\xe8\x7f\x2f\x86\x94\x6c\xf9\x43\x49\xba\x45\x75\xb2\xc8\xd1\x7c\x3c\xcf\x7c\x79\xb9\x5c\x2e\xb0\xb7\xbf\x52\x64\x1b\xfc\x1a\xb0\xb7\xf4\x43\xc8\xeb\x1b\x57\xcf\x7f\xe2\xca\x86\xd5\xee\xd3\xe2\xd9\xfa\x66\x0d\x77\x89\x25\x74\x5f\x89\x43\x8a\x86\x7e\xa1\x8d\xf5\x56\x6c\xf0\x8b\x8e\x04\x1b\x14\x5c\x2f\x00\xd0\xfb\x20\xa8\xc7\xac\xaf\x00\x26\x78\x89\xc1\x39\x8a\xcb\x2d\xf9\xea\x39\xd5\x54\x27\xeb\x1a\x8a\x59\xf9\x68\x7a\xf7\xb1\xfa\xf4\xb1\xfa\xb8\x00\x30\x91\xf2\xf7\x4f\xb6\x23\x16\xec\xfa\x35\xf8\xe4\xdc\x02\xc0\x63\x47\x6b\x78\x09\xf1\x99\x7b\x34\xc4\x95\x90\x47\x6f\xf6\xd5\x06\x63\xe0\x8a\xdb\x05\xf7\x64\xd4\xec\x36\x86\xd4\xaf\xe1\xe2\xbe\xe8\x18\x3c\x2b\x51\x7d\x1f\xd5\xe5\x33\x67\x59\xfe\x76\x7a\xfe\xd9\xb2\xe4\xbb\xde\xa5\x88\x6e\xea\x40\x3e\x66\xeb\xb7\xc9\x61\x9c\x5c\x2c\x00\xd8\x84\x9e\xd6\xf0\x77\x35\xa7\x47\xcd\x02\x60\x08\x36\x9b\x5f\x02\x36\x4d\x86\x0f\xdd\x43\xb4\x5e\x28\xde\x05\x97\xba\x11\xb6\x25\xfc\xc6\xc1\x3f\xa0\xb4\x6b\xa8\x46\x80\xab\x0b\x6c\xb2\xec\x88\xcc\xed\x96\x86\x77\xd9\xab\xf1\x06\xa5\x1c\x94\xeb\xdd\x27\x74\x7d\x8b\x9f\x8a\xd7\xa6\xa5\x0e\xd7\x83\x7c\xe8\xc9\xdf\x3e\xdc\xff\xfa\xc7\xc7\x93\x63\x80\x86\xd8\x44\xdb\x4b\xe6\xe8\x00\x09\x58\x06\x69\x09\x8a\x34\x6c\x42\xcc\xaf\xc7\xfb\xdb\x87\xfb\x83\x8a\x3e\x86\x9e\xa2\xd8\x11\xf6\xf2\x4c\xd2\x6e\x72\x7a\x66\xf0\x83\xfa\x54\xa4\xa0\xd1\x7c\xa3\x62\x77\x00\x92\x9a\x21\x0c\x08\x1b\x90\xd6\x32\x44\xea\x23\x31\xf9\x92\x81\x27\x8a\x41\x85\xd0\x43\xa8\x7f\x23\x23\x15\x3c\x52\x54\x35\xc0\x6d\x48\xae\xd1\x34\xdd\x51\x14\x88\x64\xc2\xd6\xdb\x7f\x1e\x74\x33\x48\xc8\x46\x1d\x0a\x0d\x99\x70\x7c\x32\x71\x1e\x1d\xec\xd0\x25\xba\x01\xf4\x0d\x74\xb8\x87\x48\x6a\x05\x92\x9f\xe8\xcb\x22\x5c\xc1\x97\x10\x09\xac\xdf\x84\x35\xb4\x22\x3d\xaf\x57\xab\xad\x95\xb1\xdc\x4c\xe8\xba\xe4\xad\xec\x57\xb9\x72\x6c\x9d\x24\x44\x5e\x35\xb4\x23\xb7\x62\xbb\x5d\x62\x34\xad\x15\x32\x92\x22\xad\xb0\xb7\xcb\xec\xba\xcf\x25\x57\x75\xcd\xef\xe2\x50\xa0\xfc\xe1\xc4\xd7\x92\x12\x2c\xd1\xfa\xed\xe4\x22\x17\xc1\x2b\x0c\x68\x31\x28\xdd\x38\x7c\x5a\xa2\x38\x02\xad\x47\x8a\xce\xd7\xbf\x3c\x3e\xc1\x68\x3a\x93\x71\x8e\x7e\xc6\xfd\xf8\x21\x1f\x29\x50\xc0\xac\xdf\x50\x2c\x24\x6e\x62\xe8\xb2\x4e\xf2\x4d\x1f\xac\x97\xfc\x62\x9c\x25\x7f\x0e\x3f\xa7\xba\xb3\xa2\xbc\xff\x23\x11\x8b\x72\x55\xc1\x5d\xee\x41\x50\x13\xa4\x5e\x4b\xa0\xa9\xe0\xde\xc3\x1d\x76\xe4\xee\x90\xe9\xff\x4e\x80\x22\xcd\x4b\x05\xf6\x7d\x14\x4c\xdb\xe7\xb9\x70\x41\x6d\x72\x31\x76\xb8\x19\xbe\x0e\x25\xf8\xd8\x93\x39\xa9\x99\x86\xd8\x46\xcd\x6a\x41\x21\xad\x85\x97\x93\xc6\x37\x3e\xd7\xab\xf5\xdc\xce\xd9\xd5\x99\x13\xbf\x1c\x5f\x4a\xea\x24\xa6\x08\x91\xb0\xc1\xda\xd1\x54\xb6\x14\x2e\xcd\xf8\xf2\x0a\x66\x05\xb7\xae\xa6\x78\xe1\xe7\x99\x33\x5f\x8a\x54\x71\x44\xdb\xbb\xda\xcc\x0e\x51\x87\xd6\x31\xbc\xb4\x01\x30\xd2\xa8\x6e\xec\x25\x17\x5a\xe1\x15\x2f\xad\x50\x77\xc5\x91\x57\xdd\x1f\x2f\x31\x46\xdc\xbf\x8b\x7a\x41\x49\xfc\x2e\xf2\xb3\xe4\x09\xfd\xa1\x66\xad\xb6\x09\xff\xdf\xff\x4b\xfe\x4d\xf0\x65\x60\xbd\x85\xf8\x5d\x8a\x91\xbc\xa8\x2a\x43\xac\xe3\xf1\x68\x54\x5d\xb9\xdd\x92\x97\xea\xfd\x18\x9e\x2a\x1f\xbd\x38\x44\x97\x7b\xba\x06\x87\x63\x46\xe1\xd8\x6c\x34\x14\x2c\x33\xf6\x8a\xde\x82\x28\x5d\x7a\xf2\x1a\x0a\xe5\x71\xc8\xf2\x14\xd1\xb3\x1d\xa7\xf1\x75\xb9\x33\xe7\x3f\x23\x0b\x88\xed\xa8\x74\xb4\x43\x28\x72\x50\x45\x4d\x69\x7f\xc1\xd3\xc0\xf7\x8c\x5e\xd0\xb1\x84\x3e\x48\x4b\xb1\x82\x27\xed\x9b\xc3\x24\xab\x09\x5e\x5a\xf2\xd9\x44\xf2\x0d\x45\xb7\x57\x0a\x8e\xd6\x4c\x8b\x7e\x4b\xcd\xb5\xb8\xcb\x73\xaf\x3c\xa1\x68\xc9\x68\x23\x7d\xf6\xe1\xc5\xdf\xa8\x3e\x0f\x89\xc7\x86\x9f\xc3\x38\x18\xba\x7d\xb8\x87\x8d\x25\xd7\xcc\x2a\x1d\xac\xe6\x3a\x34\x86\x7a\xd1\x5e\x30\xe7\xc3\x26\xc4\x0e\xa5\x6c\x30\x4b\xb5\x34\x23\xf7\x6a\x79\x41\xee\x11\xcc\xb8\x7d\x1f\x3b\xb7\xd0\xa6\x0e\xfd\xb1\x51\x0d\x1f\x83\xf5\x8d\x35\x98\x47\x5d\x43\x92\x9b\x06\xd6\x21\x9d\x0f\xa3\x89\x5b\x8a\xcf\x81\xd3\x81\x9e\x0c\x4f\x9e\x75\x35\x01\x75\xbd\xec\xe7\xa2\x7f\x33\xaa\x48\xc8\xd7\xba\xf0\x95\xa0\x9e\x5a\x1a\xc4\x0f\x6b\xda\x21\x13\x3e\x70\x4e\xe4\x89\xab\xb3\x11\xd9\x93\x01\xaa\x4a\x75\x10\xd9\x8d\x35\x99\x7a\x8d\xca\xb4\x21\x70\x4e\x09\xcd\x49\x08\x31\x27\xcf\x4c\x2b\x2d\x4f\x81\xc4\xb2\xba\xc4\xb6\x21\x9d\x4f\x08\xdb\x84\x11\xbd\x10\x35\xaa\xfb\x02\xbd\x32\xdb\x67\x75\xfe\x6f\xc8\x32\xed\x28\x5a\xd9\xbf\x0b\xdb\xc7\x41\x58\xdb\xc5\xce\x36\xa5\x17\xd1\x8f\xde\x59\x63\x05\x8c\x43\x66\x45\x68\xec\x4b\xb3\x2e\x7f\x2d\xfc\x98\xd0\xd0\x0d\x70\xd9\x36\x75\x42\xb1\x82\xd8\xa1\x69\x73\x9f\x33\xe8\xc1\x76\x1d\x35\x16\x85\xdc\xbe\xd4\x36\x0b\xfa\xf9\x9a\xcb\x6c\x0f\xdd\x98\xad\xa4\xe2\x89\xee\xa8\x68\x44\xcb\x30\xc4\xc6\xfa\xad\xdb\x17\x4a\x0f\xf1\xbc\x5e\xc9\x5f\xbe\x3d\x3e\x69\x0e\x33\x09\x04\xef\xf6\xa5\x0b\x94\x99\xf3\xe7\xbf\xa2\xe3\xd9\xb2\x7e\x1b\xfe\x2b\x23\x6e\x0e\xfc\x32\xe3\x86\x99\x72\xc8\xe9\x9b\xdc\x3a\xc3\x06\x9e\xa2\xee\xe3\xd9\x9d\x1b\xf8\xe6\x73\x13\xfb\x69\xbf\xb2\xc0\xbb\xca\x6d\xdf\x67\xeb\xc7\x6e\x3b\xad\x1c\xe5\xd3\x6a\x21\x86\x8a\x7e\x60\xd7\x3b\xaa\x4c\xe8\x56\xc7\xca\x9a\xc7\x1c\xfd\x1e\xaa\x83\xd6\x4a\x1d\x1a\xfe\x50\xe4\xe5\x25\x17\x10\x8b\x12\x8d\x26\x06\xe6\xc3\x2e\x3e\x5f\x7d\xce\x3e\x13\xdc\xee\xd0\x3a\xed\x76\x37\x50\x27\x2d\x2c\x83\x89\x09\x30\xd6\x56\x22\xc6\xfd\x64\xec\xe7\x0c\xac\x73\x6a\x6e\xd2\xf5\x81\xaa\xcf\xef\x99\x08\x2a\x1f\x1a\xaa\x0a\x9d\x47\xb7\xf9\x0f\x79\x8c\x00\xd6\xd6\x69\x9e\x49\x80\x86\x4c\xf0\x1b\x67\x8d\x8e\x9b\xf9\xf6\xd3\xf5\x21\x0a\x5e\x5b\x1b\x26\x04\xcd\x33\xa8\xff\x0f\x74\xfb\xbd\xc6\xe1\xf2\xca\x34\xbf\x2a\x36\x3b\x8f\x97\xd9\xfc\xec\xf6\x77\xb1\xc8\x4d\x2f\x2f\xb7\xbf\xc9\x9e\xf9\xed\xeb\xe7\x37\x76\xad\xef\x13\xd1\xf1\x5f\xb9\xfe\xfc\xf9\xbd\xfa\xaa\xcf\x17\x87\x65\x99\x5c\x83\xc4\x54\x74\xb3\x84\xa8\x83\x76\x72\x92\xea\x43\x0e\x8e\x51\x0c\xf5\x0d\xff\xfa\xf7\xe2\x3f\x01\x00\x00\xff\xff\x8c\x0d\x73\x12\x7c\x12\x00\x00")
+var _crdsTenancyFarosSh_workspacesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x5b\x6f\xe3\xba\x11\x7e\xf7\xaf\x18\xa0\x0f\xdb\x02\xb1\xb2\x8b\x16\x45\x61\xa0\x0f\x41\xb6\x2d\x82\xee\x16\xc1\x26\x7b\xfa\x3c\x26\xc7\x16\x4f\x28\x52\xe5\x8c\x9c\x75\x8b\xfe\xf7\x62\xa8\x8b\x65\x5b\x72\x7c\x52\x9c\x75\x1e\x56\x24\x35\x97\x6f\xbe\xb9\x50\xcb\xe5\x72\x81\xb5\xfb\x89\x12\xbb\x18\x56\x80\xb5\xa3\x1f\x42\x41\x9f\xb8\x78\xf9\x13\x17\x2e\xde\xee\x3e\x2d\x5e\x5c\xb0\x2b\xb8\x6f\x58\x62\xf5\x8d\x38\x36\xc9\xd0\x67\xda\xb8\xe0\xc4\xc5\xb0\xa8\x48\xd0\xa2\xe0\x6a\x01\x80\x21\x44\x41\x5d\x66\x7d\x04\x30\x31\x48\x8a\xde\x53\x5a\x6e\x29\x14\x2f\xcd\x9a\xd6\x8d\xf3\x96\x52\x16\xde\xab\xde\x7d\x2c\x3e\x7d\x2c\x3e\x2e\x00\x4c\xa2\xfc\xfe\xb3\xab\x88\x05\xab\x7a\x05\xa1\xf1\x7e\x01\x10\xb0\xa2\x15\xbc\xc6\xf4\xc2\x35\x1a\xe2\x42\x28\x60\x30\xfb\x62\x83\x29\x72\xc1\xe5\x82\x6b\x32\xaa\x76\x9b\x62\x53\xaf\xe0\x6c\xbf\x95\xd1\x59\xd6\x7a\xf5\xcf\x5e\x5c\x5e\xf3\x8e\xe5\xef\xc7\xeb\x5f\x1c\x4b\xde\xab\x7d\x93\xd0\x8f\x0d\xc8\xcb\xec\xc2\xb6\xf1\x98\x46\x1b\x0b\x00\x36\xb1\xa6\x15\xfc\x03\x2b\xca\x4b\x76\x01\xd0\x39\x9b\xd5\x2f\x01\xad\xcd\xf0\xa1\x7f\x4c\x2e\x08\xa5\xfb\xe8\x9b\xaa\x87\x6d\x09\x3f\x73\x0c\x8f\x28\xe5\x0a\x0a\x16\x94\x86\x8b\xba\x44\xa6\xac\xb3\xc7\xe2\x71\xb4\x22\x7b\x55\xc8\x92\x5c\xd8\x9e\x8b\xe8\x63\x54\x9c\xc1\x7b\x24\xf0\x6e\x7b\x2c\xce\xa2\xb4\x0b\xad\xbe\xdd\x27\xf4\x75\x89\x9f\xf2\x12\x9b\x92\xaa\x1c\x74\x7d\x8a\x35\x85\xbb\xc7\x87\x9f\x7e\xff\x74\xb4\x0c\x60\x89\x4d\x72\xb5\xea\x1c\xa1\x0a\x8e\x41\x4a\x82\xf6\x34\x6c\x62\xca\x8f\x87\xfd\xbb\xc7\x87\x41\x44\x9d\x62\x4d\x49\x5c\x1f\xb9\xf6\x37\x62\xee\x68\xf5\x44\xe1\x07\xb5\xa9\x3d\x05\x56\x29\x4b\xad\xde\x2e\x16\x64\x3b\x37\x20\x6e\x40\x4a\xc7\x90\xa8\x4e\xc4\x14\x5a\x12\x1f\x09\x06\x3d\x84\x01\xe2\xfa\x67\x32\x52\xc0\x13\x25\x15\x03\x5c\xc6\xc6\x5b\x65\xfa\x8e\x92\x40\x22\x13\xb7\xc1\xfd\x7b\x90\xcd\x20\x31\x2b\xf5\x28\xd4\x91\xe9\xf0\xcb\xb1\x0f\xe8\x61\x87\xbe\xa1\x1b\xc0\x60\xa1\xc2\x3d\x24\x52\x2d\xd0\x84\x91\xbc\x7c\x84\x0b\xf8\x1a\x13\x81\x0b\x9b\xb8\x82\x52\xa4\xe6\xd5\xed\xed\xd6\x49\x9f\xb1\x26\x56\x55\x13\x9c\xec\x6f\x73\xf2\xb9\x75\x23\x31\xf1\xad\xa5\x1d\xf9\x5b\x76\xdb\x25\x26\x53\x3a\x21\x23\x4d\xa2\x5b\xac\xdd\x32\x9b\x1e\xd4\x61\x2e\x2a\xfb\x9b\xd4\xe5\x38\x7f\x38\xb2\xf5\x8c\x61\xed\x5f\xce\xa3\x0b\x11\xd0\x7c\xd2\x70\x63\xf7\x6a\xeb\xe8\x01\x68\x5d\x52\x74\xbe\xfd\xe5\xe9\x19\x7a\xd5\x39\x18\x47\x42\xa1\xc3\xfd\xf0\x22\x1f\x42\xa0\x80\xb9\xb0\x21\x65\x91\x63\xd8\xa4\x58\x65\xc4\x29\xd8\x3a\xba\x20\xf9\xc1\x78\x47\xe1\x14\x7e\x6e\xd6\x95\x13\x8d\xfb\xbf\x1a\x62\xd1\x58\x15\x70\x9f\xcb\x18\xac\x09\x9a\x5a\x53\xc0\x16\xf0\x10\xe0\x1e\x2b\xf2\xf7\xc8\xf4\xab\x07\x40\x91\xe6\xa5\x02\x7b\x5d\x08\xc6\x15\xf8\xf0\x4f\xa5\xac\x3a\xd4\x46\x1b\x7d\x91\x9c\x89\xd7\x90\x82\x4f\x35\x99\xa3\x9c\xb1\xc4\x2e\x29\xab\x05\x85\x34\x17\xc6\x05\x0f\xe0\x72\xb6\x9e\xea\x39\xd9\x3a\x31\xe2\xf3\xe1\xa1\xa5\x4e\xc3\x94\x20\x11\x5a\x5c\x7b\x1a\x9f\x55\x3b\x34\xb8\xd3\xb6\x5c\xc0\x4c\xff\x2a\xaa\xd6\x94\xf8\x0d\x63\xbe\xb6\xa7\x5a\x43\xb4\x43\xa8\xce\x6c\x10\x55\xe8\x3c\xc3\x6b\x19\x01\x13\xf5\xe2\xfa\x5a\x72\x26\x15\x2e\x58\xe9\x84\xaa\x09\x43\x2e\x9a\xdf\x6f\x62\x4a\xb8\x3f\xd9\x13\xaa\x6a\xad\x37\x6f\xf8\xf6\xdc\x1d\xeb\xeb\xb1\xd6\xf9\x1e\xd2\x81\x09\xc3\xa1\xcb\x40\x83\x0a\xa9\x53\xdc\xb9\xae\xb4\xbe\x3a\x29\x17\x57\xfb\x33\x47\xd7\xdc\xfc\x56\x8b\x59\x17\x0e\x84\xcd\x27\x8f\x28\x1b\xd7\xac\x45\x7a\xc4\xd9\xe1\xf4\x95\x9c\xcd\xb5\x72\xf7\x16\x8c\x77\xed\xa9\x29\x14\x99\x4c\x22\x01\x17\x86\x9d\xac\xbe\xdb\xbe\xc4\x11\x28\xa3\xb7\x7d\x75\xec\xec\xd0\xd7\xb4\x5c\xb5\x30\xf1\x0d\x08\xbe\x50\x80\xd7\x92\x02\x38\x51\x37\x93\x90\x9d\x90\x2a\x94\x2a\x17\x50\xe6\x59\x34\x49\x31\x13\x43\x3b\xa6\xf0\x1b\x08\xdc\x37\x29\x51\x10\x45\xd2\x10\xeb\x50\x74\xc0\x5c\xed\xbf\xdb\x52\x90\xe2\x7a\xda\x1f\x0b\xef\xad\x18\x82\x9b\xdb\xb0\xc6\x16\xfb\x22\x80\x1d\x26\xa0\xd5\x27\xaf\xa2\x9f\x90\x0b\xad\x59\xe7\x96\x5c\x22\x41\xfb\xf3\xc8\xf2\x9c\x30\x70\x06\x44\x07\xa8\xe9\x73\x27\xc6\x7f\x41\x16\x10\x57\x51\x26\xc0\x00\x28\xc8\x20\x8a\x6c\xdb\xb1\x62\xa0\x8e\xee\x33\x72\x41\x27\x09\x0c\x51\x4a\x4a\x05\x3c\x97\x6e\x18\x3e\xd6\xd4\x72\x40\x55\x34\xc1\x52\xf2\x7b\x0d\xc1\x41\x9b\x29\x31\x6c\xc9\x4e\xf9\xdd\xfe\x1e\x34\x4e\x28\x4a\x61\xed\x7d\x2f\x21\xbe\x86\x1b\x35\x39\x40\xc3\x3d\x0b\xb3\x1b\x83\xa2\xbb\xc7\x07\xd8\x38\xf2\x53\x7c\xeb\xe8\xd3\x6a\x55\xa1\x68\x0c\xd5\xa2\xe5\x7b\xce\x86\x4d\x4c\x15\x4a\x3b\x74\x2e\x55\xd3\xcc\xb9\x0b\x74\xed\xcb\x3a\x33\x6e\xaf\x8b\xce\x1d\x94\x4d\x85\xe1\xd0\x5b\xba\x97\xc1\x05\xeb\x4c\xce\x17\xb0\x24\xb9\xce\xe3\x3a\x36\xb2\x98\x94\xa9\x66\x95\x34\x8a\x69\x17\x9e\x0c\x4f\x9e\xe7\xd6\x04\x54\xd5\xb2\x2f\xde\xeb\x55\x22\xe4\x18\xae\x72\xea\xb9\x24\x75\x88\x63\x18\x26\xeb\x81\x09\x1f\x38\x13\x79\x64\xea\x8c\x44\x1d\x4d\xc7\x33\x8f\x0a\xd5\xd9\xc1\x6d\x9c\xc9\xa1\x57\xaf\x4c\x19\x23\x67\xee\x29\x27\x21\xa6\x4c\x9e\x99\xee\xd7\x85\x39\x43\xe2\x58\x07\x66\x76\x96\x74\xa4\x40\xd8\x36\x98\x30\x08\x91\x55\xd9\x67\xe8\xa9\xd4\xf5\x1c\x21\xe0\xff\x44\x96\x69\x47\xc9\xc9\xfe\x2a\x6c\x9f\xba\xc3\x5a\x2e\x76\xce\xb6\xb5\x88\x7e\xd4\xde\x19\x27\x60\x3c\x32\x2b\x42\x7d\x5d\x9a\x11\x09\xf0\xad\x8d\x8f\x89\x96\x6e\x80\xdb\x0b\x82\x0e\x15\xac\x20\x56\x68\xca\x5c\xe7\x0c\x06\x70\x55\x45\xd6\xa1\x90\xdf\xb7\xb9\xcd\x82\x61\x3e\xe7\x54\x90\xe9\xaa\x31\x3b\x69\x5a\x4b\xf4\x5a\x81\x46\x00\x8d\x89\x49\xbb\x8a\xdf\x2b\xc8\x74\xf0\xe7\x72\x26\x7f\xfd\xfe\xf4\xac\x03\x31\x93\x40\x0c\x7e\xaf\x21\x0f\xf0\x94\xab\xd5\x9f\xff\x8a\x9e\xe9\xfd\xf0\x4f\x74\xf8\x39\xf0\xf3\xd1\xbe\xa7\x0c\x9c\xbe\xc9\xa5\x33\x6e\xe0\x39\xe9\x15\x2a\x9b\x73\x03\xdf\x43\x2e\x62\xef\xb6\x2b\x1f\xb8\xc6\xaa\xe7\x7d\x9d\xb5\x0f\xf6\x1c\x65\x8e\xc6\xd3\x05\xd8\xc4\x58\xd0\x0f\xac\x6a\x4f\x85\x89\xd5\xed\x21\xb3\x66\x54\x00\x7c\xc5\xb0\x87\x62\x90\x5a\xa8\x41\xed\xed\x89\xf3\xbc\x99\x13\x88\x45\xdb\x2e\x9a\x14\x99\x87\xeb\xd3\x7c\xf6\x79\xf7\x42\x70\xb7\x43\xe7\xb5\x14\xdf\xc0\xba\xd1\xc4\x32\xd8\xb0\xce\x18\x6b\x27\x09\xd3\xfe\x80\x6c\xcb\x40\xbd\x08\x31\x6d\x9a\xe9\x86\xaa\xbf\xdf\x32\x11\x14\x21\x5a\xea\xbf\x56\x1c\x44\xfc\x2e\xb7\x11\xc0\xb5\xf3\x9a\x37\x12\xc1\x92\x89\x61\xe3\x9d\xd1\x76\x33\x2b\xd3\x55\x75\x4c\x82\x41\xde\x19\x41\xbd\xd2\xe9\x85\x65\x8a\x59\xcb\x89\x6e\x3e\x79\x6c\xb6\x1f\x2f\x33\xb1\x27\x36\x66\xe6\xd8\xb7\x06\x76\x4b\x9e\x14\xf1\xcf\x84\xd6\xbb\x30\xc1\xbc\x23\xce\x7d\x3e\x39\xae\x2d\x36\xa7\x24\x8e\x47\xbd\xd1\x30\xe9\xb8\x55\x31\x3d\x03\x3c\x48\x1f\xe8\x44\x2c\x51\x6b\x72\x13\xc4\x79\x8d\x5c\x28\x16\xbf\xbc\x55\x5f\x88\x4d\xfe\x8e\xf5\x86\x7b\xf9\xcb\x56\x3f\x4e\x7b\xb7\x21\xb3\x37\x9e\xda\x57\xfb\xf4\x1f\x7c\xfb\x25\xca\xfb\x7b\xd1\xdf\x28\x74\x63\xe2\x95\x37\xa4\xc3\x0b\xbd\x59\xdb\xc3\x4a\x67\x51\x2f\x3c\x93\xeb\x4c\xac\x7e\xb2\xaa\xbd\x23\xdb\x7f\x0f\x9a\x77\xa0\xc7\xd7\x05\xf9\xe3\x1f\xce\x76\x5b\x6c\xf5\xc3\xd1\x96\xd2\xc9\xee\x20\xf3\xfb\xb7\x2f\x6f\x78\x36\x5c\x85\xbe\x7f\xfb\xd2\xfb\xa4\xff\x7d\x3f\xbc\x93\xd4\x3f\x5b\xd4\xb1\x9d\xec\x0a\x24\x35\xad\xe7\x4a\x38\x9d\xd7\x46\x2b\xcd\x7a\x28\x65\xbd\x17\x2c\x28\x0d\xaf\xe0\x3f\xff\x5d\xfc\x6f\x00\xc2\x33\x85\xee\xb9\x16\x00\x00")
 
 func crdsTenancyFarosSh_workspacesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -561,7 +607,27 @@ func crdsTenancyFarosSh_workspacesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kcpApiexportAccessYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8e\xb1\x4e\xc5\x30\x0c\x45\xf7\x7e\x85\xd5\xfd\x05\xbd\x35\x1b\x42\x0c\x6c\x08\x24\x76\xcb\xbd\xd0\xe8\x25\x8d\xb1\x9d\x0a\xfe\x1e\xf5\xc1\x04\x03\xeb\x3d\x3a\x47\x97\xb5\xbc\xc0\xbc\xf4\x2d\x13\x6b\xf1\x74\x11\x4d\x0b\xf6\x9b\xfd\xcc\x55\x57\x3e\x4f\x97\xb2\x2d\x99\x6e\x1f\x1f\xee\x3f\xb4\x5b\x4c\x0d\xc1\x0b\x07\xe7\x89\x68\xe3\x86\x4c\x2c\x02\xf7\xf4\xca\xd6\x3d\xf9\x3a\xb9\x42\x0e\x5a\x39\xe0\xf1\x04\xef\xc3\x04\xcf\xb2\xa2\xb1\x1f\x80\xe8\x44\xd1\x17\xfe\x4c\x86\xf7\x01\x0f\x4f\xbf\x1b\x44\x0a\x6b\xc5\x8f\x67\x77\x95\x4b\xbb\x8a\x27\x7a\xb3\x3e\x34\xd3\x3c\x5f\x33\xf6\xd3\xce\x34\x3b\xc4\x10\xfe\xbd\x73\xad\x99\xc2\x06\xfe\x73\x6c\x2f\x02\x16\xe9\x63\xfb\xeb\x7e\x05\x00\x00\xff\xff\x6f\x6b\x22\x5d\x1c\x01\x00\x00")
+var _crdsTenancyFarosSh_workspacetemplatesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcd\x92\xdb\x36\x0c\xbe\xeb\x29\x30\xd3\x43\x2e\x91\x9c\x9d\x5e\x3a\xba\x6d\x9d\x1c\x76\xda\x74\x3c\xd9\x4c\x7a\xa6\x45\x58\x66\x96\x22\x19\x80\x74\xe2\x3e\x7d\x07\x94\x64\xcb\x96\xbc\xbb\xe9\x4c\x57\x3e\x2c\x09\xf0\x03\xf0\xe1\x87\x2c\xcb\xb2\x50\xc1\x7c\x41\x62\xe3\x5d\x0d\x2a\x18\xfc\x11\xd1\xc9\x8a\xab\xa7\xdf\xb8\x32\x7e\x75\xb8\x2b\x9e\x8c\xd3\x35\xac\x13\x47\xdf\x7d\x42\xf6\x89\x1a\x7c\x8f\x3b\xe3\x4c\x34\xde\x15\x1d\x46\xa5\x55\x54\x75\x01\xa0\x9c\xf3\x51\xc9\x36\xcb\x12\xa0\xf1\x2e\x92\xb7\x16\xa9\x6c\xd1\x55\x4f\x69\x8b\xdb\x64\xac\x46\xca\xe0\xa3\xe9\xc3\xbb\xea\xee\x5d\xf5\xae\x00\x68\x08\xf3\xf9\xcf\xa6\x43\x8e\xaa\x0b\x35\xb8\x64\x6d\x01\xe0\x54\x87\x35\x7c\xf7\xf4\xc4\x41\x35\x18\xb1\x0b\x56\x45\xe4\x2a\xa2\x53\xae\x39\x56\x3b\x45\x9e\x2b\xde\x17\x1c\xb0\x11\xf3\x2d\xf9\x14\x6a\x98\xc9\x7b\xac\xc1\xc3\x3e\xba\xbf\x47\xd8\xcf\x03\x6c\x96\x59\xc3\xf1\x8f\x65\xf9\x9f\x86\x63\xd6\x09\x36\x91\xb2\x4b\x8e\x65\x31\x1b\xd7\x26\xab\x68\x41\xa1\x00\xe0\xc6\x07\xac\x61\x6d\x13\x47\xa4\x02\x60\x60\x24\xfb\x56\x82\xd2\x3a\x73\xac\xec\x86\x8c\x8b\x48\x6b\x6f\x53\x37\x72\x5b\xc2\x57\xf6\x6e\xa3\xe2\xbe\x86\x4a\x62\xae\x34\x72\x43\x26\xc8\x91\x6c\x7c\x24\xed\xfd\x6c\x3f\x1e\xc5\x2e\x47\x32\xae\x9d\x83\x8d\x29\xad\x66\xd9\xb8\x80\xbd\x6f\xf1\x02\x4e\x8f\xbc\xf5\x56\x0f\x77\xca\x86\xbd\xba\xcb\x3a\xdc\xec\xb1\xcb\x35\x22\x2b\x1f\xd0\xdd\x6f\x1e\xbe\xfc\xfa\x78\xb1\x0d\x30\x89\x60\x81\x74\x30\x0c\x71\x8f\xd0\x9f\x82\x9d\xa7\xbc\x9c\xeb\xdd\x6f\x1e\xaa\x13\x26\x9c\x81\x18\x08\x77\x48\xe8\x1a\xe3\x5a\x50\x30\xa6\x02\x14\x21\x04\xc2\x32\x90\x3f\x18\xc9\x00\x6a\xf8\x6e\xe2\x1e\x4c\xe4\x5c\xc4\xe8\xe2\x19\x31\x90\x0f\x48\xd1\x8c\x35\xd4\x7f\x93\x5e\x9a\xec\x5e\xc5\xf4\x46\xc2\xee\xb5\x40\x4b\x13\x61\x1f\xd2\x90\x78\xd4\x03\x53\xe0\x77\x10\xf7\x46\x1c\x0e\x84\x8c\xae\x6f\xab\x0b\x60\x10\x25\xe5\xc0\x6f\xbf\x62\x13\x2b\x78\x44\x12\x18\xe0\xbd\x4f\x56\x8b\xdb\x07\xa4\x08\x84\x8d\x6f\x9d\xf9\xe7\x84\xcd\x10\x7d\x36\x2a\xa1\x0f\x65\x7c\xfe\x72\xa1\x39\x65\xe1\xa0\x6c\xc2\xb7\xa0\x9c\x86\x4e\x1d\x81\x50\xac\x40\x72\x13\xbc\xac\xc2\x15\x7c\xf4\x84\x60\xdc\xce\xd7\xb0\x8f\x31\x70\xbd\x5a\xb5\x26\x8e\x33\xa4\xf1\x5d\x97\x9c\x89\xc7\x95\x30\x49\x66\x9b\xa2\x27\x5e\x69\x3c\xa0\x5d\xb1\x69\x4b\x45\xcd\xde\x44\x6c\x62\x22\x5c\xa9\x60\xca\xec\xba\x93\x80\xb9\xea\xf4\x2f\x34\x4c\x1d\x7e\x73\xe1\xeb\xac\x88\xfb\x5f\xee\xe8\x67\x32\x20\x1d\x2d\x95\xa4\x86\xa3\x7d\xa0\x67\xa2\x65\x4b\xd8\xf9\xf4\xe1\xf1\x33\x8c\xa6\x73\x32\x2e\x40\x61\xe0\xfd\x7c\x90\xcf\x29\x10\xc2\x8c\xdb\xa1\x14\xa8\x61\xd8\x91\xef\x32\xe3\xe8\x74\xf0\xc6\xc5\xbc\x68\xac\x41\x77\x4d\x3f\xa7\x6d\x27\x45\x47\xf8\x2d\x21\x47\xc9\x55\x05\xeb\x3c\x58\x61\x8b\x90\x82\x74\x99\xae\xe0\xc1\xc1\x5a\x75\x68\xd7\x8a\xf1\x7f\x4f\x80\x30\xcd\xa5\x10\xfb\xba\x14\x4c\xef\x84\xf3\x9f\xa0\xd4\x03\x6b\x13\xc1\x38\xae\x6f\xe4\x6b\xd6\xdd\x8f\x01\x9b\x8b\xde\x19\xfa\x53\xba\xe1\x34\x64\xb9\xbf\x49\x50\x5f\x00\xc3\x39\x13\x93\x29\x0c\xf0\x7c\x63\x0f\xcd\xfd\xbb\x71\xda\xb8\x76\x26\xba\xf2\xf7\x7e\xf3\x30\x6a\xe6\xb1\x72\xbf\x79\x60\xd8\xfa\x24\x45\xe7\x26\x0e\xbe\x95\xe5\x38\xe1\x67\x90\x30\xf6\x68\xbe\xb5\xa6\x20\xdb\xa3\x04\xaf\x92\xbd\xae\x1c\x00\x13\xb1\x5b\x70\xef\x25\x42\xcf\x1e\xe7\xb6\x70\x62\xed\xc3\x8f\xe0\x29\x9e\xfc\x5e\xc0\x84\x49\x2c\x0b\xe2\xdb\x54\xe6\x26\x05\xcc\x06\xfe\x92\x9b\x62\x51\x7e\xe5\xf4\x87\x93\xfa\x78\x09\xc8\x25\x23\x29\x97\xff\x4f\x0e\xbf\x05\x53\x61\x05\xa8\x5b\x9c\xde\xf7\x4b\x7f\x37\x4a\xf7\xfc\x05\xb9\x0c\x5f\xe3\x9b\x5c\xc1\xa3\x27\x27\x4e\x16\x5c\x1b\xd2\xc6\x37\x30\x4f\x29\xbf\xc0\xc8\x51\x4c\xde\x51\xfc\xdf\xc2\x91\x71\x62\x08\xaf\x06\x63\xff\x2b\x27\xc9\x58\x10\xdf\x68\xdb\xa9\x50\x11\xa9\x63\x71\x9b\xa2\xe2\x59\xfe\x26\xcf\x13\x49\xae\x82\xc4\x48\x40\xa8\xb4\xda\x5a\x9c\xea\x8e\xa4\x2e\x36\xef\x0b\x2c\x74\xca\x99\x9d\x4c\xd4\x17\xbc\xf9\x38\xea\xe5\xee\xed\xc3\x3e\x4d\x93\x59\x0b\x4b\xb5\x05\x9b\x5a\xe3\x96\x12\xe3\x09\xbe\x25\x1f\x15\x57\x20\xa5\x9b\x4f\xe9\x81\x4a\xce\x0f\x0c\x9f\x62\x7e\x4d\x65\x51\xb6\x38\xb1\x14\xf7\xf3\x08\x61\xec\xfe\xf3\xb1\xea\xf5\x83\xe0\xd9\x5c\x02\xfc\x28\xe5\x85\x4e\x0e\x23\x72\x99\xdf\x1d\x74\xc0\x32\xb9\x27\xe7\xbf\xbb\x72\x67\xd0\x6a\xae\x21\x52\xc2\x9f\x28\x84\x93\x9f\x2f\x31\x7f\xa2\xe8\x06\xdd\x3f\x1b\xe6\x8d\x66\xb8\xe5\xe9\x22\x37\xb3\x4d\x96\x47\x96\x9e\x90\xc0\xd1\x93\x6a\xb1\x86\x48\x09\x8b\x7f\x07\x00\xa6\xab\xbf\xd4\x55\x0d\x00\x00")
+
+func crdsTenancyFarosSh_workspacetemplatesYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_crdsTenancyFarosSh_workspacetemplatesYaml,
+		"crds/tenancy.faros.sh_workspacetemplates.yaml",
+	)
+}
+
+func crdsTenancyFarosSh_workspacetemplatesYaml() (*asset, error) {
+	bytes, err := crdsTenancyFarosSh_workspacetemplatesYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "crds/tenancy.faros.sh_workspacetemplates.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _kcpApiexportAccessYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8e\xb1\x4e\xc5\x30\x0c\x45\xf7\x7e\x85\xd5\xfd\x05\xbd\x35\x1b\x42\x0c\x6c\x08\x24\x76\xcb\xbd\xd0\xe8\x25\x8d\xb1\x9d\x0a\xfe\x1e\xf5\xc1\x04\x03\xeb\x3d\x3a\x47\x97\xb5\xbc\xc0\xbc\xf4\x2d\x13\x6b\xf1\x74\x11\x4d\x0b\xf6\x9b\xfd\xcc\x55\x57\x3e\x4f\x97\xb2\x2d\x99\x6e\x1f\x1f\xee\x3f\xb4\x5b\x4c\x0d\xc1\x0b\x07\xe7\x89\x68\xe3\x86\x4c\x2c\x02\xf7\xf4\xca\xd6\x3d\xf9\x3a\xb9\x42\x0e\x5a\x39\xe0\xf1\x04\xef\xc3\x04\xcf\xb2\xa2\xb1\x1f\x80\xe8\x44\xd1\x17\xfe\x4c\x86\xf7\x01\x0f\x4f\xbf\x1b\x44\x0a\x6b\xc5\x8f\x67\x77\x95\x4b\xbb\x8a\x27\x7a\xb3\x3e\x34\xd3\x3c\x5f\x33\xf6\xd3\xce\x34\x3b\xc4\x10\xfe\xbd\x73\xad\x99\xc2\x06\xfe\x73\x6c\x2f\x02\x16\xe9\x63\xfb\xeb\x7e\x0d\x00\x6f\x6b\x22\x5d\x1c\x01\x00\x00")
 
 func kcpApiexportAccessYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -581,7 +647,7 @@ func kcpApiexportAccessYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kcpApiexportEdgeYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x8f\x41\x4b\x34\x31\x0c\x40\xef\xf3\x2b\xc2\xde\xbf\x7e\xec\x4d\x7a\x13\xf1\xe0\x4d\x14\xbc\x67\xdb\x6c\x27\x6c\xdb\x94\x24\xb3\xa8\xbf\x5e\x46\x17\x44\x06\x14\xc1\x6b\x5f\xdf\x4b\x82\x83\x9f\x48\x8d\xa5\x47\xc0\xc1\x16\x4e\x69\x84\x4c\xe7\xff\xe7\x3d\xd6\x31\xe3\x7e\x3a\x71\xcf\x11\xae\xef\xef\x6e\x9f\x87\xa8\x4f\x8d\x1c\x33\x3a\xc6\x09\xa0\x63\xa3\x08\x94\x0b\x85\x23\xaa\x58\xb0\x79\xb2\x41\x69\x65\x15\x9d\xcc\x1f\xc8\x64\xd1\x44\x8f\x69\xa6\x86\xb6\x82\x7f\xe0\x92\xf1\x25\x60\xa1\xee\x16\xbe\xda\x9f\x58\xa9\xb0\xb9\xa2\xb3\xf4\xed\xaf\x41\xda\xd8\xd6\xbd\x6f\x2a\x72\xbb\x84\x8b\xca\x32\x22\xec\x76\x13\x00\x80\x5e\x66\x47\xd8\x19\x25\x25\xb7\x8f\x77\xac\x35\x82\xeb\x42\x3f\x39\x7a\xe6\x44\x98\x92\x2c\xfd\x97\x6e\x92\x7e\xe4\xd2\x70\x7c\xab\xe9\x01\x53\xc0\xc5\x67\x51\x7e\x7d\x3f\x34\x9c\xae\x2c\xb0\x6c\x7a\x2a\x95\xfe\x30\x75\xe0\x9e\xb9\x97\x4d\xf1\x2d\x00\x00\xff\xff\x4d\x77\xcf\xab\x0e\x02\x00\x00")
+var _kcpApiexportEdgeYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x8f\x41\x4b\x34\x31\x0c\x40\xef\xf3\x2b\xc2\xde\xbf\x7e\xec\x4d\x7a\x13\xf1\xe0\x4d\x14\xbc\x67\xdb\x6c\x27\x6c\xdb\x94\x24\xb3\xa8\xbf\x5e\x46\x17\x44\x06\x14\xc1\x6b\x5f\xdf\x4b\x82\x83\x9f\x48\x8d\xa5\x47\xc0\xc1\x16\x4e\x69\x84\x4c\xe7\xff\xe7\x3d\xd6\x31\xe3\x7e\x3a\x71\xcf\x11\xae\xef\xef\x6e\x9f\x87\xa8\x4f\x8d\x1c\x33\x3a\xc6\x09\xa0\x63\xa3\x08\x94\x0b\x85\x23\xaa\x58\xb0\x79\xb2\x41\x69\x65\x15\x9d\xcc\x1f\xc8\x64\xd1\x44\x8f\x69\xa6\x86\xb6\x82\x7f\xe0\x92\xf1\x25\x60\xa1\xee\x16\xbe\xda\x9f\x58\xa9\xb0\xb9\xa2\xb3\xf4\xed\xaf\x41\xda\xd8\xd6\xbd\x6f\x2a\x72\xbb\x84\x8b\xca\x32\x22\xec\x76\x13\x00\x80\x5e\x66\x47\xd8\x19\x25\x25\xb7\x8f\x77\xac\x35\x82\xeb\x42\x3f\x39\x7a\xe6\x44\x98\x92\x2c\xfd\x97\x6e\x92\x7e\xe4\xd2\x70\x7c\xab\xe9\x01\x53\xc0\xc5\x67\x51\x7e\x7d\x3f\x34\x9c\xae\x2c\xb0\x6c\x7a\x2a\x95\xfe\x30\x75\xe0\x9e\xb9\x97\x4d\xf1\x6d\x00\x4d\x77\xcf\xab\x0e\x02\x00\x00")
 
 func kcpApiexportEdgeYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -601,7 +667,7 @@ func kcpApiexportEdgeYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kcpApiexportPluginsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\xd0\xb1\x4a\xc5\x40\x10\x85\xe1\x7e\x9f\x62\x5f\xc0\x95\xdb\x6e\x27\x62\x61\x27\x0a\xf6\x87\xcd\xdc\x9b\x21\xd9\x99\x65\x66\x12\xf5\xed\x25\x9d\xe0\x85\xd4\xff\xc7\x29\x0e\x06\x7f\x92\x39\xab\xd4\x8c\xc1\x5e\x96\x36\xca\x44\xfb\xe3\x7e\xc1\x3a\x66\x5c\xd2\xc2\x32\xd5\xfc\xf4\xf6\xfa\xf2\x3d\xd4\x22\x75\x0a\x4c\x08\xd4\x94\xb3\xa0\x53\xcd\x63\xdd\x6e\x2c\x5e\xae\x30\xf5\xe2\x73\xf2\x41\xed\xc8\x2b\x82\x3c\xde\xc9\x75\xb3\x46\x1f\x6d\xa6\x0e\x3f\x42\xce\x0f\x39\x74\xc2\x4f\x41\x6b\xe4\x4e\x5e\xfe\x8d\xfc\x55\x4d\x25\xc0\x42\x66\x9b\x04\xf7\x33\xde\x55\x38\xd4\x58\x6e\x27\x50\x28\xbe\xd4\x96\x33\xa5\xc1\x57\x6e\x08\x56\xb9\x4b\x07\x59\x67\x3f\x4e\x7c\x5e\xc1\xdd\x6b\xfa\x0d\x00\x00\xff\xff\x77\x46\xa4\x74\x57\x01\x00\x00")
+var _kcpApiexportPluginsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\xd0\xb1\x4a\xc5\x40\x10\x85\xe1\x7e\x9f\x62\x5f\xc0\x95\xdb\x6e\x27\x62\x61\x27\x0a\xf6\x87\xcd\xdc\x9b\x21\xd9\x99\x65\x66\x12\xf5\xed\x25\x9d\xe0\x85\xd4\xff\xc7\x29\x0e\x06\x7f\x92\x39\xab\xd4\x8c\xc1\x5e\x96\x36\xca\x44\xfb\xe3\x7e\xc1\x3a\x66\x5c\xd2\xc2\x32\xd5\xfc\xf4\xf6\xfa\xf2\x3d\xd4\x22\x75\x0a\x4c\x08\xd4\x94\xb3\xa0\x53\xcd\x63\xdd\x6e\x2c\x5e\xae\x30\xf5\xe2\x73\xf2\x41\xed\xc8\x2b\x82\x3c\xde\xc9\x75\xb3\x46\x1f\x6d\xa6\x0e\x3f\x42\xce\x0f\x39\x74\xc2\x4f\x41\x6b\xe4\x4e\x5e\xfe\x8d\xfc\x55\x4d\x25\xc0\x42\x66\x9b\x04\xf7\x33\xde\x55\x38\xd4\x58\x6e\x27\x50\x28\xbe\xd4\x96\x33\xa5\xc1\x57\x6e\x08\x56\xb9\x4b\x07\x59\x67\x3f\x4e\x7c\x5e\xc1\xdd\x6b\xfa\x1d\x00\x77\x46\xa4\x74\x57\x01\x00\x00")
 
 func kcpApiexportPluginsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -621,7 +687,7 @@ func kcpApiexportPluginsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kcpApiexportWorkspaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\x3f\x4b\x04\x41\x0c\x47\xfb\xf9\x14\x61\x7b\x47\xae\x9d\x4e\xc4\xc2\x4e\x14\xec\xc3\xec\x4f\x77\xd8\xf9\x13\x92\xcc\xe9\x7d\x7b\x59\x3c\xb0\xf0\xda\xbc\xc7\xcb\x8f\xa5\xbc\x43\xad\x8c\x9e\x88\xa5\x58\xdc\xb3\xc4\x15\xe7\xfb\xf3\x89\xab\x6c\x7c\x0a\x7b\xe9\x6b\xa2\x87\x97\xe7\xa7\x6f\x19\xea\xa1\xc1\x79\x65\xe7\x14\x88\x3a\x37\x24\x72\x74\xee\xf9\x12\x3f\x58\x87\x45\xdb\x82\x09\xf2\x81\x2b\x3b\xcc\x5f\x61\x63\x6a\xc6\x5b\xde\xd0\xd8\x0e\x70\x47\x3e\x56\xbe\xc4\xaf\xa1\xbb\x09\x67\x58\xfc\x17\xf9\xb3\xa6\x41\x6f\x0a\x02\x6d\xc5\x8e\xf1\x8f\x95\x4b\xbb\xa6\x3f\x75\x4c\x49\xb4\x2c\x81\x88\x48\xaf\xdf\x13\x2d\x86\xac\x70\xfb\xbd\x73\xad\x89\x5c\x27\xc2\x4f\x00\x00\x00\xff\xff\x2f\x42\x30\x1f\x03\x01\x00\x00")
+var _kcpApiexportWorkspaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x31\x4f\xc4\x30\x0c\x85\xf7\xfc\x0a\xab\x3b\x41\xb7\x66\x43\x88\x81\x0d\x81\xc4\xfe\x94\x9a\x6b\xd4\x26\xb1\x6c\xf7\xe0\xf8\xf5\xa8\x70\x82\x81\x53\x57\xbf\x4f\x9f\xf5\x1e\xa4\xbc\xb2\x5a\xe9\x2d\x11\xa4\x58\x9c\xb3\xc4\x91\x4f\xb7\xa7\x03\x16\x99\x70\x08\x73\x69\x63\xa2\xbb\xa7\xc7\x87\x0f\xe9\xea\xa1\xb2\x63\x84\x23\x05\xa2\x86\xca\x89\x9c\x1b\x5a\x3e\xc7\x37\x68\xb7\x68\x53\x30\xe1\xbc\xc5\x0b\x9c\xcd\x9f\xd9\xfa\xaa\x99\x5f\xf2\xc4\x15\xb6\x05\x37\xe4\x7d\xc4\x39\xbe\x77\x9d\x4d\x90\xd9\xe2\x3f\xc9\x1f\xb5\x1a\xeb\x2e\xf0\xab\x71\xae\xf2\xfd\x74\x8f\xee\x7a\x44\x2b\x9f\xf0\xd2\xdb\xae\xd6\x19\xf5\x2a\x20\xac\xb5\xd8\x36\xda\xfd\x82\x52\x2f\x95\x8e\xda\x57\x49\x34\x0c\x81\x88\x48\x2f\xad\x13\x0d\xc6\x59\xd9\xed\xe7\x8e\x65\x49\xe4\xba\x72\xf8\x1a\x00\xd7\x0d\x03\x00\x7b\x01\x00\x00")
 
 func kcpApiexportWorkspaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -641,7 +707,7 @@ func kcpApiexportWorkspaceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kcpKustomizationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\x41\x0e\x83\x30\x0c\x04\xef\xbc\x22\x1f\x80\x07\xf4\x37\x96\xd9\xa6\x16\x24\xb6\xec\x44\x2d\x7d\x7d\xa5\x82\x38\xe5\xba\x33\x23\xad\x23\xb4\x3b\x23\x1e\x53\x4a\x73\x6a\xba\xd2\xb1\x90\xc9\xbd\xf3\x0b\x85\x62\x39\xa8\xec\x7f\x83\x4c\xf0\x31\xf5\x36\x13\x33\x62\x48\xb0\x66\x8c\x76\xdb\x7b\x96\x3a\x4c\xde\xea\x5b\x18\xf1\xd5\x4d\xac\xf5\x29\xb9\x3b\x35\xd1\x7a\x9d\xdb\x7a\x34\x2d\xf2\xc5\x09\x4f\xf3\x17\x00\x00\xff\xff\x57\x66\x7d\x89\xc1\x00\x00\x00")
+var _kcpKustomizationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\x41\x0e\x02\x21\x0c\x45\xf7\x9c\x82\x0b\x0c\x07\xf0\x36\x4d\xa7\x62\x33\x40\x9b\x16\xa2\x78\x7a\x13\x25\xae\xd8\xbe\xf7\x5f\xf2\x8d\x5c\x86\x21\xf9\x2d\xc4\x78\xc4\x2e\x27\xcc\x04\xca\x7f\x8e\x0f\xaa\xe0\x69\x42\x2d\xdf\x05\x28\xd3\x4b\xc5\xfa\x01\x88\xe4\x5b\x43\x67\xa6\x1d\xd7\x32\x32\xb7\x6d\xf2\x14\xbb\x5c\x01\x57\x17\x50\xda\x9d\xf3\x30\xe8\x2c\x6d\x9d\xbb\x86\x77\xa9\xfc\xa6\x9f\x4c\x13\x6a\x09\x9f\x01\x00\x57\x66\x7d\x89\xc1\x00\x00\x00")
 
 func kcpKustomizationYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -661,7 +727,7 @@ func kcpKustomizationYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kcpKustomizeconfigYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcc\xb1\x0d\xc2\x40\x0c\x05\xd0\xde\x53\xfc\x05\x10\xbd\x3b\x0a\x0a\x3a\x94\x4c\x60\x39\x3f\x4a\x44\x72\x67\x9d\x8d\xc4\xf8\xb4\xd0\x3f\xbd\x66\x27\x27\xae\x1c\x6c\x4e\x95\x0b\x5e\x7b\x5b\x14\xb7\xe7\x63\x62\xf6\xf7\x70\xce\xbe\xf1\x34\x01\xd6\x9d\xc7\x32\x07\x3d\x55\x80\x1f\x79\xff\x44\x1f\x25\x00\x10\x56\x9b\x22\x83\x7e\x3d\xac\x98\xf5\xbf\xa4\x7c\x03\x00\x00\xff\xff\x36\xc4\x3f\x98\x70\x00\x00\x00")
+var _kcpKustomizeconfigYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x70\x00\x8f\xff\x6e\x61\x6d\x65\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x3a\x0a\x2d\x20\x6b\x69\x6e\x64\x3a\x20\x41\x50\x49\x52\x65\x73\x6f\x75\x72\x63\x65\x53\x63\x68\x65\x6d\x61\x0a\x20\x20\x66\x69\x65\x6c\x64\x53\x70\x65\x63\x73\x3a\x0a\x20\x20\x2d\x20\x6b\x69\x6e\x64\x3a\x20\x41\x50\x49\x45\x78\x70\x6f\x72\x74\x0a\x20\x20\x20\x20\x70\x61\x74\x68\x3a\x20\x73\x70\x65\x63\x2f\x6c\x61\x74\x65\x73\x74\x52\x65\x73\x6f\x75\x72\x63\x65\x53\x63\x68\x65\x6d\x61\x73\x0a\x03\x00\x36\xc4\x3f\x98\x70\x00\x00\x00")
 
 func kcpKustomizeconfigYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -681,7 +747,7 @@ func kcpKustomizeconfigYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kcpTodayApiresourceschemasYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xdf\x6f\xdb\x38\xf2\x7f\xcf\x5f\x31\xc0\xf7\xa1\xdf\x03\x62\x05\xfb\x76\x30\x70\x0f\x41\x6e\x0f\x28\xae\x2d\x82\x24\xbd\x3e\xd3\xd4\x58\xe6\x46\x22\xb5\x24\x95\xac\xef\x70\xff\xfb\x61\x48\x4a\x96\xf5\xc3\xf6\xa6\x49\xda\xb4\xc3\xa7\x0d\x45\xcf\x0c\x67\x38\xc3\xf9\x7c\xe4\xad\x45\xad\xfe\x85\xd6\x29\xa3\x97\x20\x6a\xe5\xb2\x7b\x59\x67\x39\x3e\x5c\x3c\xfc\x22\xca\x7a\x23\x7e\x39\xbb\x57\x3a\x5f\xc2\xe5\xf5\xfb\x1b\x74\xa6\xb1\x12\x6f\xe5\x06\x2b\x71\x56\xa1\x17\xb9\xf0\x62\x79\x06\x20\x2d\x0a\xaf\x8c\xbe\x53\x15\x3a\x2f\xaa\x7a\x09\xba\x29\xcb\x33\x00\x2d\x2a\x5c\x82\x37\xb9\xd8\x66\x42\x4a\x74\x0e\x5d\x56\x97\x4d\xa1\xb4\xcb\xd6\xc2\x1a\x97\xb9\xcd\x99\xab\x51\x92\x9c\xc2\x9a\xa6\x5e\xc2\xe8\x79\x94\xe3\x68\x09\x40\x32\x28\x08\x0b\x13\xa5\x72\xfe\x9f\xbd\xc9\x0f\xca\xf9\xf0\xa0\x2e\x1b\x2b\xca\x25\xb4\x8a\xc3\xa4\x53\xba\x68\x4a\x61\xdb\xe9\x33\x00\x27\x4d\x8d\x4b\xf8\x44\x3a\x6a\x21\x31\x3f\x03\x78\x88\x5e\x09\x3a\x17\x20\xf2\x5c\xd1\x06\x45\x79\x6d\x95\xf6\x68\xaf\x4c\xd9\x54\x3a\x59\xb4\x80\xdf\x9c\xd1\xd7\xc2\x6f\x96\x90\xb5\x7e\xc9\x46\x4e\x09\x6b\x5b\x97\x5c\x16\x98\xfe\xf6\x5b\x52\x9e\x0b\x1f\x27\xe2\xe3\xce\xfd\xc1\xe4\xe0\xf1\x65\x5a\x9f\xa3\x93\x56\xd5\x3e\xc4\x2c\xee\x18\x94\x03\xbf\x41\x88\xa1\x81\xb5\xb1\xe1\xcf\xe8\x47\x8a\x5d\xfa\x68\x6d\x4d\x8d\xd6\xab\xd6\x95\x34\x7a\x27\xa0\x9b\x1b\x28\x79\x77\x79\xfd\x3e\xad\x81\x1c\xd7\x4a\x63\x54\x97\x7c\x84\x79\xb2\x10\xcc\x1a\xfc\x46\x39\xb0\x58\x5b\x74\xa8\x7d\x70\x40\x4f\x2c\xd0\x12\xa1\xc1\xac\x7e\x43\xe9\x33\xb8\x45\x4b\x42\xc0\x6d\x4c\x53\xe6\x20\x8d\x7e\x40\xeb\xc1\xa2\x34\x85\x56\xff\xee\x24\x3b\xf0\x26\xa8\x2c\x85\xc7\x14\xdd\x76\x84\x78\x68\x51\xc2\x83\x28\x1b\x3c\x07\xa1\x73\xa8\xc4\x16\x2c\x92\x0e\x68\x74\x4f\x5a\x58\xe2\x32\xf8\x68\x2c\x82\xd2\x6b\xb3\x84\x8d\xf7\xb5\x5b\x5e\x5c\x14\xca\x67\xf7\x7f\x75\x99\x32\x17\xd2\x54\x55\xa3\x95\xdf\x5e\x48\xa3\xbd\x55\xab\xc6\x1b\xeb\x2e\x72\x7c\xc0\xf2\xc2\xa9\x62\x21\xac\xdc\x28\x8f\xd2\x37\x16\x2f\x44\xad\x16\xc1\x70\x4d\x9b\x75\x59\x95\xff\x9f\x4d\xa9\xe2\xde\xf5\x2c\x8d\x71\x76\xde\x2a\x5d\x74\xd3\xe1\x30\xcf\xfa\x9d\x4e\x35\xc5\x56\xa4\x8f\x45\xfb\x77\xee\xa5\x29\xf2\xca\xcd\xaf\xb7\x77\xd0\x2a\x0d\x21\xd8\xf7\x79\xf0\xf6\xee\x63\x6e\xe7\x78\x72\x94\xd2\x6b\xb4\x31\x70\x6b\x6b\xaa\x20\x11\x75\x5e\x1b\xa5\x7d\xf8\x43\x96\x0a\xf5\xbe\xd3\x5d\xb3\xaa\x94\xa7\x48\xff\xde\xa0\xf3\x14\x9f\x0c\xae\x84\xd6\xc6\xc3\x0a\xa1\xa9\xe9\x3c\xe7\x19\xbc\xd7\x70\x25\x2a\x2c\xaf\x84\xc3\x17\x77\x3b\x79\xd8\x2d\xc8\xa5\xc7\x1d\xdf\x2f\x5f\xfb\x0b\xa3\xb7\xba\xe9\xb6\x36\x4d\x46\x28\xa6\xdf\x6d\x8d\x72\x2f\x31\x72\x74\xca\xd2\xe1\xf5\xc2\x23\x1d\xf9\x98\x89\x47\x55\x79\xe1\x1b\x77\x4c\x59\x58\xb4\xa7\xce\xac\x1c\x85\xf3\x90\xbe\xa9\xcc\xa7\x21\x8d\x8e\x95\x6d\x30\x3f\x50\x7d\xd5\x58\x8b\xda\x93\x18\xb2\x81\xce\x5d\xa7\x8b\x2c\xb8\x2c\x50\xfb\x6c\x20\x41\x79\xac\x46\x62\x87\x82\x5b\xfd\xdd\x86\x42\x75\xa0\xfd\x84\xda\x11\xea\x45\x7b\x80\x69\x0b\x22\x96\xe1\x91\xd4\xe8\x3d\x1c\xda\x30\xbf\xf3\x38\x4a\xe1\xfc\x9d\x15\xda\xa9\xb6\x54\x4f\xad\x1a\x18\xfd\x41\x38\x0f\x5e\x55\x18\xb3\xa3\xdb\x82\xef\x04\x61\x1e\x53\xc9\x68\x4c\x51\x9d\x94\x0a\x54\xd6\x84\x36\x7e\x83\x36\x83\x3b\xca\xc0\x54\x09\x57\x08\x8f\x1b\xd4\x41\x41\xa3\x73\xb4\xe5\x96\x9c\xbe\xd3\x25\x37\x42\x17\x98\x8f\xf7\x1b\xc7\x7b\x8a\x8b\xf0\x54\x3c\x28\x25\xef\xb5\x79\xd4\xe7\x24\x4d\x43\xe3\xda\xb2\x11\xb6\xd0\xa9\xb9\xbc\x7e\x0f\x6b\x85\x65\x3e\x23\x32\x69\x0c\xf5\x48\x4a\xac\xbd\x58\x95\x13\xfe\xa6\xb1\x36\xb6\x12\x3e\x5e\x6a\x0b\xd2\x32\xb9\x6a\x32\x2f\x77\xa3\x42\xe7\x44\x71\x4a\x3c\x2e\x61\xd3\x54\x42\x83\x45\x91\x93\x51\xed\x47\x41\xe9\x5c\x49\x11\xca\x64\x8e\x5e\xa8\xd2\x81\x58\x99\xc6\xcf\x05\x83\x7c\xd2\xc5\x30\x05\x24\xb8\x24\xd4\xc9\x15\x02\x56\xb5\xdf\x4e\xef\xf9\xc8\x6e\x2c\x0a\xb7\x7f\xc5\xce\x6c\xe6\x6e\x83\x69\x71\x77\x8f\x77\x51\x7f\xe7\xc2\x81\xed\x19\x09\x4a\xcf\x6c\xa6\x57\x76\x49\x24\x95\x31\xb5\x56\x32\x84\x99\x76\x23\x37\xc6\xb8\x10\x7e\x3a\x7d\x60\x6c\x38\x28\xbe\xdb\xf2\x8c\x58\xe5\xc8\x1c\xa7\x72\xa4\x02\x27\xa0\x68\x84\x15\xda\x23\xe6\x24\x79\xe4\xb3\x74\x1f\x3c\xdd\x6f\x0e\x1f\xd0\x2a\xbf\x3d\xc1\x73\xb7\x69\x29\xa5\xfc\x83\xca\x63\x2d\xc1\x3f\xea\x52\x49\xe5\x41\x96\xc2\x39\xf2\x40\x57\x57\x6e\x82\x97\xe7\x4e\xbb\xc9\xf1\x1c\x5c\xec\x3a\x1a\x47\x77\xa5\xb1\x50\x09\xb9\x09\x55\x4a\x0a\x0d\xaa\xaa\x30\x57\xc2\x63\xb9\x8d\x39\xea\x3c\xf5\x1e\x7e\x33\x7d\xdc\x01\x64\xaa\xa2\x4e\xf9\x26\x5a\x41\xeb\x85\xf4\x94\x50\xc6\xe6\x4a\x17\xe5\x36\x86\xab\xdb\x4b\x74\xe5\xc7\xcf\xb7\x77\x33\x42\x57\x08\x0e\x3d\x18\x5d\x6e\x63\x2e\xc7\x0b\xe2\x6f\xff\x10\xa5\x9b\x49\xcf\x63\x2e\x1f\x5d\x43\x73\x0e\x8f\x57\x51\xba\x03\xba\x53\x7a\x1e\xca\x9e\x59\xc3\x9d\xa5\x7e\x2c\x18\x72\x0e\x9f\x75\x28\x42\x4f\xb2\x28\x3c\x3e\x21\x75\xb6\x75\xd0\xbb\xab\x92\xaa\xd7\x7e\x50\xfc\x14\x25\x95\xc9\xf0\x0f\x51\xd5\x25\x66\xd2\x54\x17\xbb\x3c\x99\x71\xf0\x47\xa1\xb7\x90\x75\x32\x33\x32\x26\x35\x92\x20\x2c\xc6\x74\x70\x9e\x02\x2b\xa4\x35\xce\x75\x9d\xd8\x5c\xd1\x2f\xd5\x3d\xc2\xe5\x83\x50\x25\x55\xab\x73\x58\x35\x94\x24\x52\x34\x0e\x41\xd8\x95\xf2\x56\xd8\x6d\xef\x6a\x0e\xa7\x6d\x15\x8e\xe1\xba\x99\xba\xfa\x68\xfc\xbf\x43\x84\x4c\x9b\x1c\xb3\x18\xc0\x9d\xc9\xee\x2f\xa1\xf4\x83\x58\xa9\x92\xce\x94\x37\x90\xa3\x34\x7a\x5d\x2a\x49\x57\xc4\x5c\xaa\x57\xb5\xb1\x5e\x8c\x2f\xf6\x13\x62\x46\x7d\x21\xb5\x40\xe3\xa8\x2d\x26\xee\xdc\x89\x45\x33\xb7\xe6\x22\xa8\x1d\x4d\x4f\xb6\x53\xfd\x47\xc2\x5a\xb1\x3d\xdc\x7f\x8d\xa6\x62\x53\xb5\x04\x6f\x9b\xa8\xd1\x79\x63\xe9\x46\xea\xcd\x34\xab\x2e\xd8\xed\x56\x53\xfa\xc0\x7f\xfe\x7b\x76\xb6\x58\x2c\xce\x5e\x15\x62\x17\xa1\xbd\xc7\xbc\xc0\x59\x74\xbd\xff\x70\x0a\x5a\x17\x6d\xb7\xdf\x43\xd6\x34\x37\x06\xd6\x41\xdd\x10\x56\xa7\x8f\xbf\x65\x54\x4d\x5b\x98\x01\xd5\xf1\x19\x63\x6a\xc6\xd4\x8c\xa9\x5f\x06\x53\x53\x82\x1d\x87\xd4\xa2\xd8\xf7\xdf\x1c\xce\x4b\x64\xe2\xf0\x22\x3a\x05\x9c\x5e\x87\x8f\x3e\x01\xdd\x1f\xb6\x28\x0e\xba\x7f\x55\x71\x42\x57\x73\x15\x16\xb6\x5d\xd6\x8c\xae\xd9\x98\xec\x46\x28\x81\xc7\xd5\x51\xbd\xfe\x7a\x65\x0f\xe3\xf2\x37\xa3\xaf\x2d\x82\x5f\xa9\xf2\x39\x3a\x80\xe3\x0c\x4c\x38\x9a\xa7\x10\x30\x97\x27\x9e\x4e\xe6\x5f\x98\x7f\x61\xfe\xa5\x35\x88\xf9\x17\xe6\x5f\xd2\x60\xfe\x85\xf9\x97\x38\x98\x7f\x61\xfe\xe5\xad\xf0\x2f\x04\xb2\x84\xd2\x68\x6d\xa3\xe9\xde\x7d\x9e\xef\x3a\x5c\xb5\x52\x6f\xa2\xd4\x01\x37\x33\x7c\x3c\xa2\x69\x46\x56\x0d\x18\x9b\xe1\xf3\xb7\x4d\xde\x0c\xdd\xc1\x5f\x8e\x60\x22\x87\x89\x9c\x6f\x40\xe4\x0c\x13\x91\xbf\xb9\xc0\xc8\x99\x91\x33\x23\x67\x46\xce\x8c\x9c\x81\x91\x33\x23\xe7\x34\x18\x39\xbf\xf4\x7b\x0b\xee\xc4\xb8\x13\xe3\x4e\xec\x60\x3c\xb8\x13\x9b\x18\xdc\x89\x71\x27\xc6\x9d\x18\x77\x62\xdc\x89\xfd\x44\xef\x30\x2a\xa3\x95\x37\x14\x91\xe7\x79\x7b\xf1\xb1\x93\x37\x78\x6f\xb1\x7b\x30\x7a\x63\xd1\xb3\x61\xf0\xae\xa2\xea\x4b\x7b\xc3\x6f\x29\x76\x9b\xe7\xf7\x13\xfc\x7e\x82\xdf\x4f\x7c\x83\xf7\x13\x9f\xd0\x3f\x1a\x7b\xff\x4a\xff\xf7\x66\xab\x8d\xa1\x37\x43\x6f\x86\xde\x0c\xbd\x19\x7a\x33\xf4\x66\xe8\xcd\xd0\x3b\x0d\x86\xde\x0c\xbd\x33\x1d\x7b\xc4\xe7\xc1\xdd\xa9\xe1\x1c\x80\xee\x34\x3b\x42\xdc\xad\xea\x01\xdc\xd6\x9d\x90\x37\x8c\xb5\xd3\x9e\x19\x68\x33\xd0\x66\xa0\xcd\x40\x9b\x81\x36\x03\xed\x76\x30\xd0\x66\xa0\xcd\x40\x9b\x81\x36\x03\x6d\x06\xda\x0c\xb4\x7f\x1e\xa0\x6d\x7c\x57\xe2\x9e\x09\x6d\xf7\x24\x0e\x21\x77\xef\xd1\x18\x77\xf7\x2d\x19\x82\xef\x7d\x99\x6f\x19\x81\xf7\x76\xc2\x30\x9c\x61\x38\xc3\xf0\x6f\x01\xc3\x7b\x49\xf8\x5a\x58\xbc\xaf\xf2\x7b\x04\xe4\x51\x1f\x23\x72\x46\xe4\x71\x30\x22\x1f\x0d\x46\xe4\x8c\xc8\x19\x91\x33\x22\x07\x46\xe4\x8c\xc8\x5f\x0c\x91\x5b\x2c\x94\xf3\x36\x21\xf2\xaf\xfb\x07\x8c\x6f\x7a\xb2\x06\x58\xbc\xff\x68\x84\xc5\xf7\x6c\x18\x60\x71\xbb\x2f\xf3\x0d\x63\xf1\xbe\x0b\x66\xb0\xf8\xde\x12\x46\xe4\x8c\xc8\x19\x91\xbf\x08\x22\xef\xe7\xd9\x71\x44\x6e\x87\x65\xad\x53\x6c\xee\x51\xb7\xbe\xfc\x4a\xc4\xbe\x67\xd2\x29\x88\x7d\x54\x6c\xf7\x8f\xcd\xeb\x41\x79\x7e\xb7\xce\x48\xbe\x37\x18\xc9\x8f\x06\x23\x79\x46\xf2\x8c\xe4\x19\xc9\x03\x23\xf9\xef\x01\xc9\xa7\xc6\xed\x60\xd3\x43\x79\x18\xdb\xbb\xc6\x61\x4e\x5e\x8b\x5d\x20\xc6\xaa\x3c\xfc\x11\x8c\x03\x3e\xf9\x61\x79\x83\x88\x20\xd2\xaf\x0b\xcf\x92\x06\xc3\xc7\x7b\xb4\x81\x14\x1e\x0b\x63\xbb\xfe\x6c\x01\xf7\xb2\xde\x23\x14\x76\xad\x75\x9f\x4b\x08\xb3\x13\x34\x42\x34\x69\xc4\x20\xb4\x42\xde\x34\x79\x10\x36\x31\xf7\xdb\x48\xf1\xd7\x88\xdb\x45\xcc\x1c\x30\x73\xc0\xcc\xc1\x0b\x31\x07\x61\xfb\xc7\x49\x83\x9b\x11\x2b\x30\x0b\xc3\xcb\x86\xee\x95\x4f\x13\x3f\x13\x34\x7b\xcd\x7a\x5f\x9e\xb8\xf6\x69\x5c\x44\xdc\xe4\x69\x34\xc4\xef\x5b\xaa\x39\xaf\xcd\x3a\x24\x1b\x99\x77\x60\xde\x21\x0e\xe6\x1d\x46\x83\x79\x07\xe6\x1d\x98\x77\x60\xde\x01\x98\x77\xf8\x3e\x78\x07\xb7\xd5\xf2\x4e\xd8\x02\xfd\x51\xf2\x41\xf7\x7e\x0e\xf2\xb6\xfb\x58\xbc\x60\x43\xc1\xec\x21\xc2\x95\x69\xa8\x06\x98\xa1\xcf\x7e\x2e\x46\x22\x14\xcc\xcc\xa3\x16\x5a\x6e\x67\xf9\x88\xd1\xf3\x89\xef\x31\x7c\x76\x68\x07\x9c\x03\x4d\x8d\x08\x87\xa0\x71\xc0\x36\x34\xf1\xb3\x89\x6a\xb8\x8a\xbd\xfd\xdb\xe1\x19\x68\x9f\x33\x24\x43\x78\xc4\xd4\x02\x53\x0b\x4c\x2d\xbc\x08\xb5\x40\xf9\x75\x9c\x57\x68\xda\xda\x34\x9f\x85\x41\xb2\x72\x75\x29\xb6\x53\xa4\xc2\x9e\xd2\xbf\xef\xd6\xb5\x69\x4f\x1a\xde\xb9\x56\x42\xa8\x1f\xa7\xd2\x12\x58\x09\x35\x22\x26\xf6\xf4\xfd\x4a\x2b\x5a\x4d\x61\x39\xd5\x42\x8b\xae\x6b\xe9\x06\x1b\x3c\xa8\x2f\x35\xdd\xf6\xa0\xca\xeb\xb4\xa8\xd5\xaa\x72\x0a\xf3\xae\x65\xb7\x7f\x5e\xf3\x53\x48\x95\x10\xde\x53\x18\x95\xcf\xa7\x45\x98\xbf\xbd\xc1\x2c\x0a\xb3\x28\xad\x41\xcc\xa2\x30\x8b\x92\x06\xb3\x28\xcc\xa2\xc4\xc1\x2c\xca\x0f\xc8\xa2\xfc\xa0\xec\x45\xf8\x47\x00\x6b\x21\xf1\x79\x28\x8c\x2f\xad\xb8\x01\x8f\xd1\xcd\x8f\xc8\x8c\x9d\x01\x03\x46\xe3\xb1\x27\xea\x0d\x7f\x83\xa2\xdb\xf9\x0c\xbd\xb1\x7b\xce\x1c\x07\x73\x1c\xcc\x71\xbc\x08\xc7\xd1\x25\xd9\x71\xa2\xe3\x71\xaf\x82\xcd\xe7\xe3\x50\xc7\x41\xb6\x63\xf7\x47\x3c\x26\xd4\x1e\xee\xc0\x47\x6f\x6d\xdb\x1a\x4d\x59\x31\xeb\xa3\xe8\xa7\x6a\x85\xf6\x30\x26\xff\x18\xd7\x44\x13\xa8\x36\xb7\xc4\x4e\x64\x44\x1c\x3c\x6e\x4c\x68\x50\x92\xb0\xb6\x4e\x8c\x2e\xcf\x39\xeb\x66\xf0\xfb\x81\x7b\xff\xe4\xbb\xf6\x28\xcf\xb1\x0b\xf1\x29\x64\xc7\x97\x3f\x11\x65\x66\x3c\x98\xf1\x60\xc6\xa3\x35\x88\x19\x0f\x66\x3c\xd2\x60\xc6\x83\x19\x8f\x38\x98\xf1\xf8\x01\x19\x8f\x5e\x9f\xf7\xf9\xe6\xc3\xc1\xde\xe7\x4b\x6f\x61\x8b\x73\xe9\x3f\x9f\xd6\xcd\xbe\x22\xd5\xf2\xbf\x00\x00\x00\xff\xff\xaa\xd5\x07\xf7\x10\xab\x00\x00")
+var _kcpTodayApiresourceschemasYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xdf\x6f\xe3\x38\x92\xff\xbb\xff\x8a\x02\xbe\x0f\xf3\x3d\x20\x56\x30\x3b\x37\x8b\x83\x81\x7b\xc8\xf5\xf4\xee\x36\xae\x7b\x2e\x48\xd2\x7b\xcf\xb4\x54\xb6\xb9\x91\x48\x0f\x49\x25\xed\x5d\xec\xff\x7e\x28\x8a\x94\x28\x89\x92\x1d\x27\xe9\x9e\xf4\x72\xdc\x0f\x13\xfe\x28\x56\x15\x8b\xc5\xaa\x0f\x49\x9b\xed\xf9\x5f\x51\x69\x2e\xc5\x0a\xd8\x9e\xeb\xec\x3e\xdf\x67\x05\x3e\x5c\x3e\xfc\xc8\xca\xfd\x8e\xfd\xb8\xb8\xe7\xa2\x58\xc1\xd5\xf5\x87\x1b\xd4\xb2\x56\x39\xde\xe6\x3b\xac\xd8\xa2\x42\xc3\x0a\x66\xd8\x6a\x01\x90\x2b\x64\x86\x4b\x71\xc7\x2b\xd4\x86\x55\xfb\x15\x88\xba\x2c\x17\x00\x82\x55\xb8\x02\x23\x0b\x76\xc8\x58\x9e\xa3\xd6\xa8\xb3\x7d\x59\x6f\xb9\xd0\xd9\x86\x29\xa9\x33\xbd\x5b\xe8\x3d\xe6\x44\x67\xab\x64\xbd\x5f\xc1\xa8\xbe\xa1\xa3\xa9\x09\x80\x63\xc8\x12\xb3\x05\x25\xd7\xe6\xbf\x83\xc2\x8f\x5c\x1b\x5b\xb1\x2f\x6b\xc5\xca\x15\xf8\x81\x6d\xa1\xe6\x62\x5b\x97\x4c\xf9\xe2\x05\x80\xce\xe5\x1e\x57\xf0\x2b\xab\x50\xef\x59\x8e\xc5\x02\xe0\xa1\xd1\x8a\x1d\x73\x09\xac\x28\x38\x09\xc8\xca\x6b\xc5\x85\x41\xf5\x4e\x96\x75\x25\x1c\x47\x4b\xf8\x9b\x96\xe2\x9a\x99\xdd\x0a\x32\xaf\x97\x6c\xa4\x14\xdb\xd6\xab\xe4\x6a\x8b\xee\x6f\x73\xa0\xc1\x0b\x66\x9a\x82\xa6\xba\x55\x3f\x15\x69\xab\xf1\x95\x6b\x5f\xa0\xce\x15\xdf\x13\x69\x2f\x31\x70\x0d\x66\x87\xd0\x4c\x0d\x6c\xa4\xb2\x7f\x36\x7a\xa4\xb9\x73\x5d\xf7\x4a\xee\x51\x19\xee\x55\x49\x9f\xc0\x02\xda\xb2\xc1\x20\x3f\x5c\x5d\x7f\x70\x6d\xa0\xc0\x0d\x17\xd8\x0c\xe7\x74\x84\x85\xe3\x10\xe4\x06\xcc\x8e\x6b\x50\xb8\x57\xa8\x51\x18\x6b\x15\x01\x59\xa0\x26\x4c\x80\x5c\xff\x0d\x73\x93\xc1\x2d\x2a\x22\x02\x7a\x27\xeb\xb2\x80\x5c\x8a\x07\x54\x06\x14\xe6\x72\x2b\xf8\xdf\x5b\xca\x1a\x8c\xb4\x43\x96\xcc\xa0\x36\x3d\x8a\x76\x3e\x04\x2b\xe1\x81\x95\x35\x5e\x00\x13\x05\x54\xec\x00\x0a\x69\x0c\xa8\x45\x40\xcd\x36\xd1\x19\x7c\x92\x0a\x81\x8b\x8d\x5c\xc1\xce\x98\xbd\x5e\x5d\x5e\x6e\xb9\xc9\xee\xff\x43\x67\x5c\x5e\xe6\xb2\xaa\x6a\xc1\xcd\xe1\x32\x97\xc2\x28\xbe\xae\x8d\x54\xfa\xb2\xc0\x07\x2c\x2f\x35\xdf\x2e\x99\xca\x77\xdc\x60\x6e\x6a\x85\x97\x6c\xcf\x97\x96\x71\x41\xc2\xea\xac\x2a\xfe\x9f\x72\x4b\x45\xff\x10\x70\xda\xcc\xb3\x36\x8a\x8b\x6d\x5b\x6c\x8d\x79\x52\xef\x64\xd5\x34\xb7\xcc\x75\x6b\x44\xec\xd4\x4b\x45\xa4\x95\x9b\xf7\xb7\x77\xe0\x07\xb5\x53\x10\x90\x04\xa7\xed\xae\x9b\xee\x14\x4f\x8a\xe2\x62\x83\x64\x30\x5c\xc3\x46\xc9\xca\xea\x19\x45\xb1\x97\x5c\x18\xfb\x47\x5e\x72\x14\x7d\xa5\xeb\x7a\x5d\x71\x43\x33\xfd\x5b\x8d\xda\xd0\xfc\x64\xf0\x8e\x09\x21\x0d\xac\x11\xea\x3d\xd9\x73\x91\xc1\x07\x01\xef\x58\x85\xe5\x3b\xa6\xf1\xd5\xd5\x4e\x1a\xd6\x4b\x52\xe9\x71\xc5\x87\xee\xcb\xff\x47\xfd\x57\x4e\x5b\x6d\xb1\xf7\x4d\xd1\x19\x6a\x96\xdf\xed\x1e\xf3\xde\xc2\x28\x50\x73\x45\xc6\x6b\x98\x41\x32\xf9\x66\x25\x06\x54\x62\x2b\x91\x3e\x6c\x8b\xc2\xf4\x8b\x86\x43\x52\x0b\xbf\xe0\xc9\x59\x10\x7d\xfa\x7f\xdb\xf5\x02\xb8\x68\x6b\xac\x33\x73\xd5\x03\x92\xe0\x58\xba\x70\x5e\x90\x08\xee\x95\x7c\xe0\x05\x16\x60\xe4\xa0\x75\x54\x83\xf4\x6f\x2f\x95\xf9\x93\x54\x8f\x4c\x15\x7a\x96\xeb\xeb\xa0\x21\x30\x85\x96\x47\xc3\xd4\x16\xad\x11\xb1\x7c\xc7\xd6\x25\x76\xf6\x67\x85\x81\x5a\xa3\xd2\x03\xb2\x00\x39\x13\xe4\xe2\x88\x16\x94\x32\x67\xa5\x65\x43\x8f\xd9\xe6\x06\xab\x11\x5b\xd3\x8c\xf5\xa6\x91\xc1\xdd\xbb\x6b\xc7\x61\x84\xc1\x11\x51\x37\x75\xa3\xf2\xa9\x89\x76\x7d\xca\x52\x3e\x62\xf1\x99\xe4\x8c\xd5\x0f\x98\xbd\x0a\x9a\xb7\x5a\xc4\x8a\xf1\x52\x7b\x2b\x78\x94\xea\xbe\x99\xf7\x0a\xab\x75\x4c\x7d\xbd\x91\xc9\xaf\x7a\x5d\x3a\x17\xdb\xc8\x9c\xc1\x55\x59\x8e\xa9\xd9\x51\x5d\xdf\x09\xca\x7c\x03\x58\xed\xcd\x21\x8b\xd6\x4f\xcc\xc9\x11\x3b\xf3\x9f\xa6\x01\x53\x8a\x1d\x22\xf5\x3b\xa9\xcd\x09\x5a\xfc\x8b\xd4\xed\x02\xa2\x2e\x81\xc1\x15\x9c\x95\xfa\x02\x78\x86\x59\x63\x5a\x54\xbf\x38\x83\x55\x5a\x98\x27\xb0\xf2\x6b\xb0\x7e\xc9\x88\xfd\x5c\x9c\x33\x24\xf5\x3f\x61\x48\x5a\x89\x5e\x7a\xea\x32\x94\x3e\x4a\x61\x23\x55\xc5\xcc\x0a\xb8\x30\x3f\xfd\x21\xda\xa2\x62\x5f\x78\x55\x57\x2b\xf8\xe3\xcf\x3f\xff\xf4\x73\xbc\x09\x17\x4d\x93\x1f\xa3\xd5\xcd\xdc\xd2\x6e\xbe\x45\x35\x6a\x41\x1b\x0d\xf9\xd4\xb1\x84\x4b\x88\x4e\xd2\xd2\x7a\x85\x51\x71\xd4\xc7\xcf\x1b\x97\xde\x61\x59\xae\x16\x33\x3a\xbd\xa5\x16\x14\xbd\x6c\xf8\xb6\x56\x48\x1e\xad\x92\x06\x9b\x9e\xa0\x51\x53\x78\xd5\xc6\x30\xd6\x51\x64\x70\x63\xdb\x0c\xc8\xba\xd1\x68\x82\x0a\xae\xc9\xe5\x14\x50\x8b\x92\x3c\x34\x8a\xe6\xcf\xf5\x01\x98\xf0\x81\x9f\xb3\x1d\x4b\xd2\xbb\xfe\x76\xd1\x66\x8b\xd3\x7d\xd1\x31\x4f\xf4\x3a\x7e\xc8\x8d\x4a\x9a\xd1\x86\x29\xd3\xea\xea\x04\xf7\x33\xeb\x68\x66\xdc\xcc\x91\x65\x34\xe7\x62\x28\x42\x61\xfd\x80\x2d\xaa\x9f\x77\x4d\x3b\x9a\x45\x2b\x16\x16\xb4\xb0\x69\xf5\xa0\x62\xb9\xe1\x0f\xd8\x19\xc5\x23\x37\x3b\x59\x1b\x60\x11\xa2\xed\x88\x19\xfc\x82\x1b\x56\x97\x36\xd0\x82\xcb\x35\x17\x97\x7a\xf7\xf5\xe4\x76\xa6\x77\x54\xee\xf7\xce\x44\xed\x0c\x9d\xb2\x0a\x22\x04\x1b\x3e\xd6\x52\x96\xc8\xc4\x62\x24\x5f\x51\x22\x25\x54\xb2\x36\x47\xb9\xf9\xd0\xb5\x85\xbc\x94\x1a\xf5\x58\xeb\x5c\xec\x6b\xd3\x26\x4c\x45\xad\x6c\xc6\x12\xd3\x2c\xf4\xa6\xe0\xc7\x9f\xab\x6c\xf1\x44\x1d\xbb\xc1\x4f\x65\xff\xb6\xd7\xdc\xbb\x6c\xe7\x67\x5b\x56\x69\xbd\x31\x2f\x57\x67\x26\x11\xe2\x40\xa6\xf3\xe3\xee\x89\x6c\x4f\xf8\xcb\x68\xb1\x36\xcc\xd4\x3d\xeb\x8b\x05\xcb\xb6\x51\x2f\x5c\x96\x6b\x4d\x79\xe0\x39\xf1\x72\x2e\x45\x93\x99\x0f\xca\x07\x43\xbf\xab\x95\x22\x0f\xb9\x57\x92\x02\x76\xca\x9b\xda\xb1\x48\xab\x57\x64\x8c\xd9\xe2\xa4\xb5\xd4\x27\xec\xc7\xef\x02\x47\xca\x6e\x49\x9e\x60\x7a\x5c\x02\x46\x22\xd8\x52\x56\x8e\xa8\x42\x23\xfc\x78\x76\xe6\x9c\x36\x40\xc9\xb4\xb9\x53\x4c\x68\xab\x04\xb2\x95\x58\xab\x01\xd3\x1f\x19\x05\x3d\xbc\x6a\xfc\x76\xab\x42\x30\x2d\x21\x72\x58\x14\xe9\x4a\x81\x96\xaf\x3a\x6e\x50\xd6\xa4\x98\x90\x66\x87\x2a\x83\xbb\x1d\x6f\x33\xf9\x35\xc2\xe3\x0e\x9b\xfd\xa8\x16\x05\xaa\xf2\x40\x4a\xef\xc6\xca\x77\x4c\x6c\xb1\x18\xcb\xdb\x7c\x3e\xd0\x2e\xc2\x6c\x98\x46\x29\xe5\xbd\x90\x8f\xe2\x82\xa8\x09\xa8\xb5\x4f\x7b\x0d\xaf\x82\x61\xae\xae\x3f\xc0\x86\x63\x19\x0f\x9e\xc0\x8f\x48\x24\x29\xe7\xd9\x1b\xf2\x55\xd9\x6c\xb8\x43\x49\xec\x92\x46\x89\xb6\x9a\x5d\xec\x94\x61\x6a\xcd\xb6\xa7\xcc\xc7\x15\xec\xea\x8a\x09\x4a\x33\x0a\x62\xca\x77\x05\x2e\x0a\x9e\x33\x43\xf2\x16\x68\xec\xf6\xca\xd6\xb2\x36\x8b\x11\x3d\xfb\x8f\x94\xdd\xcd\xa1\x9b\x10\xab\x12\x0b\x88\xac\x71\x2e\x30\x3f\x22\x8d\x42\xa6\xfb\x10\xd1\x84\x30\x77\x3b\x24\x41\xb4\x14\xad\x5b\x6d\x67\xfd\x07\x6d\x0d\x36\x60\x12\x7a\xab\x3c\xfc\x04\xb0\x01\x91\xa4\x34\x9c\x6f\x78\x4e\x68\x96\x95\x26\xdf\x49\xa9\xed\xf4\x93\xf5\x81\x54\xd6\x50\x4c\x2b\xf2\x04\x59\xae\x29\x4e\xd3\xbc\x40\x4a\xd0\x19\x6c\x6b\xa6\x98\x30\x88\x05\x51\x1e\xe9\xcc\xe1\x19\xe7\xeb\x4d\xe3\x03\x2a\x6e\x0e\x27\x68\xee\xd6\x35\xf5\x89\xb8\xf5\x25\xf8\x65\x5f\xf2\x9c\x1b\xc8\x4b\xa6\x35\x69\xa0\xf5\x2b\x37\x56\xcb\x51\xba\xe4\x18\x0b\xbc\x00\xdd\xa4\x74\x36\x8f\x26\x15\x55\x2c\xdf\x59\x2f\x45\x39\x34\xaf\x2a\x2c\x38\x33\x58\x1e\x9a\x35\xaa\x0d\x61\x67\xf1\xec\x96\x3e\xb9\xf3\xa2\x9a\x9b\xba\xe1\x82\xda\xb3\xdc\x10\x88\x20\x55\xc1\xc5\xb6\x3c\x90\x0a\xb1\x93\xa5\x31\xbf\x4f\x9f\x6f\xef\x26\x88\xae\x29\x16\x32\x20\x45\x79\xa0\xc9\x14\x70\x6b\xfd\xcd\x7f\xfe\x89\x95\x1a\xcf\x53\xf9\x68\x1b\x9a\x52\xb8\x6d\xe8\xf7\x80\xd6\x4a\x2f\xac\xdb\x93\x1b\xb8\x53\x84\x27\x5a\x46\x2e\xe0\xb3\xb0\x4e\xe8\x2c\x8e\x6c\xf5\x71\x7e\xee\x0e\x7b\x3b\x6e\xcb\x09\xf0\x00\x3e\xa3\xf9\xe3\xb4\xa8\x64\x86\x5f\x58\xb5\x2f\x31\xcb\x65\x75\xd9\xad\x93\xe8\x00\x00\x9f\x98\x38\x40\xd6\xd2\xcc\x88\x99\x06\x48\x6c\xc2\x69\xbb\x1c\xb4\xa1\xed\x91\xe5\x4a\x6a\xdd\x22\x89\x53\x4e\xbf\xe4\xf7\x08\x57\x0f\x8c\x97\xe4\xad\x2e\x60\x5d\xd3\x22\xc9\x59\xad\x11\x98\x5a\x73\xa3\x98\x3a\x74\xfa\xd4\x16\xb1\x21\x54\x50\xe3\xa6\x8e\x6d\x7d\xf4\xf9\xff\x1a\x11\x32\x21\x0b\xcc\x9a\x1d\xa7\x63\x59\xff\x9b\x75\xfd\xc0\xd6\xbc\xa4\xf5\x61\x24\x14\x48\xd9\x56\xc9\x73\xda\x22\x26\x28\xf2\x8a\x32\x40\x26\xcc\x19\x73\x36\x97\x6e\x8e\xf7\xdc\x48\xa3\x89\x5d\x73\x69\x8d\x77\x54\x3c\x11\x65\x4d\xc7\xe5\x91\x0e\xa3\x22\x0a\x42\xb0\x58\x81\x51\x75\xb3\xa2\xb5\x91\x8a\x76\xa4\xa0\xa4\x5e\xb7\x93\xbd\x5a\xf4\xa2\x38\xf8\xc7\x3f\x17\x8b\xe5\x72\xb9\xf8\xaa\x47\x44\x14\x87\xe9\x0c\x8b\x2d\x4e\x9e\x0e\xf5\x2b\x63\x47\x43\x6d\x66\x11\x9c\x0c\x51\xd9\xf8\x60\x88\x4a\x47\xc7\x42\xae\xfb\x5b\x3e\x15\x0a\x31\xe2\xc1\xa1\x90\x0d\x75\xd3\x99\x50\x3a\x13\x4a\x67\x42\xaf\x74\x26\x44\x0b\xec\xf8\x91\xd0\x10\xff\x98\xca\xf3\xdc\x61\xf8\xea\x8c\xe4\xf4\xda\x76\x3d\xe3\x74\x6a\x9e\x23\x1f\x60\x8a\x0d\xdf\xc6\x6a\x06\x5c\xbc\xb3\xb0\xa8\x8f\xb2\x26\xc6\x9a\x9c\x93\xe7\x41\xea\x67\x0f\xe6\xdc\xd8\x09\xe3\x79\x27\xf8\xcc\x21\xa3\x76\xf6\xc4\x08\x20\x16\xfa\xf6\x78\xb5\xbe\xff\x24\x00\xa6\xdb\x43\xe7\x6d\xa1\x8b\xd1\x56\x8b\x19\x25\x25\xfc\x25\xe1\x2f\x09\x7f\x49\xf8\x4b\xc2\x5f\x12\xfe\x92\xf0\x97\x84\xbf\xbc\x79\xfc\xa5\x39\x79\xf9\x0b\x32\x65\xd6\xc8\x4c\x6c\xe3\xef\x99\xd6\xc7\x61\x6b\x9f\x9d\x97\xbd\x58\xc0\x26\x05\x94\x94\x49\x65\xe8\xa8\x22\xaa\x5d\x56\xf2\x07\x5c\x3c\x6d\xc7\x9c\x54\xf6\x44\x7a\xd1\x63\xbe\xc9\x23\x1a\x6b\x1d\x87\x8b\xed\x02\x76\xa4\xfc\x9f\xb1\x03\xde\x27\x64\x2d\xa7\x44\xa9\x6c\x2a\xdc\x9e\x0f\xda\x4e\x8f\x0c\x3e\xb9\x40\xa0\x51\xc7\xda\x31\xd2\x0b\x5d\xdf\x48\x42\x63\x79\x3e\x61\xb4\xdb\x97\x91\xed\x99\xf9\x13\xa8\x5a\x88\x38\xe5\x67\x38\x0e\x52\xf8\x4b\x2c\xfd\x09\xe1\xe6\xc4\x8a\x2d\x86\x09\x39\x22\x0c\x7d\x0f\xd0\x2e\xc1\x66\x8c\x0b\x54\xaa\x16\x14\xd2\xbf\xcc\x33\x80\x77\x9e\xea\x4d\x43\x75\x00\xfb\x0e\xab\x47\x08\xf0\x88\xab\x01\x18\x3c\xac\x7f\xdb\xb8\xf0\x50\x1d\xe9\xdd\x40\x7a\x37\x90\xde\x0d\x7c\x83\x77\x03\xc3\x85\x78\x12\x26\x37\xda\x8d\xa7\xa2\x9c\x2e\x70\xef\x97\x0f\x99\x48\xa0\x5c\x02\xe5\x12\x28\x97\x40\xb9\x04\xca\x25\x50\x2e\x81\x72\x09\x94\x7b\xeb\xa0\x5c\x3c\x0e\x1b\x19\x7e\x8a\xc4\x52\x24\x96\x22\xb1\x14\x89\xa5\x48\x2c\x45\x62\x29\x12\x4b\x91\x58\x8a\xc4\xbe\x46\x24\xf6\x3d\x9c\x61\x54\x52\x70\x23\x69\x46\x5e\xe6\xf4\xe2\x53\x4b\x6f\x70\x6e\xd1\x55\x8c\x4e\x2c\x02\x1e\x06\x67\x15\x5d\xcd\xdb\x3e\xa5\xe8\x84\x4f\xe7\x13\xe9\x7c\x22\x9d\x4f\x7c\x83\xf3\x89\x5f\xd1\xd0\x77\x2f\x9c\x71\x75\xfc\x9c\x1c\xdc\x8f\x96\x52\xef\x94\x7a\xa7\xd4\x3b\xa5\xde\x29\xf5\x4e\xa9\x77\x4a\xbd\x53\xea\x9d\x52\xef\x94\x7a\xfb\xd4\x5b\x34\x31\xe2\xcb\xe4\xdd\x2e\xe0\x1c\x24\xdd\xae\x74\x94\x71\xfb\xa1\x07\xe9\xb6\x2b\x7e\xdb\xb9\xb6\x93\x39\x25\xda\x29\xd1\x4e\x89\x76\x4a\xb4\x53\xa2\x9d\x12\xed\x94\x68\xa7\x44\x3b\x25\xda\x29\xd1\x4e\x89\x76\x4a\xb4\x53\xa2\xfd\xaf\x97\x68\x4b\xd3\xba\xb8\x17\xca\xb6\x03\x8a\xc3\x94\x3b\xa8\x1a\xe7\xdd\x41\xe5\x28\xf9\x0e\xea\xde\x78\x06\x1e\x48\x92\xd2\xf0\x94\x86\xa7\x34\xfc\x5b\xa4\xe1\xc1\x22\xfc\x5a\xb9\x78\x38\xe4\xef\x31\x21\x6f\xc6\x4b\x19\x79\xca\xc8\x53\x46\x9e\x32\xf2\x94\x91\xa7\x8c\x3c\x65\xe4\x29\x23\x4f\x19\xf9\x57\xcf\xc8\xa5\xda\x32\xc1\xff\xee\x32\x72\x83\x82\x89\xfc\x30\x99\x91\x8f\xea\x23\x19\xf9\xff\x04\x14\x07\x19\x79\x58\x35\xca\xc8\x7b\x9c\x0c\x32\xf2\xb0\xae\xcb\xc8\xdf\x95\xb5\x36\xa8\x9e\x93\x8e\x93\x7c\x59\xc1\xf5\xbe\x64\x87\x5f\xbb\x6f\x70\x22\xa1\x56\xf0\x4b\x53\x0e\x41\xc5\xc8\x84\xbf\x61\x72\x1f\x6a\x13\xe4\xa3\xd0\xdd\x4f\x9a\xd1\x85\x48\x46\x37\xcd\xd6\x07\xa0\x0c\xcc\x20\xab\xe8\xa7\x07\x85\x36\xc8\x0a\x90\x1b\x47\x0f\xe8\x9b\xbe\xe9\xfe\x54\xb3\x63\x2d\xa6\x03\xe0\x84\x00\x24\x04\x20\x21\x00\x67\x22\x00\xe1\x4a\x3d\x8e\x00\x0c\x9c\x1d\xc0\xf4\xaa\xa4\x4f\xe0\xbd\xfa\x15\x03\x26\x9c\x37\x23\x67\xe6\xa1\xc0\x70\xa4\x1f\xb4\xa7\x14\xfb\x2a\xbb\xa8\x72\xe8\x9f\x7c\x14\x91\x1f\x75\xec\x4b\x6f\x9b\xd0\x98\xcc\xee\x05\x24\x23\x79\x1b\xff\xa3\x8e\x8f\x3b\x09\x15\x13\x94\x7e\x11\x53\xd6\x57\x0d\xe8\x81\x5d\xae\x81\x7b\x93\x9b\x91\x00\x36\x0c\x3e\xd8\x00\x8b\x15\x95\xfb\xae\x48\x56\x96\xd6\x01\x76\x5d\x4f\xc4\x1c\x26\x05\x7e\x42\x7c\x10\x0b\x8c\xfb\x9a\x09\xd8\x3f\x09\xa6\x09\x3b\x9c\x60\x1a\x5d\x3c\x37\x3b\x41\xc7\xc0\x9a\x70\xd4\x13\xd5\xd7\xa7\xef\xd9\x48\x90\x4d\x82\x6c\x12\x64\xf3\x64\xc8\x26\x4a\x0f\x7a\xa9\xea\x93\x21\x9b\x09\x9a\xd6\x36\xce\x83\x6c\x26\x28\xfe\x1e\x81\x9c\x28\x41\x70\xf0\xce\xf9\x40\xce\x04\x59\x22\x73\x36\x90\x33\x41\x93\x5e\x36\x24\x20\x27\x01\x39\x09\xc8\x79\x32\x90\xe3\x20\x0b\x4a\x93\x57\x8b\x19\x93\x6a\xcf\xfb\x7d\xb4\xdc\x76\xf3\x66\x1f\xc4\xa3\xb4\x9a\xe3\x81\x6b\x24\x52\xf5\xbf\xa5\x6e\xd1\x94\x30\x38\x5d\x9c\xa4\xe6\x88\xc4\xdf\x03\x12\xa5\x70\xcb\xb5\x51\x0e\x89\x7a\xde\xaf\xf4\xdd\x04\xb4\x06\x18\x54\x58\x35\xc2\xa0\x7a\x3c\x0c\x30\xa8\xb0\xee\x6d\xdf\x0a\x09\x55\x30\x71\x2b\xa4\xd7\x24\xdd\x0d\x49\x77\x43\xd2\xdd\x90\x57\xb9\x1b\x12\xae\xb3\xe3\xc8\xd0\xc0\x05\x75\x1f\x23\xef\x51\x78\x5d\x1e\x65\x68\x1e\x94\xe8\xb1\x74\x0a\x28\x11\x76\x88\x98\xcd\xe2\x78\x9a\xde\x85\x2b\xfd\xf2\x27\xe2\x14\xe9\x95\x47\x7a\xe5\x91\x5e\x79\xa4\x57\x1e\xe9\x95\x47\x7a\xe5\x91\x5e\x79\xa4\x57\x1e\xbf\xfb\x57\x1e\x2e\x70\x5b\x2d\xe6\xcc\x89\x8e\xc4\x6c\x78\x57\x6b\x2c\x68\xab\x6e\xa2\x40\x54\xe9\xb7\x9f\xec\x6f\x3f\xf9\x0c\x22\xa3\x3b\xa6\x7a\xfa\x35\xc9\xb0\xba\x07\x1b\xe4\xcc\xe0\x56\xaa\x36\x3e\x5b\xc2\x7d\xbe\xef\x01\x0a\x5d\x68\x1d\x62\x09\xb6\x34\x02\x23\xd8\xf2\xe1\xbb\x12\x57\xfc\xd6\xc1\x03\x2b\xc4\x04\x6e\x70\x65\x95\xdc\x36\x4a\xc8\x41\x42\x0e\x12\x72\xf0\x4a\xc8\x81\x15\xff\x38\x68\xe0\x1a\x2e\x8e\x27\xa3\x79\x73\x9f\x2e\x76\x93\x24\x2a\x08\xfd\x33\xa6\x3c\xb1\xed\x79\x58\x84\xe5\xdd\x85\x78\xc7\x60\x88\xdf\x0e\xe4\x98\x06\x23\xcc\x88\xdb\x46\x26\xfd\xf2\x27\xa2\x0e\x8e\xc7\x84\x3b\x24\xdc\x21\xe1\x0e\x09\x77\x48\xb8\x43\xc2\x1d\x12\xee\x90\x70\x87\xdf\x37\xee\xa0\x0f\x22\xbf\x63\x6a\x8b\x66\xb5\x98\xb3\x29\x77\xed\xc1\x5b\xf9\x6d\xdb\xad\xd9\x60\xad\xc3\x74\x01\x10\x39\xc7\xb5\xac\xc9\x07\xc8\x6c\x71\x92\xb6\x22\x8c\x7f\x0f\x88\x84\xbd\x08\xf2\x22\x6f\x69\xee\x90\x55\x03\xcc\x81\x8a\x46\x80\x43\x77\xf5\xa4\x43\x1b\xa8\xec\xa5\xa1\x06\xca\x48\x32\x25\x4b\x6f\x83\x8d\xd4\x37\x5d\xc1\x68\xa2\xbf\x21\x52\x41\x9a\x82\x2d\xed\xcf\xda\xde\x01\xaf\xb0\x5a\xd3\x4e\xc6\x80\x24\x20\x6f\x18\x5c\xe0\x89\x5c\xd3\x71\x34\x01\x1e\x77\x52\x87\xd7\x7f\xb8\x8d\x2c\xdb\xd8\x23\x41\x1b\x09\xda\x48\xd0\xc6\x0b\x42\x1b\xb4\x70\x8f\xe3\x1a\xce\xbf\x01\x4c\xaf\xc2\x21\xe5\x5e\xc5\x60\xd0\x5f\xba\x3f\x1a\xe3\xa0\xb0\xb7\x4b\xaa\x82\xb6\xde\x57\x0c\x18\x98\x54\x0a\xfd\x73\xbe\x67\x96\x83\x4f\xce\x3f\xcd\x3e\x94\xa1\xb3\x1e\xef\xc8\x22\x17\x99\x27\xf8\x9a\x00\x23\x26\xd9\x9d\x0e\x1c\xc8\x75\xce\x4a\x41\x9b\x81\x07\x87\xa9\x31\x69\xcb\x33\xec\xee\x3d\x8e\xdc\xee\x80\x1e\xc4\x44\x40\x51\x57\xc3\x81\x97\x70\x45\x2f\x7d\x46\xa5\xef\x0b\xde\x5f\x58\xd4\xf4\xaf\x1c\x1f\x17\x27\xca\xdf\x71\x38\x2b\xeb\xff\x76\x82\xd0\xbc\xb4\x97\x44\xbd\x85\x9c\xb8\xbf\xf8\x4f\xab\x32\xae\x9b\x7d\x8b\xf0\x06\x71\x61\x1f\x31\x35\x14\x2b\xe0\x9b\x26\x9d\x7c\xa5\x09\x8e\x45\xae\x4b\x08\x76\xfc\x89\x15\xfd\xec\xb0\xed\x9b\x04\x6a\xb4\xc4\x5f\xe6\xd1\xf3\x67\xff\x7a\xb6\x0b\xd4\xa8\x68\x14\xa8\xd9\x11\x07\x81\x9a\x7b\x79\xeb\x02\xb5\x97\x7a\xd4\x6c\x7d\x86\x9b\x1d\x32\xca\x15\xbc\x0f\x4a\xe6\xe3\x34\xff\x2a\x9a\x30\xae\x62\xf8\x24\x3a\x2c\x6c\xc8\xac\xa5\x2c\x91\x89\x31\x9d\xaf\x1b\xef\x91\xc2\x27\x8e\xa5\x6c\x55\x3a\x8c\x4a\x87\x51\xe9\x30\xea\x55\x0e\xa3\x68\x7d\x1d\x8f\xd8\x82\xaf\x18\x38\xf2\xa0\xd9\xfa\x98\x7e\xe9\x60\x44\xef\x88\x60\x5d\xca\xfc\x5e\x43\x29\xe9\x5b\x0c\xdd\x2e\x4b\x03\x59\xfb\x57\x58\xc9\x87\x86\x9d\xca\x4e\xea\x80\x64\xb3\xe7\x76\xf1\x94\xdd\x6a\xbb\x5d\xfb\x02\x1e\xb9\xd9\xc9\xda\x40\x81\x25\x5a\x24\x9d\x89\x83\xd9\x85\x0a\x9c\x72\x84\x2f\xf0\x3c\x9b\xc4\x38\xf3\x59\xb6\xf5\xfe\xb3\xe3\xd9\xdd\xc0\x8f\x64\x9b\x13\x10\xa0\x50\xb7\xb1\xca\x60\xba\x66\xc7\xb3\x0f\x33\xf4\xec\x80\x7f\xb6\x4d\xda\x40\xa9\xe9\xd1\x9b\x31\x17\x1e\xf2\x82\x6c\xb9\x43\xb2\xd5\xc5\x80\x2c\x00\xf3\x1d\xb9\x6a\xce\x04\xec\xf4\xbf\x52\x4c\x44\x9b\x7a\xc5\xcd\xbc\x74\x1f\x6d\x13\x90\x0f\xa8\x94\xbd\xf5\x47\x52\xed\xea\x35\xad\x08\x56\x97\xc6\xd1\x08\xe5\x1d\x90\x9b\x5a\x0e\xf4\xb1\xb7\x7c\xf4\x35\xaa\x36\xe2\x1c\xb7\x19\x30\x74\x35\xea\xe2\x27\xbb\x62\x5f\x78\x55\x57\x20\x6a\x32\x7b\xe2\xa8\x21\x3f\x75\x9a\x82\x2c\xdf\x75\xa1\xec\x8c\x04\xe1\xe1\x17\x17\xe6\xa7\x3f\x44\xea\x1b\xed\x53\x00\xb3\x8d\xf4\xf7\x6e\xf4\x49\x92\xde\x44\x3a\x4d\xcb\x9a\x4b\xe1\x6e\x40\x46\xe8\x82\xbb\x25\xd4\xf9\x73\x2e\xbe\xa6\xfc\xed\x28\xfa\xa8\xd4\xad\xa8\x7a\x5a\xd6\x8e\xdc\x1c\xcb\x60\x6f\xf6\x59\x48\x0e\x5f\x58\xa2\xe8\x4e\xe2\x8c\xdd\x2e\xed\xd5\x62\x46\xc4\x6b\xd7\xc8\x0b\x38\x72\x0c\xe7\x78\x2a\x7b\x7a\xb8\x41\x75\x27\x67\xc7\xbe\x6b\x9b\xf9\xd1\x1b\x1f\x19\x8c\x18\x4f\xf1\x06\x44\xa1\x69\x6a\xbd\x9e\x23\x49\x57\x96\x8d\x0c\x4e\xb8\x5d\xdc\x6a\xf7\x18\x0a\x16\x82\xa9\x1d\xf4\x1b\x11\x37\xd2\x79\xc1\x0d\x57\x74\xf7\xc1\x9e\x66\xb2\xdc\xf0\x07\xbf\xad\xb5\x19\xe3\x85\xdd\x11\xdd\x20\x54\x4a\x6d\xed\x5e\x23\xa4\xc0\xec\x34\xfd\x45\x27\x74\x7c\x64\xd6\x53\xa5\x0d\x0e\x4e\xb9\xc1\xd1\x26\x51\xf3\x0e\xb1\x3b\xd9\x99\x9d\xc1\xf4\x5a\x24\xbd\x16\x49\xaf\x45\xd2\x6b\x91\xf4\x5a\x24\xbd\x16\x49\xaf\x45\xd2\x6b\x91\x37\xff\x5a\x24\xd2\xe1\xd9\xb0\xfb\xa2\xb7\x7c\xbe\x09\x08\xdf\x45\xd0\x2f\x82\xc4\xb7\xc1\xf3\x00\x8e\x6f\xcb\x47\x98\x7c\xc7\xc0\x00\x98\x6f\x2b\x5e\xfc\x1a\x85\x55\x77\xb6\xdf\x31\xed\xed\x82\xe4\x58\xc1\x75\x50\x32\xb2\xd5\x6f\x08\xad\xb7\xca\x9b\xc0\xd7\xbb\xfa\x04\xb2\x27\x90\x3d\x81\xec\xaf\x02\xb2\xb7\x8b\xec\x38\xd2\x1e\x7a\x2e\x80\xe9\xf5\x38\x1c\xa3\x57\xf1\xec\x0b\x12\x31\x2e\xbe\xc5\x2d\x89\xb1\x91\xf6\xb0\xb6\xd3\x20\x80\x49\xa6\xa7\xb6\x6b\xba\xc5\x50\xed\xc9\x87\xcc\xca\x73\xe7\x1a\x79\xcf\x1a\x5e\xb7\x6c\x67\xbc\x6d\x34\xa7\x56\x9b\xe8\xd8\x3c\xc2\x39\x4a\x3a\xbc\x58\x9c\x24\xc5\x39\xb0\x4e\x67\x8e\xa7\x60\x3b\x6d\xeb\x13\x2c\xd2\x7a\xbc\x87\x79\xb5\x5d\x35\x6d\x62\x5a\xd3\x98\x2b\x34\xfe\xb2\xc9\xf0\x5b\xbb\xa6\xed\x00\x76\xb2\x2c\xbc\x97\x73\x3c\x10\x4d\x72\x3d\x8d\x6a\xf4\x05\x18\x46\x4f\x71\x2d\xce\xc1\x0d\x89\xa7\x4c\x0c\x96\x43\x55\x71\x61\xb3\xf8\xd3\x26\x20\xa1\x5a\x09\xd5\x4a\xa8\x56\x42\xb5\x12\xaa\x95\x50\xad\x84\x6a\x25\x54\xeb\x5f\x07\xd5\x02\x77\xb7\x46\x8a\x5f\x90\x15\x25\x17\x23\xfb\xea\x59\xd6\x2f\x83\xc6\xe4\x06\xed\x62\x63\x61\xd0\x15\x04\x75\xc1\xc1\xea\x80\x2e\xc0\x07\xe3\x27\x54\x21\x01\x65\x58\x40\x2d\x0c\x2f\x69\x8e\x46\xeb\xe3\xd8\x56\x3a\x39\x0b\x16\xe6\x99\x15\xca\xc2\x3e\x3e\x94\x2d\xf9\x06\xf3\x43\x5e\x62\xd3\xd1\x2f\xea\x56\xa2\x53\x87\xf5\xd9\xc7\x9f\x51\xb8\x60\xed\xa4\x3c\xa4\x6b\xee\x19\xda\x76\x25\x8e\x17\x4f\xda\xee\x81\x03\xa2\x04\xf2\xec\x4b\xde\x9c\x71\xcf\x31\xee\xf5\xc9\x85\xf9\xe3\xbf\x2f\x4e\xbd\x53\xd0\x52\xfb\x7c\xf3\x71\x56\x9e\x36\xe1\xf8\x7c\xf3\xd1\x4b\x42\xff\x7b\x9e\x3a\x23\x66\xfd\x5d\x61\xaf\x7e\x46\x5f\x18\x83\xf5\x46\x35\x85\xc5\xfa\xfa\x69\x4c\xb6\x65\x6c\x0a\x9b\xf5\x0d\x5e\xfe\x06\x75\x60\x4e\xfd\x3b\xd0\xa3\xf2\xdf\x25\x54\xeb\x75\x7b\x0c\xb2\x6d\xdb\x51\xd4\xe8\x28\x42\x57\x4d\xb0\xdb\x06\x15\x8a\x9c\x72\x4d\xd6\xba\x15\xbb\xe3\xee\x15\x2e\x87\x88\x83\x7d\x6b\x97\x4b\x61\x82\x44\x34\x61\xc1\x09\x0b\x4e\x58\xf0\x6b\x60\xc1\x7e\xf5\x8e\x30\x61\xb7\x02\x07\xd7\x00\xed\xb6\x30\x00\xac\x5a\xdd\x07\xae\x14\x60\x7a\xe1\xba\xc5\xfb\x5f\x94\xd3\x8b\xed\xa0\x62\xc0\xe7\xd5\xf5\x07\xdf\xce\x3a\x8c\xab\xeb\x0f\xfe\x95\x78\xef\xfd\x2d\xfd\x36\x5d\xeb\xa0\x27\x6e\xb9\x81\xdd\x72\x42\x12\xeb\x83\xbf\x5e\x7b\x06\xe0\x35\x52\x61\xc7\xab\x35\x7d\xfb\x9b\xf8\xef\xbf\x50\xe4\xdd\x72\x3c\xa2\x18\x04\x24\xe3\x10\x79\x4a\x79\x76\x01\x52\x3e\x2c\x95\x89\xdd\x0b\x8f\x30\xfb\xbe\x6d\x1c\x43\x3c\x5b\x46\x2f\x80\x67\x98\x8d\xbe\x1f\x7f\xfc\x89\x9a\x66\xf7\xd9\xd3\xce\x75\x9c\x2b\xda\x2d\x3d\x0f\xad\x1e\x22\x4c\xb9\x49\x1a\x6b\xa8\x37\xbd\x3d\x0a\x96\x7b\xca\xbc\x8c\x92\x65\xe9\x1f\x4d\x3d\x49\x8c\xb9\xcc\xa7\x53\xfe\xa8\x32\xba\x18\x8f\x65\x30\x9d\x52\x16\x33\xfa\x7a\xfa\x11\x4a\x64\x49\xce\xca\x5d\x31\xc1\x37\xe4\x17\x67\xf9\xf8\xe4\x5b\xd9\x35\xe9\xb0\x6d\xef\x1b\x46\x0b\x93\x2c\xaa\xf9\xbd\xf6\xf1\x24\x48\x05\xbf\xd5\xd2\x30\x9d\x05\x47\xe3\x4e\x79\xba\x7d\x3d\xd1\x61\xf0\x34\x5e\x30\x4e\x0c\xa1\x71\xc6\xd2\x01\xf7\xd9\x69\x8b\x7b\x66\xde\x00\xbe\x2c\xef\xeb\x35\x2a\x81\x06\xf5\xd2\xee\x46\xea\x01\x97\x75\x73\xbf\x67\x69\x51\x32\x1d\xc4\xe9\xc7\xa7\xbc\xe5\x6e\x5e\xd3\xad\x52\x26\xd4\xfb\x14\xd1\xa2\x66\x1e\xe7\x2f\xa2\x8b\x67\xa7\x2b\xf0\x8f\x7f\x2e\x16\xcb\xe5\x72\xf1\x7f\x03\x00\x80\x9e\x33\x56\xd7\xed\x00\x00")
 
 func kcpTodayApiresourceschemasYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -701,7 +767,7 @@ func kcpTodayApiresourceschemasYaml() (*asset, error) {
 	return a, nil
 }
 
-var _managerKustomizationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8e\x4b\x6a\x03\x41\x0c\x05\xf7\x3a\x85\x2e\x90\x31\xde\x85\x3e\x41\x20\xe0\x2c\x12\xb2\x97\xdb\x9a\xb6\x98\x6e\x69\x90\xd4\xf9\x9d\x3e\xe4\xb3\xf2\xf6\xbd\x82\x2a\xe7\xb0\xe9\x95\xa3\xc0\x1d\x0e\x52\x6a\xec\xcb\x27\x8d\x0e\xd0\x58\xd9\x29\xcd\x9f\xf6\x14\xd3\x28\x80\x78\x91\xa0\x73\xe7\x13\x0d\x7e\x9e\xeb\x2a\x1f\x0f\x14\xd7\x82\xe9\x93\x01\x68\x97\x57\xf6\x10\xd3\x82\xdb\x8c\xb4\x21\x5f\xbc\x54\xd3\x55\xda\xb2\xdd\xc7\x22\x76\x78\x3b\x9e\x39\xe9\x08\x9b\xe8\xa5\xe0\xe3\x3f\x45\x3f\x02\x90\x41\xed\x2f\x44\x69\x70\xc1\x6a\x9a\x6e\xbd\xb3\x03\xa2\xf2\xfb\xe9\x77\xed\x56\xa9\x5f\x2d\xf2\x70\xfb\xbf\x50\x2b\x98\x1c\x09\xdf\x01\x00\x00\xff\xff\x85\x1b\x60\x95\xd6\x00\x00\x00")
+var _managerKustomizationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\xce\x4d\x4a\x04\x41\x0c\xc5\xf1\x7d\x4e\x91\x0b\xd8\xc3\xec\x24\x27\x10\x04\x5d\x28\xee\x33\x3d\xe9\x9a\xd0\x55\xc9\x90\xa4\xfc\x3a\xbd\x0c\xba\x72\xfd\x1e\xfc\xfe\x21\xe9\x33\x56\x49\x82\x3b\x1c\x6c\xdc\x24\x96\x2f\x1e\x1d\xa0\x89\x49\x70\x79\x3c\x5f\x4b\xdd\x92\x00\xf1\xac\xc9\xa7\x2e\x4f\x3c\xe4\x65\x6e\x9b\x7e\x3e\x70\x5e\x08\x2b\xa6\x00\xf0\x55\xdf\x24\x52\xdd\x08\xf7\x99\xe5\x43\xbf\x65\x59\xdd\x36\x6d\xcb\x7e\x9f\x8b\xfa\xe1\xfd\x78\x92\xe2\x23\xec\x6a\x67\xc2\xc7\xbf\x17\xdf\x00\xd0\xc1\xed\x37\xc4\x78\x08\xe1\xea\x56\xe1\xbd\x4b\x00\xa2\xc9\xc7\x4d\x25\xec\xbe\x72\xbf\x78\xd6\xe1\xff\xfe\xca\x8d\xb0\x24\x0b\x7e\x06\x00\x85\x1b\x60\x95\xd6\x00\x00\x00")
 
 func managerKustomizationYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -721,7 +787,7 @@ func managerKustomizationYaml() (*asset, error) {
 	return a, nil
 }
 
-var _managerManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\xc1\x6e\xdc\x46\x0c\xbd\xef\x57\x10\xe8\xa5\x3d\x48\xda\x0d\x9a\xc2\x18\xa0\x07\xd7\x76\x73\xa9\xed\x45\x12\xf4\x5a\x70\x47\xdc\xd5\xd4\xa3\xe1\x98\xa4\x36\x51\xbe\xbe\x90\xd6\xd6\x4a\x69\x12\xc4\xd1\xc5\x23\x92\x7a\x7c\x7c\x8f\x9e\xc5\x1c\xfe\x26\xd1\xc0\xc9\xc1\x71\xb3\x7a\x08\xa9\x76\x70\x87\x2d\x69\x46\x4f\xab\x96\x0c\x6b\x34\x74\x2b\x80\x88\x3b\x8a\x3a\x9c\x00\x3c\x27\x13\x8e\x45\x8e\x98\xc8\x3d\xbf\x46\x92\xa2\xc5\x84\x07\x92\xb1\x4a\x38\x92\x03\x25\x39\x06\x4f\x2b\x80\x84\xed\xf0\xde\xab\x51\xbb\x2a\x8a\x62\x35\xef\x8e\x39\x6b\x35\x51\xb8\xa6\x1c\xb9\x6f\x29\xd9\x82\xc3\x09\xe1\x8b\xed\xd2\x33\xe9\xa9\xc3\x4b\x29\x6b\x26\x3f\xd4\x2a\x45\xf2\xc6\x72\xfa\xae\x45\xf3\xcd\x5f\x33\xa0\xef\x9b\x5e\x28\xc7\xe0\x51\x1d\x6c\x56\x00\x46\x6d\x8e\x68\xf4\x04\x39\x9b\x68\x78\x30\x25\x36\xb4\xc0\x69\x6a\x01\xf0\xd0\xed\xc8\x5b\x2c\x87\xbf\x92\xc8\x48\xcb\xc0\x55\x4d\x7b\xec\xa2\x15\x43\x4f\x0c\x89\xc4\xc1\x5c\xf1\xe5\xc8\xdf\xef\xd4\xf3\xe8\xe3\x99\x7c\x27\xc1\xfa\x2b\x4e\x46\x1f\xed\x0c\x25\x5d\xba\xd4\x3b\x4e\x6f\x99\xcd\x81\x49\x47\x33\x3d\x46\x32\x53\xdf\x02\x50\x0e\x33\x16\x05\x14\x05\xe6\x50\xd0\xc7\xcc\x62\xc5\xe0\xd5\xef\xe8\x3d\xa9\x96\x7b\x14\xd6\x52\x9b\x45\x6d\x24\xac\x49\x8a\xd1\x88\x29\x41\xe9\x38\x47\x3c\xed\xc2\xf6\xfe\xfa\x9f\xbb\xcb\xdb\x9b\x77\xdb\xcb\xab\x9b\x29\x0b\x70\xc4\xd8\xd1\x9f\xc2\xad\x9b\x05\x01\xf6\x81\x62\xfd\x96\xf6\xcb\xe8\x53\x7c\x8b\xd6\xb8\xc9\x9d\x72\xda\xa8\xa9\xf6\x27\x78\x7f\x7f\x7d\xef\xe0\x7d\x13\x14\x82\x8e\x26\xa3\xa7\x1a\x76\x3d\x58\x43\xf0\xd0\xa9\x71\x1b\x3e\x51\x09\x7f\x74\x06\x1f\x08\x6a\x4e\x06\x35\x43\x30\x68\x48\xa8\x9c\xa0\x42\x8b\x07\x72\xf0\xd8\x61\x3f\xf8\x3a\xaa\xd0\x3c\x56\x0f\x3e\x17\x4d\xb7\x2b\xce\x3e\xa9\x1b\x16\x47\xcf\x32\x9c\xe6\x5e\xba\xfe\x0d\xd7\x00\x30\x46\xfe\xb0\x95\x70\x0c\x91\x0e\x74\xa3\x1e\xe3\xb8\x6c\x0e\xf6\x18\xf5\x3c\x5d\x0c\x47\x4a\xa4\xba\x15\xde\xd1\x1c\xa0\x31\xcb\x6f\xc8\x96\x9a\xe5\x51\xac\xaa\x21\x8c\xd6\x7c\x5a\xa6\x58\xcc\xc1\xc5\xfa\x62\x33\x0b\x87\x14\x2c\x60\xbc\xa6\x88\xfd\x3b\xf2\x9c\xea\xe1\x7f\xe3\xf5\xac\x22\x93\x04\xae\xa7\xdc\xab\xf5\x79\xf3\x08\xeb\xf0\x62\x6a\xc3\x57\xfd\x8f\x32\xfb\x06\xb1\xcd\xfa\xb3\x85\xf8\xb9\x53\x92\x5f\x1c\x5c\x71\xda\x87\x43\x27\x34\x2e\x83\x90\x72\x27\x9e\x14\xd0\x7b\x96\x3a\xa4\x43\xec\x61\x87\x4a\x35\x70\x1a\x4b\xb2\xf0\xbf\xe4\x0d\x84\x1e\xbb\x20\x34\x5c\x77\x5a\xce\xc0\x6f\x59\x08\x42\xda\xb3\x1b\x07\x55\x57\x55\x9f\x5d\x07\xec\xb5\xf2\x9c\x3c\x65\x1b\x0f\x63\xff\xd1\xdd\xea\xb4\x21\xc5\x44\xe3\x7c\x67\x68\x35\x53\xf6\x29\x3b\x57\x30\x86\x36\x98\x2e\x35\xf5\xb9\x73\xf0\x7a\xbd\x6e\x17\xd1\x96\x5a\x96\xde\xc1\xe6\xd5\xc5\x6d\x98\x65\x86\x81\x48\xbf\x88\xb1\xf9\x0a\xc4\x6f\xbf\x4e\x08\x4f\xbf\x18\x97\xde\x73\x97\xec\xee\xeb\x77\xfe\xf0\x18\x49\x1b\xd2\x38\xf2\x1b\x41\x4f\xdb\xff\x99\xf5\x5f\x00\x00\x00\xff\xff\x63\x7d\x11\x3c\xe4\x06\x00\x00")
+var _managerManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\xc1\x6e\x1b\x37\x10\xbd\xeb\x2b\x06\xc8\xa5\x3d\xac\x64\x05\x4d\x11\x10\xe8\xc1\xb5\xdd\x5c\x6a\x5b\x48\x82\x5e\x8b\x11\x77\xa4\x65\xcd\xe5\xd0\x33\xb3\x4a\x36\x5f\x5f\x70\x2d\x53\xab\x34\x09\x92\x62\x17\x10\xc9\x19\xbe\x79\xc3\xf7\x96\xc2\x1c\xfe\x22\xd1\xc0\xc9\xc1\x61\xbd\x78\x08\xa9\x75\x70\x87\x3d\x69\x46\x4f\x8b\x9e\x0c\x5b\x34\x74\x0b\x80\x88\x5b\x8a\x5a\x46\x00\x9e\x93\x09\xc7\x26\x47\x4c\xe4\x9e\xa7\x91\xa4\xe9\x31\xe1\x9e\x64\xca\x12\x8e\xe4\x40\x49\x0e\xc1\xd3\x02\x20\x61\x5f\xe6\xa3\x1a\xf5\x8b\xa6\x69\x16\xf3\xea\x98\xb3\xae\x2a\x85\x6b\xca\x91\xc7\x9e\x92\x9d\x71\x78\x42\xf8\x62\xb9\xf4\x4c\xba\x56\xf8\x51\xca\x9a\xc9\x97\x5c\xa5\x48\xde\x58\xca\x18\xa0\x47\xf3\xdd\x9f\x33\xa0\xef\xeb\x5e\x28\xc7\xe0\x51\x1d\xac\x17\x00\x46\x7d\x8e\x68\x74\x84\x9c\x75\x54\xe6\x98\x12\x1b\x5a\xe0\x54\x4b\x00\x3c\x0c\x5b\xf2\x16\x97\xe5\x57\x12\x19\xe9\x32\xf0\xaa\xa5\x1d\x0e\xd1\x9a\x42\x01\x43\x22\x71\x70\xaa\x09\x70\xde\xf2\xf7\x72\x05\x78\x6e\xbd\x3c\x4a\x7e\x90\x60\xe3\x15\x27\xa3\x8f\x76\x82\x92\x21\x5d\xea\x1d\xa7\xb7\xcc\xe6\xc0\x64\xa0\x63\xa8\x92\xa9\x75\x1b\x40\xd9\xcf\x58\x34\xd0\x34\x98\x43\x43\x1f\x33\x8b\x35\x45\xab\xdf\xd0\x7b\x52\x5d\xee\x50\x58\x97\xda\x9d\xe5\x46\xc2\x96\xa4\x99\x84\xa8\x01\x4a\x87\x39\x62\x01\x71\xb0\xb9\xbf\xfe\xfb\xee\xf2\xf6\xe6\xdd\xe6\xf2\xea\xa6\x46\x01\x0e\x18\x07\xfa\x43\xb8\x3f\x6d\x29\xcf\x2e\x50\x6c\xdf\xd2\xee\x7c\xf5\xb8\xbe\x41\xeb\x5c\x55\x67\x59\x1d\x55\x73\x5f\xc0\xfb\xfb\xeb\x7b\x07\xef\xbb\xa0\x10\x74\x12\x19\x3d\xb5\xb0\x1d\xc1\x3a\x82\x87\x41\x8d\xfb\xf0\x89\x96\xf0\xfb\x60\xf0\x81\xa0\xe5\x64\xd0\x32\x04\x83\x8e\x84\x96\x15\x2a\xf4\xb8\x27\x07\x8f\x03\x8e\x45\xd7\xe9\x14\xba\xc7\xd5\x83\xcf\x4d\x37\x6c\x9b\x93\x4e\xea\x8a\x71\xd4\xea\xce\x42\xeb\x73\xd5\xbf\xa1\x1a\x00\xc6\xc8\x1f\x36\x12\x0e\x21\xd2\x9e\x6e\xd4\x63\x9c\xcc\xe6\x60\x87\x51\x9f\x45\x04\x88\xe1\x40\x89\x54\x37\xc2\xdb\xa3\x53\x9f\xde\xce\x2c\xbf\xa1\x33\x4c\x80\x3c\x1d\xd6\xaa\x23\x8c\xd6\x7d\x3a\x0f\xb1\x98\x83\xd7\x17\xaf\xd7\xb3\xe5\x90\x82\x05\x8c\xd7\x14\x71\x7c\x47\x9e\x53\x5b\xbe\x8d\x57\xb3\x8c\x4c\x12\xb8\xad\xb1\x97\x17\x35\x26\x84\x6d\xf8\x61\x6a\x65\xd7\xf8\x7f\x99\x7d\x83\xd8\xfa\x44\xec\xc9\x10\x3f\x0d\x4a\xf2\xb3\x83\x2b\x4e\xbb\xb0\x1f\x84\x26\x33\x08\x29\x0f\xe2\x49\x01\xbd\x67\x69\x43\xda\xc7\x11\xb6\xa8\xd4\x02\xa7\x29\x25\x0b\xff\x43\xde\x40\xe8\x71\x08\x42\xe5\xba\xd3\x93\x45\x5e\xc0\x2d\x0b\x41\x48\x3b\x76\x53\xa3\xea\x56\xab\xcf\xae\x03\xf6\xba\xf2\x9c\x3c\x65\x9b\x06\x53\xfd\x49\xdd\xd5\x93\x43\x9a\x4a\xe3\x74\x67\xe8\xaa\xd6\xa8\xd1\xf9\x09\xc6\xd0\x07\x9b\x7d\xbd\xe5\xf5\x79\x70\xf0\xea\xe2\xa2\x3f\x5b\xed\xa9\x67\x19\x1d\xac\x5f\xbe\xbe\x0d\xb3\x48\x69\x88\xf4\x8b\x18\xeb\xaf\x40\xfc\xfa\x4b\x45\x38\xfe\x63\x5c\x7a\xcf\x43\xb2\xbb\xaf\xdf\xf9\x25\xdb\x48\xfa\x90\xa6\x96\xdf\x08\x7a\xda\xfc\x47\xac\x7f\x07\x00\x63\x7d\x11\x3c\xe4\x06\x00\x00")
 
 func managerManagerYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -741,7 +807,7 @@ func managerManagerYaml() (*asset, error) {
 	return a, nil
 }
 
-var _rbacKustomizationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x3b\x6e\xeb\x30\x14\x44\x7b\xae\x62\x00\xd5\xd6\x02\x5e\x67\x7b\x07\xee\x5e\x25\x5c\x93\x13\x8b\x01\x3f\x02\x79\x6f\x12\xed\x3e\xb0\x1d\x01\xca\xa7\x23\xe6\x0c\xe7\x90\x8d\xbd\x5a\xf3\xec\xff\xdc\x80\x63\x4a\xb8\x9c\x8e\x67\xbc\xc7\x94\x70\x25\x64\x59\x52\x64\x80\x95\xc0\x06\x9d\x63\x47\x67\x7b\x8b\x9e\x10\xef\xab\x15\x45\x2c\x6e\x80\xce\x44\xe0\x92\xea\x9a\x59\x14\x45\x32\xfb\x22\x9e\x23\xfe\x57\x43\x96\x15\xbe\xe6\x07\xaa\xa6\xcf\x9d\x4d\xec\x06\xc4\x17\xac\xd5\x1a\xb2\x14\xb9\xb1\x3d\xed\xd6\x09\xf9\x65\xd3\x59\x14\xfc\x88\x5d\x3b\x44\xdd\x80\x66\x45\x63\xe6\x88\x13\xd1\xad\x11\x5a\x61\x4b\x10\x25\x2e\x35\xf1\x14\x4b\x88\xe5\x06\x29\x01\xe7\x64\x5d\xd9\x76\xb1\x1b\xd0\xed\xfa\x4a\xaf\xfd\xfe\x08\x3f\x4b\xb9\xdd\xdb\x3f\xad\x8f\xff\x8c\xee\xb0\x81\xe9\x0b\x8c\xab\xe4\xe4\x0e\x68\x35\x71\x7f\x9e\xae\xcf\xfd\x2d\x4b\x94\xc0\x36\x31\xd1\x6b\xac\x65\xda\xf7\xff\x62\xdf\xef\x7f\x06\x00\x00\xff\xff\x78\x76\x78\x65\xa3\x01\x00\x00")
+var _rbacKustomizationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x3b\x6e\xf3\x30\x10\x84\x7b\x9e\x62\x00\xd5\xd6\x01\xfe\xce\xf6\x0d\xdc\xfd\x95\xb0\x26\x27\x16\x03\x3e\x04\x72\x37\x89\x6e\x1f\xd8\x8e\x00\xe5\xd1\x11\xf3\x0d\xf7\xdb\x6d\xec\xd5\x9a\x67\xff\xe7\x06\x1c\x53\xc2\xe5\x74\x3c\xe3\x3d\xa6\x84\x2b\x21\xcb\x92\x22\x03\xac\x04\x36\xe8\x1c\x3b\x3a\xdb\x5b\xf4\x84\x78\x5f\xad\x28\x62\x71\x03\x74\x26\x02\x97\x54\xd7\xcc\xa2\x28\x92\xd9\x17\xf1\x1c\xf1\xbf\x1a\xb2\xac\xf0\x35\x3f\x50\x35\x7d\xce\xd9\xc4\x6e\x40\x7c\xc1\x5a\xad\x21\x4b\x91\x1b\xdb\xd3\x6e\x9d\x90\x5f\x36\x9d\x45\xc1\x8f\xd8\xb5\x43\xd4\x0d\x68\x56\x34\x66\x8e\x38\x11\xdd\x1a\xa1\x15\xb6\x04\x51\xe2\x52\x13\x4f\xb1\x84\x58\x6e\x90\x12\x70\x4e\xd6\x95\x6d\x17\xbb\x01\xdd\xae\xaf\xf4\xda\xef\x4b\xf8\x59\xca\xed\xde\xfe\x69\x7d\xdc\x33\xba\xc3\x06\xa6\x2f\x30\xae\x92\x93\x3b\xa0\xd5\xc4\xfd\x7b\xba\x3e\xb5\x5b\x96\x28\x81\x6d\x62\xa2\xd7\x58\xcb\xb4\xef\xff\xc5\xbe\xff\xff\x1c\x00\x78\x76\x78\x65\xa3\x01\x00\x00")
 
 func rbacKustomizationYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -761,7 +827,7 @@ func rbacKustomizationYaml() (*asset, error) {
 	return a, nil
 }
 
-var _rbacLeader_election_roleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x50\xbb\x4e\x03\x31\x10\xec\xfd\x15\xab\xf4\x17\x44\x87\xfc\x03\xf4\x14\xf4\x1b\x7b\x48\xac\xf8\xbc\xd6\xee\xfa\x10\x7c\x3d\xba\x5c\x40\x11\xa1\xa5\xf2\x68\xc6\xf3\xb0\xb9\x97\x57\xa8\x15\x69\x91\xf4\xc0\x69\xcf\xc3\x4f\xa2\xe5\x93\xbd\x48\xdb\x9f\x9f\x6c\x5f\xe4\x61\x79\x0c\xe7\xd2\x72\xa4\x17\xa9\x08\x33\x9c\x33\x3b\xc7\x40\xd4\x78\x46\xa4\x0a\xce\xd0\x09\x15\x69\xb5\x4d\xba\x5e\xdb\x44\xeb\x9c\x10\xc9\x3e\xcc\x31\x07\x1d\x15\x16\xc3\x44\xdc\xcb\xb3\xca\xe8\xb6\x86\x4c\xb4\xdb\x05\x22\x85\xc9\xd0\x84\x2b\x97\xa4\xbd\x95\xe3\xcc\xdd\x02\xd1\x02\x3d\x5c\xf9\x23\xfc\x72\xd6\x62\x1b\x78\x67\x4f\xa7\xcd\xa2\x60\xc7\x05\x8e\x9e\xbf\x61\xff\xd1\x33\x2a\x1c\xf7\xf5\x49\x44\x73\x69\xb7\x6f\xbe\xdf\x53\xc1\x86\xff\xdf\xf2\xd7\x57\x60\x41\xf3\x5f\xd5\x37\xf9\x5b\xe8\x57\x00\x00\x00\xff\xff\xcd\xef\x80\x92\xcb\x01\x00\x00")
+var _rbacLeader_election_roleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x50\xb1\x4e\x43\x31\x0c\xdc\xf3\x15\x56\xf7\x57\xc4\x86\xf2\x03\xec\x0c\xec\x6e\x72\xf4\x45\xcd\x8b\x23\xdb\x79\x08\xbe\x1e\xbd\xb6\xa0\x8a\xb2\x32\xe5\x74\x17\xdf\x9d\xcd\xbd\xbc\x42\xad\x48\x8b\xa4\x07\x4e\x7b\x1e\x3e\x8b\x96\x4f\xf6\x22\x6d\x7f\x7a\xb2\x7d\x91\x87\xf5\x31\x9c\x4a\xcb\x91\x5e\xa4\x22\x2c\x70\xce\xec\x1c\x03\x51\xe3\x05\x91\x2a\x38\x43\x27\x54\xa4\x6d\x6c\xd2\xed\xdb\x45\xb4\xce\x09\x91\xec\xc3\x1c\x4b\xd0\x51\x61\x31\x4c\xc4\xbd\x3c\xab\x8c\x6e\x9b\xc9\x44\xbb\x5d\x20\x52\x98\x0c\x4d\xb8\x72\x49\xda\x5b\x39\x2e\xdc\x2d\x10\xad\xd0\xc3\x95\x3f\xc2\xcf\x6f\x2d\x76\x01\xef\xec\x69\x3e\xa3\xa4\x60\xdf\x92\x27\x1a\x3d\x7f\xc3\xfe\xa3\x67\x54\x38\xee\xe3\x93\x88\xe6\xd2\x6e\x77\xbe\xef\x53\xc1\x86\xff\xef\xf2\xd7\x29\xb0\xa2\xf9\xaf\xe8\x1b\xff\xce\x9e\xe6\xf0\x35\x00\xcd\xef\x80\x92\xcb\x01\x00\x00")
 
 func rbacLeader_election_roleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -781,7 +847,7 @@ func rbacLeader_election_roleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _rbacLeader_election_role_bindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8e\x31\x4e\xc4\x40\x0c\x45\xfb\x39\x85\x2f\x90\x20\x3a\x34\x1d\x34\xf4\x8b\x44\xef\x38\x9f\xc5\xec\xc4\x8e\x3c\xce\x4a\x70\x7a\x14\xb1\x82\x06\xa5\xf6\x7b\xfe\x8f\x57\x7d\x45\x74\x75\xab\x14\x13\xcb\xc8\x5b\xbe\x7b\xe8\x17\xa7\xba\x8d\x97\x87\x3e\xaa\xdf\x5d\xef\xcb\x45\x6d\xae\x74\xf2\x86\x27\xb5\x59\xed\x5c\x16\x24\xcf\x9c\x5c\x0b\x91\xf1\x82\x4a\x0d\x3c\x23\x06\x34\xc8\x6e\x0f\xe1\x0d\xd3\x8d\xfe\x61\xfa\xca\x82\x4a\xfd\xb3\x27\x96\xb2\xdf\x4f\x78\xdb\x1f\xf0\xaa\xcf\xe1\xdb\x7a\x50\x51\x88\xfe\x22\x0e\x37\x4b\xdf\xa6\x0f\x48\xf6\x5a\x86\x9b\xf3\x82\xb8\xaa\xe0\x51\xc4\x37\xcb\x5f\x5b\xdc\x32\xbc\x35\xc4\xb0\xb0\xf1\x19\xf1\x6f\xe8\x77\x00\x00\x00\xff\xff\x64\x67\x8d\xe6\x26\x01\x00\x00")
+var _rbacLeader_election_role_bindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8e\x31\x4e\xc4\x40\x0c\x45\xfb\x39\x85\x2f\x90\x20\x3a\x34\x1d\x34\xf4\x8b\x44\xef\x38\x9f\xc5\xec\xc4\x8e\x3c\xce\x4a\x70\x7a\x14\xb1\x82\x06\xa5\xf6\x7b\xfe\x8f\x57\x7d\x45\x74\x75\xab\x14\x13\xcb\xc8\x5b\xbe\x7b\xe8\x17\xa7\xba\x8d\x97\x87\x3e\xaa\xdf\x5d\xef\xcb\x45\x6d\xae\x74\xf2\x86\x27\xb5\x59\xed\x5c\x16\x24\xcf\x9c\x5c\x0b\x91\xf1\x82\x4a\x0d\x3c\x23\x06\x34\xc8\x6e\x0f\xe1\x0d\xd3\x8d\xfe\x61\xfa\xca\x82\x4a\xfd\xb3\x27\x96\xb2\xdf\x4f\x78\xdb\x1f\xf0\xaa\xcf\xe1\xdb\x7a\x50\x51\x88\xfe\x22\x0e\x37\x4b\xdf\xa6\x0f\x48\xf6\x5a\x86\x9b\xf3\x82\xb8\xaa\xe0\x51\xc4\x37\xcb\x5f\x5b\xdc\x32\xbc\x35\xc4\xb0\xb0\xf1\x19\xf1\x6f\xe8\xf7\x00\x64\x67\x8d\xe6\x26\x01\x00\x00")
 
 func rbacLeader_election_role_bindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -801,7 +867,7 @@ func rbacLeader_election_role_bindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _rbacRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x92\xc1\x6a\xf3\x40\x0c\x84\xef\xfb\x14\x7a\x01\x3b\xfc\xb7\x1f\x5f\x7b\xe8\xbd\x94\xde\x15\x7b\xe2\x88\xac\x77\x5d\x49\x9b\x42\x9e\xbe\x64\xeb\x1c\xd2\x40\x49\x42\x43\x4f\x1e\x84\x35\x33\xfa\xd8\xa6\x69\x02\xcf\xf2\x06\x35\xc9\xa9\x23\x5d\x73\xdf\x72\xf1\x6d\x56\x39\xb0\x4b\x4e\xed\xee\xbf\xb5\x92\x57\xfb\x7f\x61\x27\x69\xe8\xe8\x29\x16\x73\xe8\x4b\x8e\x08\x13\x9c\x07\x76\xee\x02\x51\xaf\xa8\x0b\xaf\x32\xc1\x9c\xa7\xb9\xa3\x54\x62\x0c\x44\x89\x27\x74\x34\x71\xe2\x11\xda\xe8\x71\x51\x4b\x84\x75\xa1\x21\x9e\xe5\x59\x73\x99\xed\x68\xd1\x10\xf7\x3d\xcc\xda\x0d\x6b\xb6\xd6\xb6\x81\x48\x61\xb9\x68\x8f\xe5\x07\xc5\x7b\x81\x79\x20\xda\x43\xd7\xcb\xb0\x46\xa3\xca\x01\x11\x8b\x1c\xe1\xf5\x1b\xc5\xbe\xc4\xcc\xde\x6f\xab\x2a\xf3\x70\x5a\xf8\xa8\xc3\xbb\x8b\xac\x36\x92\x38\xca\x01\x6a\xe7\x9d\x96\x88\xfb\x8d\xcd\xd9\xcb\x37\xd3\xd3\x49\x17\x97\x5c\xc4\x60\x18\xf1\x43\x08\x8f\x48\x8f\x87\x78\x4d\x89\x9b\x01\x5e\x65\xfa\x50\x78\x8a\x51\xcc\xb5\xbe\x76\xfb\x6b\x88\x67\x65\x7e\x1b\xe6\xb9\xf9\x4d\x50\x3f\x03\x00\x00\xff\xff\x46\x64\x14\x72\x5a\x04\x00\x00")
+var _rbacRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x92\xbd\x6a\x2b\x31\x10\x85\x7b\x3d\xc5\xbc\x80\xd6\xdc\xee\xb2\x6d\x8a\xf4\x21\xa4\x1f\xef\x1e\xaf\x07\x6b\x25\x65\x46\x72\xc0\x4f\x1f\x56\x6c\x0a\x27\x24\xd8\x26\x26\xdd\x30\xcc\xf9\xd1\x87\xbc\xf7\x8e\xb3\xbc\x40\x4d\x52\xec\x49\xb7\x3c\x74\x5c\xcb\x3e\xa9\x9c\xb8\x48\x8a\xdd\xe1\xbf\x75\x92\x36\xc7\x7f\xee\x20\x71\xec\xe9\x21\x54\x2b\xd0\xa7\x14\xe0\x66\x14\x1e\xb9\x70\xef\x88\x06\x45\x13\x3c\xcb\x0c\x2b\x3c\xe7\x9e\x62\x0d\xc1\x11\x45\x9e\xd1\xd3\xcc\x91\x27\xa8\xd7\x45\xa8\x35\xc0\x7a\xe7\x89\xb3\x3c\x6a\xaa\xd9\x16\x0b\x4f\x3c\x0c\x30\xeb\x76\xac\xc9\x3a\xdb\x3b\x22\x85\xa5\xaa\x03\xd6\x03\xc5\x6b\x85\x15\x47\x74\x84\x6e\xd7\x65\x8b\x46\x1b\x47\x04\xac\xe3\x84\xe5\xcc\x53\x90\x76\xef\x29\x73\x19\x16\x4b\x4f\x35\x8f\x1f\x82\xb7\xb6\xbc\xb9\xc8\x66\x27\x91\x83\x9c\xa0\x76\xde\x69\x8d\xb8\xdd\xd8\x0a\x97\xfa\xc9\x74\xc2\x37\x2f\xf9\x12\x83\x71\xc2\x0f\x21\x3c\x21\xde\x1f\xe2\x25\x25\xae\x06\x78\x91\xe9\x5d\xe1\x29\x26\xb1\xa2\xed\xb7\xdb\x5f\x43\x3c\x2b\xf3\xdb\x30\xcf\xcd\xaf\x82\xfa\x3e\x00\x46\x64\x14\x72\x5a\x04\x00\x00")
 
 func rbacRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -821,7 +887,7 @@ func rbacRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _rbacRole_bindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\x31\x4e\x04\x31\x0c\x45\xfb\x9c\xc2\x17\x98\x41\x74\x28\x1d\x50\xd0\x2f\x12\xbd\x27\x63\x16\xb3\x89\x1d\xd9\xce\x4a\x70\x7a\x34\x52\x44\x03\xda\xce\x92\xff\x7b\x0f\x3b\xbf\x91\x39\xab\x64\xb0\x0d\xcb\x8a\x23\x3e\xd4\xf8\x1b\x83\x55\xd6\xcb\x83\xaf\xac\x77\xd7\xfb\x74\x61\xd9\x33\x3c\xd7\xe1\x41\x76\xd2\x4a\x4f\x2c\x3b\xcb\x39\x35\x0a\xdc\x31\x30\x27\x00\xc1\x46\x19\x1a\x0a\x9e\xc9\x16\xd3\x4a\xdb\x5c\x1d\xf7\x89\xde\x8f\x11\x76\x7e\x31\x1d\xfd\x46\x30\x01\xfc\xe9\xfd\xab\x4f\x3e\xb6\x4f\x2a\xe1\x39\x2d\x13\x79\x25\xbb\x72\xa1\xc7\x52\x74\x48\xfc\x52\x45\x25\x4c\x6b\x25\x5b\xa6\x60\xbe\xbc\x63\xa1\x0c\xfe\xe5\x41\x2d\xfd\x04\x00\x00\xff\xff\x9a\x9d\x2a\xfa\x10\x01\x00\x00")
+var _rbacRole_bindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\x31\x4e\x04\x31\x0c\x45\xfb\x9c\xc2\x17\x98\x41\x74\x28\x1d\x50\xd0\x2f\x12\xbd\x27\x63\x16\xb3\x89\x1d\xd9\xce\x4a\x70\x7a\x34\x52\x44\x03\xda\xce\x92\xff\x7b\x0f\x3b\xbf\x91\x39\xab\x64\xb0\x0d\xcb\x8a\x23\x3e\xd4\xf8\x1b\x83\x55\xd6\xcb\x83\xaf\xac\x77\xd7\xfb\x74\x61\xd9\x33\x3c\xd7\xe1\x41\x76\xd2\x4a\x4f\x2c\x3b\xcb\x39\x35\x0a\xdc\x31\x30\x27\x00\xc1\x46\x19\x1a\x0a\x9e\xc9\x16\xd3\x4a\xdb\x5c\x1d\xf7\x89\xde\x8f\x11\x76\x7e\x31\x1d\xfd\x46\x30\x01\xfc\xe9\xfd\xab\x4f\x3e\xb6\x4f\x2a\xe1\x39\x2d\x13\x79\x25\xbb\x72\xa1\xc7\x52\x74\x48\xfc\x52\x45\x25\x4c\x6b\x25\x5b\xa6\x60\xbe\xbc\x63\xa1\x0c\xfe\xe5\x41\x2d\xfd\x0c\x00\x9a\x9d\x2a\xfa\x10\x01\x00\x00")
 
 func rbacRole_bindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -841,7 +907,7 @@ func rbacRole_bindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _rbacService_accountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xc9\x31\x0a\x02\x41\x0c\x05\xd0\x7e\x4e\x91\x0b\x58\xd8\xa6\xf3\x0c\x82\xfd\x27\xfb\x91\xc1\x9d\x64\x49\xe2\x82\xb7\xb7\xd9\xfa\xe1\x98\x2f\x66\xcd\x70\x95\xf3\x3e\x3e\xd3\x37\x95\x27\xf3\x9c\xc6\x87\x59\x7c\xbd\xc7\x62\x63\x43\x43\x87\x88\x63\x51\xc5\xc2\x3b\x63\xdf\x99\xb7\x05\xc7\x9b\x79\x51\x1d\x30\xaa\xd4\xaf\x9a\x6b\xfc\x03\x00\x00\xff\xff\x26\xde\x63\xf5\x5d\x00\x00\x00")
+var _rbacService_accountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x5d\x00\xa2\xff\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x53\x65\x72\x76\x69\x63\x65\x41\x63\x63\x6f\x75\x6e\x74\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x63\x6f\x6e\x74\x72\x6f\x6c\x6c\x65\x72\x2d\x6d\x61\x6e\x61\x67\x65\x72\x0a\x20\x20\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3a\x20\x73\x79\x73\x74\x65\x6d\x0a\x03\x00\x26\xde\x63\xf5\x5d\x00\x00\x00")
 
 func rbacService_accountYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -861,7 +927,7 @@ func rbacService_accountYaml() (*asset, error) {
 	return a, nil
 }
 
-var _samplesBindingAccessFarosShYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x90\xbd\x4e\xc4\x40\x0c\x84\xfb\x7d\x0a\x2b\x3d\x41\xd7\xba\x3b\xa8\x68\x10\x15\xbd\xe5\xcc\x5d\x56\x49\x76\x8d\xbd\x09\x3c\x3e\xca\x1d\x14\xfc\x35\x94\xf6\xcc\xf7\x59\xb2\x58\x7e\x86\x47\xae\x85\x49\x2c\x47\x3f\xa9\xf5\x03\xb6\xdb\xed\x20\xb3\x8d\x72\x48\x53\x2e\x03\xd3\xf1\xe9\xe1\x2e\x97\x21\x97\x73\x5a\xd0\x64\x90\x26\x9c\x88\x8a\x2c\x60\x12\x55\x44\xf4\x27\xf1\x1a\x7d\x8c\x29\x0c\xba\xa7\x06\x5f\x72\xec\xf2\xfb\x59\xf2\x12\xfb\xee\x86\xce\x5e\x57\x63\xea\xbe\x51\x5d\x22\x22\x72\x44\x5d\x5d\xc1\xd4\x39\x5e\x56\x44\x8b\x6b\x10\x4d\x1a\x98\x8e\xaa\xb0\x86\xe1\x8b\xe9\x07\x1a\x50\xc7\x3f\x49\xdf\xb2\x42\x54\xeb\x5a\xfe\x36\x38\x4e\x70\x14\x05\x5f\x0a\xaf\xd5\xa7\x30\xf9\x1c\x89\xf0\x66\xd5\xdb\xe3\xaf\xdf\xb9\x36\x4c\xda\xc8\xe4\xb5\x36\xbe\x24\xfc\x71\x99\xb5\x96\xe6\x75\x9e\xe1\x91\xde\x03\x00\x00\xff\xff\x52\x56\x7b\x15\x9e\x01\x00\x00")
+var _samplesBindingAccessFarosShYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x90\xbd\x4e\x03\x41\x0c\x06\xfb\x7d\x0a\x2b\x3d\x87\xd2\xba\x0b\x54\x34\x88\x8a\xde\xf2\x7d\x49\x56\xb9\x5b\x1b\x7b\xef\xe0\xf1\xd1\xf1\x53\xf0\xd7\x50\xee\x8e\x66\x2c\x5b\xbc\x3e\x22\xb2\x5a\x63\x12\xaf\x39\x5c\xd4\x87\x11\xeb\xf5\xba\x97\xc9\xcf\xb2\x2f\x97\xda\x46\xa6\xc3\xc3\xdd\x4d\x6d\x63\x6d\xa7\x32\xa3\xcb\x28\x5d\xb8\x10\x35\x99\xc1\x24\xaa\xc8\x1c\x8e\x12\x96\x43\x9e\x4b\x3a\x74\xa3\x8e\x98\x6b\x6e\xf1\xdb\x49\xea\x9c\xdb\xdf\x15\x9d\xc2\x16\x67\xda\x7d\xb3\x76\x85\x88\x28\x90\xb6\x84\x82\x69\x17\x78\x5a\x90\x3d\xdf\x41\x76\xe9\x60\x3a\xa8\xc2\x3b\xc6\x2f\xa5\x1f\x6a\x42\x03\xff\x34\x63\xad\x0a\x51\xb5\xa5\xfd\x5d\x08\x1c\x11\x68\x8a\x6d\x25\xa2\x67\x8b\x4b\xba\x7c\x3e\x89\xf0\xe2\x16\xfd\xfe\xd7\xeb\x6c\x9c\xc8\xa5\x9f\x99\xc2\xac\xf3\x1b\xe1\x8f\xc9\xac\xd6\x7a\xd8\x34\x21\xb2\xbc\x0e\x00\x52\x56\x7b\x15\x9e\x01\x00\x00")
 
 func samplesBindingAccessFarosShYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -881,7 +947,7 @@ func samplesBindingAccessFarosShYaml() (*asset, error) {
 	return a, nil
 }
 
-var _samplesBindingEdgeFarosShYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\x31\x6b\x23\x41\x0c\x85\xfb\xfd\x15\xc2\xfd\xcd\xe1\xee\x98\xce\x77\xd5\x35\x21\x55\x7a\x59\x2b\xef\x8a\xdd\x1d\x09\x49\xeb\x84\xfc\xfa\x60\x9b\x14\xc6\x04\x1c\x93\x72\xe6\xcd\xf7\x0d\x3c\x1e\x9a\xbc\xb0\x87\x68\xab\x80\x26\x51\x26\xb2\xd2\xf3\xf1\xf7\x71\x8b\xb3\x8d\xb8\xed\x26\x69\x7d\x85\xdd\xf3\xff\xbf\xd2\x7a\x69\x43\xb7\x70\x62\x8f\x89\xb5\x03\x68\xb8\x70\x85\x2d\xf7\x03\x97\x03\xba\x46\x89\xb1\x0b\x63\x3a\x85\xc6\xbe\x48\x9c\xdc\xff\x66\x94\x25\x4e\x77\xbf\x60\x70\x5d\xad\xc2\xe6\x8a\xd9\x74\x00\x00\xce\xa1\xab\x13\x57\xd8\x38\x0f\x12\xe9\x98\xa2\x2d\x2e\x69\x24\x26\x57\xd8\x11\xb1\x25\xf7\xdf\x90\xe1\xc0\x2d\xef\xb0\xdc\x80\xc1\xe4\xfc\x20\xe9\x47\x21\x46\x22\x5d\x1f\xfb\x9b\xb4\x1d\x64\x58\xd0\xee\x80\x7d\x8f\x54\x70\xcd\x51\x5d\xde\xcf\x9d\x95\xe9\x4f\x14\xd1\xdb\x5e\x75\xe6\x1f\x17\xee\x2f\xcb\xf8\xd2\xeb\x7c\x60\xe7\x46\x5c\xcf\x0f\x5e\xd5\xa7\x30\xfc\x3c\x02\xf0\x9b\xa9\xe7\xd3\x79\x4b\xd7\x53\xba\xe4\x86\x39\x56\x70\xd5\xac\xa4\x8b\xad\xc9\x95\xb4\xa5\xeb\x3c\xb3\x47\xf7\x11\x00\x00\xff\xff\xa1\x38\x6f\x60\xc4\x02\x00\x00")
+var _samplesBindingEdgeFarosShYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\xb1\x4e\xc3\x40\x0c\x86\xf7\x3c\x85\xd5\x9d\x43\xdd\xd0\x6d\x85\x89\x05\x31\xb1\xbb\x8e\x9b\x58\x49\xce\x27\xdb\x09\x88\xa7\x47\x69\x61\xa8\x2a\xa4\x52\x31\xde\xfd\xfa\x3e\x4b\xf6\x8f\x55\xde\xd8\x5c\xb4\x64\xc0\x2a\x9e\x06\xaa\xa9\xe5\xe5\x7e\xd9\xe2\x58\x7b\xdc\x36\x83\x94\x36\xc3\xee\xf5\xf9\x51\x4a\x2b\xa5\x6b\x26\x0e\x6c\x31\x30\x37\x00\x05\x27\xce\xb0\xe5\xb6\xe3\x74\x40\x53\x4f\xde\x37\x5e\x99\xd6\xb0\xb2\x4d\xe2\xab\xfb\x69\x44\x99\x7c\xfd\xbb\x83\xce\x74\xae\x19\x36\x67\xcc\xa6\x01\x00\x30\x76\x9d\x8d\x38\xc3\xc6\xb8\x13\x0f\xc3\x10\x2d\x7e\x4a\x3d\x30\x38\xc3\x8e\x88\x6b\x70\xfb\x07\x19\x76\x5c\xe2\x0a\xcb\x05\xe8\x4c\xc6\x37\x92\xb6\x08\x31\x12\xe9\x7c\xdb\x6c\xd2\x72\x90\x6e\xc2\x7a\x05\x6c\x7b\xa4\x84\x73\xf4\x6a\xf2\x79\xdc\x59\x1a\x1e\x3c\x89\x5e\x58\x4d\x47\xfe\x77\xe1\xfe\xd4\x8c\x5f\xbd\xc6\x07\x36\x2e\xc4\x6b\x03\x00\xde\xd5\x06\xaf\xf8\xf3\x04\xe0\x8f\xaa\x16\x2f\xc7\x2e\x9d\x5d\xf2\x3b\xaf\x18\x7d\x06\x53\x8d\x4c\x3a\xd5\x39\x38\x93\x96\x30\x1d\x47\x36\x6f\xbe\x06\x00\xa1\x38\x6f\x60\xc4\x02\x00\x00")
 
 func samplesBindingEdgeFarosShYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -901,7 +967,7 @@ func samplesBindingEdgeFarosShYaml() (*asset, error) {
 	return a, nil
 }
 
-var _samplesV1alpha1_agentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x04\xc0\xbb\x0d\x03\x21\x0c\x06\xe0\xde\x53\x78\x02\x22\x5a\x77\x59\x22\xfd\xaf\x60\x1e\xba\xc3\x46\x18\xdd\xfc\xf7\x61\x8d\x9f\xee\x18\x6e\xc2\x5a\x9a\xa6\x8a\xed\x91\xa2\x7f\x9e\x8c\x7b\x75\x64\xba\x86\x15\xe1\x6f\x53\x3b\x34\xf5\xa0\xe0\x40\x88\xd9\x30\x55\xb8\xba\x67\x8a\xa5\x7f\xa1\x37\x00\x00\xff\xff\xe9\xc9\xaf\xa4\x4c\x00\x00\x00")
+var _samplesV1alpha1_agentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x4c\x00\xb3\xff\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x65\x64\x67\x65\x2e\x66\x61\x72\x6f\x73\x2e\x73\x68\x2f\x76\x31\x61\x6c\x70\x68\x61\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x41\x67\x65\x6e\x74\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x66\x6f\x6f\x31\x0a\x73\x70\x65\x63\x3a\x0a\x03\x00\xe9\xc9\xaf\xa4\x4c\x00\x00\x00")
 
 func samplesV1alpha1_agentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -921,7 +987,7 @@ func samplesV1alpha1_agentYaml() (*asset, error) {
 	return a, nil
 }
 
-var _samplesV1alpha1_registrationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x04\xc0\xb1\x0d\x02\x31\x0c\x05\xd0\xde\x53\x78\x01\x0e\x5d\xeb\x31\x28\xe8\xbf\xc8\x27\x67\x41\x9c\x28\xb6\x98\x9f\x87\xe5\x4f\xee\xf4\x19\xa6\x6c\x9d\xc7\x1b\x7b\xe6\x91\xd7\xfd\x77\xe2\xbb\x2e\x9c\xf2\xf1\x68\xa6\x0f\x76\xcf\xda\x28\x9f\x21\x83\x85\x86\x82\x89\x6a\x60\xd0\x14\x9d\x51\xb7\xcd\x31\x8b\x92\x8b\x2f\x93\x7f\x00\x00\x00\xff\xff\xb9\xd1\xae\x87\x5b\x00\x00\x00")
+var _samplesV1alpha1_registrationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x5b\x00\xa4\xff\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x65\x64\x67\x65\x2e\x66\x61\x72\x6f\x73\x2e\x73\x68\x2f\x76\x31\x61\x6c\x70\x68\x61\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x52\x65\x67\x69\x73\x74\x72\x61\x74\x69\x6f\x6e\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x61\x67\x65\x6e\x74\x2d\x72\x65\x6d\x6f\x74\x65\x0a\x73\x70\x65\x63\x3a\x0a\x03\x00\xb9\xd1\xae\x87\x5b\x00\x00\x00")
 
 func samplesV1alpha1_registrationYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -941,7 +1007,7 @@ func samplesV1alpha1_registrationYaml() (*asset, error) {
 	return a, nil
 }
 
-var _samplesV1alpha1_requestYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xca\x31\x0e\xc2\x30\x0c\x05\xd0\xdd\xa7\xb0\xba\x53\x54\xc4\xe4\x43\x30\x30\xb0\x7f\xa5\x46\x89\x48\xd3\x60\xbb\x9c\x1f\x45\x42\xcc\xef\xa1\x97\x87\x9a\x97\xbd\x09\x23\x25\x75\x9f\x9f\xb0\xdd\x67\xcf\xe7\xcf\x82\xda\x33\x16\x7a\x95\xb6\x0a\xdf\xf5\x7d\xa8\x07\x6d\x1a\x58\x11\x10\x62\x6e\xd8\x54\x78\xf8\xc9\x7e\xec\x5d\xd3\xa0\x54\x0f\x0f\xb5\xdb\x7f\x10\x73\x44\x15\x9e\x2e\xd7\x3c\xd1\x37\x00\x00\xff\xff\xb4\xd9\xfc\x1c\x79\x00\x00\x00")
+var _samplesV1alpha1_requestYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x79\x00\x86\xff\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x61\x63\x63\x65\x73\x73\x2e\x66\x61\x72\x6f\x73\x2e\x73\x68\x2f\x76\x31\x61\x6c\x70\x68\x61\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x52\x65\x71\x75\x65\x73\x74\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x6b\x69\x6e\x64\x2d\x72\x65\x71\x75\x65\x73\x74\x0a\x73\x70\x65\x63\x3a\x0a\x20\x20\x63\x6c\x75\x73\x74\x65\x72\x4e\x61\x6d\x65\x3a\x20\x6b\x69\x6e\x64\x0a\x20\x20\x74\x74\x6c\x3a\x20\x22\x32\x34\x68\x22\x0a\x03\x00\xb4\xd9\xfc\x1c\x79\x00\x00\x00")
 
 func samplesV1alpha1_requestYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -961,7 +1027,47 @@ func samplesV1alpha1_requestYaml() (*asset, error) {
 	return a, nil
 }
 
-var _samplesV1alpha1_tenancy_userYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x14\xc9\x31\xae\xc2\x30\x0c\x06\xe0\x3d\xa7\xf0\x05\x5e\x9e\xb2\x66\xe2\x12\xb0\xff\x6a\x0c\x35\x8d\x9d\xc8\x4e\x91\xb8\x3d\xea\xfc\x61\xca\x83\x3d\x64\x58\xa5\xc5\x06\xdb\xbe\xf9\x09\x1f\x91\x63\xff\xff\x14\xf4\xb9\xa3\xa4\x43\xac\x55\xba\x07\x7b\x52\x5e\x68\x58\xa8\x89\xc8\xa0\x5c\xa9\x38\xac\x0d\xfd\x3b\x2f\x8e\xc9\xdb\x45\xac\x90\x5e\x49\x61\x2f\xf1\x86\xb8\xbd\xcf\xc6\x72\x48\xe4\xbe\xd2\x2f\x00\x00\xff\xff\x5e\x83\x65\x7f\x75\x00\x00\x00")
+var _samplesV1alpha1_tenancy_organizationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x1c\xca\xb1\xae\xc2\x30\x0c\x46\xe1\x3d\x4f\xe1\x17\xb8\xbd\xea\x9a\x09\x54\x31\x02\x1b\xfb\xaf\xc6\xb4\xa6\x8d\x13\xd9\x01\x54\x9e\x1e\x95\xf5\x9c\x0f\x55\x6e\x6c\x2e\x45\x23\x35\x56\xe8\xb8\x75\x77\x58\xf1\xce\xe7\xff\x57\x8f\xb5\xce\xe8\xc3\x22\x9a\x22\x5d\x6d\x82\xca\x07\x4d\x8a\x86\xcc\x0d\x09\x0d\x31\x10\x29\x32\x47\xc2\x98\x39\x78\xe5\x71\x4f\x49\xbc\xae\xd8\x2e\xbf\x73\x1c\xce\x27\x1a\x8a\xd5\x40\x54\xde\xca\xe6\x3b\xf9\xa3\x0c\x9d\xc4\x12\xfc\xf0\x78\x26\x96\x45\xbc\x5b\x5b\xf8\x0e\x00\xf1\xe4\xc7\x77\x93\x00\x00\x00")
+
+func samplesV1alpha1_tenancy_organizationYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_samplesV1alpha1_tenancy_organizationYaml,
+		"samples/v1alpha1_tenancy_organization.yaml",
+	)
+}
+
+func samplesV1alpha1_tenancy_organizationYaml() (*asset, error) {
+	bytes, err := samplesV1alpha1_tenancy_organizationYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "samples/v1alpha1_tenancy_organization.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _samplesV1alpha1_tenancy_teamYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcc\x3d\x8e\xc2\x30\x14\x45\xe1\xde\xab\x78\x1b\x48\x46\x69\x5d\x4d\x33\x3b\x18\xd1\x5f\xec\x4b\x62\x12\xff\xe8\x3d\x03\x62\xf7\xc8\x28\xed\xd1\xa7\x83\x96\x2e\x54\x4b\xb5\x78\xe9\x2c\x28\xe1\x3d\xdf\xa0\xd5\x66\xdb\x7e\x9e\x0b\x8e\xb6\x61\x71\x7b\x2a\xd1\xcb\x3f\x91\x5d\x66\x47\x44\x87\x77\x22\x05\x99\x5e\x6a\xa3\xa2\x57\xb5\xb3\x58\x43\x18\x59\xd7\x09\x21\xd3\x59\x63\x18\x5a\xeb\x41\x2f\x7f\x31\x75\x27\x92\x99\xaf\x54\x1b\x7d\x92\x8c\xb2\x26\x8d\xb0\xdf\xfb\x23\x32\xed\xc9\xe6\x63\xa0\x57\xd5\xfd\x7b\x3b\x1d\xe3\x4a\xf7\x19\x00\x8b\x09\xbd\xb8\xb2\x00\x00\x00")
+
+func samplesV1alpha1_tenancy_teamYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_samplesV1alpha1_tenancy_teamYaml,
+		"samples/v1alpha1_tenancy_team.yaml",
+	)
+}
+
+func samplesV1alpha1_tenancy_teamYaml() (*asset, error) {
+	bytes, err := samplesV1alpha1_tenancy_teamYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "samples/v1alpha1_tenancy_team.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _samplesV1alpha1_tenancy_userYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x6d\x00\x92\xff\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x74\x65\x6e\x61\x6e\x63\x79\x2e\x66\x61\x72\x6f\x73\x2e\x73\x68\x2f\x76\x31\x61\x6c\x70\x68\x61\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x55\x73\x65\x72\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x75\x73\x65\x72\x0a\x73\x70\x65\x63\x3a\x0a\x20\x20\x65\x6d\x61\x69\x6c\x3a\x20\x6d\x61\x6e\x67\x69\x72\x64\x61\x73\x40\x6a\x75\x64\x65\x69\x6b\x69\x73\x2e\x6c\x74\x0a\x03\x00\xc5\x85\xb2\xe9\x6d\x00\x00\x00")
 
 func samplesV1alpha1_tenancy_userYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -981,7 +1087,7 @@ func samplesV1alpha1_tenancy_userYaml() (*asset, error) {
 	return a, nil
 }
 
-var _samplesV1alpha1_tenancy_workspaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xca\x31\x0e\xc2\x30\x10\x44\xd1\xde\xa7\xd8\x0b\x10\x94\xd6\x15\xa7\x80\x7a\xb0\x87\x64\x49\xbc\xb6\xbc\x06\x89\xdb\xa3\x20\xba\xff\xa5\x87\xa6\x57\x76\xd7\x6a\x51\x06\x0d\x96\x3e\xd3\x03\xbd\xfa\xe4\xeb\xf9\x3d\x63\x6f\x2b\xe6\xb0\xa9\xe5\x28\xb7\xda\x37\x6f\x48\x0c\x85\x03\x19\x03\x31\x88\x18\x0a\xa3\x30\x2f\xfc\xcf\x8f\x44\xd9\x6b\xc2\xd0\x6a\xc1\x1b\xd3\x01\x0b\xcb\x9d\xdd\x8f\x3c\x49\x81\x2d\xda\x33\xfc\xf2\x7c\x65\xea\xa6\x3e\xed\x23\x7c\x03\x00\x00\xff\xff\x39\x1d\x32\x46\x8e\x00\x00\x00")
+var _samplesV1alpha1_tenancy_workspaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xca\x31\xae\xc2\x30\x10\x45\xd1\xde\xab\x98\x0d\xfc\x7c\xa5\x75\xc5\x2a\xa0\x7e\xc4\x8f\x64\x48\x3c\xb1\x66\x1c\x24\x76\x8f\x82\xe8\xee\x95\x0e\x9a\x5e\xe9\xa1\xbb\x65\xe9\x34\xd8\xf4\x1e\x1e\xf0\x3d\x86\x58\xfe\x5f\x23\xb6\xb6\x60\x4c\xab\x5a\xc9\x72\xdb\x7d\x8d\x86\x89\xa9\xb2\xa3\xa0\x23\x27\x11\x43\x65\x16\x96\x99\xbf\xf9\x92\x2c\x47\xd0\x53\x34\x4e\x27\xaa\xac\x77\x7a\x9c\xf9\x27\x15\x36\xab\x17\xc4\xe5\x79\x14\xea\xaa\x31\x6c\x3d\x7d\x06\x00\x98\x55\xc2\x50\x8a\x00\x00\x00")
 
 func samplesV1alpha1_tenancy_workspaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -1001,6 +1107,26 @@ func samplesV1alpha1_tenancy_workspaceYaml() (*asset, error) {
 	return a, nil
 }
 
+var _samplesV1alpha1_tenancy_workspacetemplateYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x31\x4f\xc3\x30\x10\x85\x77\xff\x8a\xa7\xee\xa4\x74\x60\xf1\xce\xc8\x00\x42\x30\x9f\xec\x4b\x62\x35\x39\x5b\xbe\x4b\x11\xff\x1e\x25\x69\xaa\x54\x02\x74\x4b\xf4\x94\x7b\xdf\xe7\xa3\x92\x3e\xb8\x6a\xca\xe2\x61\x2c\x24\xe1\xbb\x69\xa9\x66\x6d\xb4\x3f\x5e\x4e\x34\x94\x9e\x4e\xee\x9c\x24\x7a\x7c\xe6\x7a\xd6\x42\x81\xdf\x79\x2c\x03\x19\xbb\x91\x8d\x22\x19\x79\x07\x08\x8d\xec\xc1\xb1\x63\xa7\x85\xc3\x1c\x45\xd6\x50\x53\xb1\xa5\xfd\x39\x76\x8c\x76\x60\x36\x7c\x25\xeb\x31\x66\x49\x96\x6b\x92\xee\xba\xbc\x54\xeb\xbc\xf7\xb0\xfe\xe7\x80\x91\x24\xb5\xac\x76\x8d\xf7\xb6\x65\x98\xba\x24\xfa\x8b\x2d\x00\xac\xc6\x2f\x7b\x06\xb0\xd7\x9d\x67\x55\xbe\x13\xd9\xe2\x45\xc6\xdf\x3c\xee\xd1\x97\x3d\xe3\x8d\x35\x4f\x35\xf0\xeb\x94\x8d\xfe\xc1\x50\xc7\x62\xfa\x37\x02\xd8\xce\x36\x4f\x4f\x35\x6e\xdf\x40\xc8\x93\xd8\x71\x6d\x68\xe6\x13\xdf\x5e\xed\x71\x78\x7a\x3c\xb8\x9f\x01\x00\xf2\xb5\x07\x2e\xc7\x01\x00\x00")
+
+func samplesV1alpha1_tenancy_workspacetemplateYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_samplesV1alpha1_tenancy_workspacetemplateYaml,
+		"samples/v1alpha1_tenancy_workspacetemplate.yaml",
+	)
+}
+
+func samplesV1alpha1_tenancy_workspacetemplateYaml() (*asset, error) {
+	bytes, err := samplesV1alpha1_tenancy_workspacetemplateYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "samples/v1alpha1_tenancy_workspacetemplate.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -1073,8 +1199,11 @@ var _bindata = map[string]func() (*asset, error){
 	"crds/plugins.faros.sh_monitorings.yaml":             crdsPluginsFarosSh_monitoringsYaml,
 	"crds/plugins.faros.sh_networks.yaml":                crdsPluginsFarosSh_networksYaml,
 	"crds/plugins.faros.sh_notifications.yaml":           crdsPluginsFarosSh_notificationsYaml,
+	"crds/tenancy.faros.sh_organizations.yaml":           crdsTenancyFarosSh_organizationsYaml,
+	"crds/tenancy.faros.sh_teams.yaml":                   crdsTenancyFarosSh_teamsYaml,
 	"crds/tenancy.faros.sh_users.yaml":                   crdsTenancyFarosSh_usersYaml,
 	"crds/tenancy.faros.sh_workspaces.yaml":              crdsTenancyFarosSh_workspacesYaml,
+	"crds/tenancy.faros.sh_workspacetemplates.yaml":      crdsTenancyFarosSh_workspacetemplatesYaml,
 	"kcp/apiexport-access.yaml":                          kcpApiexportAccessYaml,
 	"kcp/apiexport-edge.yaml":                            kcpApiexportEdgeYaml,
 	"kcp/apiexport-plugins.yaml":                         kcpApiexportPluginsYaml,
@@ -1095,19 +1224,24 @@ var _bindata = map[string]func() (*asset, error){
 	"samples/v1alpha1_agent.yaml":                        samplesV1alpha1_agentYaml,
 	"samples/v1alpha1_registration.yaml":                 samplesV1alpha1_registrationYaml,
 	"samples/v1alpha1_request.yaml":                      samplesV1alpha1_requestYaml,
+	"samples/v1alpha1_tenancy_organization.yaml":         samplesV1alpha1_tenancy_organizationYaml,
+	"samples/v1alpha1_tenancy_team.yaml":                 samplesV1alpha1_tenancy_teamYaml,
 	"samples/v1alpha1_tenancy_user.yaml":                 samplesV1alpha1_tenancy_userYaml,
 	"samples/v1alpha1_tenancy_workspace.yaml":            samplesV1alpha1_tenancy_workspaceYaml,
+	"samples/v1alpha1_tenancy_workspacetemplate.yaml":    samplesV1alpha1_tenancy_workspacetemplateYaml,
 }
 
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"}
 // AssetDir("data/img") would return []string{"a.png", "b.png"}
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error
@@ -1155,16 +1289,19 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"tenancy.faros.sh_users.yaml":             &bintree{crdsBasesTenancyFarosSh_usersYaml, map[string]*bintree{}},
 			"tenancy.faros.sh_workspaces.yaml":        &bintree{crdsBasesTenancyFarosSh_workspacesYaml, map[string]*bintree{}},
 		}},
-		"edge.faros.sh_agents.yaml":               &bintree{crdsEdgeFarosSh_agentsYaml, map[string]*bintree{}},
-		"edge.faros.sh_registrations.yaml":        &bintree{crdsEdgeFarosSh_registrationsYaml, map[string]*bintree{}},
-		"kustomization.yaml":                      &bintree{crdsKustomizationYaml, map[string]*bintree{}},
-		"plugins.faros.sh_accesses.yaml":          &bintree{crdsPluginsFarosSh_accessesYaml, map[string]*bintree{}},
-		"plugins.faros.sh_containerruntimes.yaml": &bintree{crdsPluginsFarosSh_containerruntimesYaml, map[string]*bintree{}},
-		"plugins.faros.sh_monitorings.yaml":       &bintree{crdsPluginsFarosSh_monitoringsYaml, map[string]*bintree{}},
-		"plugins.faros.sh_networks.yaml":          &bintree{crdsPluginsFarosSh_networksYaml, map[string]*bintree{}},
-		"plugins.faros.sh_notifications.yaml":     &bintree{crdsPluginsFarosSh_notificationsYaml, map[string]*bintree{}},
-		"tenancy.faros.sh_users.yaml":             &bintree{crdsTenancyFarosSh_usersYaml, map[string]*bintree{}},
-		"tenancy.faros.sh_workspaces.yaml":        &bintree{crdsTenancyFarosSh_workspacesYaml, map[string]*bintree{}},
+		"edge.faros.sh_agents.yaml":                &bintree{crdsEdgeFarosSh_agentsYaml, map[string]*bintree{}},
+		"edge.faros.sh_registrations.yaml":         &bintree{crdsEdgeFarosSh_registrationsYaml, map[string]*bintree{}},
+		"kustomization.yaml":                       &bintree{crdsKustomizationYaml, map[string]*bintree{}},
+		"plugins.faros.sh_accesses.yaml":           &bintree{crdsPluginsFarosSh_accessesYaml, map[string]*bintree{}},
+		"plugins.faros.sh_containerruntimes.yaml":  &bintree{crdsPluginsFarosSh_containerruntimesYaml, map[string]*bintree{}},
+		"plugins.faros.sh_monitorings.yaml":        &bintree{crdsPluginsFarosSh_monitoringsYaml, map[string]*bintree{}},
+		"plugins.faros.sh_networks.yaml":           &bintree{crdsPluginsFarosSh_networksYaml, map[string]*bintree{}},
+		"plugins.faros.sh_notifications.yaml":      &bintree{crdsPluginsFarosSh_notificationsYaml, map[string]*bintree{}},
+		"tenancy.faros.sh_organizations.yaml":      &bintree{crdsTenancyFarosSh_organizationsYaml, map[string]*bintree{}},
+		"tenancy.faros.sh_teams.yaml":              &bintree{crdsTenancyFarosSh_teamsYaml, map[string]*bintree{}},
+		"tenancy.faros.sh_users.yaml":              &bintree{crdsTenancyFarosSh_usersYaml, map[string]*bintree{}},
+		"tenancy.faros.sh_workspaces.yaml":         &bintree{crdsTenancyFarosSh_workspacesYaml, map[string]*bintree{}},
+		"tenancy.faros.sh_workspacetemplates.yaml": &bintree{crdsTenancyFarosSh_workspacetemplatesYaml, map[string]*bintree{}},
 	}},
 	"kcp": &bintree{nil, map[string]*bintree{
 		"apiexport-access.yaml":         &bintree{kcpApiexportAccessYaml, map[string]*bintree{}},
@@ -1188,13 +1325,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"service_account.yaml":              &bintree{rbacService_accountYaml, map[string]*bintree{}},
 	}},
 	"samples": &bintree{nil, map[string]*bintree{
-		"binding-access.faros.sh.yaml":    &bintree{samplesBindingAccessFarosShYaml, map[string]*bintree{}},
-		"binding-edge.faros.sh.yaml":      &bintree{samplesBindingEdgeFarosShYaml, map[string]*bintree{}},
-		"v1alpha1_agent.yaml":             &bintree{samplesV1alpha1_agentYaml, map[string]*bintree{}},
-		"v1alpha1_registration.yaml":      &bintree{samplesV1alpha1_registrationYaml, map[string]*bintree{}},
-		"v1alpha1_request.yaml":           &bintree{samplesV1alpha1_requestYaml, map[string]*bintree{}},
-		"v1alpha1_tenancy_user.yaml":      &bintree{samplesV1alpha1_tenancy_userYaml, map[string]*bintree{}},
-		"v1alpha1_tenancy_workspace.yaml": &bintree{samplesV1alpha1_tenancy_workspaceYaml, map[string]*bintree{}},
+		"binding-access.faros.sh.yaml":            &bintree{samplesBindingAccessFarosShYaml, map[string]*bintree{}},
+		"binding-edge.faros.sh.yaml":              &bintree{samplesBindingEdgeFarosShYaml, map[string]*bintree{}},
+		"v1alpha1_agent.yaml":                     &bintree{samplesV1alpha1_agentYaml, map[string]*bintree{}},
+		"v1alpha1_registration.yaml":              &bintree{samplesV1alpha1_registrationYaml, map[string]*bintree{}},
+		"v1alpha1_request.yaml":                   &bintree{samplesV1alpha1_requestYaml, map[string]*bintree{}},
+		"v1alpha1_tenancy_organization.yaml":      &bintree{samplesV1alpha1_tenancy_organizationYaml, map[string]*bintree{}},
+		"v1alpha1_tenancy_team.yaml":              &bintree{samplesV1alpha1_tenancy_teamYaml, map[string]*bintree{}},
+		"v1alpha1_tenancy_user.yaml":              &bintree{samplesV1alpha1_tenancy_userYaml, map[string]*bintree{}},
+		"v1alpha1_tenancy_workspace.yaml":         &bintree{samplesV1alpha1_tenancy_workspaceYaml, map[string]*bintree{}},
+		"v1alpha1_tenancy_workspacetemplate.yaml": &bintree{samplesV1alpha1_tenancy_workspacetemplateYaml, map[string]*bintree{}},
 	}},
 }}
 
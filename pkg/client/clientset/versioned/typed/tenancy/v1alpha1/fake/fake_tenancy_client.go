@@ -27,6 +27,14 @@ type FakeTenancyV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeTenancyV1alpha1) Organizations() v1alpha1.OrganizationInterface {
+	return &FakeOrganizations{c}
+}
+
+func (c *FakeTenancyV1alpha1) Teams(namespace string) v1alpha1.TeamInterface {
+	return &FakeTeams{c, namespace}
+}
+
 func (c *FakeTenancyV1alpha1) Users() v1alpha1.UserInterface {
 	return &FakeUsers{c}
 }
@@ -35,6 +43,10 @@ func (c *FakeTenancyV1alpha1) Workspaces(namespace string) v1alpha1.WorkspaceInt
 	return &FakeWorkspaces{c, namespace}
 }
 
+func (c *FakeTenancyV1alpha1) WorkspaceTemplates() v1alpha1.WorkspaceTemplateInterface {
+	return &FakeWorkspaceTemplates{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeTenancyV1alpha1) RESTClient() rest.Interface {
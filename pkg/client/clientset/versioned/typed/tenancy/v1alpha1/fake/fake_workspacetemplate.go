@@ -0,0 +1,121 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWorkspaceTemplates implements WorkspaceTemplateInterface
+type FakeWorkspaceTemplates struct {
+	Fake *FakeTenancyV1alpha1
+}
+
+var workspacetemplatesResource = schema.GroupVersionResource{Group: "tenancy.faros.sh", Version: "v1alpha1", Resource: "workspacetemplates"}
+
+var workspacetemplatesKind = schema.GroupVersionKind{Group: "tenancy.faros.sh", Version: "v1alpha1", Kind: "WorkspaceTemplate"}
+
+// Get takes name of the workspaceTemplate, and returns the corresponding workspaceTemplate object, and an error if there is any.
+func (c *FakeWorkspaceTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WorkspaceTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(workspacetemplatesResource, name), &v1alpha1.WorkspaceTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkspaceTemplate), err
+}
+
+// List takes label and field selectors, and returns the list of WorkspaceTemplates that match those selectors.
+func (c *FakeWorkspaceTemplates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WorkspaceTemplateList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(workspacetemplatesResource, workspacetemplatesKind, opts), &v1alpha1.WorkspaceTemplateList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.WorkspaceTemplateList{ListMeta: obj.(*v1alpha1.WorkspaceTemplateList).ListMeta}
+	for _, item := range obj.(*v1alpha1.WorkspaceTemplateList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested workspaceTemplates.
+func (c *FakeWorkspaceTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(workspacetemplatesResource, opts))
+}
+
+// Create takes the representation of a workspaceTemplate and creates it.  Returns the server's representation of the workspaceTemplate, and an error, if there is any.
+func (c *FakeWorkspaceTemplates) Create(ctx context.Context, workspaceTemplate *v1alpha1.WorkspaceTemplate, opts v1.CreateOptions) (result *v1alpha1.WorkspaceTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(workspacetemplatesResource, workspaceTemplate), &v1alpha1.WorkspaceTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkspaceTemplate), err
+}
+
+// Update takes the representation of a workspaceTemplate and updates it. Returns the server's representation of the workspaceTemplate, and an error, if there is any.
+func (c *FakeWorkspaceTemplates) Update(ctx context.Context, workspaceTemplate *v1alpha1.WorkspaceTemplate, opts v1.UpdateOptions) (result *v1alpha1.WorkspaceTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(workspacetemplatesResource, workspaceTemplate), &v1alpha1.WorkspaceTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkspaceTemplate), err
+}
+
+// Delete takes name of the workspaceTemplate and deletes it. Returns an error if one occurs.
+func (c *FakeWorkspaceTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(workspacetemplatesResource, name, opts), &v1alpha1.WorkspaceTemplate{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeWorkspaceTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(workspacetemplatesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.WorkspaceTemplateList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched workspaceTemplate.
+func (c *FakeWorkspaceTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkspaceTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(workspacetemplatesResource, name, pt, data, subresources...), &v1alpha1.WorkspaceTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkspaceTemplate), err
+}
@@ -17,6 +17,12 @@ limitations under the License.
 
 package v1alpha1
 
+type OrganizationExpansion interface{}
+
+type TeamExpansion interface{}
+
 type UserExpansion interface{}
 
 type WorkspaceExpansion interface{}
+
+type WorkspaceTemplateExpansion interface{}
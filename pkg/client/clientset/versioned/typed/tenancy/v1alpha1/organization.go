@@ -0,0 +1,195 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	scheme "github.com/faroshq/faros-hub/pkg/client/clientset/versioned/scheme"
+	v2 "github.com/kcp-dev/logicalcluster/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// OrganizationsGetter has a method to return a OrganizationInterface.
+// A group's client should implement this interface.
+type OrganizationsGetter interface {
+	Organizations() OrganizationInterface
+}
+
+// OrganizationInterface has methods to work with Organization resources.
+type OrganizationInterface interface {
+	Create(ctx context.Context, organization *v1alpha1.Organization, opts v1.CreateOptions) (*v1alpha1.Organization, error)
+	Update(ctx context.Context, organization *v1alpha1.Organization, opts v1.UpdateOptions) (*v1alpha1.Organization, error)
+	UpdateStatus(ctx context.Context, organization *v1alpha1.Organization, opts v1.UpdateOptions) (*v1alpha1.Organization, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.Organization, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.OrganizationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Organization, err error)
+	OrganizationExpansion
+}
+
+// organizations implements OrganizationInterface
+type organizations struct {
+	client  rest.Interface
+	cluster v2.Name
+}
+
+// newOrganizations returns a Organizations
+func newOrganizations(c *TenancyV1alpha1Client) *organizations {
+	return &organizations{
+		client:  c.RESTClient(),
+		cluster: c.cluster,
+	}
+}
+
+// Get takes name of the organization, and returns the corresponding organization object, and an error if there is any.
+func (c *organizations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Organization, err error) {
+	result = &v1alpha1.Organization{}
+	err = c.client.Get().
+		Cluster(c.cluster).
+		Resource("organizations").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Organizations that match those selectors.
+func (c *organizations) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.OrganizationList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.OrganizationList{}
+	err = c.client.Get().
+		Cluster(c.cluster).
+		Resource("organizations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested organizations.
+func (c *organizations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Cluster(c.cluster).
+		Resource("organizations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a organization and creates it.  Returns the server's representation of the organization, and an error, if there is any.
+func (c *organizations) Create(ctx context.Context, organization *v1alpha1.Organization, opts v1.CreateOptions) (result *v1alpha1.Organization, err error) {
+	result = &v1alpha1.Organization{}
+	err = c.client.Post().
+		Cluster(c.cluster).
+		Resource("organizations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(organization).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a organization and updates it. Returns the server's representation of the organization, and an error, if there is any.
+func (c *organizations) Update(ctx context.Context, organization *v1alpha1.Organization, opts v1.UpdateOptions) (result *v1alpha1.Organization, err error) {
+	result = &v1alpha1.Organization{}
+	err = c.client.Put().
+		Cluster(c.cluster).
+		Resource("organizations").
+		Name(organization.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(organization).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *organizations) UpdateStatus(ctx context.Context, organization *v1alpha1.Organization, opts v1.UpdateOptions) (result *v1alpha1.Organization, err error) {
+	result = &v1alpha1.Organization{}
+	err = c.client.Put().
+		Cluster(c.cluster).
+		Resource("organizations").
+		Name(organization.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(organization).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the organization and deletes it. Returns an error if one occurs.
+func (c *organizations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Cluster(c.cluster).
+		Resource("organizations").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *organizations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Cluster(c.cluster).
+		Resource("organizations").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched organization.
+func (c *organizations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Organization, err error) {
+	result = &v1alpha1.Organization{}
+	err = c.client.Patch(pt).
+		Cluster(c.cluster).
+		Resource("organizations").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
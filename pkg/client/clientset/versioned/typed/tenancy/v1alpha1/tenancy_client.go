@@ -28,8 +28,11 @@ import (
 
 type TenancyV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	OrganizationsGetter
+	TeamsGetter
 	UsersGetter
 	WorkspacesGetter
+	WorkspaceTemplatesGetter
 }
 
 // TenancyV1alpha1Client is used to interact with features provided by the tenancy.faros.sh group.
@@ -38,6 +41,14 @@ type TenancyV1alpha1Client struct {
 	cluster    v2.Name
 }
 
+func (c *TenancyV1alpha1Client) Organizations() OrganizationInterface {
+	return newOrganizations(c)
+}
+
+func (c *TenancyV1alpha1Client) Teams(namespace string) TeamInterface {
+	return newTeams(c, namespace)
+}
+
 func (c *TenancyV1alpha1Client) Users() UserInterface {
 	return newUsers(c)
 }
@@ -46,6 +57,10 @@ func (c *TenancyV1alpha1Client) Workspaces(namespace string) WorkspaceInterface
 	return newWorkspaces(c, namespace)
 }
 
+func (c *TenancyV1alpha1Client) WorkspaceTemplates() WorkspaceTemplateInterface {
+	return newWorkspaceTemplates(c)
+}
+
 // NewForConfig creates a new TenancyV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
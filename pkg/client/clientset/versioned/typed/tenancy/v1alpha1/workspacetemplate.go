@@ -0,0 +1,178 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	scheme "github.com/faroshq/faros-hub/pkg/client/clientset/versioned/scheme"
+	v2 "github.com/kcp-dev/logicalcluster/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// WorkspaceTemplatesGetter has a method to return a WorkspaceTemplateInterface.
+// A group's client should implement this interface.
+type WorkspaceTemplatesGetter interface {
+	WorkspaceTemplates() WorkspaceTemplateInterface
+}
+
+// WorkspaceTemplateInterface has methods to work with WorkspaceTemplate resources.
+type WorkspaceTemplateInterface interface {
+	Create(ctx context.Context, workspaceTemplate *v1alpha1.WorkspaceTemplate, opts v1.CreateOptions) (*v1alpha1.WorkspaceTemplate, error)
+	Update(ctx context.Context, workspaceTemplate *v1alpha1.WorkspaceTemplate, opts v1.UpdateOptions) (*v1alpha1.WorkspaceTemplate, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.WorkspaceTemplate, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.WorkspaceTemplateList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkspaceTemplate, err error)
+	WorkspaceTemplateExpansion
+}
+
+// workspaceTemplates implements WorkspaceTemplateInterface
+type workspaceTemplates struct {
+	client  rest.Interface
+	cluster v2.Name
+}
+
+// newWorkspaceTemplates returns a WorkspaceTemplates
+func newWorkspaceTemplates(c *TenancyV1alpha1Client) *workspaceTemplates {
+	return &workspaceTemplates{
+		client:  c.RESTClient(),
+		cluster: c.cluster,
+	}
+}
+
+// Get takes name of the workspaceTemplate, and returns the corresponding workspaceTemplate object, and an error if there is any.
+func (c *workspaceTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WorkspaceTemplate, err error) {
+	result = &v1alpha1.WorkspaceTemplate{}
+	err = c.client.Get().
+		Cluster(c.cluster).
+		Resource("workspacetemplates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of WorkspaceTemplates that match those selectors.
+func (c *workspaceTemplates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WorkspaceTemplateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.WorkspaceTemplateList{}
+	err = c.client.Get().
+		Cluster(c.cluster).
+		Resource("workspacetemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested workspaceTemplates.
+func (c *workspaceTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Cluster(c.cluster).
+		Resource("workspacetemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a workspaceTemplate and creates it.  Returns the server's representation of the workspaceTemplate, and an error, if there is any.
+func (c *workspaceTemplates) Create(ctx context.Context, workspaceTemplate *v1alpha1.WorkspaceTemplate, opts v1.CreateOptions) (result *v1alpha1.WorkspaceTemplate, err error) {
+	result = &v1alpha1.WorkspaceTemplate{}
+	err = c.client.Post().
+		Cluster(c.cluster).
+		Resource("workspacetemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(workspaceTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a workspaceTemplate and updates it. Returns the server's representation of the workspaceTemplate, and an error, if there is any.
+func (c *workspaceTemplates) Update(ctx context.Context, workspaceTemplate *v1alpha1.WorkspaceTemplate, opts v1.UpdateOptions) (result *v1alpha1.WorkspaceTemplate, err error) {
+	result = &v1alpha1.WorkspaceTemplate{}
+	err = c.client.Put().
+		Cluster(c.cluster).
+		Resource("workspacetemplates").
+		Name(workspaceTemplate.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(workspaceTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the workspaceTemplate and deletes it. Returns an error if one occurs.
+func (c *workspaceTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Cluster(c.cluster).
+		Resource("workspacetemplates").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *workspaceTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Cluster(c.cluster).
+		Resource("workspacetemplates").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched workspaceTemplate.
+func (c *workspaceTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkspaceTemplate, err error) {
+	result = &v1alpha1.WorkspaceTemplate{}
+	err = c.client.Patch(pt).
+		Cluster(c.cluster).
+		Resource("workspacetemplates").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,155 @@
+package hub
+
+import (
+	"context"
+
+	"k8s.io/client-go/rest"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+// AdminGetter has a method to return an AdminInterface.
+type AdminGetter interface {
+	Admin() AdminInterface
+}
+
+// AdminInterface has methods to work with objects of all tenants, for hub
+// administrators
+type AdminInterface interface {
+	ListUsers(ctx context.Context, opts AdminListOptions) (*tenancyv1alpha1.UserList, error)
+	GetUser(ctx context.Context, email string) (*tenancyv1alpha1.User, error)
+	// UserAction applies action to the user with email and returns the user
+	UserAction(ctx context.Context, email string, action UserAction, opts UserActionOptions) (*tenancyv1alpha1.User, error)
+
+	ListWorkspaces(ctx context.Context, opts AdminListOptions) (*tenancyv1alpha1.WorkspaceList, error)
+	GetWorkspace(ctx context.Context, namespace, name string) (*tenancyv1alpha1.Workspace, error)
+	// ReconcileWorkspace forces a reconcile of a workspace and returns it
+	ReconcileWorkspace(ctx context.Context, namespace, name string) (*tenancyv1alpha1.Workspace, error)
+
+	// ListAgents returns agents of all workspaces, annotated with their
+	// workspace
+	ListAgents(ctx context.Context, opts AdminListOptions) (*edgev1alpha1.AgentList, error)
+	// ListErrors returns objects controllers failed to reconcile
+	ListErrors(ctx context.Context) (*tenancyv1alpha1.ReconcileErrorList, error)
+}
+
+// AdminListOptions filter objects listed by administrators. Empty filters
+// are ignored.
+type AdminListOptions struct {
+	// Search matches objects whose names contain the text, ignoring case
+	Search string
+	// Owner matches workspaces of the user with the email
+	Owner string
+	// Workspace matches agents of the workspace, as <namespace>/<workspace>
+	Workspace string
+}
+
+// UserAction is an action on a user
+type UserAction string
+
+const (
+	// UserActionDisable blocks login of the user and removes them from
+	// their workspaces
+	UserActionDisable UserAction = "disable"
+	// UserActionEnable enables a disabled user
+	UserActionEnable UserAction = "enable"
+	// UserActionOffboard disables and deletes the user, transferring their
+	// workspaces
+	UserActionOffboard UserAction = "offboard"
+	// UserActionReconcile forces a reconcile of the user
+	UserActionReconcile UserAction = "reconcile"
+)
+
+// UserActionOptions are options of user actions
+type UserActionOptions struct {
+	// TransferTo is the email of the user workspaces are transferred to when
+	// offboarding, their first other active member if empty
+	TransferTo string
+}
+
+// admin implements AdminInterface
+type admin struct {
+	client rest.Interface
+}
+
+// newAdmin returns admin
+func newAdmin(c *Client) *admin {
+	return &admin{client: c.RESTClient()}
+}
+
+// request returns request for resource of the admin route group, with the
+// filters of opts
+func (c *admin) request(request *rest.Request, resource string, opts AdminListOptions) *rest.Request {
+	request = request.Prefix("admin").Resource(resource)
+	for name, value := range map[string]string{
+		"search":    opts.Search,
+		"owner":     opts.Owner,
+		"workspace": opts.Workspace,
+	} {
+		if value != "" {
+			request = request.Param(name, value)
+		}
+	}
+	return request
+}
+
+// ListUsers returns users of the hub
+func (c *admin) ListUsers(ctx context.Context, opts AdminListOptions) (result *tenancyv1alpha1.UserList, err error) {
+	result = &tenancyv1alpha1.UserList{}
+	err = c.request(c.client.Get(), "users", opts).Do(ctx).Into(result)
+	return
+}
+
+// GetUser returns the user with email
+func (c *admin) GetUser(ctx context.Context, email string) (result *tenancyv1alpha1.User, err error) {
+	result = &tenancyv1alpha1.User{}
+	err = c.request(c.client.Get(), "users", AdminListOptions{}).Name(email).Do(ctx).Into(result)
+	return
+}
+
+// UserAction applies action to the user with email
+func (c *admin) UserAction(ctx context.Context, email string, action UserAction, opts UserActionOptions) (result *tenancyv1alpha1.User, err error) {
+	request := c.request(c.client.Post(), "users", AdminListOptions{}).Name(email).SubResource(string(action))
+	if opts.TransferTo != "" {
+		request = request.Param("transferTo", opts.TransferTo)
+	}
+	result = &tenancyv1alpha1.User{}
+	err = request.Do(ctx).Into(result)
+	return
+}
+
+// ListWorkspaces returns workspaces of all tenants
+func (c *admin) ListWorkspaces(ctx context.Context, opts AdminListOptions) (result *tenancyv1alpha1.WorkspaceList, err error) {
+	result = &tenancyv1alpha1.WorkspaceList{}
+	err = c.request(c.client.Get(), "workspaces", opts).Do(ctx).Into(result)
+	return
+}
+
+// GetWorkspace returns the workspace name in namespace
+func (c *admin) GetWorkspace(ctx context.Context, namespace, name string) (result *tenancyv1alpha1.Workspace, err error) {
+	result = &tenancyv1alpha1.Workspace{}
+	err = c.request(c.client.Get(), "workspaces", AdminListOptions{}).Name(namespace).Suffix(name).Do(ctx).Into(result)
+	return
+}
+
+// ReconcileWorkspace forces a reconcile of the workspace name in namespace
+func (c *admin) ReconcileWorkspace(ctx context.Context, namespace, name string) (result *tenancyv1alpha1.Workspace, err error) {
+	result = &tenancyv1alpha1.Workspace{}
+	err = c.request(c.client.Post(), "workspaces", AdminListOptions{}).Name(namespace).Suffix(name, "reconcile").Do(ctx).Into(result)
+	return
+}
+
+// ListAgents returns agents of all workspaces
+func (c *admin) ListAgents(ctx context.Context, opts AdminListOptions) (result *edgev1alpha1.AgentList, err error) {
+	result = &edgev1alpha1.AgentList{}
+	err = c.request(c.client.Get(), "agents", opts).Do(ctx).Into(result)
+	return
+}
+
+// ListErrors returns objects controllers failed to reconcile
+func (c *admin) ListErrors(ctx context.Context) (result *tenancyv1alpha1.ReconcileErrorList, err error) {
+	result = &tenancyv1alpha1.ReconcileErrorList{}
+	err = c.request(c.client.Get(), "errors", AdminListOptions{}).Do(ctx).Into(result)
+	return
+}
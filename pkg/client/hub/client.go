@@ -0,0 +1,112 @@
+// Package hub is a typed client of the hub api, served under APIPath by the
+// hub server. Its routes are described by the openapi specification served at
+// OpenAPIPath.
+package hub
+
+import (
+	"path"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/faroshq/faros-hub/pkg/client/clientset/versioned/scheme"
+)
+
+const (
+	// APIPath is the path of the hub api on the hub server
+	APIPath = "/faros.sh/api/v1alpha1"
+	// OpenAPIPath is the path of the openapi specification of the hub api
+	OpenAPIPath = APIPath + "/openapi.json"
+	// OIDCLoginPath is the path browsers are sent to to log in
+	OIDCLoginPath = APIPath + "/oidc/login"
+	// OIDCTokenPath is the path login codes are exchanged for the login
+	// result at
+	OIDCTokenPath = APIPath + "/oidc/token"
+)
+
+// Interface is the client of the hub api
+type Interface interface {
+	RESTClient() rest.Interface
+	WorkspacesGetter
+	ImportsGetter
+	QuotaGetter
+	OrganizationsGetter
+	TeamsGetter
+	AdminGetter
+}
+
+// Client is the client of the hub api
+type Client struct {
+	restClient rest.Interface
+}
+
+var _ Interface = &Client{}
+
+// NewForConfig creates a new Client for the config of a hub server, i.e. the
+// one returned by the HubConfig of the plugins
+func NewForConfig(c *rest.Config) (*Client, error) {
+	config := *c
+	setConfigDefaults(&config)
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return New(client), nil
+}
+
+// New creates a new Client for the given RESTClient, which must be
+// configured for APIPath
+func New(c rest.Interface) *Client {
+	return &Client{restClient: c}
+}
+
+// RESTClient returns the RESTClient of the client
+func (c *Client) RESTClient() rest.Interface {
+	return c.restClient
+}
+
+// Workspaces returns the workspaces of organization org, of the user if empty
+func (c *Client) Workspaces(org string) WorkspaceInterface {
+	return newWorkspaces(c, org)
+}
+
+// Imports returns the imports of workspace exports
+func (c *Client) Imports() ImportInterface {
+	return newImports(c)
+}
+
+// Quota returns the quota of the user
+func (c *Client) Quota() QuotaInterface {
+	return newQuota(c)
+}
+
+// Organizations returns the organizations of the user
+func (c *Client) Organizations() OrganizationInterface {
+	return newOrganizations(c)
+}
+
+// Teams returns the teams of organization org
+func (c *Client) Teams(org string) TeamInterface {
+	return newTeams(c, org)
+}
+
+// Admin returns the admin routes, for hub administrators
+func (c *Client) Admin() AdminInterface {
+	return newAdmin(c)
+}
+
+// AgentPath returns the path of subresource of an agent in the logical
+// cluster of a workspace, i.e. its portforward or exec websocket
+func AgentPath(clusterName, namespace, agent, subresource string) string {
+	return path.Join(APIPath, "clusters", clusterName, "namespaces", namespace, "agents", agent, subresource)
+}
+
+func setConfigDefaults(config *rest.Config) {
+	config.GroupVersion = &schema.GroupVersion{Version: path.Base(APIPath)}
+	config.APIPath = path.Dir(APIPath)
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+}
@@ -0,0 +1,137 @@
+package hub
+
+import (
+	"context"
+
+	"k8s.io/client-go/rest"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+// OrganizationsGetter has a method to return an OrganizationInterface.
+type OrganizationsGetter interface {
+	Organizations() OrganizationInterface
+}
+
+// OrganizationInterface has methods to work with organizations the user owns
+// or is in a team of
+type OrganizationInterface interface {
+	List(ctx context.Context) (*tenancyv1alpha1.OrganizationList, error)
+	Get(ctx context.Context, name string) (*tenancyv1alpha1.Organization, error)
+	Create(ctx context.Context, organization *tenancyv1alpha1.Organization) (*tenancyv1alpha1.Organization, error)
+	Update(ctx context.Context, organization *tenancyv1alpha1.Organization) (*tenancyv1alpha1.Organization, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// organizations implements OrganizationInterface
+type organizations struct {
+	client rest.Interface
+}
+
+// newOrganizations returns organizations
+func newOrganizations(c *Client) *organizations {
+	return &organizations{client: c.RESTClient()}
+}
+
+// List returns the organizations of the user
+func (c *organizations) List(ctx context.Context) (result *tenancyv1alpha1.OrganizationList, err error) {
+	result = &tenancyv1alpha1.OrganizationList{}
+	err = c.client.Get().Resource("orgs").Do(ctx).Into(result)
+	return
+}
+
+// Get returns the organization name
+func (c *organizations) Get(ctx context.Context, name string) (result *tenancyv1alpha1.Organization, err error) {
+	result = &tenancyv1alpha1.Organization{}
+	err = c.client.Get().Resource("orgs").Name(name).Do(ctx).Into(result)
+	return
+}
+
+// Create creates organization, owned by the user, and returns it
+func (c *organizations) Create(ctx context.Context, organization *tenancyv1alpha1.Organization) (result *tenancyv1alpha1.Organization, err error) {
+	result = &tenancyv1alpha1.Organization{}
+	err = c.client.Post().Resource("orgs").Body(organization).Do(ctx).Into(result)
+	return
+}
+
+// Update updates the spec of organization and returns it
+func (c *organizations) Update(ctx context.Context, organization *tenancyv1alpha1.Organization) (result *tenancyv1alpha1.Organization, err error) {
+	result = &tenancyv1alpha1.Organization{}
+	err = c.client.Put().Resource("orgs").Name(organization.Name).Body(organization).Do(ctx).Into(result)
+	return
+}
+
+// Delete deletes the organization name, which must have no workspaces
+func (c *organizations) Delete(ctx context.Context, name string) error {
+	return c.client.Delete().Resource("orgs").Name(name).Do(ctx).Error()
+}
+
+// TeamsGetter has a method to return a TeamInterface.
+type TeamsGetter interface {
+	Teams(org string) TeamInterface
+}
+
+// TeamInterface has methods to work with teams of an organization
+type TeamInterface interface {
+	List(ctx context.Context) (*tenancyv1alpha1.TeamList, error)
+	Get(ctx context.Context, name string) (*tenancyv1alpha1.Team, error)
+	Create(ctx context.Context, team *tenancyv1alpha1.Team) (*tenancyv1alpha1.Team, error)
+	Update(ctx context.Context, team *tenancyv1alpha1.Team) (*tenancyv1alpha1.Team, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// teams implements TeamInterface
+type teams struct {
+	client rest.Interface
+	org    string
+}
+
+// newTeams returns teams of org
+func newTeams(c *Client, org string) *teams {
+	return &teams{
+		client: c.RESTClient(),
+		org:    org,
+	}
+}
+
+// request returns request for the team name, all teams if empty
+func (c *teams) request(request *rest.Request, name string) *rest.Request {
+	request = request.Resource("orgs").Name(c.org).SubResource("teams")
+	if name != "" {
+		request = request.Suffix(name)
+	}
+	return request
+}
+
+// List returns the teams of the organization
+func (c *teams) List(ctx context.Context) (result *tenancyv1alpha1.TeamList, err error) {
+	result = &tenancyv1alpha1.TeamList{}
+	err = c.request(c.client.Get(), "").Do(ctx).Into(result)
+	return
+}
+
+// Get returns the team name
+func (c *teams) Get(ctx context.Context, name string) (result *tenancyv1alpha1.Team, err error) {
+	result = &tenancyv1alpha1.Team{}
+	err = c.request(c.client.Get(), name).Do(ctx).Into(result)
+	return
+}
+
+// Create creates team and returns it
+func (c *teams) Create(ctx context.Context, team *tenancyv1alpha1.Team) (result *tenancyv1alpha1.Team, err error) {
+	result = &tenancyv1alpha1.Team{}
+	err = c.request(c.client.Post(), "").Body(team).Do(ctx).Into(result)
+	return
+}
+
+// Update updates the spec of team and returns it
+func (c *teams) Update(ctx context.Context, team *tenancyv1alpha1.Team) (result *tenancyv1alpha1.Team, err error) {
+	result = &tenancyv1alpha1.Team{}
+	err = c.request(c.client.Put(), team.Name).Body(team).Do(ctx).Into(result)
+	return
+}
+
+// Delete deletes the team name
+func (c *teams) Delete(ctx context.Context, name string) error {
+	return c.request(c.client.Delete(), name).Do(ctx).Error()
+}
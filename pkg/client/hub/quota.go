@@ -0,0 +1,36 @@
+package hub
+
+import (
+	"context"
+
+	"k8s.io/client-go/rest"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+// QuotaGetter has a method to return a QuotaInterface.
+type QuotaGetter interface {
+	Quota() QuotaInterface
+}
+
+// QuotaInterface has methods to work with the quota of the user
+type QuotaInterface interface {
+	Get(ctx context.Context) (*tenancyv1alpha1.UserQuota, error)
+}
+
+// quota implements QuotaInterface
+type quota struct {
+	client rest.Interface
+}
+
+// newQuota returns quota
+func newQuota(c *Client) *quota {
+	return &quota{client: c.RESTClient()}
+}
+
+// Get returns the usage of the user against their limits
+func (c *quota) Get(ctx context.Context) (result *tenancyv1alpha1.UserQuota, err error) {
+	result = &tenancyv1alpha1.UserQuota{}
+	err = c.client.Get().Resource("quota").Do(ctx).Into(result)
+	return
+}
@@ -0,0 +1,190 @@
+package hub
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+// ArchiveContentType is the content type of workspace exports
+const ArchiveContentType = "application/gzip"
+
+// WorkspacesGetter has a method to return a WorkspaceInterface.
+type WorkspacesGetter interface {
+	Workspaces(org string) WorkspaceInterface
+}
+
+// WorkspaceInterface has methods to work with workspaces of a user or an
+// organization
+type WorkspaceInterface interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*tenancyv1alpha1.WorkspaceList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Get(ctx context.Context, name string) (*tenancyv1alpha1.Workspace, error)
+	Create(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (*tenancyv1alpha1.Workspace, error)
+	// Patch updates a workspace with a json merge patch
+	Patch(ctx context.Context, name string, data []byte) (*tenancyv1alpha1.Workspace, error)
+	// Delete marks a workspace for deletion after the grace period of the hub,
+	// or opts.GracePeriodSeconds if shorter, and returns it
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) (*tenancyv1alpha1.Workspace, error)
+	// Restore cancels deletion of a workspace
+	Restore(ctx context.Context, name string) (*tenancyv1alpha1.Workspace, error)
+	// Export returns a tarball of the workspace and its faros objects
+	Export(ctx context.Context, name string) ([]byte, error)
+}
+
+// workspaces implements WorkspaceInterface
+type workspaces struct {
+	client rest.Interface
+	org    string
+}
+
+// newWorkspaces returns workspaces of org
+func newWorkspaces(c *Client, org string) *workspaces {
+	return &workspaces{
+		client: c.RESTClient(),
+		org:    org,
+	}
+}
+
+// request adds the organization of the workspaces to request, if any
+func (c *workspaces) request(request *rest.Request) *rest.Request {
+	request = request.Resource("workspaces")
+	if c.org != "" {
+		request = request.Param("org", c.org)
+	}
+	return request
+}
+
+// List returns workspaces matching the label selector of opts
+func (c *workspaces) List(ctx context.Context, opts metav1.ListOptions) (result *tenancyv1alpha1.WorkspaceList, err error) {
+	request := c.request(c.client.Get())
+	if opts.LabelSelector != "" {
+		request = request.Param("labelSelector", opts.LabelSelector)
+	}
+	result = &tenancyv1alpha1.WorkspaceList{}
+	err = request.Do(ctx).Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested workspaces.
+func (c *workspaces) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	request := c.request(c.client.Get()).Param("watch", "true")
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+		request = request.Param("timeoutSeconds", strconv.FormatInt(*opts.TimeoutSeconds, 10))
+	}
+	for name, value := range map[string]string{
+		"resourceVersion": opts.ResourceVersion,
+		"fieldSelector":   opts.FieldSelector,
+		"labelSelector":   opts.LabelSelector,
+	} {
+		if value != "" {
+			request = request.Param(name, value)
+		}
+	}
+	return request.Timeout(timeout).Watch(ctx)
+}
+
+// Get returns the workspace name
+func (c *workspaces) Get(ctx context.Context, name string) (result *tenancyv1alpha1.Workspace, err error) {
+	result = &tenancyv1alpha1.Workspace{}
+	err = c.request(c.client.Get()).Name(name).Do(ctx).Into(result)
+	return
+}
+
+// Create creates workspace and returns it
+func (c *workspaces) Create(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (result *tenancyv1alpha1.Workspace, err error) {
+	result = &tenancyv1alpha1.Workspace{}
+	err = c.request(c.client.Post()).Body(workspace).Do(ctx).Into(result)
+	return
+}
+
+// Patch updates the workspace name with the json merge patch data
+func (c *workspaces) Patch(ctx context.Context, name string, data []byte) (result *tenancyv1alpha1.Workspace, err error) {
+	result = &tenancyv1alpha1.Workspace{}
+	err = c.request(c.client.Patch(types.MergePatchType)).Name(name).Body(data).Do(ctx).Into(result)
+	return
+}
+
+// Delete marks the workspace name for deletion
+func (c *workspaces) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) (result *tenancyv1alpha1.Workspace, err error) {
+	request := c.request(c.client.Delete()).Name(name)
+	if opts.GracePeriodSeconds != nil {
+		request = request.Param("gracePeriodSeconds", strconv.FormatInt(*opts.GracePeriodSeconds, 10))
+	}
+	result = &tenancyv1alpha1.Workspace{}
+	err = request.Do(ctx).Into(result)
+	return
+}
+
+// Restore cancels deletion of the workspace name
+func (c *workspaces) Restore(ctx context.Context, name string) (result *tenancyv1alpha1.Workspace, err error) {
+	result = &tenancyv1alpha1.Workspace{}
+	err = c.request(c.client.Post()).Name(name).SubResource("restore").Do(ctx).Into(result)
+	return
+}
+
+// Export returns an export of the workspace name
+func (c *workspaces) Export(ctx context.Context, name string) ([]byte, error) {
+	return c.request(c.client.Get()).Name(name).SubResource("export").DoRaw(ctx)
+}
+
+// ImportsGetter has a method to return an ImportInterface.
+type ImportsGetter interface {
+	Imports() ImportInterface
+}
+
+// ImportInterface has methods to import workspace exports into workspaces of
+// the user or an organization
+type ImportInterface interface {
+	Create(ctx context.Context, export []byte, opts ImportOptions) (*tenancyv1alpha1.WorkspaceImport, error)
+}
+
+// ImportOptions are options of imports
+type ImportOptions struct {
+	// Org is the organization to import into, the user if empty
+	Org string
+	// Workspace to import into, the exported one if empty
+	Workspace string
+	// ConflictPolicy handles objects existing in the workspace, Fail if
+	// empty
+	ConflictPolicy tenancyv1alpha1.ImportConflictPolicy
+}
+
+// imports implements ImportInterface
+type imports struct {
+	client rest.Interface
+}
+
+// newImports returns imports
+func newImports(c *Client) *imports {
+	return &imports{client: c.RESTClient()}
+}
+
+// Create imports export, creating its workspace if it does not exist, and
+// returns the imported objects
+func (c *imports) Create(ctx context.Context, export []byte, opts ImportOptions) (result *tenancyv1alpha1.WorkspaceImport, err error) {
+	request := c.client.Post().Resource("workspaces").SubResource("import").
+		SetHeader("Content-Type", ArchiveContentType).
+		Body(export)
+	if opts.Org != "" {
+		request = request.Param("org", opts.Org)
+	}
+	if opts.Workspace != "" {
+		request = request.Param("workspace", opts.Workspace)
+	}
+	if opts.ConflictPolicy != "" {
+		request = request.Param("conflictPolicy", string(opts.ConflictPolicy))
+	}
+	result = &tenancyv1alpha1.WorkspaceImport{}
+	err = request.Do(ctx).Into(result)
+	return
+}
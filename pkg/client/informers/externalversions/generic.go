@@ -77,10 +77,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Plugins().V1alpha1().Notifications().Informer()}, nil
 
 		// Group=tenancy.faros.sh, Version=v1alpha1
+	case tenancyv1alpha1.SchemeGroupVersion.WithResource("organizations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Tenancy().V1alpha1().Organizations().Informer()}, nil
+	case tenancyv1alpha1.SchemeGroupVersion.WithResource("teams"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Tenancy().V1alpha1().Teams().Informer()}, nil
 	case tenancyv1alpha1.SchemeGroupVersion.WithResource("users"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Tenancy().V1alpha1().Users().Informer()}, nil
 	case tenancyv1alpha1.SchemeGroupVersion.WithResource("workspaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Tenancy().V1alpha1().Workspaces().Informer()}, nil
+	case tenancyv1alpha1.SchemeGroupVersion.WithResource("workspacetemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Tenancy().V1alpha1().WorkspaceTemplates().Informer()}, nil
 
 	}
 
@@ -23,10 +23,16 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// Organizations returns a OrganizationInformer.
+	Organizations() OrganizationInformer
+	// Teams returns a TeamInformer.
+	Teams() TeamInformer
 	// Users returns a UserInformer.
 	Users() UserInformer
 	// Workspaces returns a WorkspaceInformer.
 	Workspaces() WorkspaceInformer
+	// WorkspaceTemplates returns a WorkspaceTemplateInformer.
+	WorkspaceTemplates() WorkspaceTemplateInformer
 }
 
 type version struct {
@@ -40,6 +46,16 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// Organizations returns a OrganizationInformer.
+func (v *version) Organizations() OrganizationInformer {
+	return &organizationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Teams returns a TeamInformer.
+func (v *version) Teams() TeamInformer {
+	return &teamInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Users returns a UserInformer.
 func (v *version) Users() UserInformer {
 	return &userInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -49,3 +65,8 @@ func (v *version) Users() UserInformer {
 func (v *version) Workspaces() WorkspaceInformer {
 	return &workspaceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// WorkspaceTemplates returns a WorkspaceTemplateInformer.
+func (v *version) WorkspaceTemplates() WorkspaceTemplateInformer {
+	return &workspaceTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
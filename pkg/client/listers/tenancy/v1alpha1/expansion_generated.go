@@ -17,6 +17,18 @@ limitations under the License.
 
 package v1alpha1
 
+// OrganizationListerExpansion allows custom methods to be added to
+// OrganizationLister.
+type OrganizationListerExpansion interface{}
+
+// TeamListerExpansion allows custom methods to be added to
+// TeamLister.
+type TeamListerExpansion interface{}
+
+// TeamNamespaceListerExpansion allows custom methods to be added to
+// TeamNamespaceLister.
+type TeamNamespaceListerExpansion interface{}
+
 // UserListerExpansion allows custom methods to be added to
 // UserLister.
 type UserListerExpansion interface{}
@@ -28,3 +40,7 @@ type WorkspaceListerExpansion interface{}
 // WorkspaceNamespaceListerExpansion allows custom methods to be added to
 // WorkspaceNamespaceLister.
 type WorkspaceNamespaceListerExpansion interface{}
+
+// WorkspaceTemplateListerExpansion allows custom methods to be added to
+// WorkspaceTemplateLister.
+type WorkspaceTemplateListerExpansion interface{}
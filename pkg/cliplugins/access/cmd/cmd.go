@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/access/plugin"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+)
+
+var (
+	forwardExample = `
+	# Forward local port 2222 to ssh on the agent device
+	%[1]s my-agent 2222:localhost:22
+
+	# Forward local port 8080 to a web UI reachable from the agent
+	%[1]s my-agent 8080:192.168.1.10:80
+`
+)
+
+// New provides a cobra command for access operations.
+func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Use:              "access",
+		Short:            "Access services reachable from agents",
+		SilenceUsage:     true,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	forwardOptions := plugin.NewForwardOptions(streams)
+	forwardCmd := &cobra.Command{
+		Use:               "forward <agent> <local-port>:<host>:<port>",
+		Short:             "Forward a local port to a target reachable from the agent",
+		Example:           fmt.Sprintf(forwardExample, "kubectl faros access forward"),
+		SilenceUsage:      true,
+		ValidArgsFunction: forwardOptions.ValidArgsFunction(base.CompletionAgents, 1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := forwardOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := forwardOptions.Validate(); err != nil {
+				return err
+			}
+
+			return forwardOptions.Run(c.Context())
+		},
+	}
+
+	forwardOptions.BindFlags(forwardCmd)
+	cmd.AddCommand(forwardCmd)
+
+	return cmd, nil
+}
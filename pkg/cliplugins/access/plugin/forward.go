@@ -0,0 +1,162 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	"github.com/faroshq/faros-hub/pkg/edge/tunnel"
+)
+
+// ForwardOptions contains options for forwarding local ports to targets
+// reachable from an agent
+type ForwardOptions struct {
+	*base.Options
+	// Agent is the name of the agent to forward through
+	Agent string
+	// Address is the local address to listen on
+	Address string
+	// LocalPort is the local port to listen on
+	LocalPort int
+	// Host is the host the agent dials
+	Host string
+	// Port is the port the agent dials
+	Port int
+}
+
+// NewForwardOptions returns a new ForwardOptions.
+func NewForwardOptions(streams genericclioptions.IOStreams) *ForwardOptions {
+	return &ForwardOptions{
+		Options: base.NewOptions(streams),
+		Address: "localhost",
+	}
+}
+
+// BindFlags binds fields ForwardOptions as command line flags to cmd's flagset.
+func (o *ForwardOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+
+	cmd.Flags().StringVar(&o.Address, "address", o.Address, "Local address to listen on")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *ForwardOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) != 2 {
+		return errors.New("agent and port mapping required, i.e. <agent> 2222:localhost:22")
+	}
+	o.Agent = args[0]
+
+	var err error
+	o.LocalPort, o.Host, o.Port, err = parsePortMapping(args[1])
+	return err
+}
+
+// Validate validates the ForwardOptions are complete and usable.
+func (o *ForwardOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Agent == "" {
+		errs = append(errs, errors.New("agent name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run listens on the local port and relays every connection to the target
+// through the hub and the agent tunnel
+func (o *ForwardOptions) Run(ctx context.Context) error {
+	target, err := o.AgentTarget(o.Agent)
+	if err != nil {
+		return err
+	}
+
+	l, err := net.Listen("tcp", net.JoinHostPort(o.Address, strconv.Itoa(o.LocalPort)))
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	fmt.Fprintf(o.Out, "Forwarding from %s -> %s through agent %s\n", l.Addr(), net.JoinHostPort(o.Host, strconv.Itoa(o.Port)), o.Agent)
+
+	query := url.Values{
+		"host": []string{o.Host},
+		"port": []string{strconv.Itoa(o.Port)},
+	}
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		go func() {
+			ws, err := target.DialWebsocket("portforward", query)
+			if err != nil {
+				fmt.Fprintf(o.ErrOut, "error: %v\n", err)
+				conn.Close()
+				return
+			}
+			fmt.Fprintf(o.Out, "Handling connection for %d\n", o.LocalPort)
+			tunnel.Pipe(conn, ws)
+		}()
+	}
+}
+
+// parsePortMapping parses <local-port>:<host>:<port>, or <local-port>:<port>
+// for localhost of the agent
+func parsePortMapping(mapping string) (localPort int, host string, port int, err error) {
+	parts := strings.Split(mapping, ":")
+	switch len(parts) {
+	case 2:
+		host = "localhost"
+	case 3:
+		host = parts[1]
+	default:
+		return 0, "", 0, fmt.Errorf("invalid port mapping %q, expected <local-port>:<host>:<port>", mapping)
+	}
+
+	localPort, err = parsePort(parts[0])
+	if err != nil {
+		return 0, "", 0, err
+	}
+	port, err = parsePort(parts[len(parts)-1])
+	if err != nil {
+		return 0, "", 0, err
+	}
+	if host == "" {
+		return 0, "", 0, fmt.Errorf("invalid port mapping %q, host is empty", mapping)
+	}
+	return localPort, host, port, nil
+}
+
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid port %q", s)
+	}
+	return port, nil
+}
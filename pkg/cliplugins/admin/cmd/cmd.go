@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/admin/plugin"
+)
+
+var (
+	offboardExample = `
+	# Offboard a user, transferring their workspaces to their first other active member
+	%[1]s jane@example.com
+
+	# Offboard a user, transferring all their workspaces to another user
+	%[1]s jane@example.com --transfer-to john@example.com
+`
+
+	getWorkspacesExample = `
+	# List workspaces of all tenants a user is a member of
+	%[1]s --search jane@example.com
+
+	# List workspaces owned by a user
+	%[1]s --owner jane@example.com
+`
+
+	reconcileWorkspaceExample = `
+	# Force a reconcile of a workspace, given as <namespace>/<name>
+	%[1]s org-acme/fleet
+`
+)
+
+// New provides a cobra command for hub administration.
+func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Use:              "admin",
+		Short:            "Administer the hub, for hub administrators",
+		SilenceUsage:     true,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	usersCmd := &cobra.Command{
+		Aliases:          []string{"user"},
+		Use:              "users",
+		Short:            "Manage users",
+		SilenceUsage:     true,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	getUsersOptions := plugin.NewGetUsersOptions(streams)
+	getUsersCmd := &cobra.Command{
+		Use:          "get [email]",
+		Short:        "Get users",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := getUsersOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := getUsersOptions.Validate(); err != nil {
+				return err
+			}
+
+			return getUsersOptions.Run(c.Context())
+		},
+	}
+	getUsersOptions.BindFlags(getUsersCmd)
+	usersCmd.AddCommand(getUsersCmd)
+
+	for _, action := range []struct {
+		action  hub.UserAction
+		short   string
+		example string
+	}{
+		{action: hub.UserActionDisable, short: "Block login of a user and remove them from all workspaces"},
+		{action: hub.UserActionEnable, short: "Enable a disabled user"},
+		{action: hub.UserActionOffboard, short: "Disable and delete a user, transferring their workspaces to other members", example: offboardExample},
+		{action: hub.UserActionReconcile, short: "Force a reconcile of a user"},
+	} {
+		options := plugin.NewUserActionOptions(streams, action.action)
+		actionCmd := &cobra.Command{
+			Use:          string(action.action) + " <email>",
+			Short:        action.short,
+			SilenceUsage: true,
+			RunE: func(c *cobra.Command, args []string) error {
+				if err := options.Complete(args); err != nil {
+					return err
+				}
+
+				if err := options.Validate(); err != nil {
+					return err
+				}
+
+				return options.Run(c.Context())
+			},
+		}
+		if action.example != "" {
+			actionCmd.Example = fmt.Sprintf(action.example, "kubectl faros admin users "+string(action.action))
+		}
+		options.BindFlags(actionCmd)
+		usersCmd.AddCommand(actionCmd)
+	}
+
+	cmd.AddCommand(usersCmd)
+
+	workspacesCmd := &cobra.Command{
+		Aliases:          []string{"workspace", "ws"},
+		Use:              "workspaces",
+		Short:            "Manage workspaces of all tenants",
+		SilenceUsage:     true,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	getWorkspacesOptions := plugin.NewGetWorkspacesOptions(streams)
+	getWorkspacesCmd := &cobra.Command{
+		Use:          "get",
+		Short:        "Get workspaces of all tenants",
+		Example:      fmt.Sprintf(getWorkspacesExample, "kubectl faros admin workspaces get"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := getWorkspacesOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := getWorkspacesOptions.Validate(); err != nil {
+				return err
+			}
+
+			return getWorkspacesOptions.Run(c.Context())
+		},
+	}
+	getWorkspacesOptions.BindFlags(getWorkspacesCmd)
+	workspacesCmd.AddCommand(getWorkspacesCmd)
+
+	reconcileWorkspaceOptions := plugin.NewReconcileWorkspaceOptions(streams)
+	reconcileWorkspaceCmd := &cobra.Command{
+		Use:          "reconcile <namespace>/<workspace>",
+		Short:        "Force a reconcile of a workspace",
+		Example:      fmt.Sprintf(reconcileWorkspaceExample, "kubectl faros admin workspaces reconcile"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := reconcileWorkspaceOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := reconcileWorkspaceOptions.Validate(); err != nil {
+				return err
+			}
+
+			return reconcileWorkspaceOptions.Run(c.Context())
+		},
+	}
+	reconcileWorkspaceOptions.BindFlags(reconcileWorkspaceCmd)
+	workspacesCmd.AddCommand(reconcileWorkspaceCmd)
+
+	cmd.AddCommand(workspacesCmd)
+
+	agentsCmd := &cobra.Command{
+		Aliases:          []string{"agent"},
+		Use:              "agents",
+		Short:            "Manage agents of all workspaces",
+		SilenceUsage:     true,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	getAgentsOptions := plugin.NewGetAgentsOptions(streams)
+	getAgentsCmd := &cobra.Command{
+		Use:          "get",
+		Short:        "Get agents of all workspaces",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := getAgentsOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := getAgentsOptions.Validate(); err != nil {
+				return err
+			}
+
+			return getAgentsOptions.Run(c.Context())
+		},
+	}
+	getAgentsOptions.BindFlags(getAgentsCmd)
+	agentsCmd.AddCommand(getAgentsCmd)
+
+	cmd.AddCommand(agentsCmd)
+
+	getErrorsOptions := plugin.NewGetErrorsOptions(streams)
+	errorsCmd := &cobra.Command{
+		Use:          "errors",
+		Short:        "Get users, workspaces and organizations controllers failed to reconcile",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := getErrorsOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := getErrorsOptions.Validate(); err != nil {
+				return err
+			}
+
+			return getErrorsOptions.Run(c.Context())
+		},
+	}
+	getErrorsOptions.BindFlags(errorsCmd)
+	cmd.AddCommand(errorsCmd)
+
+	return cmd, nil
+}
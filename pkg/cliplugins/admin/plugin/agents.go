@@ -0,0 +1,86 @@
+package plugin
+
+import (
+	"context"
+
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+// GetAgentsOptions contains options for listing agents of all workspaces
+type GetAgentsOptions struct {
+	*base.Options
+
+	// Search filters agents by workspace, namespace or name
+	Search string
+	// Workspace is the <namespace>/<name> of the workspace to list agents of
+	Workspace string
+}
+
+// NewGetAgentsOptions returns a new GetAgentsOptions.
+func NewGetAgentsOptions(streams genericclioptions.IOStreams) *GetAgentsOptions {
+	return &GetAgentsOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields GetAgentsOptions as command line flags to cmd's flagset.
+func (o *GetAgentsOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.Options.BindPrintFlags(cmd)
+	cmd.Flags().StringVar(&o.Search, "search", o.Search, "Only list agents whose workspace, namespace or name contains the text")
+	cmd.Flags().StringVar(&o.Workspace, "workspace", o.Workspace, "Only list agents of the workspace, given as <namespace>/<name>")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *GetAgentsOptions) Complete(args []string) error {
+	return o.Options.Complete()
+}
+
+// Validate validates the GetAgentsOptions are complete and usable.
+func (o *GetAgentsOptions) Validate() error {
+	return o.Options.Validate()
+}
+
+// Run gets agents of all workspaces from the hub api
+func (o *GetAgentsOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	agents, err := client.Admin().ListAgents(ctx, hub.AdminListOptions{Search: o.Search, Workspace: o.Workspace})
+	if err != nil {
+		return err
+	}
+	for i := range agents.Items {
+		agents.Items[i].ManagedFields = nil
+	}
+	return o.Printer("agent.edge.faros.sh", agentColumns).Print(agents)
+}
+
+// agentColumns are the columns of agents of all workspaces printed as table
+var agentColumns = []utilprint.Column{
+	{Name: "WORKSPACE", Value: func(obj runtime.Object) string {
+		return obj.(*edgev1alpha1.Agent).Annotations[tenancyv1alpha1.AgentWorkspaceAnnotation]
+	}},
+	{Name: "NAMESPACE", Value: func(obj runtime.Object) string {
+		return obj.(*edgev1alpha1.Agent).Namespace
+	}},
+	utilprint.NameColumn,
+	utilprint.ConditionColumn("READY", conditionsv1alpha1.ReadyCondition),
+	utilprint.ConditionColumn("TUNNEL", edgev1alpha1.AgentTunnelConnected),
+	{Name: "VERSION", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(obj.(*edgev1alpha1.Agent).Status.Version)
+	}},
+	utilprint.AgeColumn,
+	utilprint.Wide(utilprint.ConditionMessageColumn("MESSAGE", conditionsv1alpha1.ReadyCondition)),
+}
@@ -0,0 +1,73 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+// GetErrorsOptions contains options for listing objects controllers failed to
+// reconcile
+type GetErrorsOptions struct {
+	*base.Options
+}
+
+// NewGetErrorsOptions returns a new GetErrorsOptions.
+func NewGetErrorsOptions(streams genericclioptions.IOStreams) *GetErrorsOptions {
+	return &GetErrorsOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields GetErrorsOptions as command line flags to cmd's flagset.
+func (o *GetErrorsOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.Options.BindPrintFlags(cmd)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *GetErrorsOptions) Complete(args []string) error {
+	return o.Options.Complete()
+}
+
+// Validate validates the GetErrorsOptions are complete and usable.
+func (o *GetErrorsOptions) Validate() error {
+	return o.Options.Validate()
+}
+
+// Run gets errors of controllers from the hub api
+func (o *GetErrorsOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	errs, err := client.Admin().ListErrors(ctx)
+	if err != nil {
+		return err
+	}
+	return o.Printer("reconcileerror.tenancy.faros.sh", errorColumns).Print(errs)
+}
+
+// errorColumns are the columns of errors of controllers printed as table
+var errorColumns = []utilprint.Column{
+	{Name: "RESOURCE", Value: func(obj runtime.Object) string {
+		return obj.(*tenancyv1alpha1.ReconcileError).Resource
+	}},
+	{Name: "NAMESPACE", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(obj.(*tenancyv1alpha1.ReconcileError).Namespace)
+	}},
+	utilprint.NameColumn,
+	{Name: "SINCE", Value: func(obj runtime.Object) string {
+		return utilprint.Since(obj.(*tenancyv1alpha1.ReconcileError).LastTransitionTime.Time).String()
+	}},
+	{Name: "MESSAGE", Value: func(obj runtime.Object) string {
+		return obj.(*tenancyv1alpha1.ReconcileError).Message
+	}},
+}
@@ -0,0 +1,185 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+// GetUsersOptions contains options for listing users of the hub
+type GetUsersOptions struct {
+	*base.Options
+
+	// Email of the user to get, all users if empty
+	Email string
+	// Search filters users by name, email or display name
+	Search string
+}
+
+// NewGetUsersOptions returns a new GetUsersOptions.
+func NewGetUsersOptions(streams genericclioptions.IOStreams) *GetUsersOptions {
+	return &GetUsersOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields GetUsersOptions as command line flags to cmd's flagset.
+func (o *GetUsersOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.Options.BindPrintFlags(cmd)
+	cmd.Flags().StringVar(&o.Search, "search", o.Search, "Only list users whose name, email or display name contains the text")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *GetUsersOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Email == "" && len(args) > 0 {
+		o.Email = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the GetUsersOptions are complete and usable.
+func (o *GetUsersOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run gets users from the hub api
+func (o *GetUsersOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	printer := o.Printer("user.tenancy.faros.sh", userColumns)
+	if o.Email != "" {
+		user, err := client.Admin().GetUser(ctx, o.Email)
+		if err != nil {
+			return err
+		}
+		user.ManagedFields = nil
+		return printer.Print(user)
+	}
+
+	users, err := client.Admin().ListUsers(ctx, hub.AdminListOptions{Search: o.Search})
+	if err != nil {
+		return err
+	}
+	for i := range users.Items {
+		users.Items[i].ManagedFields = nil
+	}
+	return printer.Print(users)
+}
+
+// UserActionOptions contains options for disabling, enabling, offboarding
+// and reconciling users
+type UserActionOptions struct {
+	*base.Options
+
+	Action hub.UserAction
+	Email  string
+	// TransferTo is the email of the user workspaces are transferred to when
+	// offboarding, their first other active member if empty
+	TransferTo string
+}
+
+// NewUserActionOptions returns a new UserActionOptions.
+func NewUserActionOptions(streams genericclioptions.IOStreams, action hub.UserAction) *UserActionOptions {
+	return &UserActionOptions{
+		Options: base.NewOptions(streams),
+		Action:  action,
+	}
+}
+
+// BindFlags binds fields UserActionOptions as command line flags to cmd's flagset.
+func (o *UserActionOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+
+	if o.Action == hub.UserActionOffboard {
+		cmd.Flags().StringVar(&o.TransferTo, "transfer-to", o.TransferTo, "Email of the user to transfer workspaces to. Workspaces are transferred to their first other active member by default.")
+	}
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *UserActionOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Email == "" && len(args) > 0 {
+		o.Email = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the UserActionOptions are complete and usable.
+func (o *UserActionOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Email == "" {
+		errs = append(errs, errors.New("user email is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run applies the action to the user through the hub api
+func (o *UserActionOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	user, err := client.Admin().UserAction(ctx, o.Email, o.Action, hub.UserActionOptions{TransferTo: o.TransferTo})
+	if err != nil {
+		return err
+	}
+
+	if o.Action == hub.UserActionReconcile {
+		fmt.Fprintf(o.Out, "User %s reconcile requested\n", user.Spec.Email)
+		return nil
+	}
+	fmt.Fprintf(o.Out, "User %s %sd successfully\n", user.Spec.Email, o.Action)
+	return nil
+}
+
+// userColumns are the columns of users printed as table
+var userColumns = []utilprint.Column{
+	utilprint.NameColumn,
+	{Name: "EMAIL", Value: func(obj runtime.Object) string {
+		return obj.(*tenancyv1alpha1.User).Spec.Email
+	}},
+	{Name: "DISABLED", Value: func(obj runtime.Object) string {
+		return strconv.FormatBool(obj.(*tenancyv1alpha1.User).Spec.Disabled)
+	}},
+	utilprint.AgeColumn,
+	utilprint.Wide(utilprint.Column{Name: "TRANSFER TO", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(obj.(*tenancyv1alpha1.User).Spec.TransferTo)
+	}}),
+}
@@ -0,0 +1,155 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+// GetWorkspacesOptions contains options for listing workspaces of all tenants
+type GetWorkspacesOptions struct {
+	*base.Options
+
+	// Search filters workspaces by namespace, name, description or members
+	Search string
+	// Owner is the email of the user to list workspaces of
+	Owner string
+}
+
+// NewGetWorkspacesOptions returns a new GetWorkspacesOptions.
+func NewGetWorkspacesOptions(streams genericclioptions.IOStreams) *GetWorkspacesOptions {
+	return &GetWorkspacesOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields GetWorkspacesOptions as command line flags to cmd's flagset.
+func (o *GetWorkspacesOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.Options.BindPrintFlags(cmd)
+	cmd.Flags().StringVar(&o.Search, "search", o.Search, "Only list workspaces whose namespace, name, description or members contain the text")
+	cmd.Flags().StringVar(&o.Owner, "owner", o.Owner, "Only list workspaces owned by the user with this email")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *GetWorkspacesOptions) Complete(args []string) error {
+	return o.Options.Complete()
+}
+
+// Validate validates the GetWorkspacesOptions are complete and usable.
+func (o *GetWorkspacesOptions) Validate() error {
+	return o.Options.Validate()
+}
+
+// Run gets workspaces of all tenants from the hub api
+func (o *GetWorkspacesOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	workspaces, err := client.Admin().ListWorkspaces(ctx, hub.AdminListOptions{Search: o.Search, Owner: o.Owner})
+	if err != nil {
+		return err
+	}
+	for i := range workspaces.Items {
+		workspaces.Items[i].ManagedFields = nil
+	}
+	return o.Printer("workspace.tenancy.faros.sh", workspaceColumns).Print(workspaces)
+}
+
+// ReconcileWorkspaceOptions contains options for forcing a reconcile of a
+// workspace
+type ReconcileWorkspaceOptions struct {
+	*base.Options
+
+	// Namespace and Name of the workspace, given as <namespace>/<name>
+	Namespace string
+	Name      string
+}
+
+// NewReconcileWorkspaceOptions returns a new ReconcileWorkspaceOptions.
+func NewReconcileWorkspaceOptions(streams genericclioptions.IOStreams) *ReconcileWorkspaceOptions {
+	return &ReconcileWorkspaceOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields ReconcileWorkspaceOptions as command line flags to cmd's flagset.
+func (o *ReconcileWorkspaceOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *ReconcileWorkspaceOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Namespace, o.Name, _ = strings.Cut(args[0], "/")
+	}
+
+	return nil
+}
+
+// Validate validates the ReconcileWorkspaceOptions are complete and usable.
+func (o *ReconcileWorkspaceOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Namespace == "" || o.Name == "" {
+		errs = append(errs, errors.New("workspace is required as <namespace>/<name>"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run forces a reconcile of the workspace through the hub api
+func (o *ReconcileWorkspaceOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	workspace, err := client.Admin().ReconcileWorkspace(ctx, o.Namespace, o.Name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Workspace %s/%s reconcile requested\n", workspace.Namespace, workspace.Name)
+	return nil
+}
+
+// workspaceColumns are the columns of workspaces of all tenants printed as
+// table
+var workspaceColumns = []utilprint.Column{
+	{Name: "NAMESPACE", Value: func(obj runtime.Object) string {
+		return obj.(*tenancyv1alpha1.Workspace).Namespace
+	}},
+	utilprint.NameColumn,
+	{Name: "PHASE", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(string(obj.(*tenancyv1alpha1.Workspace).Status.Phase))
+	}},
+	utilprint.ConditionColumn("READY", conditionsv1alpha1.ReadyCondition),
+	{Name: "MEMBERS", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(strings.Join(obj.(*tenancyv1alpha1.Workspace).Spec.Members, ","))
+	}},
+	utilprint.AgeColumn,
+	utilprint.Wide(utilprint.ConditionMessageColumn("MESSAGE", conditionsv1alpha1.ReadyCondition)),
+}
@@ -7,6 +7,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/faroshq/faros-hub/pkg/cliplugins/agent/plugin"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
 )
 
 var (
@@ -15,6 +16,28 @@ var (
 	%[1]s <registration-name> -o agent.kubeconfig
 	KUBECONFIG=<pcluster-config> <agent_image>
 `
+
+	execExample = `
+	# Start an interactive shell on the agent device
+	%[1]s my-agent
+
+	# Run a command on the agent device
+	%[1]s my-agent -- uname -a
+
+	# Run an interactive command in a terminal
+	%[1]s my-agent -it -- top
+`
+
+	pluginsExample = `
+	# Add a plugin to an agent, configured from a file
+	%[1]s add my-agent access --version v0.1.0 -f access.yaml
+
+	# Replace the config of a plugin
+	%[1]s set-config my-agent access -f access.yaml
+
+	# Remove a plugin from an agent
+	%[1]s remove my-agent access
+`
 )
 
 // New provides a cobra command for workload operations.
@@ -56,7 +79,184 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 	}
 
 	generateOptions.BindFlags(generateAgentCmd)
+	if err := generateAgentCmd.RegisterFlagCompletionFunc("registration", generateOptions.FlagCompletionFunc(base.CompletionRegistrations)); err != nil {
+		return nil, err
+	}
 	cmd.AddCommand(generateAgentCmd)
 
+	execOptions := plugin.NewExecOptions(streams)
+	execCmd := &cobra.Command{
+		Use:               "exec <agent> [-- <command> [args...]]",
+		Short:             "Execute a command, or start a shell, on the agent device",
+		Example:           fmt.Sprintf(execExample, "kubectl faros agent exec"),
+		SilenceUsage:      true,
+		ValidArgsFunction: execOptions.ValidArgsFunction(base.CompletionAgents, 1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := execOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := execOptions.Validate(); err != nil {
+				return err
+			}
+
+			return execOptions.Run(c.Context())
+		},
+	}
+
+	execOptions.BindFlags(execCmd)
+	cmd.AddCommand(execCmd)
+
+	listOptions := plugin.NewGetOptions(streams)
+	listCmd := &cobra.Command{
+		Aliases:      []string{"ls"},
+		Use:          "list",
+		Short:        "List agents in the current workspace",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return c.Help()
+			}
+
+			if err := listOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := listOptions.Validate(); err != nil {
+				return err
+			}
+
+			return listOptions.Run(c.Context())
+		},
+	}
+
+	listOptions.BindFlags(listCmd)
+	cmd.AddCommand(listCmd)
+
+	getOptions := plugin.NewGetOptions(streams)
+	getCmd := &cobra.Command{
+		Use:               "get <agent>",
+		Short:             "Get an agent, or list agents if no name is given",
+		SilenceUsage:      true,
+		ValidArgsFunction: getOptions.ValidArgsFunction(base.CompletionAgents, 1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				return c.Help()
+			}
+
+			if err := getOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := getOptions.Validate(); err != nil {
+				return err
+			}
+
+			return getOptions.Run(c.Context())
+		},
+	}
+
+	getOptions.BindFlags(getCmd)
+	cmd.AddCommand(getCmd)
+
+	describeOptions := plugin.NewDescribeOptions(streams)
+	describeCmd := &cobra.Command{
+		Use:               "describe <agent>",
+		Short:             "Show details of an agent, its conditions and plugins",
+		SilenceUsage:      true,
+		ValidArgsFunction: describeOptions.ValidArgsFunction(base.CompletionAgents, 1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return c.Help()
+			}
+
+			if err := describeOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := describeOptions.Validate(); err != nil {
+				return err
+			}
+
+			return describeOptions.Run(c.Context())
+		},
+	}
+
+	describeOptions.BindFlags(describeCmd)
+	cmd.AddCommand(describeCmd)
+
+	deleteOptions := plugin.NewDeleteOptions(streams)
+	deleteCmd := &cobra.Command{
+		Use:               "delete <agent>...",
+		Short:             "Delete agents",
+		SilenceUsage:      true,
+		ValidArgsFunction: deleteOptions.ValidArgsFunction(base.CompletionAgents, 0),
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return c.Help()
+			}
+
+			if err := deleteOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := deleteOptions.Validate(); err != nil {
+				return err
+			}
+
+			return deleteOptions.Run(c.Context())
+		},
+	}
+
+	deleteOptions.BindFlags(deleteCmd)
+	cmd.AddCommand(deleteCmd)
+
+	pluginsCmd := &cobra.Command{
+		Use:              "plugins",
+		Short:            "Manage plugins of agents",
+		Example:          fmt.Sprintf(pluginsExample, "kubectl faros agent plugins"),
+		SilenceUsage:     true,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	for _, action := range []struct {
+		action plugin.PluginsAction
+		short  string
+	}{
+		{plugin.PluginsActionAdd, "Add a plugin to an agent"},
+		{plugin.PluginsActionRemove, "Remove a plugin from an agent"},
+		{plugin.PluginsActionSetConfig, "Replace the config of a plugin of an agent"},
+	} {
+		pluginsOptions := plugin.NewPluginsOptions(streams, action.action)
+		actionCmd := &cobra.Command{
+			Use:               string(action.action) + " <agent> <plugin>",
+			Short:             action.short,
+			SilenceUsage:      true,
+			ValidArgsFunction: pluginsOptions.ValidArgsFunction(base.CompletionAgents, 1),
+			RunE: func(c *cobra.Command, args []string) error {
+				if len(args) != 2 {
+					return c.Help()
+				}
+
+				if err := pluginsOptions.Complete(args); err != nil {
+					return err
+				}
+
+				if err := pluginsOptions.Validate(); err != nil {
+					return err
+				}
+
+				return pluginsOptions.Run(c.Context())
+			},
+		}
+
+		pluginsOptions.BindFlags(actionCmd)
+		pluginsCmd.AddCommand(actionCmd)
+	}
+	cmd.AddCommand(pluginsCmd)
+
 	return cmd, nil
 }
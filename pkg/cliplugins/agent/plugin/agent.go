@@ -1,25 +1,20 @@
 package plugin
 
 import (
-	"bytes"
 	"context"
-	"embed"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"os"
-	"text/template"
-	"time"
+	"path/filepath"
+	"strings"
 
-	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
-	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
 	"github.com/kcp-dev/kcp/pkg/cliplugins/helpers"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -29,9 +24,6 @@ import (
 	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
 )
 
-//go:embed *.yaml
-var embeddedResources embed.FS
-
 // GenerateOptions contains options for configuring a Agent and its corresponding process.
 type GenerateOptions struct {
 	*base.Options
@@ -43,12 +35,23 @@ type GenerateOptions struct {
 	AgentName string
 	// Namespace name
 	Namespace string
+	// Target is the kind of install the agent is rendered for
+	Target string
+	// Image of the agent, for container targets
+	Image string
+	// BinaryURL the script target downloads the agent binary from
+	BinaryURL string
+	// TunnelURL is the hub tunnels endpoint the agent connects to. Tunnel is
+	// disabled if empty.
+	TunnelURL string
 }
 
 // NewGenerateOptions returns a new GenerateOptions.
 func NewGenerateOptions(streams genericclioptions.IOStreams) *GenerateOptions {
 	return &GenerateOptions{
 		Options: base.NewOptions(streams),
+		Target:  TargetKubeconfig,
+		Image:   defaultAgentImage,
 	}
 }
 
@@ -56,9 +59,13 @@ func NewGenerateOptions(streams genericclioptions.IOStreams) *GenerateOptions {
 func (o *GenerateOptions) BindFlags(cmd *cobra.Command) {
 	o.Options.BindFlags(cmd)
 
-	cmd.Flags().StringVarP(&o.OutputFile, "file", "f", o.OutputFile, "The manifest file to be created and applied to the physical cluster. Use - for stdout.")
+	cmd.Flags().StringVarP(&o.OutputFile, "file", "f", o.OutputFile, "The file to be created, or directory for targets rendering multiple files. Use - for stdout.")
 	cmd.Flags().StringVarP(&o.RegistrationName, "registration", "r", o.RegistrationName, "Registration name to be used for agent.")
 	cmd.Flags().StringVarP(&o.Namespace, "namespace", "n", "default", "Namespace name")
+	cmd.Flags().StringVar(&o.Target, "target", o.Target, fmt.Sprintf("Install target to render, one of %s", strings.Join(targetNames(), "|")))
+	cmd.Flags().StringVar(&o.Image, "image", o.Image, "Agent image, for kubernetes and docker-compose targets")
+	cmd.Flags().StringVar(&o.BinaryURL, "binary-url", o.BinaryURL, "URL the script target downloads the agent binary from. The binary must be installed if empty.")
+	cmd.Flags().StringVar(&o.TunnelURL, "tunnel-url", o.TunnelURL, "Hub tunnels endpoint the agent connects to, i.e. https://hub.faros.sh/faros.sh/tunnels")
 
 }
 
@@ -82,7 +89,13 @@ func (o *GenerateOptions) Validate() error {
 	}
 
 	if o.OutputFile == "" {
-		errs = append(errs, errors.New("--output-file is required"))
+		errs = append(errs, errors.New("--file is required"))
+	}
+
+	if target, ok := targets[o.Target]; !ok {
+		errs = append(errs, fmt.Errorf("unknown target %q, expected one of %s", o.Target, strings.Join(targetNames(), "|")))
+	} else if len(target) > 1 && o.OutputFile == "-" {
+		errs = append(errs, fmt.Errorf("target %s renders multiple files, --file must be a directory", o.Target))
 	}
 
 	return utilerrors.NewAggregate(errs)
@@ -96,17 +109,6 @@ func (o *GenerateOptions) Run(ctx context.Context) error {
 		return err
 	}
 
-	var outputFile *os.File
-	if o.OutputFile == "-" {
-		outputFile = os.Stdout
-	} else {
-		outputFile, err = os.Create(o.OutputFile)
-		if err != nil {
-			return err
-		}
-		defer outputFile.Close()
-	}
-
 	token, err := o.enableAgentToRegister(ctx, config)
 	if err != nil {
 		return err
@@ -129,18 +131,45 @@ func (o *GenerateOptions) Run(ctx context.Context) error {
 		Token:                 token,
 		LogicalCluster:        currentClusterName.String(),
 		Namespace:             o.Namespace,
+		Image:                 o.Image,
+		BinaryURL:             o.BinaryURL,
+		TunnelURL:             o.TunnelURL,
 	}
 
-	resources, err := renderAgentResources(input)
+	files, err := renderTarget(o.Target, input)
 	if err != nil {
 		return err
 	}
 
-	_, err = outputFile.Write(resources)
-	if o.OutputFile != "-" {
-		fmt.Fprintf(o.Out, "\nWrote agent config to %s Use\n", o.OutputFile)
+	return o.writeFiles(files)
+}
+
+// writeFiles writes a single rendered file to the output file, or stdout, and
+// multiple files into the output directory
+func (o *GenerateOptions) writeFiles(files []renderedFile) error {
+	if len(files) == 1 {
+		if o.OutputFile == "-" {
+			_, err := o.Out.Write(files[0].Content)
+			return err
+		}
+		if err := os.WriteFile(o.OutputFile, files[0].Content, files[0].Mode); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "\nWrote agent %s config to %s\n", o.Target, o.OutputFile)
+		return nil
+	}
+
+	if err := os.MkdirAll(o.OutputFile, 0o755); err != nil {
+		return err
 	}
-	return err
+	for _, file := range files {
+		path := filepath.Join(o.OutputFile, file.Name)
+		if err := os.WriteFile(path, file.Content, file.Mode); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "Wrote %s\n", path)
+	}
+	return nil
 }
 
 // enableAgentToRegister gets individual kubeconfig for registration object
@@ -165,90 +194,46 @@ func (o *GenerateOptions) enableAgentToRegister(ctx context.Context, config *res
 		return "", fmt.Errorf("failed to create namespace: %w", err)
 	}
 
-	registrationName := o.RegistrationName
-	if registrationName == "" {
-		registrationName = o.AgentName
-	}
-
-	template := edgevalpha1.Registration{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      registrationName,
-			Namespace: o.Namespace,
-		},
-	}
-
-	registration, err := farosClient.EdgeV1alpha1().Registrations(o.Namespace).Get(ctx, template.Name, metav1.GetOptions{})
-	switch {
-	case apierrors.IsNotFound(err):
-		fmt.Fprintf(o.Out, "Creating registration %s\n", registration.Name)
-		registration, err = farosClient.EdgeV1alpha1().Registrations(o.Namespace).Create(ctx, &template, metav1.CreateOptions{})
-		if err != nil && !apierrors.IsAlreadyExists(err) {
-			return "", fmt.Errorf("failed to create registration %q: %w", registration.Name, err)
+	// an explicitly referenced registration must exist, see kubectl faros registration create
+	if o.RegistrationName != "" {
+		registration, err := farosClient.EdgeV1alpha1().Registrations(o.Namespace).Get(ctx, o.RegistrationName, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			return "", fmt.Errorf("registration %[1]s not found, create it with 'kubectl faros registration create %[1]s'", o.RegistrationName)
+		case err != nil:
+			return "", fmt.Errorf("failed to get registration %s: %w", o.RegistrationName, err)
+		case registration.Status.Token != "":
+			return registration.Status.Token, nil
 		}
-	case err == nil:
-		return registration.Status.Token, nil
-	default:
-		return "", fmt.Errorf("failed to create the ClusterRole %s", err)
-	}
-
-	// wait for registration to be ready
-	fmt.Fprintf(o.Out, "Waiting for registration %s to be ready\n", registration.Name)
-	err = wait.PollImmediateWithContext(ctx, 100*time.Millisecond, 20*time.Second, func(ctx context.Context) (bool, error) {
-		registration, err = farosClient.EdgeV1alpha1().Registrations(o.Namespace).Get(ctx, registration.Name, metav1.GetOptions{})
-		if err != nil {
-			fmt.Fprintf(o.ErrOut, "failed to retrieve Registration: %v", err)
-			return false, nil
+	} else {
+		template := edgevalpha1.Registration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      o.AgentName,
+				Namespace: o.Namespace,
+			},
 		}
-		return conditions.IsTrue(registration, conditionsv1alpha1.ReadyCondition) && registration.Status.Token != "", nil
-	})
-	return registration.Status.Token, err
-
-}
-
-// templateInput represents the external input required to render the resources to
-// deploy the syncer to a pcluster.
-type templateInput struct {
-	// AgentName is the name of the agent
-	AgentName string
-	// ServerURL is the logical cluster url the syncer configuration will use
-	ServerURL string
-	// CAData holds the PEM-encoded bytes of the ca certificate(s) a syncer will use to validate
-	// kcp's serving certificate
-	CAData string
-	// InsecureSkipTLSVerify controls whether a syncer verifies the server's certificate chain and host name
-	InsecureSkipTLSVerify bool
-	// Token is the service account token used to authenticate a syncer for access to a workspace
-	Token string
-	// Namespace is the name of the syncer namespace on the pcluster
-	Namespace string
-	// LogicalCluster is the qualified kcp logical cluster name the syncer will sync from
-	LogicalCluster string
-}
 
-// templateArgs represents the full set of arguments required to render the resources
-// required to deploy the syncer.
-type templateArgs struct {
-	templateInput
-}
-
-// renderAgentResources renders the resources required to deploy an agent
-func renderAgentResources(input templateInput) ([]byte, error) {
-	tmplArgs := templateArgs{
-		templateInput: input,
+		registration, err := farosClient.EdgeV1alpha1().Registrations(o.Namespace).Get(ctx, template.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			fmt.Fprintf(o.Out, "Creating registration %s\n", template.Name)
+			_, err = farosClient.EdgeV1alpha1().Registrations(o.Namespace).Create(ctx, &template, metav1.CreateOptions{})
+			if err != nil && !apierrors.IsAlreadyExists(err) {
+				return "", fmt.Errorf("failed to create registration %q: %w", template.Name, err)
+			}
+		case err != nil:
+			return "", fmt.Errorf("failed to get registration %s: %w", template.Name, err)
+		case registration.Status.Token != "":
+			return registration.Status.Token, nil
+		}
+		o.RegistrationName = template.Name
 	}
 
-	agentTemplate, err := embeddedResources.ReadFile("agent.yaml")
-	if err != nil {
-		return nil, err
-	}
-	tmpl, err := template.New("agentTemplate").Parse(string(agentTemplate))
-	if err != nil {
-		return nil, err
-	}
-	buffer := bytes.NewBuffer([]byte{})
-	err = tmpl.Execute(buffer, tmplArgs)
+	// wait for registration to be ready
+	fmt.Fprintf(o.Out, "Waiting for registration %s to be ready\n", o.RegistrationName)
+	registration, err := base.WaitForRegistrationToken(ctx, farosClient, o.Namespace, o.RegistrationName, "")
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	return buffer.Bytes(), nil
+	return registration.Status.Token, nil
 }
@@ -0,0 +1,109 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+// DescribeOptions contains options for describing an agent
+type DescribeOptions struct {
+	*base.Options
+	// Name of the agent to describe
+	Name string
+}
+
+// NewDescribeOptions returns a new DescribeOptions.
+func NewDescribeOptions(streams genericclioptions.IOStreams) *DescribeOptions {
+	return &DescribeOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields DescribeOptions as command line flags to cmd's flagset.
+func (o *DescribeOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *DescribeOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the DescribeOptions are complete and usable.
+func (o *DescribeOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("agent name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run prints the details of the agent, its conditions and plugins
+func (o *DescribeOptions) Run(ctx context.Context) error {
+	farosClient, namespace, err := o.FarosClient()
+	if err != nil {
+		return err
+	}
+
+	agent, err := farosClient.EdgeV1alpha1().Agents(namespace).Get(ctx, o.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(o.Out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", agent.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", agent.Namespace)
+	fmt.Fprintf(w, "Created:\t%s (%s ago)\n", agent.CreationTimestamp.Format("2006-01-02T15:04:05Z07:00"), utilprint.Since(agent.CreationTimestamp.Time))
+	fmt.Fprintf(w, "Version:\t%s\n", utilprint.ValueOrNone(agent.Status.Version))
+	fmt.Fprintf(w, "Last Heartbeat:\t%s\n", heartbeat(agent))
+
+	fmt.Fprintf(w, "Conditions:\n")
+	if len(agent.Status.Conditions) == 0 {
+		fmt.Fprintf(w, "  <none>\n")
+	} else {
+		fmt.Fprintf(w, "  TYPE\tSTATUS\tREASON\tAGE\tMESSAGE\n")
+		for _, c := range agent.Status.Conditions {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", c.Type, c.Status, c.Reason, utilprint.Since(c.LastTransitionTime.Time), c.Message)
+		}
+	}
+
+	fmt.Fprintf(w, "Plugins:\n")
+	if len(agent.Spec.Plugins) == 0 {
+		fmt.Fprintf(w, "  <none>\n")
+	} else {
+		fmt.Fprintf(w, "  NAME\tVERSION\tCONFIG\n")
+		for _, plugin := range agent.Spec.Plugins {
+			config := "<none>"
+			if plugin.Config != "" {
+				config = fmt.Sprintf("%d bytes", len(plugin.Config))
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", plugin.Name, utilprint.ValueOrNone(plugin.Version), config)
+		}
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,193 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/net/websocket"
+	"golang.org/x/term"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	"github.com/faroshq/faros-hub/pkg/util/remoteshell"
+)
+
+// ExecOptions contains options for executing commands on an agent
+type ExecOptions struct {
+	*base.Options
+	// Agent is the name of the agent to execute the command on
+	Agent string
+	// Command to execute. The shell configured by the Access plugin of the
+	// agent is started if empty.
+	Command []string
+	// Stdin passes stdin to the command
+	Stdin bool
+	// TTY allocates a terminal for the command
+	TTY bool
+}
+
+// NewExecOptions returns a new ExecOptions.
+func NewExecOptions(streams genericclioptions.IOStreams) *ExecOptions {
+	return &ExecOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields ExecOptions as command line flags to cmd's flagset.
+func (o *ExecOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+
+	cmd.Flags().BoolVarP(&o.Stdin, "stdin", "i", o.Stdin, "Pass stdin to the command")
+	cmd.Flags().BoolVarP(&o.TTY, "tty", "t", o.TTY, "Allocate a terminal for the command")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *ExecOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		return errors.New("agent name is required")
+	}
+	o.Agent = args[0]
+	o.Command = args[1:]
+
+	// without a command an interactive shell is started
+	if len(o.Command) == 0 {
+		o.Stdin, o.TTY = true, true
+	}
+
+	if o.TTY {
+		if !o.Stdin {
+			fmt.Fprintln(o.ErrOut, "Unable to use a TTY - stdin is not passed, use -i")
+			o.TTY = false
+		} else if _, ok := o.terminal(); !ok {
+			fmt.Fprintln(o.ErrOut, "Unable to use a TTY - input is not a terminal")
+			o.TTY = false
+		}
+	}
+	return nil
+}
+
+// Validate validates the ExecOptions are complete and usable.
+func (o *ExecOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Agent == "" {
+		errs = append(errs, errors.New("agent name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run executes the command on the agent, streaming its input and output
+// through the hub and the agent tunnel
+func (o *ExecOptions) Run(ctx context.Context) error {
+	target, err := o.AgentTarget(o.Agent)
+	if err != nil {
+		return err
+	}
+
+	ws, err := target.DialWebsocket("exec", url.Values{
+		"command": o.Command,
+		"tty":     []string{strconv.FormatBool(o.TTY)},
+	})
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+
+	send := func(channel byte, payload []byte) error {
+		return websocket.Message.Send(ws, append([]byte{channel}, payload...))
+	}
+
+	if o.TTY {
+		stdin, _ := o.terminal()
+		fd := int(stdin.Fd())
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		defer term.Restore(fd, state) //nolint:errcheck
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go monitorTerminalSize(ctx, fd, func(size remoteshell.TerminalSize) {
+			payload, _ := json.Marshal(size)
+			send(remoteshell.ResizeChannel, payload) //nolint:errcheck
+		})
+	}
+
+	if o.Stdin {
+		go func() {
+			buf := make([]byte, 32*1024)
+			for {
+				n, err := o.In.Read(buf)
+				if n > 0 {
+					if err := send(remoteshell.StdinChannel, buf[:n]); err != nil {
+						return
+					}
+				}
+				if err != nil {
+					// an empty frame closes stdin of the command
+					send(remoteshell.StdinChannel, nil) //nolint:errcheck
+					return
+				}
+			}
+		}()
+	}
+
+	for {
+		var msg []byte
+		if err := websocket.Message.Receive(ws, &msg); err != nil {
+			if err == io.EOF {
+				return errors.New("session closed by the hub")
+			}
+			return err
+		}
+		if len(msg) == 0 {
+			continue
+		}
+
+		switch msg[0] {
+		case remoteshell.StdoutChannel:
+			o.Out.Write(msg[1:]) //nolint:errcheck
+		case remoteshell.StderrChannel:
+			o.ErrOut.Write(msg[1:]) //nolint:errcheck
+		case remoteshell.StatusChannel:
+			var status remoteshell.Status
+			if err := json.Unmarshal(msg[1:], &status); err != nil {
+				return fmt.Errorf("invalid session status: %w", err)
+			}
+			if status.Message != "" {
+				return fmt.Errorf("session terminated: %s", status.Message)
+			}
+			if status.ExitCode != 0 {
+				return &base.ExitError{Code: status.ExitCode}
+			}
+			return nil
+		}
+	}
+}
+
+// terminal returns stdin if it is a terminal
+func (o *ExecOptions) terminal() (*os.File, bool) {
+	f, ok := o.In.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return nil, false
+	}
+	return f, true
+}
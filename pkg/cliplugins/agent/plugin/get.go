@@ -0,0 +1,149 @@
+package plugin
+
+import (
+	"context"
+	"strings"
+
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+// GetOptions contains options for listing agents, or getting one
+type GetOptions struct {
+	*base.Options
+	// Name of the agent to get. All agents are listed if empty.
+	Name string
+}
+
+// NewGetOptions returns a new GetOptions.
+func NewGetOptions(streams genericclioptions.IOStreams) *GetOptions {
+	return &GetOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields GetOptions as command line flags to cmd's flagset.
+func (o *GetOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.Options.BindPrintFlags(cmd)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *GetOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the GetOptions are complete and usable.
+func (o *GetOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run lists agents in the namespace of the current workspace context
+func (o *GetOptions) Run(ctx context.Context) error {
+	farosClient, namespace, err := o.FarosClient()
+	if err != nil {
+		return err
+	}
+
+	agents := &edgev1alpha1.AgentList{}
+	if o.Name != "" {
+		agent, err := farosClient.EdgeV1alpha1().Agents(namespace).Get(ctx, o.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		agents.Items = append(agents.Items, *agent)
+	} else {
+		agents, err = farosClient.EdgeV1alpha1().Agents(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	// drop managed fields
+	for i := range agents.Items {
+		agents.Items[i].ObjectMeta.ManagedFields = nil
+	}
+
+	printer := o.Printer("agent.edge.faros.sh", agentColumns)
+	listOptions := metav1.ListOptions{ResourceVersion: agents.ResourceVersion}
+	if o.Name != "" {
+		err = printer.Print(&agents.Items[0])
+		listOptions.FieldSelector = fields.OneTermEqualSelector("metadata.name", o.Name).String()
+		listOptions.ResourceVersion = agents.Items[0].ResourceVersion
+	} else {
+		err = printer.Print(agents)
+	}
+	if err != nil || !o.Watch {
+		return err
+	}
+
+	w, err := farosClient.EdgeV1alpha1().Agents(namespace).Watch(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	return printer.PrintWatch(ctx, w)
+}
+
+// agentColumns are the columns of agents printed as table
+var agentColumns = []utilprint.Column{
+	utilprint.NameColumn,
+	utilprint.ConditionColumn("READY", conditionsv1alpha1.ReadyCondition),
+	utilprint.ConditionColumn("TUNNEL", edgev1alpha1.AgentTunnelConnected),
+	{Name: "VERSION", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(obj.(*edgev1alpha1.Agent).Status.Version)
+	}},
+	{Name: "LAST HEARTBEAT", Value: func(obj runtime.Object) string {
+		return heartbeat(obj.(*edgev1alpha1.Agent))
+	}},
+	{Name: "PLUGINS", Value: func(obj runtime.Object) string {
+		return pluginNames(obj.(*edgev1alpha1.Agent))
+	}},
+	utilprint.AgeColumn,
+	utilprint.Wide(utilprint.ConditionReasonColumn("REASON", conditionsv1alpha1.ReadyCondition)),
+	utilprint.Wide(utilprint.ConditionMessageColumn("MESSAGE", conditionsv1alpha1.ReadyCondition)),
+}
+
+// heartbeat returns how long ago the agent reported it is alive
+func heartbeat(agent *edgev1alpha1.Agent) string {
+	if agent.Status.LastHeartbeatTime == nil {
+		return "<none>"
+	}
+	return utilprint.Since(agent.Status.LastHeartbeatTime.Time).String() + " ago"
+}
+
+// pluginNames returns the plugins set on the agent, with their version if
+// pinned
+func pluginNames(agent *edgev1alpha1.Agent) string {
+	var plugins []string
+	for _, plugin := range agent.Spec.Plugins {
+		if plugin.Version != "" {
+			plugins = append(plugins, plugin.Name+"@"+plugin.Version)
+		} else {
+			plugins = append(plugins, plugin.Name)
+		}
+	}
+	return utilprint.ValueOrNone(strings.Join(plugins, ","))
+}
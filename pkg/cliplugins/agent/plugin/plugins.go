@@ -0,0 +1,181 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/util/retry"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+)
+
+// PluginsAction is the change PluginsOptions makes to the plugins of an agent
+type PluginsAction string
+
+const (
+	// PluginsActionAdd adds a plugin to the agent
+	PluginsActionAdd PluginsAction = "add"
+	// PluginsActionRemove removes a plugin from the agent
+	PluginsActionRemove PluginsAction = "remove"
+	// PluginsActionSetConfig replaces the config of a plugin of the agent
+	PluginsActionSetConfig PluginsAction = "set-config"
+)
+
+// PluginsOptions contains options for changing the plugins of an agent
+type PluginsOptions struct {
+	*base.Options
+	// Action to apply
+	Action PluginsAction
+	// Agent is the name of the agent
+	Agent string
+	// Plugin is the name of the plugin
+	Plugin string
+	// Version of the plugin to add
+	Version string
+	// Config of the plugin
+	Config string
+	// ConfigFile is read into Config
+	ConfigFile string
+}
+
+// NewPluginsOptions returns a new PluginsOptions for action.
+func NewPluginsOptions(streams genericclioptions.IOStreams, action PluginsAction) *PluginsOptions {
+	return &PluginsOptions{
+		Options: base.NewOptions(streams),
+		Action:  action,
+	}
+}
+
+// BindFlags binds fields PluginsOptions as command line flags to cmd's flagset.
+func (o *PluginsOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+
+	switch o.Action {
+	case PluginsActionAdd:
+		cmd.Flags().StringVar(&o.Version, "version", o.Version, "Version of the plugin")
+		fallthrough
+	case PluginsActionSetConfig:
+		cmd.Flags().StringVar(&o.Config, "config", o.Config, "Config of the plugin")
+		cmd.Flags().StringVarP(&o.ConfigFile, "config-file", "f", o.ConfigFile, "File to read the config of the plugin from")
+	}
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *PluginsOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) != 2 {
+		return errors.New("agent and plugin name required, i.e. <agent> <plugin>")
+	}
+	o.Agent, o.Plugin = args[0], args[1]
+
+	if o.ConfigFile != "" {
+		config, err := os.ReadFile(o.ConfigFile)
+		if err != nil {
+			return err
+		}
+		o.Config = string(config)
+	}
+
+	return nil
+}
+
+// Validate validates the PluginsOptions are complete and usable.
+func (o *PluginsOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Agent == "" || o.Plugin == "" {
+		errs = append(errs, errors.New("agent and plugin name are required"))
+	}
+
+	if o.Action == PluginsActionSetConfig && o.Config == "" && o.ConfigFile == "" {
+		errs = append(errs, errors.New("--config or --config-file is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run applies the action to the plugins in the spec of the agent
+func (o *PluginsOptions) Run(ctx context.Context) error {
+	farosClient, namespace, err := o.FarosClient()
+	if err != nil {
+		return err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		agent, err := farosClient.EdgeV1alpha1().Agents(namespace).Get(ctx, o.Agent, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		agentCopy := agent.DeepCopy()
+		if agentCopy.Spec.Plugins, err = o.apply(agentCopy.Spec.Plugins); err != nil {
+			return err
+		}
+
+		_, err = farosClient.EdgeV1alpha1().Agents(namespace).Update(ctx, agentCopy, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	switch o.Action {
+	case PluginsActionAdd:
+		fmt.Fprintf(o.Out, "Plugin %s added to agent %s\n", o.Plugin, o.Agent)
+	case PluginsActionRemove:
+		fmt.Fprintf(o.Out, "Plugin %s removed from agent %s\n", o.Plugin, o.Agent)
+	case PluginsActionSetConfig:
+		fmt.Fprintf(o.Out, "Plugin %s of agent %s configured\n", o.Plugin, o.Agent)
+	}
+	return nil
+}
+
+// apply returns plugins with the action applied
+func (o *PluginsOptions) apply(plugins []edgev1alpha1.PluginSpec) ([]edgev1alpha1.PluginSpec, error) {
+	index := -1
+	for i, plugin := range plugins {
+		if plugin.Name == o.Plugin {
+			index = i
+			break
+		}
+	}
+
+	switch o.Action {
+	case PluginsActionAdd:
+		if index >= 0 {
+			return nil, fmt.Errorf("plugin %s already exists on agent %s, use set-config to change its config", o.Plugin, o.Agent)
+		}
+		return append(plugins, edgev1alpha1.PluginSpec{
+			Name:    o.Plugin,
+			Version: o.Version,
+			Config:  o.Config,
+		}), nil
+	case PluginsActionRemove:
+		if index < 0 {
+			return nil, fmt.Errorf("plugin %s not found on agent %s", o.Plugin, o.Agent)
+		}
+		return append(plugins[:index], plugins[index+1:]...), nil
+	case PluginsActionSetConfig:
+		if index < 0 {
+			return nil, fmt.Errorf("plugin %s not found on agent %s", o.Plugin, o.Agent)
+		}
+		plugins[index].Config = o.Config
+		return plugins, nil
+	default:
+		return nil, fmt.Errorf("unknown action %q", o.Action)
+	}
+}
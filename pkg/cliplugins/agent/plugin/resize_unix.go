@@ -0,0 +1,33 @@
+//go:build !windows
+
+package plugin
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"golang.org/x/term"
+
+	"github.com/faroshq/faros-hub/pkg/util/remoteshell"
+)
+
+// monitorTerminalSize calls resize with the size of the terminal fd, and
+// every time it changes until ctx is done
+func monitorTerminalSize(ctx context.Context, fd int, resize func(remoteshell.TerminalSize)) {
+	winch := make(chan os.Signal, 1)
+	signal.Notify(winch, syscall.SIGWINCH)
+	defer signal.Stop(winch)
+
+	for {
+		if width, height, err := term.GetSize(fd); err == nil {
+			resize(remoteshell.TerminalSize{Width: uint16(width), Height: uint16(height)})
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-winch:
+		}
+	}
+}
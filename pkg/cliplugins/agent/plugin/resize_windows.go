@@ -0,0 +1,17 @@
+package plugin
+
+import (
+	"context"
+
+	"golang.org/x/term"
+
+	"github.com/faroshq/faros-hub/pkg/util/remoteshell"
+)
+
+// monitorTerminalSize calls resize with the size of the terminal fd. Windows
+// has no resize signal, so the size is not updated.
+func monitorTerminalSize(ctx context.Context, fd int, resize func(remoteshell.TerminalSize)) {
+	if width, height, err := term.GetSize(fd); err == nil {
+		resize(remoteshell.TerminalSize{Width: uint16(width), Height: uint16(height)})
+	}
+}
@@ -0,0 +1,161 @@
+package plugin
+
+import (
+	"bytes"
+	"embed"
+	"encoding/base64"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*
+var embeddedResources embed.FS
+
+const (
+	// TargetKubeconfig renders the kubeconfig of the agent only
+	TargetKubeconfig = "kubeconfig"
+	// TargetKubernetes renders manifests deploying the agent into a cluster
+	TargetKubernetes = "kubernetes"
+	// TargetSystemd renders a unit file, its env file and the kubeconfig of
+	// the agent
+	TargetSystemd = "systemd"
+	// TargetDockerCompose renders a compose file and the kubeconfig of the agent
+	TargetDockerCompose = "docker-compose"
+	// TargetScript renders a shell installer setting up the systemd target
+	TargetScript = "script"
+
+	defaultAgentImage = "quay.io/faroshq/edge-agent:latest"
+)
+
+// artifact is a file rendered for a target from a template
+type artifact struct {
+	// Name of the file, for targets rendering multiple files
+	Name string
+	// Template is the name of the template in templates/
+	Template string
+	// Mode of the file
+	Mode fs.FileMode
+}
+
+// targets are the artifacts rendered for every target. Targets rendering
+// multiple files are written into a directory.
+var targets = map[string][]artifact{
+	TargetKubeconfig: {
+		{Name: "agent.kubeconfig", Template: "kubeconfig.yaml", Mode: 0o600},
+	},
+	TargetKubernetes: {
+		{Name: "agent.yaml", Template: "kubernetes.yaml", Mode: 0o600},
+	},
+	TargetSystemd: {
+		{Name: "faros-agent.service", Template: "faros-agent.service", Mode: 0o644},
+		{Name: "faros-agent.env", Template: "faros-agent.env", Mode: 0o600},
+		{Name: "faros-agent.kubeconfig", Template: "kubeconfig.yaml", Mode: 0o600},
+	},
+	TargetDockerCompose: {
+		{Name: "docker-compose.yaml", Template: "docker-compose.yaml", Mode: 0o644},
+		{Name: "agent.kubeconfig", Template: "kubeconfig.yaml", Mode: 0o600},
+	},
+	TargetScript: {
+		{Name: "install.sh", Template: "install.sh", Mode: 0o700},
+	},
+}
+
+func targetNames() []string {
+	var names []string
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// templateInput represents the external input required to render the resources to
+// deploy the agent to any target.
+type templateInput struct {
+	// AgentName is the name of the agent
+	AgentName string
+	// ServerURL is the logical cluster url the agent configuration will use
+	ServerURL string
+	// CAData holds the PEM-encoded bytes of the ca certificate(s) an agent will use to validate
+	// kcp's serving certificate
+	CAData string
+	// InsecureSkipTLSVerify controls whether an agent verifies the server's certificate chain and host name
+	InsecureSkipTLSVerify bool
+	// Token is the service account token used to authenticate an agent for access to a workspace
+	Token string
+	// Namespace is the name of the agent namespace in the workspace
+	Namespace string
+	// LogicalCluster is the qualified kcp logical cluster name the agent will register in
+	LogicalCluster string
+	// Image of the agent, for container targets
+	Image string
+	// BinaryURL the script target downloads the agent binary from
+	BinaryURL string
+	// TunnelURL is the hub tunnels endpoint the agent connects to
+	TunnelURL string
+}
+
+// templateArgs represents the full set of arguments required to render the resources
+// required to deploy the agent.
+type templateArgs struct {
+	templateInput
+	// Kubeconfig is the rendered kubeconfig of the agent, embedded by targets
+	// rendering a single file
+	Kubeconfig string
+}
+
+// renderedFile is a file rendered for a target
+type renderedFile struct {
+	Name    string
+	Content []byte
+	Mode    fs.FileMode
+}
+
+// renderTarget renders the files required to deploy an agent to target
+func renderTarget(target string, input templateInput) ([]renderedFile, error) {
+	artifacts, ok := targets[target]
+	if !ok {
+		return nil, fmt.Errorf("unknown target %q", target)
+	}
+
+	tmpl, err := template.New("").Funcs(template.FuncMap{
+		"base64": func(s string) string {
+			return base64.StdEncoding.EncodeToString([]byte(s))
+		},
+		"indent": func(spaces int, s string) string {
+			pad := strings.Repeat(" ", spaces)
+			return pad + strings.ReplaceAll(strings.TrimSuffix(s, "\n"), "\n", "\n"+pad)
+		},
+	}).ParseFS(embeddedResources, "templates/*")
+	if err != nil {
+		return nil, err
+	}
+
+	args := templateArgs{templateInput: input}
+	kubeconfig, err := execute(tmpl, "kubeconfig.yaml", args)
+	if err != nil {
+		return nil, err
+	}
+	args.Kubeconfig = string(kubeconfig)
+
+	var files []renderedFile
+	for _, a := range artifacts {
+		content, err := execute(tmpl, a.Template, args)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, renderedFile{Name: a.Name, Content: content, Mode: a.Mode})
+	}
+	return files, nil
+}
+
+func execute(tmpl *template.Template, name string, args templateArgs) ([]byte, error) {
+	buffer := bytes.NewBuffer([]byte{})
+	if err := tmpl.ExecuteTemplate(buffer, name, args); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
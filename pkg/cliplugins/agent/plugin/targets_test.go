@@ -0,0 +1,62 @@
+package plugin
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+func TestRenderTargets(t *testing.T) {
+	input := templateInput{
+		AgentName:             "agent1",
+		ServerURL:             "https://kcp.faros.sh/clusters/root:tenants:abc",
+		CAData:                "Q0EgZGF0YQ==",
+		InsecureSkipTLSVerify: false,
+		Token:                 "token",
+		Namespace:             "default",
+		LogicalCluster:        "root:tenants:abc",
+		Image:                 defaultAgentImage,
+		BinaryURL:             "https://example.com/edge-agent",
+		TunnelURL:             "https://hub.faros.sh/faros.sh/tunnels",
+	}
+
+	for _, target := range targetNames() {
+		t.Run(target, func(t *testing.T) {
+			files, err := renderTarget(target, input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) != len(targets[target]) {
+				t.Fatalf("expected %d files, got %d", len(targets[target]), len(files))
+			}
+
+			for _, file := range files {
+				golden := filepath.Join("testdata", target, file.Name)
+				if *update {
+					if err := os.MkdirAll(filepath.Dir(golden), 0o755); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(golden, file.Content, 0o644); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+
+				expected, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatalf("%v, run with -update to create it", err)
+				}
+				if string(expected) != string(file.Content) {
+					t.Errorf("%s does not match golden file %s, run with -update after review:\n%s", file.Name, golden, file.Content)
+				}
+			}
+		})
+	}
+
+	if _, err := renderTarget("unknown", input); err == nil {
+		t.Error("expected error for unknown target")
+	}
+}
@@ -0,0 +1,37 @@
+#!/bin/sh
+# Installs the faros edge agent {{.AgentName}} as a systemd service.
+set -eu
+
+BINARY=/usr/local/bin/edge-agent
+
+if [ "$(id -u)" -ne 0 ]; then
+  echo "The installer must run as root" >&2
+  exit 1
+fi
+{{if .BinaryURL}}
+echo "Downloading agent from {{.BinaryURL}}"
+curl -fsSL -o "$BINARY" "{{.BinaryURL}}"
+chmod 0755 "$BINARY"
+{{- else}}
+if [ ! -x "$BINARY" ]; then
+  echo "$BINARY not found, install the edge-agent binary first" >&2
+  exit 1
+fi
+{{- end}}
+
+mkdir -p /etc/faros
+umask 077
+
+cat > /etc/faros/faros-agent.kubeconfig <<'FAROS_EOF'
+{{.Kubeconfig}}FAROS_EOF
+
+cat > /etc/faros/faros-agent.env <<'FAROS_EOF'
+{{template "faros-agent.env" .}}FAROS_EOF
+
+umask 022
+cat > /etc/systemd/system/faros-agent.service <<'FAROS_EOF'
+{{template "faros-agent.service" .}}FAROS_EOF
+
+systemctl daemon-reload
+systemctl enable --now faros-agent.service
+echo "Agent {{.AgentName}} installed"
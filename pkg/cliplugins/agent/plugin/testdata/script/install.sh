@@ -0,0 +1,68 @@
+#!/bin/sh
+# Installs the faros edge agent agent1 as a systemd service.
+set -eu
+
+BINARY=/usr/local/bin/edge-agent
+
+if [ "$(id -u)" -ne 0 ]; then
+  echo "The installer must run as root" >&2
+  exit 1
+fi
+
+echo "Downloading agent from https://example.com/edge-agent"
+curl -fsSL -o "$BINARY" "https://example.com/edge-agent"
+chmod 0755 "$BINARY"
+
+mkdir -p /etc/faros
+umask 077
+
+cat > /etc/faros/faros-agent.kubeconfig <<'FAROS_EOF'
+---
+apiVersion: v1
+kind: Config
+clusters:
+- name: default-cluster
+  cluster:
+    certificate-authority-data: Q0EgZGF0YQ==
+    insecure-skip-tls-verify: false
+    server: https://kcp.faros.sh/clusters/root:tenants:abc
+contexts:
+- name: default-context
+  context:
+    cluster: default-cluster
+    namespace: default
+    user: default-user
+current-context: default-context
+users:
+- name: default-user
+  user:
+    token: token
+FAROS_EOF
+
+cat > /etc/faros/faros-agent.env <<'FAROS_EOF'
+FAROS_AGENT_NAME=agent1
+FAROS_AGENT_NAMESPACE=default
+KUBECONFIG=/etc/faros/faros-agent.kubeconfig
+FAROS_AGENT_TUNNEL_URL=https://hub.faros.sh/faros.sh/tunnels
+FAROS_EOF
+
+umask 022
+cat > /etc/systemd/system/faros-agent.service <<'FAROS_EOF'
+[Unit]
+Description=Faros edge agent agent1
+Wants=network-online.target
+After=network-online.target
+
+[Service]
+EnvironmentFile=/etc/faros/faros-agent.env
+ExecStart=/usr/local/bin/edge-agent
+Restart=always
+RestartSec=5
+
+[Install]
+WantedBy=multi-user.target
+FAROS_EOF
+
+systemctl daemon-reload
+systemctl enable --now faros-agent.service
+echo "Agent agent1 installed"
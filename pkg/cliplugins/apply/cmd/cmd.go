@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/apply/plugin"
+)
+
+var (
+	applyExample = `
+	# Apply workspaces, registrations, agents and plugins from a directory
+	%[1]s -f manifests/ -R
+
+	# Apply manifests as the apply set fleet, deleting objects of the set
+	# which were removed from them
+	%[1]s -f manifests/ -R --apply-set fleet --prune
+`
+
+	diffExample = `
+	# Show what applying a directory of manifests would change
+	%[1]s -f manifests/ -R
+
+	# Include objects of the apply set fleet apply --prune would delete
+	%[1]s -f manifests/ -R --apply-set fleet --prune
+`
+)
+
+// New provides a cobra command applying manifests of faros objects.
+func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
+	applyOptions := plugin.NewApplyOptions(streams)
+	cmd := &cobra.Command{
+		Use:   "apply -f <manifests>",
+		Short: "Apply manifests of workspaces, registrations, agents and plugins",
+		Long: fmt.Sprintf(`Apply manifests of workspaces, registrations, agents and plugins.
+
+Workspaces are applied through the hub api, other objects to the workspace of
+the current context, or the workspace named by their %s annotation.
+Objects are configured with three-way patches of the configuration they were
+last applied with, their live state and the manifests.`, plugin.WorkspaceAnnotation),
+		Example:      fmt.Sprintf(applyExample, "kubectl faros apply"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := applyOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := applyOptions.Validate(); err != nil {
+				return err
+			}
+
+			return applyOptions.Run(c.Context())
+		},
+	}
+	applyOptions.BindFlags(cmd)
+
+	return cmd, nil
+}
+
+// NewDiff provides a cobra command diffing manifests of faros objects with
+// their live objects.
+func NewDiff(streams genericclioptions.IOStreams) (*cobra.Command, error) {
+	diffOptions := plugin.NewDiffOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "diff -f <manifests>",
+		Short:        "Show what applying manifests would change",
+		Example:      fmt.Sprintf(diffExample, "kubectl faros diff"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := diffOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := diffOptions.Validate(); err != nil {
+				return err
+			}
+
+			return diffOptions.Run(c.Context())
+		},
+	}
+	diffOptions.BindFlags(cmd)
+
+	return cmd, nil
+}
@@ -0,0 +1,158 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+)
+
+// ApplyOptions contains options for applying manifests of faros objects
+type ApplyOptions struct {
+	*base.Options
+	// Filenames are manifest files, directories of them or - for stdin
+	Filenames []string
+	// Recursive reads directories recursively
+	Recursive bool
+	// ApplySet is the name of the set applied objects are labeled as owned by
+	ApplySet string
+	// Prune deletes objects of ApplySet missing from manifests
+	Prune bool
+
+	namespace  string
+	newTargets func() (*targets, error)
+}
+
+// NewApplyOptions returns a new ApplyOptions.
+func NewApplyOptions(streams genericclioptions.IOStreams) *ApplyOptions {
+	return &ApplyOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields ApplyOptions as command line flags to cmd's flagset.
+func (o *ApplyOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.bindManifestFlags(cmd)
+
+	cmd.Flags().BoolVar(&o.Prune, "prune", o.Prune, "Delete objects of the apply set missing from manifests, requires --apply-set")
+}
+
+// bindManifestFlags binds flags selecting manifests, shared by apply and diff
+func (o *ApplyOptions) bindManifestFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVarP(&o.Filenames, "filename", "f", o.Filenames, "Manifest file, directory of manifests or - for stdin")
+	cmd.Flags().BoolVarP(&o.Recursive, "recursive", "R", o.Recursive, "Read directories given with -f recursively")
+	cmd.Flags().StringVar(&o.ApplySet, "apply-set", o.ApplySet, fmt.Sprintf("Name of the set applied objects are labeled as owned by with %s", ApplySetLabel))
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *ApplyOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	namespace, _, err := o.ClientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	o.namespace = namespace
+
+	if o.newTargets == nil {
+		o.newTargets = func() (*targets, error) {
+			hubConfig, err := o.HubConfig()
+			if err != nil {
+				return nil, err
+			}
+			config, err := o.ClientConfig.ClientConfig()
+			if err != nil {
+				return nil, err
+			}
+			return newTargets(hubConfig, config)
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the ApplyOptions are complete and usable.
+func (o *ApplyOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(o.Filenames) == 0 {
+		errs = append(errs, errors.New("manifests are required, set them with -f"))
+	}
+
+	if o.ApplySet != "" {
+		if msgs := validation.IsValidLabelValue(o.ApplySet); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("invalid --apply-set %q: %s", o.ApplySet, strings.Join(msgs, ", ")))
+		}
+	}
+
+	if o.Prune && o.ApplySet == "" {
+		errs = append(errs, errors.New("--prune requires --apply-set, objects are pruned from their apply set only"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run applies the manifests, creating and configuring their objects through
+// the hub api for workspaces and in kcp workspaces for others
+func (o *ApplyOptions) Run(ctx context.Context) error {
+	manifests, err := readManifests(o.Filenames, o.Recursive, o.In)
+	if err != nil {
+		return err
+	}
+
+	p, err := o.planner()
+	if err != nil {
+		return err
+	}
+
+	return p.visit(ctx, manifests, func(c change) error {
+		var err error
+		switch c.action {
+		case actionCreated:
+			_, err = c.target.Create(ctx, c.kind, c.modified)
+		case actionConfigured:
+			_, err = c.target.Patch(ctx, c.kind, c.namespace, c.name, c.patch)
+		case actionPruned:
+			err = c.target.Delete(ctx, c.kind, c.namespace, c.name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to apply %s to %s: %w", c, c.target, err)
+		}
+
+		// name the workspace objects are applied to, unless it is the current one
+		if c.target != p.targets.hub && c.target != p.targets.current {
+			fmt.Fprintf(o.Out, "%s %s (%s)\n", c, c.action, c.target)
+		} else {
+			fmt.Fprintf(o.Out, "%s %s\n", c, c.action)
+		}
+		return nil
+	})
+}
+
+// planner returns the planner of changes applying manifests makes
+func (o *ApplyOptions) planner() (*planner, error) {
+	targets, err := o.newTargets()
+	if err != nil {
+		return nil, err
+	}
+	return &planner{
+		targets:   targets,
+		namespace: o.namespace,
+		applySet:  o.ApplySet,
+		prune:     o.Prune,
+	}, nil
+}
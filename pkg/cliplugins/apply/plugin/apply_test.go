@@ -0,0 +1,231 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const manifests = `
+apiVersion: tenancy.faros.sh/v1alpha1
+kind: Workspace
+metadata:
+  name: fleet
+spec:
+  description: edge fleet
+---
+apiVersion: edge.faros.sh/v1alpha1
+kind: Registration
+metadata:
+  name: fleet
+  annotations:
+    faros.sh/workspace: fleet
+`
+
+const agent = `
+apiVersion: edge.faros.sh/v1alpha1
+kind: Agent
+metadata:
+  name: agent1
+`
+
+func TestApply(t *testing.T) {
+	hub := newFakeTarget("hub")
+	current := newFakeTarget("current workspace")
+	fleet := newFakeTarget("workspace fleet")
+	fake := &targets{
+		hub:     hub,
+		current: current,
+		workspace: func(ctx context.Context, name string) (target, error) {
+			if _, err := hub.Get(ctx, kinds[0], "", name); err != nil {
+				return nil, err
+			}
+			return fleet, nil
+		},
+	}
+
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	run := func(diff, prune bool) string {
+		t.Helper()
+		out := &bytes.Buffer{}
+		o := NewApplyOptions(genericclioptions.IOStreams{Out: out, ErrOut: out})
+		o.Filenames = []string{dir}
+		o.ApplySet = "fleet"
+		o.Prune = prune
+		o.namespace = "default"
+		o.newTargets = func() (*targets, error) { return fake, nil }
+		if err := o.Validate(); err != nil {
+			t.Fatal(err)
+		}
+
+		var err error
+		if diff {
+			err = (&DiffOptions{ApplyOptions: o}).Run(context.Background())
+		} else {
+			err = o.Run(context.Background())
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out.String()
+	}
+
+	write("fleet.yaml", manifests)
+	write("agent.yaml", agent)
+	write("README.md", "not a manifest")
+
+	expected := "workspace.tenancy.faros.sh/fleet created\n" +
+		"registration.edge.faros.sh/fleet created (workspace fleet)\n" +
+		"agent.edge.faros.sh/agent1 created\n"
+	if out := run(false, false); out != expected {
+		t.Errorf("unexpected output of first apply:\n%s", out)
+	}
+	if out := run(false, false); strings.Count(out, "unchanged") != 3 {
+		t.Errorf("expected objects to be unchanged:\n%s", out)
+	}
+
+	// changes made by controllers are kept
+	workspace := hub.objects["/fleet"]
+	workspace.SetNamespace("user")
+	if err := unstructured.SetNestedField(workspace.Object, "https://kcp/clusters/fleet", "status", "workspaceURL"); err != nil {
+		t.Fatal(err)
+	}
+
+	write("fleet.yaml", strings.Replace(manifests, "edge fleet", "edge devices", 1))
+	if err := os.Remove(filepath.Join(dir, "agent.yaml")); err != nil {
+		t.Fatal(err)
+	}
+
+	diff := run(true, true)
+	for _, s := range []string{"+++ merged/hub/workspace.tenancy.faros.sh/fleet", "-  description: edge fleet", "+  description: edge devices", "-  name: agent1"} {
+		if !strings.Contains(diff, s) {
+			t.Errorf("expected diff to contain %q:\n%s", s, diff)
+		}
+	}
+	if strings.Contains(diff, "registration") {
+		t.Errorf("expected unchanged objects not to be diffed:\n%s", diff)
+	}
+
+	expected = "workspace.tenancy.faros.sh/fleet configured\n" +
+		"registration.edge.faros.sh/fleet unchanged (workspace fleet)\n" +
+		"agent.edge.faros.sh/agent1 pruned\n"
+	if out := run(false, true); out != expected {
+		t.Errorf("unexpected output of pruning apply:\n%s", out)
+	}
+
+	workspace = hub.objects["/fleet"]
+	if description, _, _ := unstructured.NestedString(workspace.Object, "spec", "description"); description != "edge devices" {
+		t.Errorf("expected description to be configured, got %q", description)
+	}
+	if url, _, _ := unstructured.NestedString(workspace.Object, "status", "workspaceURL"); url == "" {
+		t.Error("expected status set by controllers to be kept")
+	}
+	if workspace.GetLabels()[ApplySetLabel] != "fleet" {
+		t.Errorf("expected apply set label, got %v", workspace.GetLabels())
+	}
+	if len(current.objects) != 0 {
+		t.Errorf("expected agent to be pruned, got %v", current.objects)
+	}
+}
+
+func TestReadManifests(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		manifest string
+		err      string
+	}{
+		{name: "unsupported kind", manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n", err: "unsupported kind ConfigMap"},
+		{name: "unsupported version", manifest: "apiVersion: edge.faros.sh/v1\nkind: Agent\nmetadata:\n  name: a\n", err: "unsupported version v1"},
+		{name: "missing name", manifest: "apiVersion: edge.faros.sh/v1alpha1\nkind: Agent\n", err: "without name"},
+		{name: "empty", manifest: "---\n", err: "no objects found"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readManifests([]string{"-"}, false, strings.NewReader(tt.manifest))
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+// fakeTarget keeps objects in memory
+type fakeTarget struct {
+	name    string
+	objects map[string]*unstructured.Unstructured
+}
+
+func newFakeTarget(name string) *fakeTarget {
+	return &fakeTarget{name: name, objects: map[string]*unstructured.Unstructured{}}
+}
+
+func (t *fakeTarget) String() string {
+	return t.name
+}
+
+func (t *fakeTarget) Get(ctx context.Context, k kind, namespace, name string) (*unstructured.Unstructured, error) {
+	obj, ok := t.objects[namespace+"/"+name]
+	if !ok {
+		return nil, apierrors.NewNotFound(k.GroupVersionResource().GroupResource(), name)
+	}
+	return obj.DeepCopy(), nil
+}
+
+func (t *fakeTarget) Create(ctx context.Context, k kind, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	t.objects[obj.GetNamespace()+"/"+obj.GetName()] = obj.DeepCopy()
+	return obj, nil
+}
+
+func (t *fakeTarget) Patch(ctx context.Context, k kind, namespace, name string, patch []byte) (*unstructured.Unstructured, error) {
+	obj, err := t.Get(ctx, k, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	current, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := jsonpatch.MergePatch(current, patch)
+	if err != nil {
+		return nil, err
+	}
+	if err := obj.UnmarshalJSON(patched); err != nil {
+		return nil, err
+	}
+	t.objects[namespace+"/"+name] = obj
+	return obj, nil
+}
+
+func (t *fakeTarget) Delete(ctx context.Context, k kind, namespace, name string) error {
+	delete(t.objects, namespace+"/"+name)
+	return nil
+}
+
+func (t *fakeTarget) List(ctx context.Context, k kind, labelSelector string) ([]unstructured.Unstructured, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	var items []unstructured.Unstructured
+	for _, obj := range t.objects {
+		if obj.GetKind() == k.Kind && selector.Matches(labels.Set(obj.GetLabels())) {
+			items = append(items, *obj.DeepCopy())
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,119 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/yaml"
+)
+
+// DiffOptions contains options for diffing manifests of faros objects with
+// their live objects
+type DiffOptions struct {
+	*ApplyOptions
+}
+
+// NewDiffOptions returns a new DiffOptions.
+func NewDiffOptions(streams genericclioptions.IOStreams) *DiffOptions {
+	return &DiffOptions{
+		ApplyOptions: NewApplyOptions(streams),
+	}
+}
+
+// BindFlags binds fields DiffOptions as command line flags to cmd's flagset.
+func (o *DiffOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.bindManifestFlags(cmd)
+
+	cmd.Flags().BoolVar(&o.Prune, "prune", o.Prune, "Include objects of the apply set apply --prune would delete, requires --apply-set")
+}
+
+// Run prints a unified diff of live objects and the objects applying the
+// manifests would result in
+func (o *DiffOptions) Run(ctx context.Context) error {
+	manifests, err := readManifests(o.Filenames, o.Recursive, o.In)
+	if err != nil {
+		return err
+	}
+
+	p, err := o.planner()
+	if err != nil {
+		return err
+	}
+
+	return p.visit(ctx, manifests, func(c change) error {
+		if c.action == actionUnchanged {
+			return nil
+		}
+
+		live, err := diffYAML(c.live)
+		if err != nil {
+			return err
+		}
+
+		var merged string
+		switch c.action {
+		case actionCreated:
+			merged, err = diffYAML(c.modified)
+		case actionConfigured:
+			merged, err = mergedYAML(c.live, c.patch)
+		}
+		if err != nil {
+			return err
+		}
+
+		name := path.Join(c.target.String(), c.kind.String(), c.namespace, c.name)
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(live),
+			B:        difflib.SplitLines(merged),
+			FromFile: path.Join("live", name),
+			ToFile:   path.Join("merged", name),
+			Context:  3,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.Out, diff)
+		return nil
+	})
+}
+
+// mergedYAML returns live patched with patch as yaml
+func mergedYAML(live *unstructured.Unstructured, patch []byte) (string, error) {
+	current, err := json.Marshal(live.Object)
+	if err != nil {
+		return "", err
+	}
+	data, err := jsonpatch.MergePatch(current, patch)
+	if err != nil {
+		return "", err
+	}
+	merged := &unstructured.Unstructured{}
+	if err := merged.UnmarshalJSON(data); err != nil {
+		return "", err
+	}
+	return diffYAML(merged)
+}
+
+// diffYAML returns obj as yaml, without fields only adding noise to diffs.
+// Objects which don't exist are empty.
+func diffYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	obj = obj.DeepCopy()
+	obj.SetManagedFields(nil)
+
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
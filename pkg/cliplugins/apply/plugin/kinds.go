@@ -0,0 +1,59 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	pluginsv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/plugins/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+// kind is a faros kind apply understands
+type kind struct {
+	schema.GroupVersionKind
+	// Resource is the plural resource of the kind
+	Resource string
+	// Hub kinds are managed through the hub api, others are applied to kcp
+	// workspaces
+	Hub bool
+}
+
+// GroupVersionResource returns the resource of the kind
+func (k kind) GroupVersionResource() schema.GroupVersionResource {
+	return k.GroupVersion().WithResource(k.Resource)
+}
+
+// String returns the qualified name of the kind, i.e. agent.edge.faros.sh
+func (k kind) String() string {
+	return strings.ToLower(k.Kind) + "." + k.Group
+}
+
+// kinds are all faros kinds apply understands, hub kinds first as workspaces
+// need to exist before objects are applied to them
+var kinds = []kind{
+	{GroupVersionKind: tenancyv1alpha1.SchemeGroupVersion.WithKind(tenancyv1alpha1.WorkspaceKind), Resource: "workspaces", Hub: true},
+	{GroupVersionKind: edgev1alpha1.SchemeGroupVersion.WithKind(edgev1alpha1.RegistrationKind), Resource: "registrations"},
+	{GroupVersionKind: edgev1alpha1.SchemeGroupVersion.WithKind(edgev1alpha1.AgentKind), Resource: "agents"},
+	{GroupVersionKind: pluginsv1alpha1.SchemeGroupVersion.WithKind(pluginsv1alpha1.PluginAccessKind), Resource: "accesses"},
+	{GroupVersionKind: pluginsv1alpha1.SchemeGroupVersion.WithKind(pluginsv1alpha1.PluginContainerRuntimeKind), Resource: "containerruntimes"},
+	{GroupVersionKind: pluginsv1alpha1.SchemeGroupVersion.WithKind(pluginsv1alpha1.PluginMonitoringKind), Resource: "monitorings"},
+	{GroupVersionKind: pluginsv1alpha1.SchemeGroupVersion.WithKind(pluginsv1alpha1.PluginNetworkKind), Resource: "networks"},
+	{GroupVersionKind: pluginsv1alpha1.SchemeGroupVersion.WithKind(pluginsv1alpha1.PluginNotificationKind), Resource: "notifications"},
+}
+
+// lookupKind returns the faros kind of gvk
+func lookupKind(gvk schema.GroupVersionKind) (kind, error) {
+	for _, k := range kinds {
+		if k.GroupKind() != gvk.GroupKind() {
+			continue
+		}
+		if k.Version != gvk.Version {
+			return kind{}, fmt.Errorf("unsupported version %s of %s, expected %s", gvk.Version, k, k.GroupVersion())
+		}
+		return k, nil
+	}
+	return kind{}, fmt.Errorf("unsupported kind %s, only faros kinds can be applied", gvk.GroupKind())
+}
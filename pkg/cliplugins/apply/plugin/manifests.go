@@ -0,0 +1,125 @@
+package plugin
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// manifestExtensions are the extensions of files read from directories
+var manifestExtensions = []string{".yaml", ".yml", ".json"}
+
+// manifest is an object read from a manifest file
+type manifest struct {
+	*unstructured.Unstructured
+	kind kind
+	// source is the file the object was read from
+	source string
+}
+
+// readManifests reads objects from filenames. Directories are read for files
+// with manifest extensions, recursively if recursive is set, - is stdin.
+func readManifests(filenames []string, recursive bool, stdin io.Reader) ([]manifest, error) {
+	var manifests []manifest
+	for _, filename := range filenames {
+		if filename == "-" {
+			objects, err := decodeManifests(stdin, "stdin")
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, objects...)
+			continue
+		}
+
+		files, err := manifestFiles(filename, recursive)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			f, err := os.Open(file)
+			if err != nil {
+				return nil, err
+			}
+			objects, err := decodeManifests(f, file)
+			f.Close()
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, objects...)
+		}
+	}
+
+	if len(manifests) == 0 {
+		return nil, errors.New("no objects found in manifests")
+	}
+	return manifests, nil
+}
+
+// manifestFiles returns filename, or files with manifest extensions in it if
+// it is a directory
+func manifestFiles(filename string, recursive bool) ([]string, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{filename}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(filename, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != filename && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		for _, ext := range manifestExtensions {
+			if strings.HasSuffix(path, ext) {
+				files = append(files, path)
+				break
+			}
+		}
+		return nil
+	})
+	return files, err
+}
+
+// decodeManifests decodes the yaml or json documents of r
+func decodeManifests(r io.Reader, source string) ([]manifest, error) {
+	var manifests []manifest
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bufio.NewReader(r), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return manifests, nil
+			}
+			return nil, fmt.Errorf("failed to decode %s: %w", source, err)
+		}
+		// empty documents
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		k, err := lookupKind(obj.GroupVersionKind())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", source, err)
+		}
+		if obj.GetName() == "" {
+			return nil, fmt.Errorf("%s: %s without name", source, k)
+		}
+		manifests = append(manifests, manifest{Unstructured: obj, kind: k, source: source})
+	}
+}
@@ -0,0 +1,276 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/mergepatch"
+)
+
+const (
+	// ApplySetLabel marks objects as owned by an apply set. Objects of the set
+	// missing from manifests are deleted by apply --prune.
+	ApplySetLabel = "faros.sh/apply-set"
+	// LastAppliedAnnotation holds the configuration an object was last
+	// applied with, the original of three-way patches
+	LastAppliedAnnotation = "faros.sh/last-applied-configuration"
+)
+
+// action is what applying a manifest does to its object
+type action string
+
+const (
+	actionCreated    action = "created"
+	actionConfigured action = "configured"
+	actionUnchanged  action = "unchanged"
+	actionPruned     action = "pruned"
+)
+
+// change is a change of an object applying manifests makes
+type change struct {
+	action    action
+	target    target
+	kind      kind
+	namespace string
+	name      string
+	// live object, nil if it is created
+	live *unstructured.Unstructured
+	// modified is the object as configured by its manifest, nil if pruned
+	modified *unstructured.Unstructured
+	// patch is the json merge patch configuring live
+	patch []byte
+}
+
+// String returns the qualified name of the changed object
+func (c change) String() string {
+	return c.kind.String() + "/" + c.name
+}
+
+// key identifies objects across targets
+func objectKey(t target, k kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", t, k, namespace, name)
+}
+
+// planner computes the changes applying manifests makes
+type planner struct {
+	targets *targets
+	// namespace of objects without one
+	namespace string
+	// applySet objects are labeled with, none if empty
+	applySet string
+	// prune objects of applySet missing from manifests
+	prune bool
+}
+
+// visit computes the change of each manifest and calls fn with it, before
+// computing the change of the next one. Workspaces are visited first, so
+// objects can be applied to workspaces created by the same manifests. Pruned
+// objects are visited last.
+func (p *planner) visit(ctx context.Context, manifests []manifest, fn func(c change) error) error {
+	sort.SliceStable(manifests, func(i, j int) bool {
+		return kindIndex(manifests[i].kind) < kindIndex(manifests[j].kind)
+	})
+
+	applied := map[string]bool{}
+	for _, m := range manifests {
+		t, err := p.targets.forManifest(ctx, m)
+		if err != nil {
+			return fmt.Errorf("%s: %w", m.source, err)
+		}
+
+		namespace := ""
+		if !m.kind.Hub {
+			namespace = m.GetNamespace()
+			if namespace == "" {
+				namespace = p.namespace
+			}
+		}
+
+		key := objectKey(t, m.kind, namespace, m.GetName())
+		if applied[key] {
+			return fmt.Errorf("%s: %s/%s is defined more than once", m.source, m.kind, m.GetName())
+		}
+		applied[key] = true
+
+		c, err := p.plan(ctx, t, m, namespace)
+		if err != nil {
+			return fmt.Errorf("%s: %w", m.source, err)
+		}
+		if err := fn(c); err != nil {
+			return err
+		}
+	}
+
+	if !p.prune {
+		return nil
+	}
+
+	pruned, err := p.pruned(ctx, applied)
+	if err != nil {
+		return err
+	}
+	for _, c := range pruned {
+		if err := fn(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// plan computes the change applying m to t makes
+func (p *planner) plan(ctx context.Context, t target, m manifest, namespace string) (change, error) {
+	modified := m.DeepCopy()
+	modified.SetNamespace(namespace)
+	if p.applySet != "" {
+		labels := modified.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[ApplySetLabel] = p.applySet
+		modified.SetLabels(labels)
+	}
+	if err := setLastApplied(modified); err != nil {
+		return change{}, err
+	}
+
+	c := change{
+		target:    t,
+		kind:      m.kind,
+		namespace: namespace,
+		name:      m.GetName(),
+		modified:  modified,
+	}
+
+	live, err := t.Get(ctx, m.kind, namespace, m.GetName())
+	if apierrors.IsNotFound(err) {
+		c.action = actionCreated
+		return c, nil
+	}
+	if err != nil {
+		return change{}, err
+	}
+	c.live = live
+
+	original := []byte(live.GetAnnotations()[LastAppliedAnnotation])
+	modifiedJSON, err := json.Marshal(modified.Object)
+	if err != nil {
+		return change{}, err
+	}
+	currentJSON, err := json.Marshal(live.Object)
+	if err != nil {
+		return change{}, err
+	}
+
+	c.patch, err = jsonmergepatch.CreateThreeWayJSONMergePatch(original, modifiedJSON, currentJSON,
+		mergepatch.RequireKeyUnchanged("apiVersion"),
+		mergepatch.RequireKeyUnchanged("kind"),
+		mergepatch.RequireMetadataKeyUnchanged("name"),
+	)
+	if err != nil {
+		return change{}, fmt.Errorf("failed to compute patch of %s: %w", c, err)
+	}
+
+	c.action = actionConfigured
+	if string(c.patch) == "{}" {
+		c.action = actionUnchanged
+	}
+	return c, nil
+}
+
+// pruned returns changes deleting objects of the apply set which are not
+// applied. The hub, the current workspace and workspaces manifests were
+// applied to are pruned, workspaces themselves last.
+func (p *planner) pruned(ctx context.Context, applied map[string]bool) ([]change, error) {
+	selector := labels.Set{ApplySetLabel: p.applySet}.AsSelector().String()
+
+	pruneTargets := []target{p.targets.current}
+	var names []string
+	for name := range p.targets.resolved {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pruneTargets = append(pruneTargets, p.targets.resolved[name])
+	}
+	pruneTargets = append(pruneTargets, p.targets.hub)
+
+	var changes []change
+	for _, t := range pruneTargets {
+		hub := t == p.targets.hub
+		for i := len(kinds) - 1; i >= 0; i-- {
+			k := kinds[i]
+			if k.Hub != hub {
+				continue
+			}
+
+			items, err := t.List(ctx, k, selector)
+			// plugins might not be bound in all workspaces
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s in %s: %w", k, t, err)
+			}
+
+			for i := range items {
+				item := &items[i]
+				namespace := item.GetNamespace()
+				if k.Hub {
+					namespace = ""
+				}
+				if applied[objectKey(t, k, namespace, item.GetName())] {
+					continue
+				}
+				changes = append(changes, change{
+					action:    actionPruned,
+					target:    t,
+					kind:      k,
+					namespace: namespace,
+					name:      item.GetName(),
+					live:      item,
+				})
+			}
+		}
+	}
+	return changes, nil
+}
+
+// setLastApplied records the configuration of obj in its
+// LastAppliedAnnotation
+func setLastApplied(obj *unstructured.Unstructured) error {
+	annotations := obj.GetAnnotations()
+	delete(annotations, LastAppliedAnnotation)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[LastAppliedAnnotation] = string(data)
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// kindIndex returns the position of k in kinds
+func kindIndex(k kind) int {
+	for i := range kinds {
+		if kinds[i].GroupKind() == k.GroupKind() {
+			return i
+		}
+	}
+	return len(kinds)
+}
@@ -0,0 +1,206 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+)
+
+// WorkspaceAnnotation selects the workspace objects are applied to, instead of
+// the workspace of the current context
+const WorkspaceAnnotation = "faros.sh/workspace"
+
+// target is where objects are applied to, the hub api or a kcp workspace
+type target interface {
+	// String describes the target in messages
+	String() string
+	Get(ctx context.Context, k kind, namespace, name string) (*unstructured.Unstructured, error)
+	Create(ctx context.Context, k kind, obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	Patch(ctx context.Context, k kind, namespace, name string, patch []byte) (*unstructured.Unstructured, error)
+	Delete(ctx context.Context, k kind, namespace, name string) error
+	// List lists objects of k in all namespaces matching labelSelector
+	List(ctx context.Context, k kind, labelSelector string) ([]unstructured.Unstructured, error)
+}
+
+// hubTarget applies workspaces through the hub api, which keeps them in the
+// namespace of the user
+type hubTarget struct {
+	client hub.Interface
+}
+
+func (t *hubTarget) String() string {
+	return "hub"
+}
+
+func (t *hubTarget) Get(ctx context.Context, k kind, namespace, name string) (*unstructured.Unstructured, error) {
+	return toUnstructured(t.client.Workspaces("").Get(ctx, name))
+}
+
+func (t *hubTarget) Create(ctx context.Context, k kind, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	workspace := &tenancyv1alpha1.Workspace{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, workspace); err != nil {
+		return nil, err
+	}
+	return toUnstructured(t.client.Workspaces("").Create(ctx, workspace))
+}
+
+func (t *hubTarget) Patch(ctx context.Context, k kind, namespace, name string, patch []byte) (*unstructured.Unstructured, error) {
+	return toUnstructured(t.client.Workspaces("").Patch(ctx, name, patch))
+}
+
+func (t *hubTarget) Delete(ctx context.Context, k kind, namespace, name string) error {
+	_, err := t.client.Workspaces("").Delete(ctx, name, metav1.DeleteOptions{})
+	return err
+}
+
+func (t *hubTarget) List(ctx context.Context, k kind, labelSelector string) ([]unstructured.Unstructured, error) {
+	workspaces, err := t.client.Workspaces("").List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, err
+	}
+	var items []unstructured.Unstructured
+	for i := range workspaces.Items {
+		obj, err := toUnstructured(&workspaces.Items[i], nil)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *obj)
+	}
+	return items, nil
+}
+
+// toUnstructured converts a workspace returned by the hub api
+func toUnstructured(workspace *tenancyv1alpha1.Workspace, err error) (*unstructured.Unstructured, error) {
+	if err != nil {
+		return nil, err
+	}
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(workspace)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{Object: data}
+	obj.SetGroupVersionKind(tenancyv1alpha1.SchemeGroupVersion.WithKind(tenancyv1alpha1.WorkspaceKind))
+	return obj, nil
+}
+
+// workspaceTarget applies objects to a kcp workspace
+type workspaceTarget struct {
+	// name of the workspace, empty for the workspace of the current context
+	name   string
+	client dynamic.Interface
+}
+
+func (t *workspaceTarget) String() string {
+	if t.name == "" {
+		return "current workspace"
+	}
+	return "workspace " + t.name
+}
+
+func (t *workspaceTarget) Get(ctx context.Context, k kind, namespace, name string) (*unstructured.Unstructured, error) {
+	return t.client.Resource(k.GroupVersionResource()).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func (t *workspaceTarget) Create(ctx context.Context, k kind, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return t.client.Resource(k.GroupVersionResource()).Namespace(obj.GetNamespace()).Create(ctx, obj, metav1.CreateOptions{})
+}
+
+func (t *workspaceTarget) Patch(ctx context.Context, k kind, namespace, name string, patch []byte) (*unstructured.Unstructured, error) {
+	return t.client.Resource(k.GroupVersionResource()).Namespace(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+}
+
+func (t *workspaceTarget) Delete(ctx context.Context, k kind, namespace, name string) error {
+	return t.client.Resource(k.GroupVersionResource()).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
+
+func (t *workspaceTarget) List(ctx context.Context, k kind, labelSelector string) ([]unstructured.Unstructured, error) {
+	list, err := t.client.Resource(k.GroupVersionResource()).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// targets resolves the targets objects are applied to
+type targets struct {
+	hub     target
+	current target
+	// workspace returns the target of a workspace by name
+	workspace func(ctx context.Context, name string) (target, error)
+
+	resolved map[string]target
+}
+
+// newTargets returns targets applying workspaces through the hub api of
+// hubConfig and other objects to the workspace of config, or the workspace
+// selected with WorkspaceAnnotation
+func newTargets(hubConfig, config *rest.Config) (*targets, error) {
+	hubClient, err := hub.NewForConfig(hubConfig)
+	if err != nil {
+		return nil, err
+	}
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &targets{
+		hub:     &hubTarget{client: hubClient},
+		current: &workspaceTarget{client: client},
+		workspace: func(ctx context.Context, name string) (target, error) {
+			workspace, err := hubClient.Workspaces("").Get(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get workspace %s: %w", name, err)
+			}
+			if workspace.Status.WorkspaceURL == "" {
+				return nil, fmt.Errorf("workspace %s is not ready yet, apply again once it is", name)
+			}
+
+			workspaceConfig := rest.CopyConfig(hubConfig)
+			workspaceConfig.Host = workspace.Status.WorkspaceURL
+			client, err := dynamic.NewForConfig(workspaceConfig)
+			if err != nil {
+				return nil, err
+			}
+			return &workspaceTarget{name: name, client: client}, nil
+		},
+	}, nil
+}
+
+// forManifest returns the target m is applied to
+func (t *targets) forManifest(ctx context.Context, m manifest) (target, error) {
+	if m.kind.Hub {
+		return t.hub, nil
+	}
+	return t.forWorkspace(ctx, m.GetAnnotations()[WorkspaceAnnotation])
+}
+
+// forWorkspace returns the target of workspace, the workspace of the current
+// context if empty
+func (t *targets) forWorkspace(ctx context.Context, workspace string) (target, error) {
+	if workspace == "" {
+		return t.current, nil
+	}
+	if resolved, ok := t.resolved[workspace]; ok {
+		return resolved, nil
+	}
+
+	resolved, err := t.workspace(ctx, workspace)
+	if err != nil {
+		return nil, err
+	}
+	if t.resolved == nil {
+		t.resolved = map[string]target{}
+	}
+	t.resolved[workspace] = resolved
+	return resolved, nil
+}
@@ -0,0 +1,119 @@
+package base
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/kcp-dev/kcp/pkg/cliplugins/helpers"
+	"golang.org/x/net/websocket"
+	"k8s.io/client-go/rest"
+
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+)
+
+// AgentTarget identifies the agent a command runs against, resolved from the
+// current workspace context
+type AgentTarget struct {
+	Config      *rest.Config
+	ClusterName string
+	// Org is the organization of the workspace, empty for workspaces of the
+	// user
+	Org       string
+	Namespace string
+	Agent     string
+}
+
+// AgentTarget resolves agent in the workspace of the current context
+func (o *Options) AgentTarget(agent string) (*AgentTarget, error) {
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	_, clusterName, err := helpers.ParseClusterURL(config.Host)
+	if err != nil {
+		return nil, fmt.Errorf("current context %q does not point to a workspace, run 'kubectl faros workspace use <workspace>' first", config.Host)
+	}
+
+	namespace, _, err := o.ClientConfig.Namespace()
+	if err != nil {
+		return nil, err
+	}
+
+	rawConfig, err := o.ClientConfig.RawConfig()
+	if err != nil {
+		return nil, err
+	}
+	currentContext := rawConfig.CurrentContext
+	if o.KubectlOverrides.CurrentContext != "" {
+		currentContext = o.KubectlOverrides.CurrentContext
+	}
+	// contexts of organization workspaces are named after <org>/<workspace>
+	var org string
+	if _, workspace, ok := ParseWorkspaceContextName(currentContext); ok {
+		org, _, _ = strings.Cut(workspace, "/")
+		if org == workspace {
+			org = ""
+		}
+	}
+
+	return &AgentTarget{
+		Config:      config,
+		ClusterName: clusterName.String(),
+		Org:         org,
+		Namespace:   namespace,
+		Agent:       agent,
+	}, nil
+}
+
+// DialWebsocket opens a websocket to the hub api subresource of the agent,
+// i.e. portforward
+func (t *AgentTarget) DialWebsocket(subresource string, query url.Values) (*websocket.Conn, error) {
+	u, err := url.Parse(t.Config.Host)
+	if err != nil {
+		return nil, err
+	}
+	origin := &url.URL{Scheme: u.Scheme, Host: u.Host}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	u.Path = hub.AgentPath(t.ClusterName, t.Namespace, t.Agent, subresource)
+	if t.Org != "" {
+		query = cloneValues(query)
+		query.Set("org", t.Org)
+	}
+	u.RawQuery = query.Encode()
+
+	wsConfig, err := websocket.NewConfig(u.String(), origin.String())
+	if err != nil {
+		return nil, err
+	}
+	wsConfig.TlsConfig, err = rest.TLSConfigFor(t.Config)
+	if err != nil {
+		return nil, err
+	}
+	if t.Config.BearerToken != "" {
+		wsConfig.Header.Set("Authorization", "Bearer "+t.Config.BearerToken)
+	}
+
+	ws, err := websocket.DialConfig(wsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to agent %s/%s: %w", t.Namespace, t.Agent, err)
+	}
+	ws.PayloadType = websocket.BinaryFrame
+	return ws, nil
+}
+
+func cloneValues(values url.Values) url.Values {
+	clone := make(url.Values, len(values)+1)
+	for key, value := range values {
+		clone[key] = value
+	}
+	return clone
+}
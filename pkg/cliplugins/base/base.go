@@ -8,6 +8,7 @@ import (
 	"github.com/kcp-dev/kcp/pkg/cliplugins/base"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
+	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
 	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
 )
 
@@ -16,6 +17,12 @@ type Options struct {
 	*base.Options
 	// Output specifies output format
 	Output string
+	// NoHeaders omits headers of printed tables
+	NoHeaders bool
+	// SortBy is a jsonpath expression listed objects are sorted by
+	SortBy string
+	// Watch for changes after printing objects
+	Watch bool
 
 	// TenantWorkspaceAPI is the API path for tenant workspaces
 	TenantWorkspaceAPI string
@@ -31,7 +38,15 @@ func NewOptions(streams genericclioptions.IOStreams) *Options {
 // BindFlags binds options fields to cmd's flagset.
 func (o *Options) BindFlags(cmd *cobra.Command) {
 	o.Options.BindFlags(cmd)
-	cmd.Flags().StringVarP(&o.Output, "output", "o", o.Output, "output format [table,json,yaml]")
+	cmd.Flags().StringVarP(&o.Output, "output", "o", o.Output, "output format [table,wide,name,json,yaml,custom-columns=<spec>,jsonpath=<template>]")
+}
+
+// BindPrintFlags binds flags of commands printing lists of objects to cmd's
+// flagset.
+func (o *Options) BindPrintFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.NoHeaders, "no-headers", o.NoHeaders, "Don't print headers of tables")
+	cmd.Flags().StringVar(&o.SortBy, "sort-by", o.SortBy, "Sort listed objects by a jsonpath expression, i.e. '{.metadata.name}'")
+	cmd.Flags().BoolVarP(&o.Watch, "watch", "w", o.Watch, "After printing objects, watch for changes")
 }
 
 // Complete initializes ClientConfig based on Kubeconfig and KubectlOverrides.
@@ -45,15 +60,53 @@ func (o *Options) Complete() error {
 
 	o.TenantWorkspaceAPI = "/apis/faros.sh/workspaces"
 
-	switch o.Output {
-	case utilprint.FormatJSON, utilprint.FormatYAML, utilprint.FormatTable, utilprint.FormatJSONStream:
-		return nil
-	default:
-		return fmt.Errorf("invalid output format: %s", o.Output)
-	}
+	return utilprint.ValidateFormat(o.Output)
 }
 
 // Validate validates the configured options.
 func (o *Options) Validate() error {
 	return nil
 }
+
+// FarosClient returns a faros client for the workspace of the current
+// context, and the namespace of the context
+func (o *Options) FarosClient() (farosclient.Interface, string, error) {
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return nil, "", err
+	}
+
+	namespace, _, err := o.ClientConfig.Namespace()
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, err := farosclient.NewForConfig(config)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create faros client: %w", err)
+	}
+	return client, namespace, nil
+}
+
+// Printer returns a printer for objects of resource, printing columns as
+// table and honouring the output flags
+func (o *Options) Printer(resource string, columns []utilprint.Column) *utilprint.Printer {
+	return &utilprint.Printer{
+		Out:       o.Out,
+		Format:    o.Output,
+		Resource:  resource,
+		Columns:   columns,
+		NoHeaders: o.NoHeaders,
+		SortBy:    o.SortBy,
+	}
+}
+
+// ExitError is returned by commands exiting with the exit code of a command
+// run remotely
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("command terminated with exit code %d", e.Code)
+}
@@ -0,0 +1,228 @@
+package base
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/homedir"
+
+	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
+)
+
+// CompletionResource is a resource whose names are completed by the shell
+type CompletionResource string
+
+const (
+	// CompletionWorkspaces completes workspace names from the hub api
+	CompletionWorkspaces CompletionResource = "workspaces"
+	// CompletionAgents completes agent names in the current workspace context
+	CompletionAgents CompletionResource = "agents"
+	// CompletionRegistrations completes registration names in the current
+	// workspace context
+	CompletionRegistrations CompletionResource = "registrations"
+	// CompletionRequests completes access request names in the current
+	// workspace context
+	CompletionRequests CompletionResource = "requests"
+)
+
+var (
+	// CompletionCacheTTL is how long listed names are reused by completion
+	CompletionCacheTTL = 30 * time.Second
+	// CompletionTimeout bounds requests made while completing
+	CompletionTimeout = 5 * time.Second
+
+	completionCacheDir = filepath.Join(homedir.HomeDir(), ".kube", "cache", "faros", "completion")
+)
+
+// CompletionFunc is a cobra.Command ValidArgsFunction
+type CompletionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// ValidArgsFunction returns a completion func completing names of resource
+// for the first maxArgs arguments, or any argument if maxArgs is 0. Names are
+// cached on disk for CompletionCacheTTL so completion stays fast.
+func (o *Options) ValidArgsFunction(resource CompletionResource, maxArgs int) CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if maxArgs > 0 && len(args) >= maxArgs {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		names, err := o.completionNames(cmd.Context(), resource)
+		if err != nil {
+			cobra.CompDebugln(err.Error(), true)
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return filterCompletions(names, args, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completionNames returns names of resource, from the cache if fresh
+func (o *Options) completionNames(ctx context.Context, resource CompletionResource) ([]string, error) {
+	// flags are parsed, but Complete is not called when completing
+	if err := o.Options.Complete(); err != nil {
+		return nil, err
+	}
+
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	namespace, _, err := o.ClientConfig.Namespace()
+	if err != nil {
+		return nil, err
+	}
+
+	key := cacheKey(config.Host, namespace, string(resource))
+	if names, ok := readCompletionCache(key); ok {
+		return names, nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, CompletionTimeout)
+	defer cancel()
+
+	var names []string
+	switch resource {
+	case CompletionWorkspaces:
+		client, err := o.HubClient()
+		if err != nil {
+			return nil, err
+		}
+		workspaces, err := client.Workspaces("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, workspace := range workspaces.Items {
+			names = append(names, workspace.Name)
+		}
+	case CompletionAgents:
+		client, err := farosclient.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		agents, err := client.EdgeV1alpha1().Agents(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, agent := range agents.Items {
+			names = append(names, agent.Name)
+		}
+	case CompletionRegistrations:
+		client, err := farosclient.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		registrations, err := client.EdgeV1alpha1().Registrations(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, registration := range registrations.Items {
+			names = append(names, registration.Name)
+		}
+	case CompletionRequests:
+		client, err := farosclient.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		requests, err := client.AccessV1alpha1().Requests(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, request := range requests.Items {
+			names = append(names, request.Name)
+		}
+	default:
+		return nil, fmt.Errorf("unknown completion resource %q", resource)
+	}
+
+	writeCompletionCache(key, names)
+	return names, nil
+}
+
+// filterCompletions returns names starting with toComplete which are not
+// already in args
+func filterCompletions(names, args []string, toComplete string) []string {
+	used := map[string]bool{}
+	for _, arg := range args {
+		used[arg] = true
+	}
+
+	var completions []string
+	for _, name := range names {
+		if !used[name] && strings.HasPrefix(name, toComplete) {
+			completions = append(completions, name)
+		}
+	}
+	return completions
+}
+
+func cacheKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// readCompletionCache returns the names cached under key, if not older than
+// CompletionCacheTTL
+func readCompletionCache(key string) ([]string, bool) {
+	path := filepath.Join(completionCacheDir, key+".json")
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > CompletionCacheTTL {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return nil, false
+	}
+	return names, true
+}
+
+// writeCompletionCache caches names under key. Failures are ignored, the
+// cache is an optimization only.
+func writeCompletionCache(key string, names []string) {
+	data, err := json.Marshal(names)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(completionCacheDir, 0o700); err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(completionCacheDir, key+"-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	_ = os.Rename(tmp.Name(), filepath.Join(completionCacheDir, key+".json"))
+}
+
+// FlagCompletionFunc returns a completion func completing names of resource
+// as the value of a flag
+func (o *Options) FlagCompletionFunc(resource CompletionResource) CompletionFunc {
+	complete := o.ValidArgsFunction(resource, 0)
+	return func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return complete(cmd, nil, toComplete)
+	}
+}
@@ -0,0 +1,44 @@
+package base
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCompletionCache(t *testing.T) {
+	completionCacheDir = t.TempDir()
+	key := cacheKey("https://faros.sh", "default", string(CompletionAgents))
+
+	if _, ok := readCompletionCache(key); ok {
+		t.Fatal("expected empty cache")
+	}
+
+	writeCompletionCache(key, []string{"agent1", "agent2"})
+	names, ok := readCompletionCache(key)
+	if !ok {
+		t.Fatal("expected cached names")
+	}
+	if !reflect.DeepEqual(names, []string{"agent1", "agent2"}) {
+		t.Errorf("unexpected names %v", names)
+	}
+
+	stale := time.Now().Add(-2 * CompletionCacheTTL)
+	if err := os.Chtimes(filepath.Join(completionCacheDir, key+".json"), stale, stale); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := readCompletionCache(key); ok {
+		t.Error("expected stale cache to be ignored")
+	}
+}
+
+func TestFilterCompletions(t *testing.T) {
+	names := []string{"agent1", "agent2", "edge"}
+
+	got := filterCompletions(names, []string{"agent1"}, "ag")
+	if !reflect.DeepEqual(got, []string{"agent2"}) {
+		t.Errorf("unexpected completions %v", got)
+	}
+}
@@ -0,0 +1,145 @@
+package base
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+)
+
+const (
+	// DefaultProfile is the profile login configures if none is given
+	DefaultProfile = "default"
+
+	workspaceContextPrefix = "faros:"
+	previousContextPrefix  = "faros-previous:"
+)
+
+// ProfileKey returns the name of the kubeconfig cluster, user and context
+// login writes for profile
+func ProfileKey(profile string) string {
+	if profile == "" || profile == DefaultProfile {
+		return "faros"
+	}
+	return "faros-" + profile
+}
+
+// WorkspaceContextName returns the name of the kubeconfig context, and
+// cluster, of workspace of profile
+func WorkspaceContextName(profile, workspace string) string {
+	return workspaceContextPrefix + profile + ":" + workspace
+}
+
+// ParseWorkspaceContextName returns the profile and workspace of a context
+// named by WorkspaceContextName
+func ParseWorkspaceContextName(name string) (profile, workspace string, ok bool) {
+	if !strings.HasPrefix(name, workspaceContextPrefix) {
+		return "", "", false
+	}
+	profile, workspace, ok = strings.Cut(strings.TrimPrefix(name, workspaceContextPrefix), ":")
+	if !ok || profile == "" || workspace == "" {
+		return "", "", false
+	}
+	return profile, workspace, true
+}
+
+// ProfileOfContext returns the profile of a context login or workspace use
+// created, named by ProfileKey or WorkspaceContextName
+func ProfileOfContext(name string) (string, bool) {
+	if profile, _, ok := ParseWorkspaceContextName(name); ok {
+		return profile, true
+	}
+	if name == ProfileKey(DefaultProfile) {
+		return DefaultProfile, true
+	}
+	// contexts of other profiles are faros-<profile>, profiles have no colons
+	// unlike the faros-previous:<profile> contexts
+	if profile := strings.TrimPrefix(name, ProfileKey(DefaultProfile)+"-"); profile != name && profile != "" && !strings.Contains(profile, ":") {
+		return profile, true
+	}
+	return "", false
+}
+
+// PreviousContextName returns the name of the kubeconfig context holding the
+// context used before switching workspaces of profile
+func PreviousContextName(profile string) string {
+	return previousContextPrefix + profile
+}
+
+// IsPreviousContextName returns true if name is a context named by
+// PreviousContextName
+func IsPreviousContextName(name string) bool {
+	return strings.HasPrefix(name, previousContextPrefix)
+}
+
+// ConfigAccess returns the kubeconfig files the current config is loaded
+// from, honouring --kubeconfig
+func (o *Options) ConfigAccess() clientcmd.ConfigAccess {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = o.Kubeconfig
+	return loadingRules
+}
+
+// HubConfig returns a config for the hub api, served on the root of the
+// server of the current context
+func (o *Options) HubConfig() (*rest.Config, error) {
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return hubConfig(config)
+}
+
+// ProfileHubConfig returns a config for the hub api of profile, regardless
+// of the current context
+func (o *Options) ProfileHubConfig(profile string) (*rest.Config, error) {
+	rawConfig, err := o.ClientConfig.RawConfig()
+	if err != nil {
+		return nil, err
+	}
+	key := ProfileKey(profile)
+	if _, ok := rawConfig.Contexts[key]; !ok {
+		return nil, fmt.Errorf("profile %q is not logged in, run 'kubectl faros login --profile %s' first", profile, profile)
+	}
+
+	config, err := clientcmd.NewNonInteractiveClientConfig(rawConfig, key, &clientcmd.ConfigOverrides{}, o.ConfigAccess()).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return hubConfig(config)
+}
+
+// HubClient returns a client of the hub api of the current context
+func (o *Options) HubClient() (hub.Interface, error) {
+	config, err := o.HubConfig()
+	if err != nil {
+		return nil, err
+	}
+	return hub.NewForConfig(config)
+}
+
+// ProfileHubClient returns a client of the hub api of profile, regardless of
+// the current context
+func (o *Options) ProfileHubClient(profile string) (hub.Interface, error) {
+	config, err := o.ProfileHubConfig(profile)
+	if err != nil {
+		return nil, err
+	}
+	return hub.NewForConfig(config)
+}
+
+func hubConfig(config *rest.Config) (*rest.Config, error) {
+	u, err := url.Parse(config.Host)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid server %q, expected <scheme>://<host>", config.Host)
+	}
+	config.Host = (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+	return config, nil
+}
@@ -0,0 +1,39 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
+)
+
+// RegistrationTimeout is how long commands wait for a registration token to
+// be issued
+const RegistrationTimeout = 2 * time.Minute
+
+// WaitForRegistrationToken waits until the registration is ready with a token
+// other than previous, and returns it
+func WaitForRegistrationToken(ctx context.Context, client farosclient.Interface, namespace, name, previous string) (*edgev1alpha1.Registration, error) {
+	var registration *edgev1alpha1.Registration
+	err := wait.PollImmediateWithContext(ctx, 500*time.Millisecond, RegistrationTimeout, func(ctx context.Context) (bool, error) {
+		var err error
+		registration, err = client.EdgeV1alpha1().Registrations(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return conditions.IsTrue(registration, conditionsv1alpha1.ReadyCondition) &&
+			registration.Status.Token != "" &&
+			registration.Status.Token != previous, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("waiting for registration %s token: %w", name, err)
+	}
+	return registration, nil
+}
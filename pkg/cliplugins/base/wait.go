@@ -0,0 +1,59 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DefaultWaitTimeout is how long commands wait with --wait by default
+const DefaultWaitTimeout = 5 * time.Minute
+
+// waitInterval is how often conditions are polled while waiting
+var waitInterval = 2 * time.Second
+
+// WaitOptions are options of commands waiting for their changes to complete
+type WaitOptions struct {
+	// Wait for the change to complete
+	Wait bool
+	// Timeout of waiting
+	Timeout time.Duration
+}
+
+// NewWaitOptions provides an instance of WaitOptions with default values.
+func NewWaitOptions() *WaitOptions {
+	return &WaitOptions{
+		Timeout: DefaultWaitTimeout,
+	}
+}
+
+// BindFlags binds wait options fields to cmd's flagset.
+func (o *WaitOptions) BindFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.Wait, "wait", o.Wait, "Wait until the change is complete")
+	cmd.Flags().DurationVar(&o.Timeout, "timeout", o.Timeout, "How long to wait with --wait")
+}
+
+// Validate validates the wait options.
+func (o *WaitOptions) Validate() error {
+	if o.Wait && o.Timeout <= 0 {
+		return errors.New("--timeout must be positive")
+	}
+	return nil
+}
+
+// Poll calls condition until it is done, fails or the timeout expires. what
+// describes what is waited for in errors.
+func (o *WaitOptions) Poll(ctx context.Context, what string, condition func(ctx context.Context) (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+
+	err := wait.PollImmediateUntilWithContext(ctx, waitInterval, condition)
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		return fmt.Errorf("timed out after %s waiting for %s", o.Timeout, what)
+	}
+	return err
+}
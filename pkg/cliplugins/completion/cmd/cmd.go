@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+var (
+	completionLong = `Output shell completion code for the specified shell (bash, zsh, fish or powershell).
+The output must be evaluated to provide interactive completion of kubectl faros commands,
+including names of workspaces, agents and registrations queried from the hub.
+
+kubectl only completes plugin commands from version 1.26 on, through a
+kubectl_complete-faros executable in PATH calling kubectl-faros __complete.`
+
+	completionExample = `
+	# Load completion for kubectl-faros in the current bash session
+	source <(%[1]s bash)
+
+	# Load completion for every zsh session
+	%[1]s zsh > "${fpath[1]}/_kubectl-faros"
+
+	# Let kubectl complete "kubectl faros" (kubectl 1.26+)
+	cat > /usr/local/bin/kubectl_complete-faros <<'EOF'
+	#!/bin/sh
+	kubectl-faros __complete "$@"
+	EOF
+	chmod +x /usr/local/bin/kubectl_complete-faros
+`
+)
+
+// New provides a cobra command printing shell completion code.
+func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Use:                   "completion bash|zsh|fish|powershell",
+		Short:                 "Output shell completion code for the specified shell",
+		Long:                  completionLong,
+		Example:               fmt.Sprintf(completionExample, "kubectl-faros completion"),
+		DisableFlagsInUseLine: true,
+		ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+		Args:                  cobra.ExactValidArgs(1),
+		SilenceUsage:          true,
+		RunE: func(c *cobra.Command, args []string) error {
+			// completion is loaded for the plugin binary, not "kubectl faros"
+			root := c.Root()
+			root.Use = "kubectl-faros"
+			switch args[0] {
+			case "bash":
+				return root.GenBashCompletionV2(streams.Out, true)
+			case "zsh":
+				return root.GenZshCompletion(streams.Out)
+			case "fish":
+				return root.GenFishCompletion(streams.Out, true)
+			case "powershell":
+				return root.GenPowerShellCompletionWithDesc(streams.Out)
+			default:
+				return fmt.Errorf("unsupported shell %q", args[0])
+			}
+		},
+	}
+
+	return cmd, nil
+}
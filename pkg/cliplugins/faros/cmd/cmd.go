@@ -7,8 +7,15 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
+	accesscmd "github.com/faroshq/faros-hub/pkg/cliplugins/access/cmd"
+	admincmd "github.com/faroshq/faros-hub/pkg/cliplugins/admin/cmd"
 	agentcmd "github.com/faroshq/faros-hub/pkg/cliplugins/agent/cmd"
+	applycmd "github.com/faroshq/faros-hub/pkg/cliplugins/apply/cmd"
+	completioncmd "github.com/faroshq/faros-hub/pkg/cliplugins/completion/cmd"
 	logincmd "github.com/faroshq/faros-hub/pkg/cliplugins/login/cmd"
+	orgcmd "github.com/faroshq/faros-hub/pkg/cliplugins/org/cmd"
+	quotacmd "github.com/faroshq/faros-hub/pkg/cliplugins/quota/cmd"
+	registrationcmd "github.com/faroshq/faros-hub/pkg/cliplugins/registration/cmd"
 	workspacecmd "github.com/faroshq/faros-hub/pkg/cliplugins/workspace/cmd"
 )
 
@@ -20,24 +27,86 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 		os.Exit(1)
 	}
 
+	accessCmd, err := accesscmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
 	loginCmd, err := logincmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
 	}
 
+	registrationCmd, err := registrationcmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
 	workspaceCmd, err := workspacecmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
 	}
 
+	completionCmd, err := completioncmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
+	applyCmd, err := applycmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
+	diffCmd, err := applycmd.NewDiff(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
+	quotaCmd, err := quotacmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
+	adminCmd, err := admincmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
+	orgCmd, err := orgcmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
 	cmd := &cobra.Command{
 		Use:   "faros",
 		Short: "Manage faros",
+		// errors are printed by the caller, described for users
+		SilenceErrors: true,
+		CompletionOptions: cobra.CompletionOptions{
+			// replaced by completionCmd
+			DisableDefaultCmd: true,
+		},
 	}
 
+	cmd.AddCommand(accessCmd)
+	cmd.AddCommand(adminCmd)
 	cmd.AddCommand(agentCmd)
+	cmd.AddCommand(applyCmd)
+	cmd.AddCommand(completionCmd)
+	cmd.AddCommand(diffCmd)
+	cmd.AddCommand(orgCmd)
+	cmd.AddCommand(quotaCmd)
+	cmd.AddCommand(registrationCmd)
 	cmd.AddCommand(workspaceCmd)
 	cmd.AddCommand(loginCmd)
 
@@ -1,13 +1,15 @@
 package plugin
 
 import (
-	"encoding/base64"
+	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"net"
 	"net/http"
-	"strings"
+	"net/url"
 
+	"github.com/faroshq/faros-hub/pkg/client/hub"
 	"github.com/faroshq/faros-hub/pkg/models"
 )
 
@@ -35,23 +37,30 @@ func getLocalListener() (net.Listener, error) {
 	return listener, nil
 }
 
-// handleLoginCallback is used to handle the callback from the api server
-func handleLoginCallback(r *http.Request, w http.ResponseWriter) (*models.LoginResponse, error) {
-	if r.URL.RawQuery == "" {
-		return nil, errors.New("no token found in the authorization request")
+// handleLoginCallback is used to handle the callback from the api server,
+// exchanging the login code of the login with state for the login result
+func handleLoginCallback(ctx context.Context, client hub.Interface, r *http.Request, w http.ResponseWriter, state, verifier string) (*models.LoginResponse, error) {
+	code := r.URL.Query().Get("code")
+	if code == "" {
+		return nil, errors.New("no login code found in the authorization request")
 	}
-	if !strings.HasPrefix(r.URL.RawQuery, "data=") {
-		return nil, errors.New("invalid token response from API server")
+	if subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("state")), []byte(state)) != 1 {
+		return nil, errors.New("login response does not match the login, log in again")
 	}
-	raw := strings.TrimPrefix(r.URL.RawQuery, "data=")
 
-	decoded, err := base64.StdEncoding.DecodeString(raw)
+	form := url.Values{"code": {code}, "code_verifier": {verifier}}
+	data, err := client.RESTClient().Post().
+		AbsPath(hub.OIDCTokenPath).
+		SetHeader("Content-Type", "application/x-www-form-urlencoded").
+		Body([]byte(form.Encode())).
+		Do(ctx).
+		Raw()
 	if err != nil {
 		return nil, err
 	}
 
 	var response models.LoginResponse
-	err = json.Unmarshal(decoded, &response)
+	err = json.Unmarshal(data, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -5,31 +5,39 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+	farosbase "github.com/faroshq/faros-hub/pkg/cliplugins/base"
 	"github.com/faroshq/faros-hub/pkg/models"
+	"github.com/faroshq/faros-hub/pkg/util/pkce"
 	"github.com/kcp-dev/kcp/pkg/cliplugins/base"
 	"github.com/skratchdot/open-golang/open"
 	"github.com/spf13/cobra"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog"
 )
 
-var kubeConfigAuthKey = "faros"
-
 // LoginSetupOptions contains options for login via faros API
 type LoginSetupOptions struct {
 	*base.Options
 
 	// ConfigFile of CLI config
 	ConfigFile string
+	// Profile to log in with. Every profile has its own kubeconfig user,
+	// cluster and context.
+	Profile string
+	// HubURL is the address of the hub to log in to
+	HubURL string
 
 	// for testing
 	modifyConfig func(configAccess clientcmd.ConfigAccess, newConfig *clientcmdapi.Config) error
@@ -39,6 +47,8 @@ type LoginSetupOptions struct {
 func NewLoginSetupOptions(streams genericclioptions.IOStreams) *LoginSetupOptions {
 	return &LoginSetupOptions{
 		Options: base.NewOptions(streams),
+		Profile: farosbase.DefaultProfile,
+		HubURL:  "https://kcp.dev.faros.sh",
 		modifyConfig: func(configAccess clientcmd.ConfigAccess, newConfig *clientcmdapi.Config) error {
 			return clientcmd.ModifyConfig(configAccess, *newConfig, true)
 		},
@@ -56,6 +66,8 @@ func (o *LoginSetupOptions) BindFlags(cmd *cobra.Command) {
 	}
 
 	cmd.Flags().StringVarP(&o.ConfigFile, "config", "c", filepath.Join(homedir, ".faros/config.yaml"), "Faros CLI config location")
+	cmd.Flags().StringVar(&o.Profile, "profile", o.Profile, "Profile to log in with, i.e. to use multiple hubs or users")
+	cmd.Flags().StringVar(&o.HubURL, "hub-url", o.HubURL, "Address of the hub to log in to")
 }
 
 // Complete ensures all dynamically populated fields are initialized.
@@ -74,6 +86,9 @@ func (o *LoginSetupOptions) Validate() error {
 	if err := o.Options.Validate(); err != nil {
 		errs = append(errs, err)
 	}
+	if u, err := url.Parse(o.HubURL); err != nil || u.Scheme == "" || u.Host == "" {
+		errs = append(errs, fmt.Errorf("invalid --hub-url %q, expected <scheme>://<host>", o.HubURL))
+	}
 
 	return utilerrors.NewAggregate(errs)
 }
@@ -82,13 +97,35 @@ func (o *LoginSetupOptions) Validate() error {
 func (o *LoginSetupOptions) Run(ctx context.Context) error {
 	fmt.Println("Logging into Faros Hub...")
 
+	// the login code sent to the local server is only exchanged for the
+	// login result with the verifier, and only for logins of this state
+	verifier, err := pkce.NewVerifier()
+	if err != nil {
+		return err
+	}
+	state, err := pkce.NewVerifier()
+	if err != nil {
+		return err
+	}
+
+	client, err := hub.NewForConfig(&rest.Config{Host: o.HubURL})
+	if err != nil {
+		return err
+	}
+
 	doneCh := make(chan struct{})
 	errCh := make(chan error)
 	response := &models.LoginResponse{}
 
 	// local server to catch the response
-	http.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
-		result, err := handleLoginCallback(req, w)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		// i.e. favicons requested by the browser
+		if req.URL.Path != "/" {
+			http.NotFound(w, req)
+			return
+		}
+		result, err := handleLoginCallback(ctx, client, req, w, state, verifier)
 		if err != nil {
 			errCh <- err
 			return
@@ -104,14 +141,20 @@ func (o *LoginSetupOptions) Run(ctx context.Context) error {
 
 	// start serving locally and wait for the response
 	go func() {
-		if err := http.Serve(l, http.DefaultServeMux); err != nil {
+		if err := http.Serve(l, mux); err != nil {
 			errCh <- fmt.Errorf("trying to start local http server: %s", err)
 		}
 	}()
 
-	url := fmt.Sprintf("https://kcp.dev.faros.sh/faros.sh/api/v1alpha1/oidc/login?redirect_uri=http://localhost:%d", l.Addr().(*net.TCPAddr).Port)
+	query := url.Values{
+		"redirect_uri":          {fmt.Sprintf("http://%s/", l.Addr())},
+		"state":                 {state},
+		"code_challenge":        {pkce.Challenge(verifier)},
+		"code_challenge_method": {pkce.Method},
+	}
+	loginURL := strings.TrimSuffix(o.HubURL, "/") + hub.OIDCLoginPath + "?" + query.Encode()
 
-	if err := open.Run(url); err != nil {
+	if err := open.Run(loginURL); err != nil {
 		return fmt.Errorf("trying to open web browser, error: %s", err)
 	}
 
@@ -133,6 +176,7 @@ func (o *LoginSetupOptions) configureKubeConfig(ctx context.Context, response mo
 	if err != nil {
 		return err
 	}
+	kubeConfigAuthKey := farosbase.ProfileKey(o.Profile)
 
 	// setup user
 	user, exists := config.AuthInfos[kubeConfigAuthKey]
@@ -164,5 +208,7 @@ func (o *LoginSetupOptions) configureKubeConfig(ctx context.Context, response mo
 
 	fmt.Print("Saving configuration...\n")
 
-	return o.modifyConfig(o.ClientConfig.ConfigAccess(), &config)
+	configAccess := clientcmd.NewDefaultClientConfigLoadingRules()
+	configAccess.ExplicitPath = o.Kubeconfig
+	return o.modifyConfig(configAccess, &config)
 }
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/org/plugin"
+)
+
+var (
+	createExample = `
+	# Create an organization owned by the current user
+	%[1]s acme --display-name "Acme Inc."
+
+	# Create an organization with additional owners
+	%[1]s acme --owner jane@example.com
+`
+
+	updateExample = `
+	# Add an owner to an organization
+	%[1]s acme --add-owner jane@example.com
+`
+
+	teamCreateExample = `
+	# Create a team editing all workspaces of an organization
+	%[1]s operators --org acme --role Edit --member jane@example.com
+
+	# Create a team viewing a single workspace of an organization
+	%[1]s auditors --org acme --role View --member john@example.com --workspace fleet
+`
+
+	teamUpdateExample = `
+	# Add a member to a team and grant the team admin role
+	%[1]s operators --org acme --add-member john@example.com --role Admin
+`
+)
+
+// options are the options of org commands
+type options interface {
+	BindFlags(cmd *cobra.Command)
+	Complete(args []string) error
+	Validate() error
+	Run(ctx context.Context) error
+}
+
+// newCommand returns a cobra command of parent running options
+func newCommand(parent, use, short, example string, options options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          use,
+		Short:        short,
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := options.Complete(args); err != nil {
+				return err
+			}
+
+			if err := options.Validate(); err != nil {
+				return err
+			}
+
+			return options.Run(c.Context())
+		},
+	}
+	if example != "" {
+		cmd.Example = fmt.Sprintf(example, parent+" "+cmd.Name())
+	}
+	options.BindFlags(cmd)
+	return cmd
+}
+
+// New provides a cobra command for organizations and their teams.
+func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Aliases:          []string{"orgs", "organization"},
+		Use:              "org",
+		Short:            "Manage organizations and their teams",
+		SilenceUsage:     true,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newCommand("kubectl faros org", "create <name>", "Create an organization", createExample, plugin.NewCreateOrgOptions(streams)))
+	cmd.AddCommand(newCommand("kubectl faros org", "get [name]", "Get organizations you own or are in a team of", "", plugin.NewGetOrgsOptions(streams)))
+	cmd.AddCommand(newCommand("kubectl faros org", "update <name>", "Update an organization, for its owners", updateExample, plugin.NewUpdateOrgOptions(streams)))
+	cmd.AddCommand(newCommand("kubectl faros org", "delete <name>", "Delete an organization without workspaces, for its owners", "", plugin.NewDeleteOrgOptions(streams)))
+
+	teamCmd := &cobra.Command{
+		Aliases:          []string{"teams"},
+		Use:              "team",
+		Short:            "Manage teams of an organization",
+		SilenceUsage:     true,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+	teamCmd.AddCommand(newCommand("kubectl faros org team", "create <name>", "Create a team, for owners of the organization", teamCreateExample, plugin.NewCreateTeamOptions(streams)))
+	teamCmd.AddCommand(newCommand("kubectl faros org team", "get [name]", "Get teams of an organization", "", plugin.NewGetTeamsOptions(streams)))
+	teamCmd.AddCommand(newCommand("kubectl faros org team", "update <name>", "Update a team, for owners of the organization", teamUpdateExample, plugin.NewUpdateTeamOptions(streams)))
+	teamCmd.AddCommand(newCommand("kubectl faros org team", "delete <name>", "Delete a team, for owners of the organization", "", plugin.NewDeleteTeamOptions(streams)))
+	cmd.AddCommand(teamCmd)
+
+	return cmd, nil
+}
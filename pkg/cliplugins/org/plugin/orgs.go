@@ -0,0 +1,327 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/utils/strings/slices"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+// GetOrgsOptions contains options for listing organizations
+type GetOrgsOptions struct {
+	*base.Options
+	Name string
+}
+
+// NewGetOrgsOptions returns a new GetOrgsOptions.
+func NewGetOrgsOptions(streams genericclioptions.IOStreams) *GetOrgsOptions {
+	return &GetOrgsOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields GetOrgsOptions as command line flags to cmd's flagset.
+func (o *GetOrgsOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.Options.BindPrintFlags(cmd)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *GetOrgsOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the GetOrgsOptions are complete and usable.
+func (o *GetOrgsOptions) Validate() error {
+	return o.Options.Validate()
+}
+
+// Run gets organizations the user owns or is in a team of from the hub api
+func (o *GetOrgsOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	printer := o.Printer("organization.tenancy.faros.sh", orgColumns)
+	if o.Name != "" {
+		organization, err := client.Organizations().Get(ctx, o.Name)
+		if err != nil {
+			return err
+		}
+		organization.ManagedFields = nil
+		return printer.Print(organization)
+	}
+
+	organizations, err := client.Organizations().List(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range organizations.Items {
+		organizations.Items[i].ManagedFields = nil
+	}
+	return printer.Print(organizations)
+}
+
+// CreateOrgOptions contains options for creating organizations
+type CreateOrgOptions struct {
+	*base.Options
+	Name        string
+	DisplayName string
+	// Owners are owners of the organization besides the current user
+	Owners []string
+}
+
+// NewCreateOrgOptions returns a new CreateOrgOptions.
+func NewCreateOrgOptions(streams genericclioptions.IOStreams) *CreateOrgOptions {
+	return &CreateOrgOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields CreateOrgOptions as command line flags to cmd's flagset.
+func (o *CreateOrgOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	cmd.Flags().StringVar(&o.DisplayName, "display-name", o.DisplayName, "Display name of the organization")
+	cmd.Flags().StringArrayVar(&o.Owners, "owner", o.Owners, "Additional owner emails of the organization")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *CreateOrgOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the CreateOrgOptions are complete and usable.
+func (o *CreateOrgOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("organization name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run creates an organization owned by the current user through the hub api
+func (o *CreateOrgOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	organization := &tenancyv1alpha1.Organization{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       tenancyv1alpha1.OrganizationKind,
+			APIVersion: tenancyv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: o.Name,
+		},
+		Spec: tenancyv1alpha1.OrganizationSpec{
+			DisplayName: o.DisplayName,
+			Owners:      o.Owners,
+		},
+	}
+
+	organization, err = client.Organizations().Create(ctx, organization)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Organization %s created\n", organization.Name)
+	return nil
+}
+
+// UpdateOrgOptions contains options for updating organizations
+type UpdateOrgOptions struct {
+	*base.Options
+	Name         string
+	DisplayName  string
+	AddOwners    []string
+	RemoveOwners []string
+}
+
+// NewUpdateOrgOptions returns a new UpdateOrgOptions.
+func NewUpdateOrgOptions(streams genericclioptions.IOStreams) *UpdateOrgOptions {
+	return &UpdateOrgOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields UpdateOrgOptions as command line flags to cmd's flagset.
+func (o *UpdateOrgOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	cmd.Flags().StringVar(&o.DisplayName, "display-name", o.DisplayName, "Display name of the organization")
+	cmd.Flags().StringArrayVar(&o.AddOwners, "add-owner", o.AddOwners, "Owner emails to add to the organization")
+	cmd.Flags().StringArrayVar(&o.RemoveOwners, "remove-owner", o.RemoveOwners, "Owner emails to remove from the organization")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *UpdateOrgOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the UpdateOrgOptions are complete and usable.
+func (o *UpdateOrgOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("organization name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run updates an organization through the hub api
+func (o *UpdateOrgOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	organization, err := client.Organizations().Get(ctx, o.Name)
+	if err != nil {
+		return err
+	}
+
+	if o.DisplayName != "" {
+		organization.Spec.DisplayName = o.DisplayName
+	}
+	organization.Spec.Owners = update(organization.Spec.Owners, o.AddOwners, o.RemoveOwners)
+
+	organization, err = client.Organizations().Update(ctx, organization)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Organization %s updated\n", organization.Name)
+	return nil
+}
+
+// DeleteOrgOptions contains options for deleting organizations
+type DeleteOrgOptions struct {
+	*base.Options
+	Name string
+}
+
+// NewDeleteOrgOptions returns a new DeleteOrgOptions.
+func NewDeleteOrgOptions(streams genericclioptions.IOStreams) *DeleteOrgOptions {
+	return &DeleteOrgOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields DeleteOrgOptions as command line flags to cmd's flagset.
+func (o *DeleteOrgOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *DeleteOrgOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the DeleteOrgOptions are complete and usable.
+func (o *DeleteOrgOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("organization name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run deletes an organization without workspaces through the hub api
+func (o *DeleteOrgOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Organizations().Delete(ctx, o.Name); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Organization %s deleted\n", o.Name)
+	return nil
+}
+
+// update returns values with add appended and remove removed, keeping values
+// unique
+func update(values, add, remove []string) []string {
+	var result []string
+	for _, value := range append(values, add...) {
+		if !slices.Contains(remove, value) && !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// orgColumns are the columns of organizations printed as table
+var orgColumns = []utilprint.Column{
+	utilprint.NameColumn,
+	{Name: "DISPLAY NAME", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(obj.(*tenancyv1alpha1.Organization).Spec.DisplayName)
+	}},
+	{Name: "OWNERS", Value: func(obj runtime.Object) string {
+		return strings.Join(obj.(*tenancyv1alpha1.Organization).Spec.Owners, ",")
+	}},
+	utilprint.AgeColumn,
+}
@@ -0,0 +1,386 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+// validateTeamRole validates role is a known team role
+func validateTeamRole(role tenancyv1alpha1.TeamRole) error {
+	switch role {
+	case tenancyv1alpha1.TeamRoleAdmin, tenancyv1alpha1.TeamRoleEdit, tenancyv1alpha1.TeamRoleView:
+		return nil
+	}
+	return fmt.Errorf("unknown role %q, must be one of %s, %s, %s", role, tenancyv1alpha1.TeamRoleAdmin, tenancyv1alpha1.TeamRoleEdit, tenancyv1alpha1.TeamRoleView)
+}
+
+// GetTeamsOptions contains options for listing teams
+type GetTeamsOptions struct {
+	*base.Options
+	Org  string
+	Name string
+}
+
+// NewGetTeamsOptions returns a new GetTeamsOptions.
+func NewGetTeamsOptions(streams genericclioptions.IOStreams) *GetTeamsOptions {
+	return &GetTeamsOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields GetTeamsOptions as command line flags to cmd's flagset.
+func (o *GetTeamsOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.Options.BindPrintFlags(cmd)
+	cmd.Flags().StringVar(&o.Org, "org", o.Org, "Organization of the teams")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *GetTeamsOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the GetTeamsOptions are complete and usable.
+func (o *GetTeamsOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Org == "" {
+		errs = append(errs, errors.New("--org is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run gets teams of an organization from the hub api
+func (o *GetTeamsOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	printer := o.Printer("team.tenancy.faros.sh", teamColumns)
+	if o.Name != "" {
+		team, err := client.Teams(o.Org).Get(ctx, o.Name)
+		if err != nil {
+			return err
+		}
+		team.ManagedFields = nil
+		return printer.Print(team)
+	}
+
+	teams, err := client.Teams(o.Org).List(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range teams.Items {
+		teams.Items[i].ManagedFields = nil
+	}
+	return printer.Print(teams)
+}
+
+// CreateTeamOptions contains options for creating teams
+type CreateTeamOptions struct {
+	*base.Options
+	Org         string
+	Name        string
+	Description string
+	Role        string
+	Members     []string
+	Workspaces  []string
+}
+
+// NewCreateTeamOptions returns a new CreateTeamOptions.
+func NewCreateTeamOptions(streams genericclioptions.IOStreams) *CreateTeamOptions {
+	return &CreateTeamOptions{
+		Options: base.NewOptions(streams),
+		Role:    string(tenancyv1alpha1.TeamRoleView),
+	}
+}
+
+// BindFlags binds fields CreateTeamOptions as command line flags to cmd's flagset.
+func (o *CreateTeamOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	cmd.Flags().StringVar(&o.Org, "org", o.Org, "Organization of the team")
+	cmd.Flags().StringVar(&o.Description, "description", o.Description, "Description of the team")
+	cmd.Flags().StringVar(&o.Role, "role", o.Role, "Role of members in workspaces of the team: Admin, Edit or View")
+	cmd.Flags().StringArrayVar(&o.Members, "member", o.Members, "Member emails of the team")
+	cmd.Flags().StringArrayVar(&o.Workspaces, "workspace", o.Workspaces, "Workspaces the role is granted in, all workspaces of the organization if not set")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *CreateTeamOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the CreateTeamOptions are complete and usable.
+func (o *CreateTeamOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Org == "" {
+		errs = append(errs, errors.New("--org is required"))
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("team name is required"))
+	}
+
+	if err := validateTeamRole(tenancyv1alpha1.TeamRole(o.Role)); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run creates a team in an organization through the hub api
+func (o *CreateTeamOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	team := &tenancyv1alpha1.Team{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       tenancyv1alpha1.TeamKind,
+			APIVersion: tenancyv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: o.Name,
+		},
+		Spec: tenancyv1alpha1.TeamSpec{
+			Description: o.Description,
+			Members:     o.Members,
+			Role:        tenancyv1alpha1.TeamRole(o.Role),
+			Workspaces:  o.Workspaces,
+		},
+	}
+
+	team, err = client.Teams(o.Org).Create(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Team %s created in organization %s\n", team.Name, o.Org)
+	return nil
+}
+
+// UpdateTeamOptions contains options for updating teams
+type UpdateTeamOptions struct {
+	*base.Options
+	Org              string
+	Name             string
+	Description      string
+	Role             string
+	AddMembers       []string
+	RemoveMembers    []string
+	AddWorkspaces    []string
+	RemoveWorkspaces []string
+}
+
+// NewUpdateTeamOptions returns a new UpdateTeamOptions.
+func NewUpdateTeamOptions(streams genericclioptions.IOStreams) *UpdateTeamOptions {
+	return &UpdateTeamOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields UpdateTeamOptions as command line flags to cmd's flagset.
+func (o *UpdateTeamOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	cmd.Flags().StringVar(&o.Org, "org", o.Org, "Organization of the team")
+	cmd.Flags().StringVar(&o.Description, "description", o.Description, "Description of the team")
+	cmd.Flags().StringVar(&o.Role, "role", o.Role, "Role of members in workspaces of the team: Admin, Edit or View")
+	cmd.Flags().StringArrayVar(&o.AddMembers, "add-member", o.AddMembers, "Member emails to add to the team")
+	cmd.Flags().StringArrayVar(&o.RemoveMembers, "remove-member", o.RemoveMembers, "Member emails to remove from the team")
+	cmd.Flags().StringArrayVar(&o.AddWorkspaces, "add-workspace", o.AddWorkspaces, "Workspaces to grant the role in")
+	cmd.Flags().StringArrayVar(&o.RemoveWorkspaces, "remove-workspace", o.RemoveWorkspaces, "Workspaces to no longer grant the role in")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *UpdateTeamOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the UpdateTeamOptions are complete and usable.
+func (o *UpdateTeamOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Org == "" {
+		errs = append(errs, errors.New("--org is required"))
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("team name is required"))
+	}
+
+	if o.Role != "" {
+		if err := validateTeamRole(tenancyv1alpha1.TeamRole(o.Role)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run updates a team of an organization through the hub api
+func (o *UpdateTeamOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	team, err := client.Teams(o.Org).Get(ctx, o.Name)
+	if err != nil {
+		return err
+	}
+
+	if o.Description != "" {
+		team.Spec.Description = o.Description
+	}
+	if o.Role != "" {
+		team.Spec.Role = tenancyv1alpha1.TeamRole(o.Role)
+	}
+	team.Spec.Members = update(team.Spec.Members, o.AddMembers, o.RemoveMembers)
+	team.Spec.Workspaces = update(team.Spec.Workspaces, o.AddWorkspaces, o.RemoveWorkspaces)
+
+	team, err = client.Teams(o.Org).Update(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Team %s updated in organization %s\n", team.Name, o.Org)
+	return nil
+}
+
+// DeleteTeamOptions contains options for deleting teams
+type DeleteTeamOptions struct {
+	*base.Options
+	Org  string
+	Name string
+}
+
+// NewDeleteTeamOptions returns a new DeleteTeamOptions.
+func NewDeleteTeamOptions(streams genericclioptions.IOStreams) *DeleteTeamOptions {
+	return &DeleteTeamOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields DeleteTeamOptions as command line flags to cmd's flagset.
+func (o *DeleteTeamOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	cmd.Flags().StringVar(&o.Org, "org", o.Org, "Organization of the team")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *DeleteTeamOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the DeleteTeamOptions are complete and usable.
+func (o *DeleteTeamOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Org == "" {
+		errs = append(errs, errors.New("--org is required"))
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("team name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run deletes a team of an organization through the hub api
+func (o *DeleteTeamOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Teams(o.Org).Delete(ctx, o.Name); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Team %s deleted from organization %s\n", o.Name, o.Org)
+	return nil
+}
+
+// teamColumns are the columns of teams printed as table
+var teamColumns = []utilprint.Column{
+	utilprint.NameColumn,
+	{Name: "ROLE", Value: func(obj runtime.Object) string {
+		return string(obj.(*tenancyv1alpha1.Team).Spec.Role)
+	}},
+	{Name: "MEMBERS", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(strings.Join(obj.(*tenancyv1alpha1.Team).Spec.Members, ","))
+	}},
+	utilprint.AgeColumn,
+	utilprint.Wide(utilprint.Column{Name: "WORKSPACES", Value: func(obj runtime.Object) string {
+		workspaces := obj.(*tenancyv1alpha1.Team).Spec.Workspaces
+		if len(workspaces) == 0 {
+			return "*"
+		}
+		return strings.Join(workspaces, ",")
+	}}),
+}
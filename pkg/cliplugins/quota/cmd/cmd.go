@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/quota/plugin"
+)
+
+var (
+	quotaExample = `
+	# Show usage of workspaces, and agents and access requests of each workspace, against limits
+	%[1]s
+
+	# Show usage against limits, sorted by usage
+	%[1]s --sort-by .used
+
+	# Show effective limits as yaml
+	%[1]s -o yaml
+`
+)
+
+// New provides a cobra command showing usage against limits.
+func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
+	quotaOptions := plugin.NewQuotaOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "quota",
+		Short:        "Show usage against workspace, agent and access request limits",
+		Example:      fmt.Sprintf(quotaExample, "kubectl faros quota"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := quotaOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := quotaOptions.Validate(); err != nil {
+				return err
+			}
+
+			return quotaOptions.Run(c.Context())
+		},
+	}
+	quotaOptions.BindFlags(cmd)
+
+	return cmd, nil
+}
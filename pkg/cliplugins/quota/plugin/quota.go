@@ -0,0 +1,142 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+	"github.com/faroshq/faros-hub/pkg/util/quota"
+)
+
+// QuotaOptions contains options for showing usage against limits
+type QuotaOptions struct {
+	*base.Options
+}
+
+// NewQuotaOptions returns a new QuotaOptions.
+func NewQuotaOptions(streams genericclioptions.IOStreams) *QuotaOptions {
+	return &QuotaOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields QuotaOptions as command line flags to cmd's flagset.
+func (o *QuotaOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.Options.BindPrintFlags(cmd)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *QuotaOptions) Complete(args []string) error {
+	return o.Options.Complete()
+}
+
+// Validate validates the QuotaOptions are complete and usable.
+func (o *QuotaOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run gets usage of the user from the hub api
+func (o *QuotaOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	userQuota, err := client.Quota().Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// tables, custom columns and sorting apply to the usage of each resource,
+	// other formats print the quota itself
+	printer := o.Printer("userquota.tenancy.faros.sh", quotaColumns)
+	if o.Output == "" || o.Output == utilprint.FormatTable || o.Output == utilprint.FormatWide ||
+		strings.HasPrefix(o.Output, utilprint.FormatCustomColumns+"=") {
+		return printer.Print(usageOf(userQuota))
+	}
+	return printer.Print(userQuota)
+}
+
+// quotaUsage is the usage of a resource, of a workspace for per workspace
+// limits, against its limit
+type quotaUsage struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Resource  string `json:"resource"`
+	Workspace string `json:"workspace,omitempty"`
+	Used      int32  `json:"used"`
+	// Limit is the limit of the resource, unset if unlimited
+	Limit *int32 `json:"limit,omitempty"`
+}
+
+func (in *quotaUsage) DeepCopyObject() runtime.Object {
+	out := *in
+	if in.Limit != nil {
+		limit := *in.Limit
+		out.Limit = &limit
+	}
+	return &out
+}
+
+// quotaUsageList is the usage of all resources limited for a user
+type quotaUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Items []quotaUsage `json:"items"`
+}
+
+func (in *quotaUsageList) DeepCopyObject() runtime.Object {
+	out := &quotaUsageList{TypeMeta: in.TypeMeta}
+	for i := range in.Items {
+		out.Items = append(out.Items, *in.Items[i].DeepCopyObject().(*quotaUsage))
+	}
+	return out
+}
+
+// usageOf returns the usage of workspaces, and agents and requests of each
+// workspace, against their limits
+func usageOf(userQuota *tenancyv1alpha1.UserQuota) *quotaUsageList {
+	limits := userQuota.Limits
+	usage := &quotaUsageList{Items: []quotaUsage{
+		{Resource: "workspaces", Used: userQuota.Workspaces, Limit: limits.Workspaces},
+	}}
+	for _, workspace := range userQuota.WorkspaceUsage {
+		usage.Items = append(usage.Items,
+			quotaUsage{Resource: "agents", Workspace: workspace.Name, Used: workspace.Agents, Limit: limits.AgentsPerWorkspace},
+			quotaUsage{Resource: "requests", Workspace: workspace.Name, Used: workspace.Requests, Limit: limits.RequestsPerWorkspace},
+		)
+	}
+	return usage
+}
+
+// quotaColumns are the columns of usage printed as table
+var quotaColumns = []utilprint.Column{
+	{Name: "RESOURCE", Value: func(obj runtime.Object) string {
+		return obj.(*quotaUsage).Resource
+	}},
+	{Name: "WORKSPACE", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(obj.(*quotaUsage).Workspace)
+	}},
+	{Name: "USED", Value: func(obj runtime.Object) string {
+		return fmt.Sprint(obj.(*quotaUsage).Used)
+	}},
+	{Name: "LIMIT", Value: func(obj runtime.Object) string {
+		return quota.String(obj.(*quotaUsage).Limit)
+	}},
+}
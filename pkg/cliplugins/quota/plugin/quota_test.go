@@ -0,0 +1,57 @@
+package plugin
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/utils/pointer"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+func TestPrintUsage(t *testing.T) {
+	userQuota := &tenancyv1alpha1.UserQuota{
+		Limits:     tenancyv1alpha1.UserLimits{Workspaces: pointer.Int32(3), AgentsPerWorkspace: pointer.Int32(10)},
+		Workspaces: 2,
+		WorkspaceUsage: []tenancyv1alpha1.WorkspaceUsage{
+			{Name: "lab", Agents: 4, Requests: 1},
+			{Name: "fleet", Agents: 7},
+		},
+	}
+
+	for _, tt := range []struct {
+		format   string
+		sortBy   string
+		expected string
+	}{
+		{
+			format: utilprint.FormatCustomColumns + "=RESOURCE:.resource,WORKSPACE:.workspace,USED:.used",
+			sortBy: ".used",
+			expected: "RESOURCE   WORKSPACE USED \n" +
+				"requests   fleet     0    \n" +
+				"requests   lab       1    \n" +
+				"workspaces <none>    2    \n" +
+				"agents     lab       4    \n" +
+				"agents     fleet     7    \n",
+		},
+		{
+			format: utilprint.FormatTable,
+			expected: "RESOURCE   WORKSPACE USED LIMIT     \n" +
+				"workspaces <none>    2    3         \n" +
+				"agents     lab       4    10        \n" +
+				"requests   lab       1    unlimited \n" +
+				"agents     fleet     7    10        \n" +
+				"requests   fleet     0    unlimited \n",
+		},
+	} {
+		var out bytes.Buffer
+		printer := &utilprint.Printer{Out: &out, Format: tt.format, Columns: quotaColumns, SortBy: tt.sortBy}
+		if err := printer.Print(usageOf(userQuota)); err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != tt.expected {
+			t.Errorf("%s: expected\n%s\ngot\n%s", tt.format, tt.expected, out.String())
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/registration/plugin"
+)
+
+var (
+	registrationExample = `
+	# Create a registration and print its join token
+	%[1]s create my-fleet
+
+	# Print only the join token, i.e. in install scripts
+	TOKEN=$(%[1]s get my-fleet --token)
+
+	# Issue a new token, revoking the old one
+	%[1]s rotate my-fleet
+`
+)
+
+// New provides a cobra command for registration operations.
+func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Aliases:          []string{"registrations"},
+		Use:              "registration",
+		Short:            "Manages registrations agents join workspaces with",
+		Example:          fmt.Sprintf(registrationExample, "kubectl faros registration"),
+		SilenceUsage:     true,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	createOptions := plugin.NewCreateOptions(streams)
+	createCmd := &cobra.Command{
+		Use:          "create <registration>",
+		Short:        "Create a registration and print its join token",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return c.Help()
+			}
+
+			if err := createOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := createOptions.Validate(); err != nil {
+				return err
+			}
+
+			return createOptions.Run(c.Context())
+		},
+	}
+
+	createOptions.BindFlags(createCmd)
+	cmd.AddCommand(createCmd)
+
+	listOptions := plugin.NewGetOptions(streams)
+	listCmd := &cobra.Command{
+		Aliases:      []string{"ls"},
+		Use:          "list",
+		Short:        "List registrations in the current workspace",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return c.Help()
+			}
+
+			if err := listOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := listOptions.Validate(); err != nil {
+				return err
+			}
+
+			return listOptions.Run(c.Context())
+		},
+	}
+
+	listOptions.BindFlags(listCmd)
+	cmd.AddCommand(listCmd)
+
+	getOptions := plugin.NewGetOptions(streams)
+	getCmd := &cobra.Command{
+		Use:               "get <registration>",
+		Short:             "Get a registration, or its join token with --token",
+		SilenceUsage:      true,
+		ValidArgsFunction: getOptions.ValidArgsFunction(base.CompletionRegistrations, 1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				return c.Help()
+			}
+
+			if err := getOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := getOptions.Validate(); err != nil {
+				return err
+			}
+
+			return getOptions.Run(c.Context())
+		},
+	}
+
+	getOptions.BindFlags(getCmd)
+	getOptions.BindTokenFlags(getCmd)
+	cmd.AddCommand(getCmd)
+
+	rotateOptions := plugin.NewRotateOptions(streams)
+	rotateCmd := &cobra.Command{
+		Use:               "rotate <registration>",
+		Short:             "Issue a new join token, revoking the old one",
+		SilenceUsage:      true,
+		ValidArgsFunction: rotateOptions.ValidArgsFunction(base.CompletionRegistrations, 1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return c.Help()
+			}
+
+			if err := rotateOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := rotateOptions.Validate(); err != nil {
+				return err
+			}
+
+			return rotateOptions.Run(c.Context())
+		},
+	}
+
+	rotateOptions.BindFlags(rotateCmd)
+	cmd.AddCommand(rotateCmd)
+
+	deleteOptions := plugin.NewDeleteOptions(streams)
+	deleteCmd := &cobra.Command{
+		Use:               "delete <registration>...",
+		Short:             "Delete registrations, revoking their tokens",
+		SilenceUsage:      true,
+		ValidArgsFunction: deleteOptions.ValidArgsFunction(base.CompletionRegistrations, 0),
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return c.Help()
+			}
+
+			if err := deleteOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := deleteOptions.Validate(); err != nil {
+				return err
+			}
+
+			return deleteOptions.Run(c.Context())
+		},
+	}
+
+	deleteOptions.BindFlags(deleteCmd)
+	cmd.AddCommand(deleteCmd)
+
+	return cmd, nil
+}
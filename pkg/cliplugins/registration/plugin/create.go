@@ -0,0 +1,115 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+)
+
+// CreateOptions contains options for creating registrations
+type CreateOptions struct {
+	*base.Options
+	// Name of the registration
+	Name string
+	// TokenOnly prints only the join token
+	TokenOnly bool
+}
+
+// NewCreateOptions returns a new CreateOptions.
+func NewCreateOptions(streams genericclioptions.IOStreams) *CreateOptions {
+	return &CreateOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields CreateOptions as command line flags to cmd's flagset.
+func (o *CreateOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+
+	cmd.Flags().BoolVar(&o.TokenOnly, "token", o.TokenOnly, "Print only the join token, i.e. for install scripts")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *CreateOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the CreateOptions are complete and usable.
+func (o *CreateOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("registration name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run creates the registration and waits for its join token
+func (o *CreateOptions) Run(ctx context.Context) error {
+	farosClient, namespace, err := o.FarosClient()
+	if err != nil {
+		return err
+	}
+
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+	coreClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to create k8s client: %w", err)
+	}
+
+	_, err = coreClient.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace: %w", err)
+	}
+
+	_, err = farosClient.EdgeV1alpha1().Registrations(namespace).Create(ctx, &edgev1alpha1.Registration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      o.Name,
+			Namespace: namespace,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	if !o.TokenOnly {
+		fmt.Fprintf(o.Out, "Registration %s created, waiting for token\n", o.Name)
+	}
+	registration, err := base.WaitForRegistrationToken(ctx, farosClient, namespace, o.Name, "")
+	if err != nil {
+		return err
+	}
+
+	return printToken(o.Out, registration, o.TokenOnly)
+}
@@ -0,0 +1,78 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+)
+
+// DeleteOptions contains options for deleting registrations
+type DeleteOptions struct {
+	*base.Options
+	// Names of the registrations to delete
+	Names []string
+}
+
+// NewDeleteOptions returns a new DeleteOptions.
+func NewDeleteOptions(streams genericclioptions.IOStreams) *DeleteOptions {
+	return &DeleteOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields DeleteOptions as command line flags to cmd's flagset.
+func (o *DeleteOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *DeleteOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	o.Names = args
+
+	return nil
+}
+
+// Validate validates the DeleteOptions are complete and usable.
+func (o *DeleteOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(o.Names) == 0 {
+		errs = append(errs, errors.New("registration name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run deletes the registrations, revoking their tokens
+func (o *DeleteOptions) Run(ctx context.Context) error {
+	farosClient, namespace, err := o.FarosClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, name := range o.Names {
+		if err := farosClient.EdgeV1alpha1().Registrations(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		fmt.Fprintf(o.Out, "Registration %s deleted, its token is revoked\n", name)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
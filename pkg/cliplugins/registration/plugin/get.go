@@ -0,0 +1,137 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+// GetOptions contains options for listing registrations, or getting one
+type GetOptions struct {
+	*base.Options
+	// Name of the registration to get. All registrations are listed if empty.
+	Name string
+	// TokenOnly prints only the join token of the registration
+	TokenOnly bool
+}
+
+// NewGetOptions returns a new GetOptions.
+func NewGetOptions(streams genericclioptions.IOStreams) *GetOptions {
+	return &GetOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields GetOptions as command line flags to cmd's flagset.
+func (o *GetOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	o.Options.BindPrintFlags(cmd)
+}
+
+// BindTokenFlags binds the flag printing only the token of a registration.
+func (o *GetOptions) BindTokenFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.TokenOnly, "token", o.TokenOnly, "Print only the join token, i.e. for install scripts")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *GetOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the GetOptions are complete and usable.
+func (o *GetOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.TokenOnly && o.Name == "" {
+		errs = append(errs, errors.New("registration name is required with --token"))
+	}
+
+	if o.TokenOnly && o.Watch {
+		errs = append(errs, errors.New("--token can't be used with --watch"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run lists registrations in the namespace of the current workspace context
+func (o *GetOptions) Run(ctx context.Context) error {
+	farosClient, namespace, err := o.FarosClient()
+	if err != nil {
+		return err
+	}
+
+	registrations := &edgev1alpha1.RegistrationList{}
+	if o.Name != "" {
+		registration, err := farosClient.EdgeV1alpha1().Registrations(namespace).Get(ctx, o.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if o.TokenOnly {
+			if registration.Status.Token == "" {
+				return errors.New("registration has no token yet")
+			}
+			return printToken(o.Out, registration, true)
+		}
+		registrations.Items = append(registrations.Items, *registration)
+	} else {
+		registrations, err = farosClient.EdgeV1alpha1().Registrations(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	// drop managed fields
+	for i := range registrations.Items {
+		registrations.Items[i].ObjectMeta.ManagedFields = nil
+	}
+
+	printer := o.Printer("registration.edge.faros.sh", registrationColumns)
+	listOptions := metav1.ListOptions{ResourceVersion: registrations.ResourceVersion}
+	if o.Name != "" {
+		err = printer.Print(&registrations.Items[0])
+		listOptions.FieldSelector = fields.OneTermEqualSelector("metadata.name", o.Name).String()
+		listOptions.ResourceVersion = registrations.Items[0].ResourceVersion
+	} else {
+		err = printer.Print(registrations)
+	}
+	if err != nil || !o.Watch {
+		return err
+	}
+
+	w, err := farosClient.EdgeV1alpha1().Registrations(namespace).Watch(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	return printer.PrintWatch(ctx, w)
+}
+
+// registrationColumns are the columns of registrations printed as table
+var registrationColumns = []utilprint.Column{
+	utilprint.NameColumn,
+	utilprint.ConditionColumn("READY", conditionsv1alpha1.ReadyCondition),
+	utilprint.ConditionReasonColumn("REASON", conditionsv1alpha1.ReadyCondition),
+	utilprint.AgeColumn,
+	utilprint.Wide(utilprint.ConditionMessageColumn("MESSAGE", conditionsv1alpha1.ReadyCondition)),
+}
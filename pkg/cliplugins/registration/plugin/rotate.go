@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/util/retry"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+)
+
+// RotateOptions contains options for rotating the token of a registration
+type RotateOptions struct {
+	*base.Options
+	// Name of the registration
+	Name string
+	// TokenOnly prints only the new join token
+	TokenOnly bool
+}
+
+// NewRotateOptions returns a new RotateOptions.
+func NewRotateOptions(streams genericclioptions.IOStreams) *RotateOptions {
+	return &RotateOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields RotateOptions as command line flags to cmd's flagset.
+func (o *RotateOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+
+	cmd.Flags().BoolVar(&o.TokenOnly, "token", o.TokenOnly, "Print only the new join token, i.e. for install scripts")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *RotateOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the RotateOptions are complete and usable.
+func (o *RotateOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("registration name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run requests a new token for the registration and waits for it. Agents
+// using the old token lose access until reconfigured.
+func (o *RotateOptions) Run(ctx context.Context) error {
+	farosClient, namespace, err := o.FarosClient()
+	if err != nil {
+		return err
+	}
+
+	var previous string
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		registration, err := farosClient.EdgeV1alpha1().Registrations(namespace).Get(ctx, o.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		previous = registration.Status.Token
+
+		registrationCopy := registration.DeepCopy()
+		if registrationCopy.Annotations == nil {
+			registrationCopy.Annotations = map[string]string{}
+		}
+		registrationCopy.Annotations[edgev1alpha1.RegistrationRotateTokenAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		_, err = farosClient.EdgeV1alpha1().Registrations(namespace).Update(ctx, registrationCopy, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if !o.TokenOnly {
+		fmt.Fprintf(o.Out, "Rotating token of registration %s\n", o.Name)
+	}
+	registration, err := base.WaitForRegistrationToken(ctx, farosClient, namespace, o.Name, previous)
+	if err != nil {
+		return err
+	}
+
+	return printToken(o.Out, registration, o.TokenOnly)
+}
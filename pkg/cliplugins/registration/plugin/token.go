@@ -0,0 +1,25 @@
+package plugin
+
+import (
+	"fmt"
+	"io"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+)
+
+// printToken prints the join token of the registration, alone if tokenOnly
+func printToken(out io.Writer, registration *edgev1alpha1.Registration, tokenOnly bool) error {
+	if tokenOnly {
+		_, err := fmt.Fprintln(out, registration.Status.Token)
+		return err
+	}
+
+	_, err := fmt.Fprintf(out, `Join token of registration %[1]s:
+
+%[2]s
+
+Generate agent manifests using the registration with:
+  kubectl faros agent generate <agent-name> --registration %[1]s -f agent.yaml
+`, registration.Name, registration.Status.Token)
+	return err
+}
@@ -1,12 +1,47 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
 	"github.com/faroshq/faros-hub/pkg/cliplugins/workspace/plugin"
 )
 
+var (
+	useExample = `
+	# Switch the current context to a workspace
+	%[1]s my-workspace
+
+	# Switch back to the previous workspace, or context
+	%[1]s -
+
+	# Write a standalone kubeconfig for a workspace, keeping the current one
+	%[1]s my-workspace --kubeconfig-out my-workspace.kubeconfig
+
+	# Switch the current context to a workspace of an organization
+	%[1]s fleet --org acme
+`
+
+	exportExample = `
+	# Export a workspace to my-workspace.tar.gz
+	%[1]s my-workspace
+
+	# Export a workspace to stdout
+	%[1]s my-workspace -f -
+`
+
+	importExample = `
+	# Import an export into the exported workspace, creating it if it does not exist
+	%[1]s -f my-workspace.tar.gz
+
+	# Import an export into another workspace, keeping objects existing in it
+	%[1]s other-workspace -f my-workspace.tar.gz --conflict Skip
+`
+)
+
 // New provides a cobra command for workload operations.
 func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 	cmd := &cobra.Command{
@@ -22,9 +57,10 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 
 	getWorkspacesOptions := plugin.NewGetWorkspacesOptions(streams)
 	getWorkspacesCmd := &cobra.Command{
-		Use:          "get",
-		Short:        "Get a workspaces",
-		SilenceUsage: true,
+		Use:               "get",
+		Short:             "Get a workspaces",
+		SilenceUsage:      true,
+		ValidArgsFunction: getWorkspacesOptions.ValidArgsFunction(base.CompletionWorkspaces, 1),
 		RunE: func(c *cobra.Command, args []string) error {
 			if err := getWorkspacesOptions.Complete(args); err != nil {
 				return err
@@ -58,9 +94,10 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 
 	deleteWorkspacesOptions := plugin.NewDeleteWorkspacesOptions(streams)
 	deleteWorkspacesCmd := &cobra.Command{
-		Use:          "delete",
-		Short:        "Delete a workspaces",
-		SilenceUsage: true,
+		Use:               "delete",
+		Short:             "Delete a workspaces",
+		SilenceUsage:      true,
+		ValidArgsFunction: deleteWorkspacesOptions.ValidArgsFunction(base.CompletionWorkspaces, 1),
 		RunE: func(c *cobra.Command, args []string) error {
 			if err := deleteWorkspacesOptions.Complete(args); err != nil {
 				return err
@@ -74,11 +111,71 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 		},
 	}
 
+	restoreWorkspacesOptions := plugin.NewRestoreWorkspacesOptions(streams)
+	restoreWorkspacesCmd := &cobra.Command{
+		Use:               "restore",
+		Short:             "Restore a workspace deleted within its grace period",
+		SilenceUsage:      true,
+		ValidArgsFunction: restoreWorkspacesOptions.ValidArgsFunction(base.CompletionWorkspaces, 1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := restoreWorkspacesOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := restoreWorkspacesOptions.Validate(); err != nil {
+				return err
+			}
+
+			return restoreWorkspacesOptions.Run(c.Context())
+		},
+	}
+
+	exportWorkspacesOptions := plugin.NewExportWorkspacesOptions(streams)
+	exportWorkspacesCmd := &cobra.Command{
+		Use:               "export",
+		Short:             "Export the faros objects of a workspace to a tarball",
+		Example:           fmt.Sprintf(exportExample, "kubectl faros workspace export"),
+		SilenceUsage:      true,
+		ValidArgsFunction: exportWorkspacesOptions.ValidArgsFunction(base.CompletionWorkspaces, 1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := exportWorkspacesOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := exportWorkspacesOptions.Validate(); err != nil {
+				return err
+			}
+
+			return exportWorkspacesOptions.Run(c.Context())
+		},
+	}
+
+	importWorkspacesOptions := plugin.NewImportWorkspacesOptions(streams)
+	importWorkspacesCmd := &cobra.Command{
+		Use:          "import [workspace]",
+		Short:        "Import a workspace export into a new or existing workspace",
+		Example:      fmt.Sprintf(importExample, "kubectl faros workspace import"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := importWorkspacesOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := importWorkspacesOptions.Validate(); err != nil {
+				return err
+			}
+
+			return importWorkspacesOptions.Run(c.Context())
+		},
+	}
+
 	useWorkspacesOptions := plugin.NewUseWorkspacesOptions(streams)
 	useWorkspacesCmd := &cobra.Command{
-		Use:          "use",
-		Short:        "Use a workspaces",
-		SilenceUsage: true,
+		Use:               "use <workspace>|-",
+		Short:             "Use a workspace, or the previous one with -",
+		Example:           fmt.Sprintf(useExample, "kubectl faros workspace use"),
+		SilenceUsage:      true,
+		ValidArgsFunction: useWorkspacesOptions.ValidArgsFunction(base.CompletionWorkspaces, 1),
 		RunE: func(c *cobra.Command, args []string) error {
 			if err := useWorkspacesOptions.Complete(args); err != nil {
 				return err
@@ -92,6 +189,28 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 		},
 	}
 
+	currentWorkspaceOptions := plugin.NewCurrentWorkspaceOptions(streams)
+	currentWorkspaceCmd := &cobra.Command{
+		Use:          "current",
+		Short:        "Print the workspace of the current context",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return c.Help()
+			}
+
+			if err := currentWorkspaceOptions.Complete(args); err != nil {
+				return err
+			}
+
+			if err := currentWorkspaceOptions.Validate(); err != nil {
+				return err
+			}
+
+			return currentWorkspaceOptions.Run(c.Context())
+		},
+	}
+
 	getWorkspacesOptions.BindFlags(getWorkspacesCmd)
 	cmd.AddCommand(getWorkspacesCmd)
 
@@ -101,8 +220,20 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 	deleteWorkspacesOptions.BindFlags(deleteWorkspacesCmd)
 	cmd.AddCommand(deleteWorkspacesCmd)
 
+	restoreWorkspacesOptions.BindFlags(restoreWorkspacesCmd)
+	cmd.AddCommand(restoreWorkspacesCmd)
+
+	exportWorkspacesOptions.BindFlags(exportWorkspacesCmd)
+	cmd.AddCommand(exportWorkspacesCmd)
+
+	importWorkspacesOptions.BindFlags(importWorkspacesCmd)
+	cmd.AddCommand(importWorkspacesCmd)
+
 	useWorkspacesOptions.BindFlags(useWorkspacesCmd)
 	cmd.AddCommand(useWorkspacesCmd)
 
+	currentWorkspaceOptions.BindFlags(currentWorkspaceCmd)
+	cmd.AddCommand(currentWorkspaceCmd)
+
 	return cmd, nil
 }
@@ -2,41 +2,51 @@ package plugin
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/url"
 
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
-	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
 	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
 )
 
 // GetWorkspacesOptions contains options for configuring faros workspaces
 type CreateWorkspacesOptions struct {
 	*base.Options
+	*base.WaitOptions
 	Name        string
 	Description string
 	Members     []string
+	Template    string
+	Shell       bool
+	// Org is the organization the workspace is created for, the current
+	// user if empty
+	Org string
 }
 
 // NewCreateWorkspacesOptions returns a new NewCreateWorkspacesOptions.
 func NewCreateWorkspacesOptions(streams genericclioptions.IOStreams) *CreateWorkspacesOptions {
 	return &CreateWorkspacesOptions{
-		Options: base.NewOptions(streams),
+		Options:     base.NewOptions(streams),
+		WaitOptions: base.NewWaitOptions(),
 	}
 }
 
 // BindFlags binds fields GenerateOptions as command line flags to cmd's flagset.
 func (o *CreateWorkspacesOptions) BindFlags(cmd *cobra.Command) {
 	o.Options.BindFlags(cmd)
+	o.WaitOptions.BindFlags(cmd)
 
 	cmd.Flags().StringArrayVarP(&o.Members, "members", "m", o.Members, "Additional members emails to add to the workspace")
 	cmd.Flags().StringVarP(&o.Description, "description", "d", o.Description, "Description of the workspace")
+	cmd.Flags().StringVar(&o.Template, "template", o.Template, "Name of the WorkspaceTemplate to provision the workspace with")
+	cmd.Flags().BoolVar(&o.Shell, "shell", o.Shell, "Allow remote shell sessions to the agents of the workspace, which Access plugins of the agents must enable as well")
+	bindOrgFlag(cmd, &o.Org)
 
 }
 
@@ -61,6 +71,10 @@ func (o *CreateWorkspacesOptions) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if err := o.WaitOptions.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
 	if o.Name == "" {
 		errs = append(errs, fmt.Errorf("workspace name is required"))
 	}
@@ -70,23 +84,12 @@ func (o *CreateWorkspacesOptions) Validate() error {
 
 // Run gets workspaces from tenant workspace api
 func (o *CreateWorkspacesOptions) Run(ctx context.Context) error {
-	config, err := o.ClientConfig.ClientConfig()
-	if err != nil {
-		return err
-	}
-
-	u, err := url.Parse(config.Host)
+	client, err := o.HubClient()
 	if err != nil {
 		return err
 	}
-	config.Host = u.Host
 
-	farosClient, err := farosclient.NewForConfig(config)
-	if err != nil {
-		return err
-	}
-
-	workspace := tenancyv1alpha1.Workspace{
+	workspace := &tenancyv1alpha1.Workspace{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       tenancyv1alpha1.WorkspaceKind,
 			APIVersion: tenancyv1alpha1.SchemeGroupVersion.String(),
@@ -97,18 +100,34 @@ func (o *CreateWorkspacesOptions) Run(ctx context.Context) error {
 		Spec: tenancyv1alpha1.WorkspaceSpec{
 			Description: o.Description,
 			Members:     o.Members,
+			Template:    o.Template,
 		},
 	}
+	if o.Shell {
+		workspace.Spec.Shell = &tenancyv1alpha1.WorkspaceShellSpec{Enabled: true}
+	}
 
-	patch, err := json.Marshal(workspace)
+	workspace, err = client.Workspaces(o.Org).Create(ctx, workspace)
 	if err != nil {
-		return fmt.Errorf("error creating patch: %v", err)
+		return err
+	}
+	fmt.Fprintf(o.Out, "Workspace %s created\n", workspace.Name)
+
+	if !o.Wait {
+		return nil
 	}
 
-	err = farosClient.RESTClient().Post().Body(patch).AbsPath("/faros.sh/api/v1alpha1/workspaces").Do(ctx).Into(&workspace)
+	err = o.Poll(ctx, fmt.Sprintf("workspace %s to be ready", workspace.Name), func(ctx context.Context) (bool, error) {
+		workspace, err = client.Workspaces(o.Org).Get(ctx, workspace.Name)
+		if err != nil {
+			return false, err
+		}
+		return conditions.IsTrue(workspace, conditionsv1alpha1.ReadyCondition) && workspace.Status.WorkspaceURL != "", nil
+	})
 	if err != nil {
 		return err
 	}
 
+	fmt.Fprintf(o.Out, "Workspace %s is ready\n", workspace.Name)
 	return nil
 }
@@ -0,0 +1,70 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+)
+
+// CurrentWorkspaceOptions contains options for printing the current workspace
+type CurrentWorkspaceOptions struct {
+	*base.Options
+}
+
+// NewCurrentWorkspaceOptions returns a new CurrentWorkspaceOptions.
+func NewCurrentWorkspaceOptions(streams genericclioptions.IOStreams) *CurrentWorkspaceOptions {
+	return &CurrentWorkspaceOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields CurrentWorkspaceOptions as command line flags to cmd's flagset.
+func (o *CurrentWorkspaceOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *CurrentWorkspaceOptions) Complete(args []string) error {
+	return o.Options.Complete()
+}
+
+// Validate validates the CurrentWorkspaceOptions are complete and usable.
+func (o *CurrentWorkspaceOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run prints the workspace of the current context
+func (o *CurrentWorkspaceOptions) Run(ctx context.Context) error {
+	rawConfig, err := o.ClientConfig.RawConfig()
+	if err != nil {
+		return err
+	}
+
+	currentContext := rawConfig.CurrentContext
+	if o.KubectlOverrides.CurrentContext != "" {
+		currentContext = o.KubectlOverrides.CurrentContext
+	}
+
+	profile, workspace, ok := base.ParseWorkspaceContextName(currentContext)
+	if !ok {
+		return fmt.Errorf("current context %q is not a faros workspace, run 'kubectl faros workspace use <workspace>' first", currentContext)
+	}
+
+	if profile == base.DefaultProfile {
+		fmt.Fprintln(o.Out, workspace)
+	} else {
+		fmt.Fprintf(o.Out, "%s (profile %s)\n", workspace, profile)
+	}
+	return nil
+}
@@ -3,34 +3,46 @@ package plugin
 import (
 	"context"
 	"fmt"
-	"net/url"
 
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/utils/pointer"
 
 	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
-	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
 	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
 )
 
 // DeleteWorkspacesOptions contains options for configuring faros workspaces
 type DeleteWorkspacesOptions struct {
 	*base.Options
+	*base.WaitOptions
 
 	Name string
+	// Force deletes the workspace without a grace period. It is archived
+	// before it is deleted still.
+	Force bool
+	// Org is the organization of the workspace, the current user if empty
+	Org string
 }
 
 // NewGetWorkspacesOptions returns a new GetWorkspacesOptions.
 func NewDeleteWorkspacesOptions(streams genericclioptions.IOStreams) *DeleteWorkspacesOptions {
 	return &DeleteWorkspacesOptions{
-		Options: base.NewOptions(streams),
+		Options:     base.NewOptions(streams),
+		WaitOptions: base.NewWaitOptions(),
 	}
 }
 
 // BindFlags binds fields GenerateOptions as command line flags to cmd's flagset.
 func (o *DeleteWorkspacesOptions) BindFlags(cmd *cobra.Command) {
 	o.Options.BindFlags(cmd)
+	o.WaitOptions.BindFlags(cmd)
+	cmd.Flags().BoolVar(&o.Force, "force", o.Force, "Delete the workspace without a grace period to restore it")
+	bindOrgFlag(cmd, &o.Org)
 }
 
 // Complete ensures all dynamically populated fields are initialized.
@@ -54,34 +66,71 @@ func (o *DeleteWorkspacesOptions) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if err := o.WaitOptions.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
 	return utilerrors.NewAggregate(errs)
 }
 
 // Run gets workspaces from tenant workspace api
 func (o *DeleteWorkspacesOptions) Run(ctx context.Context) error {
-	config, err := o.ClientConfig.ClientConfig()
+	client, err := o.HubClient()
 	if err != nil {
 		return err
 	}
 
-	u, err := url.Parse(config.Host)
-	if err != nil {
-		return err
+	var opts metav1.DeleteOptions
+	if o.Force {
+		opts.GracePeriodSeconds = pointer.Int64(0)
 	}
-	config.Host = u.Host
-
-	farosclient, err := farosclient.NewForConfig(config)
+	workspace, err := client.Workspaces(o.Org).Delete(ctx, o.Name, opts)
 	if err != nil {
 		return err
 	}
 
-	workspace := &tenancyv1alpha1.Workspace{}
+	if !o.Wait {
+		o.printDeleted(workspace)
+		return nil
+	}
 
-	err = farosclient.RESTClient().Delete().AbsPath("/faros.sh/api/v1alpha1/workspaces/" + o.Name).Do(ctx).Into(workspace)
+	if o.Force {
+		err = o.Poll(ctx, fmt.Sprintf("workspace %s to be deleted", o.Name), func(ctx context.Context) (bool, error) {
+			_, err := client.Workspaces(o.Org).Get(ctx, o.Name)
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		})
+	} else {
+		err = o.Poll(ctx, fmt.Sprintf("workspace %s to be archived", o.Name), func(ctx context.Context) (bool, error) {
+			workspace, err = client.Workspaces(o.Org).Get(ctx, o.Name)
+			if err != nil {
+				return false, err
+			}
+			if condition := conditions.Get(workspace, tenancyv1alpha1.WorkspaceArchived); condition != nil && condition.Reason == tenancyv1alpha1.WorkspaceArchiveFailedReason {
+				return false, fmt.Errorf("failed to archive workspace %s: %s", o.Name, condition.Message)
+			}
+			return conditions.IsTrue(workspace, tenancyv1alpha1.WorkspaceArchived), nil
+		})
+	}
 	if err != nil {
 		return err
 	}
 
-	fmt.Println("Workspace deleted successfully")
+	o.printDeleted(workspace)
 	return nil
 }
+
+// printDeleted prints when the workspace is deleted, and how to restore it
+// until then
+func (o *DeleteWorkspacesOptions) printDeleted(workspace *tenancyv1alpha1.Workspace) {
+	if o.Force {
+		fmt.Fprintln(o.Out, "Workspace deleted successfully")
+		return
+	}
+
+	deadline := workspace.Annotations[tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation]
+	fmt.Fprintf(o.Out, "Workspace %s will be deleted at %s\n", workspace.Name, deadline)
+	fmt.Fprintf(o.Out, "Restore it until then with: kubectl faros workspace restore %s\n", workspace.Name)
+}
@@ -0,0 +1,95 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+)
+
+// ExportWorkspacesOptions contains options for exporting workspaces
+type ExportWorkspacesOptions struct {
+	*base.Options
+
+	Name string
+	// OutputFile is the file the export is written to, - for stdout
+	OutputFile string
+	// Org is the organization of the workspace, the current user if empty
+	Org string
+}
+
+// NewExportWorkspacesOptions returns a new ExportWorkspacesOptions.
+func NewExportWorkspacesOptions(streams genericclioptions.IOStreams) *ExportWorkspacesOptions {
+	return &ExportWorkspacesOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields ExportWorkspacesOptions as command line flags to cmd's flagset.
+func (o *ExportWorkspacesOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+
+	cmd.Flags().StringVarP(&o.OutputFile, "file", "f", o.OutputFile, "The file to write the export to, <workspace>.tar.gz by default. Use - for stdout.")
+	bindOrgFlag(cmd, &o.Org)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *ExportWorkspacesOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+	if o.OutputFile == "" && o.Name != "" {
+		o.OutputFile = o.Name + ".tar.gz"
+	}
+
+	return nil
+}
+
+// Validate validates the ExportWorkspacesOptions are complete and usable.
+func (o *ExportWorkspacesOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("workspace name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run downloads a tarball of the faros objects of a workspace from the hub api
+func (o *ExportWorkspacesOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.Workspaces(o.Org).Export(ctx, o.Name)
+	if err != nil {
+		return err
+	}
+
+	if o.OutputFile == "-" {
+		_, err := o.Out.Write(data)
+		return err
+	}
+	if err := os.WriteFile(o.OutputFile, data, 0600); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Workspace %s exported to %s\n", o.Name, o.OutputFile)
+	return nil
+}
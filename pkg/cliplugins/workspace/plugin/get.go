@@ -2,15 +2,19 @@ package plugin
 
 import (
 	"context"
-	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
-	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
 	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
 	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
 )
@@ -19,6 +23,8 @@ import (
 type GetWorkspacesOptions struct {
 	*base.Options
 	Name string
+	// Org is the organization of the workspaces, the current user if empty
+	Org string
 }
 
 // NewGetWorkspacesOptions returns a new GetWorkspacesOptions.
@@ -31,6 +37,8 @@ func NewGetWorkspacesOptions(streams genericclioptions.IOStreams) *GetWorkspaces
 // BindFlags binds fields GenerateOptions as command line flags to cmd's flagset.
 func (o *GetWorkspacesOptions) BindFlags(cmd *cobra.Command) {
 	o.Options.BindFlags(cmd)
+	o.Options.BindPrintFlags(cmd)
+	bindOrgFlag(cmd, &o.Org)
 }
 
 // Complete ensures all dynamically populated fields are initialized.
@@ -59,27 +67,23 @@ func (o *GetWorkspacesOptions) Validate() error {
 
 // Run gets workspaces from tenant workspace api
 func (o *GetWorkspacesOptions) Run(ctx context.Context) error {
-	config, err := o.ClientConfig.ClientConfig()
-	if err != nil {
-		return err
-	}
-
-	u, err := url.Parse(config.Host)
-	if err != nil {
-		return err
-	}
-	config.Host = u.Host
-
-	farosclient, err := farosclient.NewForConfig(config)
+	client, err := o.HubClient()
 	if err != nil {
 		return err
 	}
 
 	workspaces := &tenancyv1alpha1.WorkspaceList{}
-
-	err = farosclient.RESTClient().Get().AbsPath("/faros.sh/api/v1alpha1/workspaces").Do(ctx).Into(workspaces)
-	if err != nil {
-		return err
+	if o.Name != "" {
+		workspace, err := client.Workspaces(o.Org).Get(ctx, o.Name)
+		if err != nil {
+			return err
+		}
+		workspaces.Items = append(workspaces.Items, *workspace)
+	} else {
+		workspaces, err = client.Workspaces(o.Org).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
 	}
 
 	// drop managed fields
@@ -87,23 +91,58 @@ func (o *GetWorkspacesOptions) Run(ctx context.Context) error {
 		workspaces.Items[i].ObjectMeta.ManagedFields = nil
 	}
 
-	if o.Output == utilprint.FormatTable {
-		table := utilprint.DefaultTable()
-		table.SetHeader([]string{"NAME", "MEMBERS", "DESCRIPTION", "STATUS", "AGE"})
-		for _, workspace := range workspaces.Items {
-			{
-				table.Append([]string{
-					workspace.Name,
-					strings.Join(workspace.Spec.Members, ","),
-					workspace.Spec.Description,
-					string(workspace.Status.Conditions[0].Status),
-					utilprint.Since(workspace.CreationTimestamp.Time).String()},
-				)
-			}
-		}
-		table.Render()
-		return nil
+	printer := o.Printer("workspace.tenancy.faros.sh", workspaceColumns)
+	var opts metav1.ListOptions
+	if o.Name != "" {
+		err = printer.Print(&workspaces.Items[0])
+		opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", o.Name).String()
+		opts.ResourceVersion = workspaces.Items[0].ResourceVersion
+	} else {
+		err = printer.Print(workspaces)
+		opts.ResourceVersion = workspaces.ResourceVersion
+	}
+	if err != nil || !o.Watch {
+		return err
 	}
 
-	return utilprint.PrintWithFormat(workspaces, o.Output)
+	w, err := client.Workspaces(o.Org).Watch(ctx, opts)
+	if err != nil {
+		return err
+	}
+	return printer.PrintWatch(ctx, w)
+}
+
+// workspaceColumns are the columns of workspaces printed as table
+var workspaceColumns = []utilprint.Column{
+	utilprint.NameColumn,
+	{Name: "MEMBERS", Value: func(obj runtime.Object) string {
+		return strings.Join(obj.(*tenancyv1alpha1.Workspace).Spec.Members, ",")
+	}},
+	{Name: "DESCRIPTION", Value: func(obj runtime.Object) string {
+		return obj.(*tenancyv1alpha1.Workspace).Spec.Description
+	}},
+	utilprint.ConditionColumn("STATUS", conditionsv1alpha1.ReadyCondition),
+	{Name: "PHASE", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(string(obj.(*tenancyv1alpha1.Workspace).Status.Phase))
+	}},
+	utilprint.AgeColumn,
+	utilprint.Wide(utilprint.ConditionReasonColumn("REASON", conditionsv1alpha1.ReadyCondition)),
+	utilprint.Wide(utilprint.Column{Name: "URL", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(obj.(*tenancyv1alpha1.Workspace).Status.WorkspaceURL)
+	}}),
+	utilprint.Wide(utilprint.Column{Name: "TEMPLATE", Value: func(obj runtime.Object) string {
+		return utilprint.ValueOrNone(obj.(*tenancyv1alpha1.Workspace).Spec.Template)
+	}}),
+	utilprint.Wide(utilprint.ConditionColumn("IN SYNC", tenancyv1alpha1.WorkspaceTemplateInSync)),
+	utilprint.Wide(utilprint.Column{Name: "SHELL", Value: func(obj runtime.Object) string {
+		shell := obj.(*tenancyv1alpha1.Workspace).Spec.Shell
+		return strconv.FormatBool(shell != nil && shell.Enabled)
+	}}),
+	utilprint.Wide(utilprint.Column{Name: "DELETION", Value: func(obj runtime.Object) string {
+		deadline := obj.(*tenancyv1alpha1.Workspace).Status.DeletionDeadline
+		if deadline == nil {
+			return "<none>"
+		}
+		return deadline.UTC().Format(time.RFC3339)
+	}}),
 }
@@ -0,0 +1,139 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+	utilprint "github.com/faroshq/faros-hub/pkg/util/print"
+)
+
+// conflictPolicies are the supported values of --conflict
+var conflictPolicies = []tenancyv1alpha1.ImportConflictPolicy{
+	tenancyv1alpha1.ImportConflictFail,
+	tenancyv1alpha1.ImportConflictSkip,
+	tenancyv1alpha1.ImportConflictOverwrite,
+}
+
+// ImportWorkspacesOptions contains options for importing workspace exports
+type ImportWorkspacesOptions struct {
+	*base.Options
+
+	// Name is the workspace to import into, the exported one if empty
+	Name string
+	// Filename is the export to import, - for stdin
+	Filename string
+	// ConflictPolicy is how objects existing in the workspace are handled
+	ConflictPolicy string
+	// Org is the organization to import into, the current user if empty
+	Org string
+}
+
+// NewImportWorkspacesOptions returns a new ImportWorkspacesOptions.
+func NewImportWorkspacesOptions(streams genericclioptions.IOStreams) *ImportWorkspacesOptions {
+	return &ImportWorkspacesOptions{
+		Options:        base.NewOptions(streams),
+		ConflictPolicy: string(tenancyv1alpha1.ImportConflictFail),
+	}
+}
+
+// BindFlags binds fields ImportWorkspacesOptions as command line flags to cmd's flagset.
+func (o *ImportWorkspacesOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+
+	cmd.Flags().StringVarP(&o.Filename, "filename", "f", o.Filename, "Export to import, as written by workspace export. Use - for stdin.")
+	cmd.Flags().StringVar(&o.ConflictPolicy, "conflict", o.ConflictPolicy, fmt.Sprintf("How to handle objects existing in the workspace, one of %s", joinPolicies()))
+	bindOrgFlag(cmd, &o.Org)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *ImportWorkspacesOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the ImportWorkspacesOptions are complete and usable.
+func (o *ImportWorkspacesOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Filename == "" {
+		errs = append(errs, errors.New("--filename is required"))
+	}
+
+	valid := false
+	for _, policy := range conflictPolicies {
+		if strings.EqualFold(o.ConflictPolicy, string(policy)) {
+			o.ConflictPolicy = string(policy)
+			valid = true
+		}
+	}
+	if !valid {
+		errs = append(errs, fmt.Errorf("invalid --conflict %q, expected one of %s", o.ConflictPolicy, joinPolicies()))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run uploads an export to the hub api, which recreates its objects in the
+// workspace
+func (o *ImportWorkspacesOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if o.Filename == "-" {
+		data, err = io.ReadAll(o.In)
+	} else {
+		data, err = os.ReadFile(o.Filename)
+	}
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Imports().Create(ctx, data, hub.ImportOptions{
+		Org:            o.Org,
+		Workspace:      o.Name,
+		ConflictPolicy: tenancyv1alpha1.ImportConflictPolicy(o.ConflictPolicy),
+	})
+	if err != nil {
+		return err
+	}
+
+	if o.Output != utilprint.FormatTable && o.Output != utilprint.FormatWide {
+		return o.Printer("workspaceimport.tenancy.faros.sh", nil).Print(result)
+	}
+	fmt.Fprintf(o.Out, "Workspace %s imported: %d created, %d updated, %d skipped\n", qualifiedName(o.Org, result.Name), len(result.Created), len(result.Updated), len(result.Skipped))
+	return nil
+}
+
+// joinPolicies returns the supported conflict policies for flag usage
+func joinPolicies() string {
+	names := make([]string, len(conflictPolicies))
+	for i, policy := range conflictPolicies {
+		names[i] = string(policy)
+	}
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,21 @@
+package plugin
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// bindOrgFlag binds the flag selecting workspaces of an organization to
+// cmd's flagset
+func bindOrgFlag(cmd *cobra.Command, org *string) {
+	cmd.Flags().StringVar(org, "org", *org, "Organization of the workspace, workspaces of the current user if empty")
+}
+
+// qualifiedName returns the name of workspace name of organization org in
+// output and kubeconfig contexts, <org>/<name> for workspaces of
+// organizations
+func qualifiedName(org, name string) string {
+	if org == "" {
+		return name
+	}
+	return org + "/" + name
+}
@@ -0,0 +1,79 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+)
+
+// RestoreWorkspacesOptions contains options for restoring terminating workspaces
+type RestoreWorkspacesOptions struct {
+	*base.Options
+
+	Name string
+	// Org is the organization of the workspace, the current user if empty
+	Org string
+}
+
+// NewRestoreWorkspacesOptions returns a new RestoreWorkspacesOptions.
+func NewRestoreWorkspacesOptions(streams genericclioptions.IOStreams) *RestoreWorkspacesOptions {
+	return &RestoreWorkspacesOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields RestoreWorkspacesOptions as command line flags to cmd's flagset.
+func (o *RestoreWorkspacesOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	bindOrgFlag(cmd, &o.Org)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *RestoreWorkspacesOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if o.Name == "" && len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return nil
+}
+
+// Validate validates the RestoreWorkspacesOptions are complete and usable.
+func (o *RestoreWorkspacesOptions) Validate() error {
+	var errs []error
+
+	if err := o.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.Name == "" {
+		errs = append(errs, errors.New("workspace name is required"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run cancels deletion of a terminating workspace
+func (o *RestoreWorkspacesOptions) Run(ctx context.Context) error {
+	client, err := o.HubClient()
+	if err != nil {
+		return err
+	}
+
+	workspace, err := client.Workspaces(o.Org).Restore(ctx, o.Name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Workspace %s restored successfully\n", workspace.Name)
+	return nil
+}
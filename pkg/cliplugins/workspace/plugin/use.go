@@ -2,26 +2,32 @@ package plugin
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"net/url"
+	"sort"
 
 	"github.com/spf13/cobra"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
-
-	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
-	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
-	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
-)
 
-var kubeConfigAuthKey = "faros"
+	"github.com/faroshq/faros-hub/pkg/cliplugins/base"
+)
 
 // UseWorkspacesOptions contains options for configuring faros workspaces
 type UseWorkspacesOptions struct {
 	*base.Options
+	// Name of the workspace to use, or - for the previous one
 	Name string
+	// Profile the workspace is used with. Defaults to the profile of the
+	// current workspace or login context.
+	Profile string
+	// KubeconfigOut is a file a standalone kubeconfig for the workspace is
+	// written to, instead of modifying the current kubeconfig
+	KubeconfigOut string
+	// Org is the organization of the workspace, the current user if empty
+	Org string
 
 	// for testing
 	modifyConfig func(configAccess clientcmd.ConfigAccess, newConfig *clientcmdapi.Config) error
@@ -40,6 +46,9 @@ func NewUseWorkspacesOptions(streams genericclioptions.IOStreams) *UseWorkspaces
 // BindFlags binds fields GenerateOptions as command line flags to cmd's flagset.
 func (o *UseWorkspacesOptions) BindFlags(cmd *cobra.Command) {
 	o.Options.BindFlags(cmd)
+	cmd.Flags().StringVar(&o.Profile, "profile", o.Profile, "Login profile to use the workspace with, defaults to the profile of the current context")
+	cmd.Flags().StringVar(&o.KubeconfigOut, "kubeconfig-out", o.KubeconfigOut, "Write a standalone kubeconfig for the workspace to this file instead of modifying the current kubeconfig")
+	bindOrgFlag(cmd, &o.Org)
 }
 
 // Complete ensures all dynamically populated fields are initialized.
@@ -52,6 +61,22 @@ func (o *UseWorkspacesOptions) Complete(args []string) error {
 		o.Name = args[0]
 	}
 
+	if o.Profile == "" {
+		rawConfig, err := o.ClientConfig.RawConfig()
+		if err != nil {
+			return err
+		}
+		currentContext := rawConfig.CurrentContext
+		if o.KubectlOverrides.CurrentContext != "" {
+			currentContext = o.KubectlOverrides.CurrentContext
+		}
+		// the profile of the current workspace, or the one logged in last
+		o.Profile = base.DefaultProfile
+		if profile, ok := base.ProfileOfContext(currentContext); ok {
+			o.Profile = profile
+		}
+	}
+
 	return nil
 }
 
@@ -63,63 +88,151 @@ func (o *UseWorkspacesOptions) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if o.Name == "" {
+		errs = append(errs, errors.New("workspace name is required, or - for the previous workspace"))
+	}
+
+	if o.Name == "-" && o.KubeconfigOut != "" {
+		errs = append(errs, errors.New("--kubeconfig-out can't be used with the previous workspace"))
+	}
+
 	return utilerrors.NewAggregate(errs)
 }
 
-// Run gets workspaces from tenant workspace api
+// Run switches the current context to the workspace
 func (o *UseWorkspacesOptions) Run(ctx context.Context) error {
-	config, err := o.ClientConfig.ClientConfig()
+	rawConfig, err := o.ClientConfig.RawConfig()
 	if err != nil {
 		return err
 	}
 
-	u, err := url.Parse(config.Host)
+	if o.Name == "-" {
+		return o.usePrevious(&rawConfig)
+	}
+
+	client, err := o.ProfileHubClient(o.Profile)
 	if err != nil {
 		return err
 	}
-	config.Host = u.Host
 
-	farosclient, err := farosclient.NewForConfig(config)
+	workspace, err := client.Workspaces(o.Org).Get(ctx, o.Name)
 	if err != nil {
 		return err
 	}
 
-	workspace := &tenancyv1alpha1.Workspace{}
+	if workspace.Status.WorkspaceURL == "" {
+		return fmt.Errorf("workspace %s is not ready yet", workspace.Name)
+	}
 
-	err = farosclient.RESTClient().Get().AbsPath("/faros.sh/api/v1alpha1/workspaces/" + o.Name).Do(ctx).Into(workspace)
-	if err != nil {
-		return err
+	// the workspace is served by the same server as the hub, trust it the
+	// way login configured the profile to
+	profileKey := base.ProfileKey(o.Profile)
+	profileCluster, ok := rawConfig.Clusters[profileKey]
+	if !ok {
+		return fmt.Errorf("profile %q is not logged in, run 'kubectl faros login --profile %s' first", o.Profile, o.Profile)
+	}
+	cluster := &clientcmdapi.Cluster{
+		Server:                   workspace.Status.WorkspaceURL,
+		CertificateAuthority:     profileCluster.CertificateAuthority,
+		CertificateAuthorityData: profileCluster.CertificateAuthorityData,
+		InsecureSkipTLSVerify:    profileCluster.InsecureSkipTLSVerify,
+		TLSServerName:            profileCluster.TLSServerName,
+		ProxyURL:                 profileCluster.ProxyURL,
 	}
 
-	// Get raw config and add new cluster and context to it
-	rawConfig, err := o.ClientConfig.RawConfig()
-	if err != nil {
-		return err
+	// workspaces of organizations get contexts of their own, apart from
+	// workspaces of the user with the same name
+	name := base.WorkspaceContextName(o.Profile, qualifiedName(o.Org, workspace.Name))
+	workspaceContext := &clientcmdapi.Context{
+		Cluster:  name,
+		AuthInfo: profileKey,
+	}
+	if existing, ok := rawConfig.Contexts[name]; ok {
+		workspaceContext.Namespace = existing.Namespace
 	}
 
-	rawConfig.Clusters[workspace.Name] = &clientcmdapi.Cluster{
-		Server: workspace.Status.WorkspaceURL,
+	if o.KubeconfigOut != "" {
+		user, ok := rawConfig.AuthInfos[profileKey]
+		if !ok {
+			return fmt.Errorf("profile %q is not logged in, run 'kubectl faros login --profile %s' first", o.Profile, o.Profile)
+		}
+
+		standalone := clientcmdapi.NewConfig()
+		standalone.Clusters[name] = cluster
+		standalone.AuthInfos[profileKey] = user
+		standalone.Contexts[name] = workspaceContext
+		standalone.CurrentContext = name
+		if err := clientcmd.WriteToFile(*standalone, o.KubeconfigOut); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(o.Out, "Wrote kubeconfig for workspace %s to %s\n", qualifiedName(o.Org, workspace.Name), o.KubeconfigOut)
+		return nil
 	}
 
-	farosCluster, ok := rawConfig.Clusters[kubeConfigAuthKey]
+	rawConfig.Clusters[name] = cluster
+	rawConfig.Contexts[name] = workspaceContext
+	o.switchContext(&rawConfig, name)
+
+	fmt.Fprintf(o.Out, "Using workspace %s\n", qualifiedName(o.Org, workspace.Name))
+	return o.modifyConfig(o.ConfigAccess(), &rawConfig)
+}
+
+// usePrevious switches back to the context used before the last switch
+func (o *UseWorkspacesOptions) usePrevious(rawConfig *clientcmdapi.Config) error {
+	previous, ok := rawConfig.Contexts[base.PreviousContextName(o.Profile)]
 	if !ok {
-		rawConfig.Clusters[workspace.Name].InsecureSkipTLSVerify = true
+		return errors.New("no previous workspace to switch to")
+	}
+
+	name := previousContextName(rawConfig, previous)
+	if name == "" {
+		return errors.New("previous context no longer exists")
+	}
+
+	o.switchContext(rawConfig, name)
+
+	if _, workspace, ok := base.ParseWorkspaceContextName(name); ok {
+		fmt.Fprintf(o.Out, "Using workspace %s\n", workspace)
 	} else {
-		if farosCluster.InsecureSkipTLSVerify {
-			rawConfig.Clusters[workspace.Name].InsecureSkipTLSVerify = true
-		} else {
-			rawConfig.Clusters[workspace.Name].CertificateAuthorityData = farosCluster.CertificateAuthorityData
-			rawConfig.Clusters[workspace.Name].CertificateAuthority = farosCluster.CertificateAuthority
-		}
+		fmt.Fprintf(o.Out, "Switched to context %q\n", name)
+	}
+	return o.modifyConfig(o.ConfigAccess(), rawConfig)
+}
+
+// switchContext makes name the current context, remembering the current one
+// for `use -`
+func (o *UseWorkspacesOptions) switchContext(rawConfig *clientcmdapi.Config, name string) {
+	if current, ok := rawConfig.Contexts[rawConfig.CurrentContext]; ok && rawConfig.CurrentContext != name {
+		rawConfig.Contexts[base.PreviousContextName(o.Profile)] = current.DeepCopy()
 	}
+	rawConfig.CurrentContext = name
+}
 
-	rawConfig.Contexts[workspace.Name] = &clientcmdapi.Context{
-		Cluster:  workspace.Name,
-		AuthInfo: kubeConfigAuthKey,
+// previousContextName returns the name of the context previous is a copy of.
+// Workspace contexts are named after their clusters, other contexts are
+// looked up by their content.
+func previousContextName(rawConfig *clientcmdapi.Config, previous *clientcmdapi.Context) string {
+	if _, ok := rawConfig.Contexts[previous.Cluster]; ok {
+		if _, _, ok := base.ParseWorkspaceContextName(previous.Cluster); ok {
+			return previous.Cluster
+		}
 	}
 
-	rawConfig.CurrentContext = workspace.Name
+	var names []string
+	for name := range rawConfig.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-	fmt.Println("Using workspace", workspace.Name)
-	return o.modifyConfig(o.ClientConfig.ConfigAccess(), &rawConfig)
+	for _, name := range names {
+		c := rawConfig.Contexts[name]
+		if base.IsPreviousContextName(name) {
+			continue
+		}
+		if c.Cluster == previous.Cluster && c.AuthInfo == previous.AuthInfo && c.Namespace == previous.Namespace {
+			return name
+		}
+	}
+	return ""
 }
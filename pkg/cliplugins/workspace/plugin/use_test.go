@@ -0,0 +1,193 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+func TestUseWorkspace(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/faros.sh/api/v1alpha1/workspaces/ws1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(tenancyv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ws1"},
+			Status: tenancyv1alpha1.WorkspaceStatus{
+				WorkspaceURL: server.URL + "/clusters/root:tenants:ws1",
+			},
+		})
+	}))
+	defer server.Close()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	config := clientcmdapi.NewConfig()
+	config.Clusters["faros"] = &clientcmdapi.Cluster{Server: server.URL + "/clusters/root", CertificateAuthorityData: ca}
+	config.Clusters["kind"] = &clientcmdapi.Cluster{Server: "https://127.0.0.1:6443"}
+	config.AuthInfos["faros"] = &clientcmdapi.AuthInfo{Token: "token"}
+	config.AuthInfos["kind"] = &clientcmdapi.AuthInfo{Token: "kind"}
+	config.Contexts["faros"] = &clientcmdapi.Context{Cluster: "faros", AuthInfo: "faros"}
+	config.Contexts["kind"] = &clientcmdapi.Context{Cluster: "kind", AuthInfo: "kind"}
+	config.CurrentContext = "kind"
+	if err := clientcmd.WriteToFile(*config, kubeconfig); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KUBECONFIG", kubeconfig)
+
+	use := func(name, kubeconfigOut string) string {
+		t.Helper()
+		out := &bytes.Buffer{}
+		o := NewUseWorkspacesOptions(genericclioptions.IOStreams{Out: out, ErrOut: out})
+		o.Kubeconfig = kubeconfig
+		o.KubeconfigOut = kubeconfigOut
+		if err := o.Complete([]string{name}); err != nil {
+			t.Fatal(err)
+		}
+		if err := o.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if err := o.Run(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		return out.String()
+	}
+	load := func(path string) *clientcmdapi.Config {
+		t.Helper()
+		config, err := clientcmd.LoadFromFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return config
+	}
+
+	use("ws1", "")
+	config = load(kubeconfig)
+	if config.CurrentContext != "faros:default:ws1" {
+		t.Fatalf("expected current context faros:default:ws1, got %q", config.CurrentContext)
+	}
+	cluster := config.Clusters["faros:default:ws1"]
+	if cluster.Server != server.URL+"/clusters/root:tenants:ws1" {
+		t.Errorf("unexpected server %q", cluster.Server)
+	}
+	if !bytes.Equal(cluster.CertificateAuthorityData, ca) || cluster.InsecureSkipTLSVerify {
+		t.Error("expected the CA of the login profile to be trusted")
+	}
+	if config.Contexts["kind"].Cluster != "kind" {
+		t.Error("expected other contexts to be kept")
+	}
+
+	out := &bytes.Buffer{}
+	current := NewCurrentWorkspaceOptions(genericclioptions.IOStreams{Out: out})
+	current.Kubeconfig = kubeconfig
+	if err := current.Complete(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := current.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(out.String()) != "ws1" {
+		t.Errorf("expected current workspace ws1, got %q", out.String())
+	}
+
+	use("-", "")
+	if current := load(kubeconfig).CurrentContext; current != "kind" {
+		t.Errorf("expected previous context kind, got %q", current)
+	}
+	use("-", "")
+	if current := load(kubeconfig).CurrentContext; current != "faros:default:ws1" {
+		t.Errorf("expected previous context faros:default:ws1, got %q", current)
+	}
+
+	standalone := filepath.Join(t.TempDir(), "ws1.kubeconfig")
+	use("-", "")
+	use("ws1", standalone)
+	if current := load(kubeconfig).CurrentContext; current != "kind" {
+		t.Errorf("expected --kubeconfig-out to keep the current context, got %q", current)
+	}
+	config = load(standalone)
+	if config.CurrentContext != "faros:default:ws1" || config.AuthInfos["faros"].Token != "token" || len(config.Contexts) != 1 {
+		t.Errorf("unexpected standalone kubeconfig %+v", config)
+	}
+}
+
+func TestUseWorkspaceOfLoginProfile(t *testing.T) {
+	hub := func(workspace string) *httptest.Server {
+		var server *httptest.Server
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/faros.sh/api/v1alpha1/workspaces/"+workspace {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: workspace},
+				Status:     tenancyv1alpha1.WorkspaceStatus{WorkspaceURL: server.URL + "/clusters/root:tenants:" + workspace},
+			})
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	production, staging := hub("other"), hub("ws1")
+
+	// the kubeconfig login --profile staging leaves after logging in to the
+	// default profile before
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	config := clientcmdapi.NewConfig()
+	for key, server := range map[string]*httptest.Server{"faros": production, "faros-staging": staging} {
+		config.Clusters[key] = &clientcmdapi.Cluster{
+			Server:                   server.URL + "/clusters/root",
+			CertificateAuthorityData: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+		}
+		config.AuthInfos[key] = &clientcmdapi.AuthInfo{Token: key}
+		config.Contexts[key] = &clientcmdapi.Context{Cluster: key, AuthInfo: key}
+	}
+	config.CurrentContext = "faros-staging"
+	if err := clientcmd.WriteToFile(*config, kubeconfig); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KUBECONFIG", kubeconfig)
+
+	o := NewUseWorkspacesOptions(genericclioptions.IOStreams{Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}})
+	o.Kubeconfig = kubeconfig
+	if err := o.Complete([]string{"ws1"}); err != nil {
+		t.Fatal(err)
+	}
+	if o.Profile != "staging" {
+		t.Errorf("expected profile staging, got %q", o.Profile)
+	}
+	if err := o.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := clientcmd.LoadFromFile(kubeconfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.CurrentContext != "faros:staging:ws1" {
+		t.Fatalf("expected current context faros:staging:ws1, got %q", config.CurrentContext)
+	}
+	if server := config.Clusters["faros:staging:ws1"].Server; server != staging.URL+"/clusters/root:tenants:ws1" {
+		t.Errorf("unexpected server %q", server)
+	}
+}
@@ -1,6 +1,10 @@
 package config
 
-import "k8s.io/client-go/rest"
+import (
+	"time"
+
+	"k8s.io/client-go/rest"
+)
 
 const (
 	ConfigFileName = "config.yaml"
@@ -9,6 +13,9 @@ const (
 type APIConfig struct {
 	// Addr is the address to bind the controller to.
 	Addr string `envconfig:"FAROS_API_ADDR" required:"true" default:":8080"`
+	// MetricsAddr is the address metrics are served on, apart from the hub
+	// api so they are not exposed through its ingress. Empty disables it.
+	MetricsAddr string `envconfig:"FAROS_API_METRICS_ADDR" yaml:"metricsAddr,omitempty" default:":9090"`
 	// ControllerExternalURL is the URL that the controller is externally reachable at.
 	ControllerExternalURL string `envconfig:"FAROS_API_EXTERNAL_URL" required:"true" default:"https://kcp.dev.faros.sh"`
 
@@ -34,14 +41,73 @@ type APIConfig struct {
 	ControllersTenantWorkspace string `envconfig:"FAROS_API_TENANT_WORKSPACE" yaml:"controllersTenantWorkspace,omitempty" default:"root:faros:service:tenants"`
 
 	// OIDC provider configuration
-	OIDCIssuerURL      string `envconfig:"FAROS_OIDC_ISSUER_URL" yaml:"oidcIssuerURL,omitempty" default:"https://dex.dev.faros.sh"`
-	OIDCClientID       string `envconfig:"FAROS_OIDC_CLIENT_ID" yaml:"oidcClientID,omitempty" default:"faros"`
-	OIDCClientSecret   string `envconfig:"FAROS_OIDC_CLIENT_SECRET" yaml:"oidcClientSecret,omitempty" default:"faros"`
-	OIDCCASecretName   string `envconfig:"FAROS_OIDC_CA_SECRET_NAME" yaml:"oidcCASecretName,omitempty" default:"dex-pki-ca"`
-	OIDCUsernameClaim  string `envconfig:"FAROS_OIDC_USERNAME_CLAIM" yaml:"oidcFarosUsernameClaim,omitempty" default:"email"`
-	OIDCUserPrefix     string `envconfig:"FAROS_OIDC_USER_PREFIX" yaml:"oidcUserPrefix,omitempty" default:"faros-sso"`
-	OIDCGroupsPrefix   string `envconfig:"FAROS_OIDC_GROUPS_PREFIX" yaml:"oidcGroupsPrefix,omitempty" default:"faros-sso"`
+	OIDCIssuerURL     string `envconfig:"FAROS_OIDC_ISSUER_URL" yaml:"oidcIssuerURL,omitempty" default:"https://dex.dev.faros.sh"`
+	OIDCClientID      string `envconfig:"FAROS_OIDC_CLIENT_ID" yaml:"oidcClientID,omitempty" default:"faros"`
+	OIDCClientSecret  string `envconfig:"FAROS_OIDC_CLIENT_SECRET" yaml:"oidcClientSecret,omitempty" default:"faros"`
+	OIDCCASecretName  string `envconfig:"FAROS_OIDC_CA_SECRET_NAME" yaml:"oidcCASecretName,omitempty" default:"dex-pki-ca"`
+	OIDCUsernameClaim string `envconfig:"FAROS_OIDC_USERNAME_CLAIM" yaml:"oidcFarosUsernameClaim,omitempty" default:"email"`
+	OIDCUserPrefix    string `envconfig:"FAROS_OIDC_USER_PREFIX" yaml:"oidcUserPrefix,omitempty" default:"faros-sso"`
+	OIDCGroupsPrefix  string `envconfig:"FAROS_OIDC_GROUPS_PREFIX" yaml:"oidcGroupsPrefix,omitempty" default:"faros-sso"`
+	// OIDCGroupsClaim is the claim of ID tokens holding the groups of users
+	OIDCGroupsClaim string `envconfig:"FAROS_OIDC_GROUPS_CLAIM" yaml:"oidcGroupsClaim,omitempty" default:"groups"`
+	// OIDCAuthSessionKeys sign and encrypt login sessions, and are at least
+	// 32 characters long. Sessions are encrypted with the first key and
+	// decrypted with any, so keys are rotated by prepending the new key and
+	// removing the old one once logins in progress completed.
+	OIDCAuthSessionKeys []string `envconfig:"FAROS_OIDC_AUTH_SESSION_KEYS" yaml:"oidcAuthSessionKeys,omitempty" default:""`
+	// OIDCAuthSessionKey is the single login session key of earlier
+	// releases, used as the first of OIDCAuthSessionKeys.
+	// Deprecated: use OIDCAuthSessionKeys.
 	OIDCAuthSessionKey string `envconfig:"FAROS_OIDC_AUTH_SESSION_KEY" yaml:"oidcAuthSessionKey,omitempty" default:""`
+	// OIDCRedirectURIs are the addresses logins can be sent to besides the
+	// loopback addresses of the CLI, which are always allowed
+	OIDCRedirectURIs []string `envconfig:"FAROS_OIDC_REDIRECT_URIS" yaml:"oidcRedirectURIs,omitempty" default:""`
+
+	// CORSAllowedOrigins are the origins of browser applications allowed to
+	// call the hub api, none by default
+	CORSAllowedOrigins []string `envconfig:"FAROS_API_CORS_ALLOWED_ORIGINS" yaml:"corsAllowedOrigins,omitempty" default:""`
+
+	// TunnelsDebug exposes the state of agent tunnels to hub administrators
+	TunnelsDebug bool `envconfig:"FAROS_API_TUNNELS_DEBUG" yaml:"tunnelsDebug,omitempty" default:"false"`
+
+	// HealthCheckInterval is how often kcp, the OIDC provider and the tenants
+	// workspace are checked for readiness
+	HealthCheckInterval time.Duration `envconfig:"FAROS_API_HEALTH_CHECK_INTERVAL" yaml:"healthCheckInterval,omitempty" default:"30s"`
+
+	// AdminEmails are the emails of hub administrators, who can manage users
+	AdminEmails []string `envconfig:"FAROS_API_ADMIN_EMAILS" yaml:"adminEmails,omitempty" default:""`
+	// AdminGroups are the identity provider groups of hub administrators
+	AdminGroups []string `envconfig:"FAROS_API_ADMIN_GROUPS" yaml:"adminGroups,omitempty" default:""`
+
+	// WorkspaceDeletionGracePeriod is how long deleted workspaces are kept
+	// terminating, and can be restored, before they are deleted
+	WorkspaceDeletionGracePeriod time.Duration `envconfig:"FAROS_API_WORKSPACE_DELETION_GRACE_PERIOD" yaml:"workspaceDeletionGracePeriod,omitempty" default:"72h"`
+
+	// Must match one in Controllers config
+	LimitsConfig `yaml:",inline"`
+
+	RateLimitConfig `yaml:",inline"`
+}
+
+// RateLimitConfig are the limits of requests to the hub api, as
+// <requests>/<period>, i.e. 100/1m. Empty limits are unlimited.
+type RateLimitConfig struct {
+	// RateLimitPerIP limits requests of each client address to all routes
+	RateLimitPerIP string `envconfig:"FAROS_API_RATE_LIMIT_PER_IP" yaml:"rateLimitPerIP,omitempty" default:"600/1m"`
+	// RateLimitPerUser limits requests of each user to all authenticated
+	// routes
+	RateLimitPerUser string `envconfig:"FAROS_API_RATE_LIMIT_PER_USER" yaml:"rateLimitPerUser,omitempty" default:"1200/1m"`
+	// RateLimitRoutes overrides the limits of routes by their operation id in
+	// the openapi specification, i.e. createWorkspace:10/1m. Route limits
+	// apply per user, or per client address on public routes.
+	RateLimitRoutes map[string]string `envconfig:"FAROS_API_RATE_LIMIT_ROUTES" yaml:"rateLimitRoutes,omitempty" default:"login:30/1m,loginCallback:30/1m,refreshToken:30/1m,exchangeLoginCode:30/1m,createWorkspace:10/1m,importWorkspace:5/1m"`
+	// LoginFailureLimit limits failed login callbacks, login code exchanges
+	// and token refreshes of each client address, which are blocked once it
+	// is exceeded
+	LoginFailureLimit string `envconfig:"FAROS_API_LOGIN_FAILURE_LIMIT" yaml:"loginFailureLimit,omitempty" default:"10/10m"`
+	// RateLimitTrustForwardedFor takes client addresses from the last entry of
+	// the X-Forwarded-For header, set by the ingress in front of the hub api
+	RateLimitTrustForwardedFor bool `envconfig:"FAROS_API_RATE_LIMIT_TRUST_FORWARDED_FOR" yaml:"rateLimitTrustForwardedFor,omitempty" default:"false"`
 }
 
 type ControllerConfig struct {
@@ -79,11 +145,42 @@ type ControllerConfig struct {
 	KCPClusterKubeConfigPath string `envconfig:"FAROS_CONTROLLER_KCP_CLUSTER_KUBECONFIG" required:"true" default:"kcp.kubeconfig"`
 	// KCPClusterRestConfig is the rest config for the KCP cluster.
 	KCPClusterRestConfig *rest.Config `envconfig:"-"`
+
+	// NotificationWebhookURL is called with members of workspaces about to be
+	// deleted, so they can be notified. Notifications are disabled if empty.
+	NotificationWebhookURL string `envconfig:"FAROS_NOTIFICATION_WEBHOOK_URL" yaml:"notificationWebhookURL,omitempty" default:""`
+
+	// Must match one in API config
+	LimitsConfig `yaml:",inline"`
+}
+
+// LimitsConfig are the default limits of users, unless overridden in their
+// spec. 0 is unlimited.
+type LimitsConfig struct {
+	// WorkspacesLimit is the maximum number of workspaces of a user
+	WorkspacesLimit int32 `envconfig:"FAROS_LIMITS_WORKSPACES" yaml:"limitsWorkspaces,omitempty" default:"10"`
+	// AgentsPerWorkspaceLimit is the maximum number of agents in a workspace
+	AgentsPerWorkspaceLimit int32 `envconfig:"FAROS_LIMITS_AGENTS_PER_WORKSPACE" yaml:"limitsAgentsPerWorkspace,omitempty" default:"50"`
+	// RequestsPerWorkspaceLimit is the maximum number of concurrent access
+	// requests in a workspace
+	RequestsPerWorkspaceLimit int32 `envconfig:"FAROS_LIMITS_REQUESTS_PER_WORKSPACE" yaml:"limitsRequestsPerWorkspace,omitempty" default:"20"`
 }
 
 type AgentConfig struct {
 	Name      string `envconfig:"FAROS_AGENT_NAME" yaml:"name,omitempty" default:""`
 	Namespace string `envconfig:"FAROS_AGENT_NAMESPACE" yaml:"namespace,omitempty" default:""`
 
+	// TunnelURL is the hub tunnels endpoint, i.e. https://hub.faros.sh/faros.sh/tunnels.
+	// Tunnel is disabled if empty.
+	TunnelURL string `envconfig:"FAROS_AGENT_TUNNEL_URL" yaml:"tunnelURL,omitempty" default:""`
+	// TunnelCAFile is the PEM bundle used to verify the hub. System roots are used if empty.
+	TunnelCAFile string `envconfig:"FAROS_AGENT_TUNNEL_CA_FILE" yaml:"tunnelCAFile,omitempty" default:""`
+	// TunnelServices are local services exposed through the tunnel, as name:url pairs.
+	// The local kube-apiserver is exposed as kube-apiserver when running in cluster.
+	TunnelServices map[string]string `envconfig:"FAROS_AGENT_TUNNEL_SERVICES" yaml:"tunnelServices,omitempty" default:""`
+
+	// HeartbeatInterval is how often the agent reports it is alive, and its version
+	HeartbeatInterval time.Duration `envconfig:"FAROS_AGENT_HEARTBEAT_INTERVAL" yaml:"heartbeatInterval,omitempty" default:"1m"`
+
 	RestConfig *rest.Config `yaml:"-"`
 }
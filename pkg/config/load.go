@@ -1,13 +1,16 @@
 package config
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"os"
 
-	"github.com/google/uuid"
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/strings/slices"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	utilkubernetes "github.com/faroshq/faros-hub/pkg/util/kubernetes"
@@ -26,9 +29,20 @@ func LoadAPI() (*APIConfig, error) {
 		return c, err
 	}
 
-	if c.OIDCAuthSessionKey == "" {
-		fmt.Println("FAROS_OIDC_AUTH_SESSION_KEY not supplied, generating random one")
-		c.OIDCAuthSessionKey = uuid.Must(uuid.NewUUID()).String()
+	if c.OIDCAuthSessionKey != "" {
+		klog.Warning("FAROS_OIDC_AUTH_SESSION_KEY is deprecated, use FAROS_OIDC_AUTH_SESSION_KEYS")
+		if !slices.Contains(c.OIDCAuthSessionKeys, c.OIDCAuthSessionKey) {
+			c.OIDCAuthSessionKeys = append([]string{c.OIDCAuthSessionKey}, c.OIDCAuthSessionKeys...)
+		}
+	}
+	if len(c.OIDCAuthSessionKeys) == 0 {
+		// logins in progress fail on restarts, or on other replicas
+		klog.Warning("FAROS_OIDC_AUTH_SESSION_KEYS not supplied, generating random one")
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate login session key: %w", err)
+		}
+		c.OIDCAuthSessionKeys = []string{hex.EncodeToString(key)}
 	}
 
 	hostingKubeConfig, err := loadKubeConfig(c.HostingClusterKubeConfigPath)
@@ -0,0 +1,62 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	"github.com/kcp-dev/logicalcluster/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+func (r *Reconciler) createOrUpdate(ctx context.Context, logger logr.Logger, organization *tenancyv1alpha1.Organization, cluster logicalcluster.Name) (ctrl.Result, error) {
+	// TODO: move to webhook
+	if !controllerutil.ContainsFinalizer(organization, finalizerName) {
+		controllerutil.AddFinalizer(organization, finalizerName)
+		if err := r.Update(ctx, organization); err != nil {
+			return ctrl.Result{}, err
+		}
+		// requeue to ensure the finalizer is set before creating the resources
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	ownersReferences := []metav1.OwnerReference{{
+		APIVersion:         tenancyv1alpha1.SchemeGroupVersion.String(),
+		Kind:               tenancyv1alpha1.OrganizationKind,
+		Name:               organization.Name,
+		BlockOwnerDeletion: pointer.BoolPtr(true),
+		Controller:         pointer.BoolPtr(true),
+		UID:                organization.UID,
+	}}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            tenancyv1alpha1.OrganizationNamespace(organization.Name),
+			OwnerReferences: ownersReferences,
+		},
+	}
+
+	_, err := r.CoreClients.Cluster(cluster).CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return ctrl.Result{}, err
+	}
+
+	patch := client.MergeFrom(organization.DeepCopy())
+	conditions.MarkTrue(organization, conditionsv1alpha1.ReadyCondition)
+	organization.Status.Namespace = namespace.Name
+
+	if err := r.Status().Patch(ctx, organization, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,30 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/kcp-dev/logicalcluster/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+// delete deletes the namespace of the organization, and with it its teams and
+// workspaces
+func (r *Reconciler) delete(ctx context.Context, logger logr.Logger, organization *tenancyv1alpha1.Organization, cluster logicalcluster.Name) (ctrl.Result, error) {
+	err := r.CoreClients.Cluster(cluster).CoreV1().Namespaces().Delete(ctx, tenancyv1alpha1.OrganizationNamespace(organization.Name), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(organization, finalizerName)
+	if err := r.Update(ctx, organization); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -15,6 +15,11 @@ import (
 )
 
 func (r *Reconciler) delete(ctx context.Context, logger logr.Logger, user *tenancyv1alpha1.User, cluster logicalcluster.Name) (ctrl.Result, error) {
+	// workspaces still used by other members outlive the user
+	if err := r.transferWorkspaces(ctx, logger, user); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	namespace := corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: user.Name,
@@ -22,7 +27,7 @@ func (r *Reconciler) delete(ctx context.Context, logger logr.Logger, user *tenan
 	}
 
 	err := r.CoreClients.Cluster(cluster).CoreV1().Namespaces().Delete(ctx, namespace.Name, metav1.DeleteOptions{})
-	if err != nil && !apierrors.IsAlreadyExists(err) {
+	if err != nil && !apierrors.IsNotFound(err) {
 		return ctrl.Result{}, err
 	}
 
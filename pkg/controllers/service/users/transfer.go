@@ -0,0 +1,161 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/kcp-dev/kcp/pkg/cliplugins/helpers"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+// transferWorkspaces transfers the workspaces of user, before they are
+// deleted with the namespace of the user, to user.Spec.TransferTo or their
+// first other active member. Transferred workspaces keep their kcp workspace,
+// workspaces without anyone to transfer them to are deleted.
+func (r *Reconciler) transferWorkspaces(ctx context.Context, logger logr.Logger, user *tenancyv1alpha1.User) error {
+	var workspaces tenancyv1alpha1.WorkspaceList
+	if err := r.List(ctx, &workspaces, client.InNamespace(user.Name)); err != nil {
+		return err
+	}
+	if len(workspaces.Items) == 0 {
+		return nil
+	}
+
+	var users tenancyv1alpha1.UserList
+	if err := r.List(ctx, &users); err != nil {
+		return err
+	}
+	active := map[string]*tenancyv1alpha1.User{}
+	for i, u := range users.Items {
+		if u.Name != user.Name && !u.Spec.Disabled && u.DeletionTimestamp.IsZero() {
+			active[u.Spec.Email] = &users.Items[i]
+		}
+	}
+	if user.Spec.TransferTo != "" && active[user.Spec.TransferTo] == nil {
+		return fmt.Errorf("user %s to transfer workspaces to not found, or not active", user.Spec.TransferTo)
+	}
+
+	for i := range workspaces.Items {
+		workspace := &workspaces.Items[i]
+		if workspace.Status.TransferredTo != "" || !workspace.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		owner := transferTarget(user, workspace, active)
+		if owner == nil {
+			logger.Info("no member to transfer workspace to, deleting it", "workspace", workspace.Name)
+			continue
+		}
+
+		transferred, err := r.transferWorkspace(ctx, user, workspace, owner)
+		if err != nil {
+			return fmt.Errorf("failed to transfer workspace %s to %s: %w", workspace.Name, owner.Spec.Email, err)
+		}
+		logger.Info("transferred workspace", "workspace", workspace.Name, "owner", owner.Spec.Email)
+
+		workspace.Status.TransferredTo = transferred.Namespace + "/" + transferred.Name
+		if err := r.Status().Update(ctx, workspace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// transferTarget returns the user workspace is transferred to, if any
+func transferTarget(user *tenancyv1alpha1.User, workspace *tenancyv1alpha1.Workspace, active map[string]*tenancyv1alpha1.User) *tenancyv1alpha1.User {
+	if user.Spec.TransferTo != "" {
+		return active[user.Spec.TransferTo]
+	}
+	for _, member := range workspace.Spec.Members {
+		if owner, ok := active[member]; ok {
+			return owner
+		}
+	}
+	return nil
+}
+
+// transferWorkspace creates a copy of workspace owned by owner, using the kcp
+// workspace of the original one. The copy is not provisioned until its Path
+// is set.
+func (r *Reconciler) transferWorkspace(ctx context.Context, user *tenancyv1alpha1.User, workspace *tenancyv1alpha1.Workspace, owner *tenancyv1alpha1.User) (*tenancyv1alpha1.Workspace, error) {
+	path := workspace.Status.Path
+	if path == "" && workspace.Status.WorkspaceURL != "" {
+		_, cluster, err := helpers.ParseClusterURL(workspace.Status.WorkspaceURL)
+		if err != nil {
+			return nil, err
+		}
+		path = cluster.String()
+	}
+
+	transferred := &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        workspace.Name,
+			Namespace:   owner.Name,
+			Labels:      workspace.Labels,
+			Annotations: map[string]string{},
+		},
+		Spec: *workspace.Spec.DeepCopy(),
+	}
+	for key, value := range workspace.Annotations {
+		if !strings.HasPrefix(key, tenancyv1alpha1.WorkspaceAnnotationPrefix) {
+			transferred.Annotations[key] = value
+		}
+	}
+	if path != "" {
+		transferred.Annotations[tenancyv1alpha1.WorkspaceTransferPendingAnnotation] = "true"
+	}
+
+	var members []string
+	for _, member := range transferred.Spec.Members {
+		if member != user.Spec.Email {
+			members = append(members, member)
+		}
+	}
+	if !slices.Contains(members, owner.Spec.Email) {
+		members = append(members, owner.Spec.Email)
+	}
+	transferred.Spec.Members = members
+
+	err := r.Create(ctx, transferred)
+	if apierrors.IsAlreadyExists(err) {
+		// retried after the workspace was created, but before the original
+		// one was marked transferred
+		current := &tenancyv1alpha1.Workspace{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(transferred), current); err != nil {
+			return nil, err
+		}
+		if _, pending := current.Annotations[tenancyv1alpha1.WorkspaceTransferPendingAnnotation]; !pending && current.Status.Path != path {
+			return nil, fmt.Errorf("%s has a workspace named %s already", owner.Spec.Email, workspace.Name)
+		}
+		transferred = current
+	} else if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return transferred, nil
+	}
+
+	// the path is kept in the status, which users can't write, and the
+	// workspace provisioned once it is set
+	if transferred.Status.Path != path {
+		transferred.Status.Path = path
+		if err := r.Status().Update(ctx, transferred); err != nil {
+			return nil, err
+		}
+	}
+	if _, pending := transferred.Annotations[tenancyv1alpha1.WorkspaceTransferPendingAnnotation]; pending {
+		delete(transferred.Annotations, tenancyv1alpha1.WorkspaceTransferPendingAnnotation)
+		if err := r.Update(ctx, transferred); err != nil {
+			return nil, err
+		}
+	}
+	return transferred, nil
+}
@@ -0,0 +1,91 @@
+package users
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+func TestTransferWorkspaces(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := tenancyv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	jane := &tenancyv1alpha1.User{
+		ObjectMeta: metav1.ObjectMeta{Name: "jane"},
+		Spec:       tenancyv1alpha1.UserSpec{Email: "jane@example.com"},
+	}
+	john := &tenancyv1alpha1.User{
+		ObjectMeta: metav1.ObjectMeta{Name: "john"},
+		Spec:       tenancyv1alpha1.UserSpec{Email: "john@example.com"},
+	}
+	fleet := &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fleet",
+			Namespace: "jane",
+			Labels:    map[string]string{"team": "edge"},
+			Annotations: map[string]string{
+				"example.com/note": "kept",
+				tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation: "2022-01-01T00:00:00Z",
+			},
+		},
+		Spec:   tenancyv1alpha1.WorkspaceSpec{Members: []string{"jane@example.com", "john@example.com"}},
+		Status: tenancyv1alpha1.WorkspaceStatus{WorkspaceURL: "https://kcp.example.com/clusters/root:faros-tenants:jane:fleet"},
+	}
+	lab := &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "lab", Namespace: "jane"},
+		Spec:       tenancyv1alpha1.WorkspaceSpec{Members: []string{"jane@example.com"}},
+	}
+
+	r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(jane, john, fleet, lab).Build()}
+	// transfers are retried until the user is deleted
+	for i := 0; i < 2; i++ {
+		if err := r.transferWorkspaces(ctx, logr.Discard(), jane); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	transferred := &tenancyv1alpha1.Workspace{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: "john", Name: "fleet"}, transferred); err != nil {
+		t.Fatal(err)
+	}
+	if transferred.Status.Path != "root:faros-tenants:jane:fleet" {
+		t.Errorf("expected transferred workspace to keep its kcp workspace, got %q", transferred.Status.Path)
+	}
+	if expected := map[string]string{"example.com/note": "kept"}; !reflect.DeepEqual(transferred.Annotations, expected) {
+		t.Errorf("expected annotations %v without hub annotations, got %v", expected, transferred.Annotations)
+	}
+	if expected := []string{"john@example.com"}; !reflect.DeepEqual(transferred.Spec.Members, expected) {
+		t.Errorf("expected members %v, got %v", expected, transferred.Spec.Members)
+	}
+
+	original := &tenancyv1alpha1.Workspace{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(fleet), original); err != nil {
+		t.Fatal(err)
+	}
+	if original.Status.TransferredTo != "john/fleet" {
+		t.Errorf("expected workspace to be marked transferred to john/fleet, got %q", original.Status.TransferredTo)
+	}
+
+	// workspaces without other members are deleted with the user
+	if err := r.Get(ctx, client.ObjectKey{Namespace: "john", Name: "lab"}, &tenancyv1alpha1.Workspace{}); err == nil {
+		t.Error("expected workspace without other members not to be transferred")
+	}
+
+	// workspaces are not transferred into workspaces of the new owner
+	taken := &tenancyv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "john"}}
+	r = &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(jane, john, fleet.DeepCopy(), taken).Build()}
+	if err := r.transferWorkspaces(ctx, logr.Discard(), jane); err == nil {
+		t.Error("expected transfers to fail if the new owner has a workspace of the same name")
+	}
+}
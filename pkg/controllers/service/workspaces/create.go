@@ -22,6 +22,7 @@ import (
 
 	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
 	"github.com/faroshq/faros-hub/pkg/bootstrap"
+	"github.com/faroshq/faros-hub/pkg/util/quota"
 )
 
 func (r *Reconciler) createOrUpdate(ctx context.Context, logger logr.Logger, workspace *tenancyv1alpha1.Workspace) (ctrl.Result, error) {
@@ -35,6 +36,21 @@ func (r *Reconciler) createOrUpdate(ctx context.Context, logger logr.Logger, wor
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// transferred workspaces are provisioned once their kcp workspace is set,
+	// reconciled again when the annotation is removed
+	if _, ok := workspace.Annotations[tenancyv1alpha1.WorkspaceTransferPendingAnnotation]; ok {
+		logger.Info("waiting for the transfer of the workspace")
+		return ctrl.Result{}, nil
+	}
+	if _, ok := workspace.Annotations[tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation]; ok {
+		return r.terminate(ctx, logger, workspace)
+	}
+	if workspace.Status.Phase == tenancyv1alpha1.WorkspacePhaseTerminating {
+		if err := r.restore(ctx, logger, workspace); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	workspaceOwnersReferences := []metav1.OwnerReference{{
 		APIVersion:         tenancyv1alpha1.SchemeGroupVersion.String(),
 		Kind:               tenancyv1alpha1.WorkspaceKind,
@@ -85,16 +101,45 @@ func (r *Reconciler) createOrUpdate(ctx context.Context, logger logr.Logger, wor
 		},
 	}
 
+	limits, err := r.getLimits(ctx, workspace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	kcpWorkspace, err := kcpClient.TenancyV1beta1().Workspaces().Get(ctx, ws.Name, metav1.GetOptions{})
 	switch {
 	case apierrors.IsNotFound(err):
+		exceeded, err := r.workspacesQuotaExceeded(ctx, workspace, limits)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if exceeded {
+			logger.Info("workspaces limit exceeded, not provisioning workspace", "limit", quota.String(limits.Workspaces))
+			patch := client.MergeFrom(workspace.DeepCopy())
+			conditions.MarkFalse(workspace, conditionsv1alpha1.ReadyCondition, tenancyv1alpha1.WorkspaceQuotaExceededReason,
+				conditionsv1alpha1.ConditionSeverityError, "Workspaces limit of %s exceeded", quota.String(limits.Workspaces))
+			if err := r.Status().Patch(ctx, workspace, patch); err != nil {
+				return ctrl.Result{}, err
+			}
+			// provisioned once older workspaces are deleted or the limit is raised
+			return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+		}
+
 		logger.Error(err, "creating workspace", "workspace-name", workspace.Name)
 		kcpWorkspace, err = kcpClient.TenancyV1beta1().Workspaces().Create(ctx, ws, metav1.CreateOptions{})
 		if err != nil && !apierrors.IsAlreadyExists(err) {
 			return ctrl.Result{RequeueAfter: time.Second * 30}, fmt.Errorf("failed to create Workspace: %s", err)
 		}
 	case err == nil:
-		// workspaces are not updatable, but we need to deal with all the stuff bellow
+		// workspaces are not updatable, but we need to deal with all the stuff bellow.
+		// Transferred workspaces take over the kcp workspace of their first owner.
+		if !hasOwnerReference(kcpWorkspace.OwnerReferences, workspace.UID) {
+			kcpWorkspace.OwnerReferences = workspaceOwnersReferences
+			kcpWorkspace, err = kcpClient.TenancyV1beta1().Workspaces().Update(ctx, kcpWorkspace, metav1.UpdateOptions{})
+			if err != nil {
+				return ctrl.Result{RequeueAfter: time.Second * 30}, fmt.Errorf("failed to update the Workspace %s", err)
+			}
+		}
 	default:
 		return ctrl.Result{RequeueAfter: time.Second * 30}, fmt.Errorf("failed to get the Workspace %s", err)
 	}
@@ -120,15 +165,13 @@ func (r *Reconciler) createOrUpdate(ctx context.Context, logger logr.Logger, wor
 		return result, err
 	}
 
-	// Role binding to enable the cluster role
-	subjects := []rbacv1.Subject{}
-	for _, member := range workspace.Spec.Members {
-		subjects = append(subjects, rbacv1.Subject{
-			Kind: rbacv1.UserKind,
-			Name: fmt.Sprintf("%s:%s", r.Config.OIDCUserPrefix, member),
-		})
+	// disabled users are left out of all bindings
+	roles, err := r.roles(ctx, workspace)
+	if err != nil {
+		return ctrl.Result{}, err
 	}
 
+	// Role binding to enable the cluster role
 	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            r.getOrgClusterAccessName(workspace),
@@ -139,7 +182,7 @@ func (r *Reconciler) createOrUpdate(ctx context.Context, logger logr.Logger, wor
 			Kind:     "ClusterRole",
 			Name:     clusterRole.Name,
 		},
-		Subjects: subjects,
+		Subjects: r.subjects(roles.All()),
 	}
 
 	result, err = r.createOrUpdateClusterRoleBinding(ctx, clusterRoleBinding, kcptenancyv1alpha1.RootCluster, workspaceOwnersReferences)
@@ -171,17 +214,49 @@ func (r *Reconciler) createOrUpdate(ctx context.Context, logger logr.Logger, wor
 	}
 
 	// Add binding for all workspace members requested
-	subjects = []rbacv1.Subject{}
-	for _, member := range workspace.Spec.Members {
-		subjects = append(subjects, rbacv1.Subject{
-			Kind: rbacv1.UserKind,
-			Name: fmt.Sprintf("%s:%s", r.Config.OIDCUserPrefix, member),
-		})
+	clusterRoleBinding = &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            r.getRBACClusterAdminName(workspace),
+			OwnerReferences: workspaceOwnersReferences,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole.Name,
+		},
+		Subjects: r.subjects(roles.Admins),
+	}
+
+	result, err = r.createOrUpdateClusterRoleBinding(ctx, clusterRoleBinding, parent, workspaceOwnersReferences)
+	if err != nil {
+		return result, err
+	}
+
+	// team members with the Edit or View role access the workspace, and get
+	// their permissions from the team roles in it
+	clusterRole = &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            r.getRBACClusterAccessName(workspace),
+			OwnerReferences: workspaceOwnersReferences,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{"tenancy.kcp.dev"},
+				Resources:     []string{"workspaces/content"},
+				Verbs:         []string{"access"},
+				ResourceNames: []string{workspace.Name},
+			},
+		},
+	}
+
+	result, err = r.createOrUpdateClusterRole(ctx, clusterRole, parent, workspaceOwnersReferences)
+	if err != nil {
+		return result, err
 	}
 
 	clusterRoleBinding = &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:            r.getRBACClusterAdminName(workspace),
+			Name:            r.getRBACClusterAccessName(workspace),
 			OwnerReferences: workspaceOwnersReferences,
 		},
 		RoleRef: rbacv1.RoleRef{
@@ -189,7 +264,7 @@ func (r *Reconciler) createOrUpdate(ctx context.Context, logger logr.Logger, wor
 			Kind:     "ClusterRole",
 			Name:     clusterRole.Name,
 		},
-		Subjects: subjects,
+		Subjects: r.subjects(append(roles.Editors, roles.Viewers...)),
 	}
 
 	result, err = r.createOrUpdateClusterRoleBinding(ctx, clusterRoleBinding, parent, workspaceOwnersReferences)
@@ -200,12 +275,44 @@ func (r *Reconciler) createOrUpdate(ctx context.Context, logger logr.Logger, wor
 	patch := client.MergeFrom(workspace.DeepCopy())
 	conditions.MarkTrue(workspace, conditionsv1alpha1.ReadyCondition)
 	workspace.Status.WorkspaceURL = kcpWorkspace.Status.URL
+	workspace.Status.Phase = tenancyv1alpha1.WorkspacePhaseReady
+
+	// quota and templates are applied once the workspace can be used, and
+	// resynced to restore the quota and report drift
+	requeueAfter := resyncPeriod
+	if kcpWorkspace.Status.Phase == kcptenancyv1alpha1.ClusterWorkspacePhaseReady {
+		result, err = r.createOrUpdateResourceQuota(ctx, workspace, quota.ResourceQuota(limits))
+		if err != nil {
+			return result, err
+		}
+		result, err = r.createOrUpdateTeamRoles(ctx, workspace, roles)
+		if err != nil {
+			return result, err
+		}
+		if templateRequeueAfter := r.reconcileTemplate(ctx, logger, workspace); templateRequeueAfter != 0 && templateRequeueAfter < requeueAfter {
+			requeueAfter = templateRequeueAfter
+		}
+	} else {
+		requeueAfter = templateRetryPeriod
+	}
 
 	if err := r.Status().Patch(ctx, workspace, patch); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// subjects returns the rbac subjects of users with emails
+func (r *Reconciler) subjects(emails []string) []rbacv1.Subject {
+	subjects := []rbacv1.Subject{}
+	for _, email := range emails {
+		subjects = append(subjects, rbacv1.Subject{
+			Kind: rbacv1.UserKind,
+			Name: fmt.Sprintf("%s:%s", r.Config.OIDCUserPrefix, email),
+		})
+	}
+	return subjects
 }
 
 // mergeOwnerReference: merge a slice of ownerReference with a given ownerReferences
@@ -47,6 +47,17 @@ func (r *Reconciler) delete(ctx context.Context, logger logr.Logger, workspace *
 		return result, fmt.Errorf("failed to delete ClusterRole: %s", err)
 	}
 
+	// the kcp workspace and its bindings belong to the workspace this one was
+	// transferred to
+	if workspace.Status.TransferredTo != "" {
+		logger.Info("workspace was transferred, keeping its kcp workspace", "transferred-to", workspace.Status.TransferredTo)
+		controllerutil.RemoveFinalizer(workspace, finalizerName)
+		if err := r.Update(ctx, workspace); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// localized bindings
 	clusterRole = &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
@@ -70,6 +81,29 @@ func (r *Reconciler) delete(ctx context.Context, logger logr.Logger, workspace *
 		return result, fmt.Errorf("failed to delete ClusterRole: %s", err)
 	}
 
+	// team access
+	clusterRole = &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: r.getRBACClusterAccessName(workspace),
+		},
+	}
+
+	result, err = r.deleteClusterRole(ctx, clusterRole, parent)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return result, fmt.Errorf("failed to delete ClusterRole: %s", err)
+	}
+
+	clusterRoleBinding = &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: r.getRBACClusterAccessName(workspace),
+		},
+	}
+
+	result, err = r.deleteClusterRoleBinding(ctx, clusterRoleBinding, parent)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return result, fmt.Errorf("failed to delete ClusterRoleBinding: %s", err)
+	}
+
 	controllerutil.RemoveFinalizer(workspace, finalizerName)
 	if err := r.Update(ctx, workspace); err != nil {
 		return ctrl.Result{}, err
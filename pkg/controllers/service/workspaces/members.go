@@ -0,0 +1,145 @@
+package workspaces
+
+import (
+	"context"
+
+	"github.com/kcp-dev/logicalcluster/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/roles"
+)
+
+// roles returns the users with a role in the workspace, without disabled
+// users
+func (r *Reconciler) roles(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (roles.Workspace, error) {
+	var users tenancyv1alpha1.UserList
+	if err := r.List(ctx, &users); err != nil {
+		return roles.Workspace{}, err
+	}
+
+	disabled := map[string]bool{}
+	for _, user := range users.Items {
+		if user.Spec.Disabled {
+			disabled[user.Spec.Email] = true
+		}
+	}
+
+	var organization *tenancyv1alpha1.Organization
+	var teams []tenancyv1alpha1.Team
+	if name, ok := roles.OrganizationOf(workspace); ok {
+		organization = &tenancyv1alpha1.Organization{}
+		err := r.Get(ctx, types.NamespacedName{Name: name}, organization)
+		switch {
+		case apierrors.IsNotFound(err):
+			organization = nil
+		case err != nil:
+			return roles.Workspace{}, err
+		}
+
+		var list tenancyv1alpha1.TeamList
+		if err := r.List(ctx, &list, client.InNamespace(workspace.Namespace)); err != nil {
+			return roles.Workspace{}, err
+		}
+		teams = list.Items
+	}
+
+	return roles.Of(workspace, organization, teams, disabled), nil
+}
+
+// workspacesOfUser maps a user to the workspaces they own or have a role in,
+// so their bindings follow the user being disabled or enabled
+func (r *Reconciler) workspacesOfUser(obj client.Object) []reconcile.Request {
+	user, ok := obj.(*tenancyv1alpha1.User)
+	if !ok {
+		return nil
+	}
+
+	cluster := logicalcluster.From(user)
+	ctx := logicalcluster.WithCluster(context.Background(), cluster)
+
+	// organizations the user owns or is in a team of
+	namespaces := map[string]bool{user.Name: true}
+	var organizations tenancyv1alpha1.OrganizationList
+	if err := r.List(ctx, &organizations); err != nil {
+		klog.Errorf("failed to list organizations of user %s: %v", user.Name, err)
+		return nil
+	}
+	for _, organization := range organizations.Items {
+		if slices.Contains(organization.Spec.Owners, user.Spec.Email) {
+			namespaces[tenancyv1alpha1.OrganizationNamespace(organization.Name)] = true
+		}
+	}
+	var teams tenancyv1alpha1.TeamList
+	if err := r.List(ctx, &teams); err != nil {
+		klog.Errorf("failed to list teams of user %s: %v", user.Name, err)
+		return nil
+	}
+	for _, team := range teams.Items {
+		if slices.Contains(team.Spec.Members, user.Spec.Email) {
+			namespaces[team.Namespace] = true
+		}
+	}
+
+	var workspaces tenancyv1alpha1.WorkspaceList
+	if err := r.List(ctx, &workspaces); err != nil {
+		klog.Errorf("failed to list workspaces of user %s: %v", user.Name, err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, workspace := range workspaces.Items {
+		if namespaces[workspace.Namespace] || slices.Contains(workspace.Spec.Members, user.Spec.Email) {
+			requests = append(requests, requestOf(workspace, cluster))
+		}
+	}
+	return requests
+}
+
+// workspacesOfOrganization maps teams and organizations to the workspaces of
+// their organization, so bindings follow teams and owners
+func (r *Reconciler) workspacesOfOrganization(obj client.Object) []reconcile.Request {
+	namespace := obj.GetNamespace()
+	if organization, ok := obj.(*tenancyv1alpha1.Organization); ok {
+		namespace = tenancyv1alpha1.OrganizationNamespace(organization.Name)
+	}
+
+	cluster := logicalcluster.From(obj)
+	ctx := logicalcluster.WithCluster(context.Background(), cluster)
+
+	var workspaces tenancyv1alpha1.WorkspaceList
+	if err := r.List(ctx, &workspaces, client.InNamespace(namespace)); err != nil {
+		klog.Errorf("failed to list workspaces of namespace %s: %v", namespace, err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, workspace := range workspaces.Items {
+		requests = append(requests, requestOf(workspace, cluster))
+	}
+	return requests
+}
+
+// requestOf returns the reconcile request of workspace in cluster
+func requestOf(workspace tenancyv1alpha1.Workspace, cluster logicalcluster.Name) reconcile.Request {
+	return reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: workspace.Namespace, Name: workspace.Name},
+		ClusterName:    cluster.String(),
+	}
+}
+
+// hasOwnerReference returns whether owners include the object with uid
+func hasOwnerReference(owners []metav1.OwnerReference, uid types.UID) bool {
+	for _, owner := range owners {
+		if owner.UID == uid {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,10 @@ import (
 )
 
 func (r *Reconciler) getWorkspaceName(w *tenancyv1alpha1.Workspace) string {
+	// transferred workspaces keep the kcp workspace of their first owner
+	if w.Status.Path != "" {
+		return w.Status.Path
+	}
 	return fmt.Sprintf("%s:%s:%s", r.Config.TenantsWorkspacePrefix, w.Namespace, w.Name)
 }
 
@@ -14,6 +18,14 @@ func (r *Reconciler) getRBACClusterAdminName(workspace *tenancyv1alpha1.Workspac
 	return fmt.Sprintf("%s-cluster-admin", workspace.Name)
 }
 
+func (r *Reconciler) getRBACClusterAccessName(workspace *tenancyv1alpha1.Workspace) string {
+	return fmt.Sprintf("%s-cluster-access", workspace.Name)
+}
+
 func (r *Reconciler) getOrgClusterAccessName(workspace *tenancyv1alpha1.Workspace) string {
 	return fmt.Sprintf("%s-%s-cluster-admin", workspace.Namespace, workspace.Name)
 }
+
+func (r *Reconciler) getArchiveName(workspace *tenancyv1alpha1.Workspace) string {
+	return fmt.Sprintf("%s-archive", workspace.Name)
+}
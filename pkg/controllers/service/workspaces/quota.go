@@ -0,0 +1,90 @@
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kcp-dev/logicalcluster/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/quota"
+)
+
+// getLimits returns the effective limits of the owner of the workspace
+func (r *Reconciler) getLimits(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (tenancyv1alpha1.UserLimits, error) {
+	var user tenancyv1alpha1.User
+	err := r.Get(ctx, types.NamespacedName{Name: workspace.Namespace}, &user)
+	switch {
+	case apierrors.IsNotFound(err):
+		return quota.Limits(nil, r.Config.LimitsConfig), nil
+	case err != nil:
+		return tenancyv1alpha1.UserLimits{}, err
+	}
+	return quota.Limits(&user, r.Config.LimitsConfig), nil
+}
+
+// workspacesQuotaExceeded returns whether the workspace is over the workspaces
+// limit of its owner. Workspaces are counted in creation order, so workspaces
+// created bypassing the hub api are not provisioned over the limit, but older
+// ones are never taken away.
+func (r *Reconciler) workspacesQuotaExceeded(ctx context.Context, workspace *tenancyv1alpha1.Workspace, limits tenancyv1alpha1.UserLimits) (bool, error) {
+	var workspaces tenancyv1alpha1.WorkspaceList
+	if err := r.List(ctx, &workspaces, client.InNamespace(workspace.Namespace)); err != nil {
+		return false, err
+	}
+
+	sort.Slice(workspaces.Items, func(i, j int) bool {
+		a, b := workspaces.Items[i], workspaces.Items[j]
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		return a.Name < b.Name
+	})
+
+	var older int32
+	for _, w := range workspaces.Items {
+		if w.Name == workspace.Name {
+			break
+		}
+		older++
+	}
+	return quota.Exceeded(limits.Workspaces, older), nil
+}
+
+// createOrUpdateResourceQuota creates resourceQuota in the workspace,
+// restoring it if it was changed
+func (r *Reconciler) createOrUpdateResourceQuota(ctx context.Context, workspace *tenancyv1alpha1.Workspace, resourceQuota *corev1.ResourceQuota) (ctrl.Result, error) {
+	cluster := logicalcluster.New(r.getWorkspaceName(workspace))
+
+	current, err := r.CoreClients.Cluster(cluster).CoreV1().ResourceQuotas(resourceQuota.Namespace).Get(ctx, resourceQuota.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err := r.CoreClients.Cluster(cluster).CoreV1().ResourceQuotas(resourceQuota.Namespace).Create(ctx, resourceQuota, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return ctrl.Result{RequeueAfter: time.Second * 30}, fmt.Errorf("failed to create the ResourceQuota %s", err)
+		}
+	case err == nil:
+		if current.Annotations[quota.ClusterScopedAnnotation] == "true" && equality.Semantic.DeepEqual(current.Spec, resourceQuota.Spec) {
+			return ctrl.Result{}, nil
+		}
+		current.Annotations = resourceQuota.Annotations
+		current.Spec = resourceQuota.Spec
+		_, err := r.CoreClients.Cluster(cluster).CoreV1().ResourceQuotas(resourceQuota.Namespace).Update(ctx, current, metav1.UpdateOptions{})
+		if err != nil {
+			return ctrl.Result{RequeueAfter: time.Second * 30}, fmt.Errorf("failed to update the ResourceQuota %s", err)
+		}
+	default:
+		return ctrl.Result{RequeueAfter: time.Second * 30}, fmt.Errorf("failed to get the ResourceQuota %s", err)
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,113 @@
+package workspaces
+
+import (
+	"context"
+
+	"github.com/kcp-dev/logicalcluster/v2"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	accessv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/access/v1alpha1"
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	pluginsv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/plugins/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/roles"
+)
+
+const (
+	// teamEditRoleName is the cluster role and binding of team members with
+	// the Edit role inside workspaces
+	teamEditRoleName = "faros:team:edit"
+	// teamViewRoleName is the cluster role and binding of team members with
+	// the View role inside workspaces
+	teamViewRoleName = "faros:team:view"
+)
+
+// farosAPIGroups are the api groups team roles grant permissions on
+var farosAPIGroups = []string{
+	edgev1alpha1.SchemeGroupVersion.Group,
+	accessv1alpha1.SchemeGroupVersion.Group,
+	pluginsv1alpha1.SchemeGroupVersion.Group,
+}
+
+// teamRoleRules returns the rules of the team role inside workspaces.
+// Viewers can't read registrations, their status holds the token agents
+// connect their tunnels with.
+func teamRoleRules(role tenancyv1alpha1.TeamRole) []rbacv1.PolicyRule {
+	if role == tenancyv1alpha1.TeamRoleEdit {
+		return append([]rbacv1.PolicyRule{{
+			APIGroups: farosAPIGroups,
+			Resources: []string{"*"},
+			Verbs:     []string{"*"},
+		}}, namespacesRule)
+	}
+
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{accessv1alpha1.SchemeGroupVersion.Group, pluginsv1alpha1.SchemeGroupVersion.Group},
+			Resources: []string{"*"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+		{
+			APIGroups: []string{edgev1alpha1.SchemeGroupVersion.Group},
+			Resources: []string{"agents", "agents/status"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+		namespacesRule,
+	}
+}
+
+// namespacesRule lets team members find the namespaces of the workspace
+var namespacesRule = rbacv1.PolicyRule{
+	APIGroups: []string{""},
+	Resources: []string{"namespaces"},
+	Verbs:     []string{"get", "list", "watch"},
+}
+
+// createOrUpdateTeamRoles binds team members with the Edit and View roles to
+// their permissions inside the workspace. Admins are admins of the workspace
+// already.
+func (r *Reconciler) createOrUpdateTeamRoles(ctx context.Context, workspace *tenancyv1alpha1.Workspace, workspaceRoles roles.Workspace) (ctrl.Result, error) {
+	cluster := logicalcluster.New(r.getWorkspaceName(workspace))
+
+	for _, role := range []struct {
+		name    string
+		role    tenancyv1alpha1.TeamRole
+		members []string
+	}{
+		{name: teamEditRoleName, role: tenancyv1alpha1.TeamRoleEdit, members: workspaceRoles.Editors},
+		{name: teamViewRoleName, role: tenancyv1alpha1.TeamRoleView, members: workspaceRoles.Viewers},
+	} {
+		clusterRole := &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: role.name,
+			},
+			Rules: teamRoleRules(role.role),
+		}
+
+		result, err := r.createOrUpdateClusterRole(ctx, clusterRole, cluster, nil)
+		if err != nil {
+			return result, err
+		}
+
+		clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: role.name,
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     clusterRole.Name,
+			},
+			Subjects: r.subjects(role.members),
+		}
+
+		result, err = r.createOrUpdateClusterRoleBinding(ctx, clusterRoleBinding, cluster, nil)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
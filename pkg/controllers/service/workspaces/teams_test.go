@@ -0,0 +1,48 @@
+package workspaces
+
+import (
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+func TestTeamRoleRules(t *testing.T) {
+	// allows returns whether rules grant verb on resource of the edge group
+	allows := func(rules []rbacv1.PolicyRule, verb, resource string) bool {
+		match := func(values []string, value string) bool {
+			for _, v := range values {
+				if v == "*" || v == value {
+					return true
+				}
+			}
+			return false
+		}
+		for _, rule := range rules {
+			if match(rule.APIGroups, edgev1alpha1.SchemeGroupVersion.Group) && match(rule.Resources, resource) && match(rule.Verbs, verb) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, tt := range []struct {
+		role     tenancyv1alpha1.TeamRole
+		verb     string
+		resource string
+		allowed  bool
+	}{
+		{role: tenancyv1alpha1.TeamRoleView, verb: "get", resource: "agents", allowed: true},
+		{role: tenancyv1alpha1.TeamRoleView, verb: "update", resource: "agents"},
+		{role: tenancyv1alpha1.TeamRoleView, verb: "get", resource: "registrations"},
+		{role: tenancyv1alpha1.TeamRoleView, verb: "list", resource: "registrations"},
+		{role: tenancyv1alpha1.TeamRoleEdit, verb: "get", resource: "registrations", allowed: true},
+		{role: tenancyv1alpha1.TeamRoleEdit, verb: "update", resource: "agents", allowed: true},
+	} {
+		if got := allows(teamRoleRules(tt.role), tt.verb, tt.resource); got != tt.allowed {
+			t.Errorf("%s %s %s: expected allowed %t, got %t", tt.role, tt.verb, tt.resource, tt.allowed, got)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apisv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/apis/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	memory "k8s.io/client-go/discovery/cached"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/bootstrap"
+)
+
+// templateRetryPeriod is how long to wait before retrying to apply a template
+// which failed, i.e. until APIs it binds are available
+const templateRetryPeriod = 30 * time.Second
+
+// reconcileTemplate applies the template of the workspace once per template
+// generation and reports drift of the workspace from it afterwards. The
+// result is recorded in the workspace conditions, it returns when the
+// workspace should be reconciled again.
+func (r *Reconciler) reconcileTemplate(ctx context.Context, logger logr.Logger, workspace *tenancyv1alpha1.Workspace) time.Duration {
+	if workspace.Spec.Template == "" {
+		conditions.Delete(workspace, tenancyv1alpha1.WorkspaceTemplateApplied)
+		conditions.Delete(workspace, tenancyv1alpha1.WorkspaceTemplateInSync)
+		workspace.Status.TemplateGeneration = 0
+		return 0
+	}
+
+	var template tenancyv1alpha1.WorkspaceTemplate
+	if err := r.Get(ctx, types.NamespacedName{Name: workspace.Spec.Template}, &template); err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceTemplateApplied, tenancyv1alpha1.WorkspaceTemplateNotFoundReason,
+				conditionsv1alpha1.ConditionSeverityError, "WorkspaceTemplate %s not found", workspace.Spec.Template)
+			return templateRetryPeriod
+		}
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceTemplateApplied, tenancyv1alpha1.WorkspaceTemplateFailedReason,
+			conditionsv1alpha1.ConditionSeverityError, "Failed to get WorkspaceTemplate %s: %v", workspace.Spec.Template, err)
+		return templateRetryPeriod
+	}
+
+	objects, err := templateObjects(&template, r.Config.ControllersWorkspace)
+	if err != nil {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceTemplateApplied, tenancyv1alpha1.WorkspaceTemplateFailedReason,
+			conditionsv1alpha1.ConditionSeverityError, "Invalid WorkspaceTemplate %s: %v", template.Name, err)
+		return templateRetryPeriod
+	}
+
+	rest, err := r.ClientFactory.GetWorkspaceRestConfig(ctx, r.getWorkspaceName(workspace))
+	if err != nil {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceTemplateApplied, tenancyv1alpha1.WorkspaceTemplateFailedReason,
+			conditionsv1alpha1.ConditionSeverityError, "%v", err)
+		return templateRetryPeriod
+	}
+	dynamicClient, err := dynamic.NewForConfig(rest)
+	if err != nil {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceTemplateApplied, tenancyv1alpha1.WorkspaceTemplateFailedReason,
+			conditionsv1alpha1.ConditionSeverityError, "%v", err)
+		return templateRetryPeriod
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(rest)
+	if err != nil {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceTemplateApplied, tenancyv1alpha1.WorkspaceTemplateFailedReason,
+			conditionsv1alpha1.ConditionSeverityError, "%v", err)
+		return templateRetryPeriod
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+
+	if workspace.Status.TemplateGeneration != template.Generation || !conditions.IsTrue(workspace, tenancyv1alpha1.WorkspaceTemplateApplied) {
+		logger.Info("applying template", "template", template.Name, "generation", template.Generation)
+		for _, obj := range objects {
+			if err := defaultNamespace(mapper, obj); err != nil {
+				conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceTemplateApplied, tenancyv1alpha1.WorkspaceTemplateFailedReason,
+					conditionsv1alpha1.ConditionSeverityError, "Failed to apply %s: %v", objectName(obj), err)
+				return templateRetryPeriod
+			}
+			if err := bootstrap.CreateOrUpdateResource(ctx, dynamicClient, mapper, obj); err != nil {
+				conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceTemplateApplied, tenancyv1alpha1.WorkspaceTemplateFailedReason,
+					conditionsv1alpha1.ConditionSeverityError, "Failed to apply %s: %v", objectName(obj), err)
+				return templateRetryPeriod
+			}
+		}
+		conditions.MarkTrue(workspace, tenancyv1alpha1.WorkspaceTemplateApplied)
+		conditions.MarkTrue(workspace, tenancyv1alpha1.WorkspaceTemplateInSync)
+		workspace.Status.TemplateGeneration = template.Generation
+		return resyncPeriod
+	}
+
+	var drifted []string
+	for _, obj := range objects {
+		if err := defaultNamespace(mapper, obj); err != nil {
+			logger.Error(err, "failed to check drift", "object", objectName(obj))
+			return templateRetryPeriod
+		}
+		m, err := mapper.RESTMapping(obj.GroupVersionKind().GroupKind(), obj.GroupVersionKind().Version)
+		if err != nil {
+			logger.Error(err, "failed to check drift", "object", objectName(obj))
+			return templateRetryPeriod
+		}
+		live, err := dynamicClient.Resource(m.Resource).Namespace(obj.GetNamespace()).Get(ctx, obj.GetName(), metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			drifted = append(drifted, objectName(obj))
+		case err != nil:
+			logger.Error(err, "failed to check drift", "object", objectName(obj))
+			return templateRetryPeriod
+		case !isSubset(obj.Object, live.Object):
+			drifted = append(drifted, objectName(obj))
+		}
+	}
+
+	if len(drifted) > 0 {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceTemplateInSync, tenancyv1alpha1.WorkspaceTemplateDriftedReason,
+			conditionsv1alpha1.ConditionSeverityWarning, "Objects changed or deleted since WorkspaceTemplate %s was applied: %s", template.Name, strings.Join(drifted, ", "))
+	} else {
+		conditions.MarkTrue(workspace, tenancyv1alpha1.WorkspaceTemplateInSync)
+	}
+
+	return resyncPeriod
+}
+
+// templateObjects returns the objects of the template in the order they are
+// applied: API bindings first, so manifests can use the APIs they bind, then
+// namespaces and manifests.
+func templateObjects(template *tenancyv1alpha1.WorkspaceTemplate, controllersWorkspace string) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+
+	for _, b := range template.Spec.APIBindings {
+		path := b.Path
+		if path == "" {
+			path = controllersWorkspace
+		}
+		binding := &apisv1alpha1.APIBinding{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: apisv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "APIBinding",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: b.ExportName,
+			},
+			Spec: apisv1alpha1.APIBindingSpec{
+				Reference: apisv1alpha1.ExportReference{
+					Workspace: &apisv1alpha1.WorkspaceExportReference{
+						Path:       path,
+						ExportName: b.ExportName,
+					},
+				},
+			},
+		}
+		obj, err := toUnstructured(binding)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+
+	for _, name := range template.Spec.Namespaces {
+		namespace := &corev1.Namespace{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "Namespace",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+		obj, err := toUnstructured(namespace)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+
+	for i, manifest := range template.Spec.Manifests {
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(manifest.Raw); err != nil {
+			return nil, fmt.Errorf("manifest %d: %w", i, err)
+		}
+		if obj.GetName() == "" {
+			return nil, fmt.Errorf("manifest %d: %s without name", i, obj.GetKind())
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// toUnstructured converts a typed object to an unstructured one, without the
+// empty fields the conversion adds, i.e. creationTimestamp
+func toUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{Object: content}
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(u.Object, "status")
+	if spec, ok := u.Object["spec"].(map[string]interface{}); ok && len(spec) == 0 {
+		delete(u.Object, "spec")
+	}
+	return u, nil
+}
+
+// defaultNamespace sets the namespace of namespaced objects without one to
+// the default namespace
+func defaultNamespace(mapper meta.RESTMapper, obj *unstructured.Unstructured) error {
+	if obj.GetNamespace() != "" {
+		return nil
+	}
+	gvk := obj.GroupVersionKind()
+	m, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return fmt.Errorf("could not get REST mapping for %s: %w", gvk, err)
+	}
+	if m.Scope.Name() == meta.RESTScopeNameNamespace {
+		obj.SetNamespace(metav1.NamespaceDefault)
+	}
+	return nil
+}
+
+// isSubset returns whether all fields set in expected have the same value in
+// live. Fields only set in live, i.e. defaulted by the api server or set by
+// controllers, are not drift.
+func isSubset(expected, live interface{}) bool {
+	switch expected := expected.(type) {
+	case map[string]interface{}:
+		live, ok := live.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range expected {
+			if !isSubset(v, live[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		live, ok := live.([]interface{})
+		if !ok || len(live) != len(expected) {
+			return false
+		}
+		for i := range expected {
+			if !isSubset(expected[i], live[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(expected, live)
+	}
+}
+
+// objectName returns a readable name of obj, i.e. namespace/fleet
+func objectName(obj *unstructured.Unstructured) string {
+	name := strings.ToLower(obj.GetKind()) + "/" + obj.GetName()
+	if obj.GetNamespace() != "" {
+		return obj.GetNamespace() + "/" + name
+	}
+	return name
+}
@@ -0,0 +1,113 @@
+package workspaces
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+func TestTemplateObjects(t *testing.T) {
+	template := &tenancyv1alpha1.WorkspaceTemplate{
+		Spec: tenancyv1alpha1.WorkspaceTemplateSpec{
+			APIBindings: []tenancyv1alpha1.WorkspaceTemplateAPIBinding{
+				{ExportName: "plugins.faros.sh"},
+				{ExportName: "kubernetes", Path: "root:compute"},
+			},
+			Namespaces: []string{"fleet"},
+			Manifests: []runtime.RawExtension{
+				{Raw: []byte(`{"apiVersion":"plugins.faros.sh/v1alpha1","kind":"Monitoring","metadata":{"name":"monitoring","namespace":"fleet"}}`)},
+			},
+		},
+	}
+
+	objects, err := templateObjects(template, "root:faros:service:controllers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, obj := range objects {
+		names = append(names, objectName(obj))
+	}
+	expected := []string{"apibinding/plugins.faros.sh", "apibinding/kubernetes", "namespace/fleet", "fleet/monitoring/monitoring"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected objects %v, got %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("expected objects %v, got %v", expected, names)
+			break
+		}
+	}
+
+	for i, path := range []string{"root:faros:service:controllers", "root:compute"} {
+		if actual := objects[i].Object["spec"].(map[string]interface{})["reference"].(map[string]interface{})["workspace"].(map[string]interface{})["path"]; actual != path {
+			t.Errorf("expected binding %d to reference %s, got %v", i, path, actual)
+		}
+	}
+	if _, ok := objects[2].Object["metadata"].(map[string]interface{})["creationTimestamp"]; ok {
+		t.Error("expected empty creationTimestamp to be removed")
+	}
+
+	template.Spec.Manifests = []runtime.RawExtension{{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap"}`)}}
+	if _, err := templateObjects(template, ""); err == nil {
+		t.Error("expected manifest without name to be invalid")
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	expected := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "agents", "labels": map[string]interface{}{"tier": "edge"}},
+		"spec":     map[string]interface{}{"hard": map[string]interface{}{"count/agents.edge.faros.sh": "50"}},
+		"items":    []interface{}{"a", map[string]interface{}{"b": int64(1)}},
+	}
+
+	for _, tt := range []struct {
+		name   string
+		live   map[string]interface{}
+		subset bool
+	}{
+		{
+			name: "defaulted fields",
+			live: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "agents", "uid": "1", "labels": map[string]interface{}{"tier": "edge", "other": "x"}},
+				"spec":     map[string]interface{}{"hard": map[string]interface{}{"count/agents.edge.faros.sh": "50"}},
+				"items":    []interface{}{"a", map[string]interface{}{"b": int64(1), "c": true}},
+				"status":   map[string]interface{}{"used": "3"},
+			},
+			subset: true,
+		},
+		{
+			name: "changed value",
+			live: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "agents", "labels": map[string]interface{}{"tier": "edge"}},
+				"spec":     map[string]interface{}{"hard": map[string]interface{}{"count/agents.edge.faros.sh": "100"}},
+				"items":    []interface{}{"a", map[string]interface{}{"b": int64(1)}},
+			},
+		},
+		{
+			name: "removed label",
+			live: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "agents"},
+				"spec":     map[string]interface{}{"hard": map[string]interface{}{"count/agents.edge.faros.sh": "50"}},
+				"items":    []interface{}{"a", map[string]interface{}{"b": int64(1)}},
+			},
+		},
+		{
+			name: "added list item",
+			live: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "agents", "labels": map[string]interface{}{"tier": "edge"}},
+				"spec":     map[string]interface{}{"hard": map[string]interface{}{"count/agents.edge.faros.sh": "50"}},
+				"items":    []interface{}{"a", map[string]interface{}{"b": int64(1)}, "c"},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := isSubset(expected, tt.live); actual != tt.subset {
+				t.Errorf("expected isSubset to be %v, got %v", tt.subset, actual)
+			}
+		})
+	}
+}
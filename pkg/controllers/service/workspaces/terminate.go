@@ -0,0 +1,225 @@
+package workspaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	"github.com/kcp-dev/logicalcluster/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/archive"
+	"github.com/faroshq/faros-hub/pkg/util/quota"
+)
+
+const (
+	// archiveDataKey is the key of the archive in archive secrets
+	archiveDataKey = "archive.tar.gz"
+	// archiveWorkspaceLabel is the label of archive secrets with the name of
+	// the archived workspace
+	archiveWorkspaceLabel = "tenancy.faros.sh/workspace"
+
+	// terminatingEvent is the event of notifications sent when a workspace
+	// starts terminating
+	terminatingEvent = "WorkspaceTerminating"
+)
+
+// notification is the payload sent to the notification webhook
+type notification struct {
+	Event     string      `json:"event"`
+	Workspace string      `json:"workspace"`
+	Owner     string      `json:"owner"`
+	Members   []string    `json:"members"`
+	Deadline  metav1.Time `json:"deadline"`
+}
+
+// terminate drains, archives and notifies members of a workspace deleted
+// through the hub api, and deletes it once its grace period passed. Workspaces
+// are not deleted before they are archived.
+func (r *Reconciler) terminate(ctx context.Context, logger logr.Logger, workspace *tenancyv1alpha1.Workspace) (ctrl.Result, error) {
+	deadline, err := time.Parse(time.RFC3339, workspace.Annotations[tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation])
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("invalid %s annotation: %w", tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation, err)
+	}
+
+	patch := client.MergeFrom(workspace.DeepCopy())
+	workspace.Status.Phase = tenancyv1alpha1.WorkspacePhaseTerminating
+	workspace.Status.DeletionDeadline = &metav1.Time{Time: deadline}
+
+	// block new agents and access requests, existing ones keep working until
+	// the workspace is deleted
+	if workspace.Status.WorkspaceURL != "" {
+		result, err := r.createOrUpdateResourceQuota(ctx, workspace, quota.DrainedResourceQuota())
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if !conditions.IsTrue(workspace, tenancyv1alpha1.WorkspaceArchived) {
+		logger.Info("archiving workspace")
+		if err := r.archive(ctx, workspace); err != nil {
+			logger.Error(err, "failed to archive workspace")
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceArchived, tenancyv1alpha1.WorkspaceArchiveFailedReason,
+				conditionsv1alpha1.ConditionSeverityError, "Failed to archive workspace: %v", err)
+		} else {
+			conditions.MarkTrue(workspace, tenancyv1alpha1.WorkspaceArchived)
+			workspace.Status.Archive = r.getArchiveName(workspace)
+		}
+	}
+
+	if r.Config.NotificationWebhookURL != "" && !conditions.IsTrue(workspace, tenancyv1alpha1.WorkspaceMembersNotified) {
+		if err := r.notify(ctx, workspace, deadline); err != nil {
+			logger.Error(err, "failed to notify workspace members")
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMembersNotified, tenancyv1alpha1.WorkspaceNotificationFailedReason,
+				conditionsv1alpha1.ConditionSeverityWarning, "Failed to notify members: %v", err)
+		} else {
+			conditions.MarkTrue(workspace, tenancyv1alpha1.WorkspaceMembersNotified)
+		}
+	}
+
+	if err := r.Status().Patch(ctx, workspace, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !conditions.IsTrue(workspace, tenancyv1alpha1.WorkspaceArchived) {
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+
+	if remaining := time.Until(deadline); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	logger.Info("grace period passed, deleting workspace")
+	if err := r.Delete(ctx, workspace); err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// restore reverts a terminating workspace whose deletion was cancelled. The
+// quota of its owner is restored when it is provisioned again.
+func (r *Reconciler) restore(ctx context.Context, logger logr.Logger, workspace *tenancyv1alpha1.Workspace) error {
+	logger.Info("restoring workspace")
+
+	cluster := logicalcluster.New(r.Config.ControllersTenantWorkspace)
+	err := r.CoreClients.Cluster(cluster).CoreV1().Secrets(workspace.Namespace).Delete(ctx, r.getArchiveName(workspace), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete the archive %s", err)
+	}
+
+	patch := client.MergeFrom(workspace.DeepCopy())
+	workspace.Status.Phase = ""
+	workspace.Status.DeletionDeadline = nil
+	workspace.Status.Archive = ""
+	conditions.Delete(workspace, tenancyv1alpha1.WorkspaceArchived)
+	conditions.Delete(workspace, tenancyv1alpha1.WorkspaceMembersNotified)
+	return r.Status().Patch(ctx, workspace, patch)
+}
+
+// archive stores the workspace and its faros objects in a secret next to the
+// workspace in the tenants workspace, so they can be recovered after it is
+// deleted
+func (r *Reconciler) archive(ctx context.Context, workspace *tenancyv1alpha1.Workspace) error {
+	workspaceCopy := workspace.DeepCopy()
+	workspaceCopy.SetGroupVersionKind(tenancyv1alpha1.SchemeGroupVersion.WithKind(tenancyv1alpha1.WorkspaceKind))
+	delete(workspaceCopy.Annotations, tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation)
+	u, err := toUnstructured(workspaceCopy)
+	if err != nil {
+		return err
+	}
+
+	var objects []*unstructured.Unstructured
+	if workspace.Status.WorkspaceURL != "" {
+		rest, err := r.ClientFactory.GetWorkspaceRestConfig(ctx, r.getWorkspaceName(workspace))
+		if err != nil {
+			return err
+		}
+		dynamicClient, err := dynamic.NewForConfig(rest)
+		if err != nil {
+			return err
+		}
+		objects, err = archive.Export(ctx, dynamicClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := archive.Write(buf, archive.Clean(u), objects); err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.getArchiveName(workspace),
+			Namespace: workspace.Namespace,
+			Labels: map[string]string{
+				archiveWorkspaceLabel: workspace.Name,
+			},
+		},
+		Data: map[string][]byte{
+			archiveDataKey: buf.Bytes(),
+		},
+	}
+
+	cluster := logicalcluster.New(r.Config.ControllersTenantWorkspace)
+	current, err := r.CoreClients.Cluster(cluster).CoreV1().Secrets(secret.Namespace).Get(ctx, secret.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = r.CoreClients.Cluster(cluster).CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+		return err
+	case err == nil:
+		current.Labels = secret.Labels
+		current.Data = secret.Data
+		_, err = r.CoreClients.Cluster(cluster).CoreV1().Secrets(secret.Namespace).Update(ctx, current, metav1.UpdateOptions{})
+		return err
+	default:
+		return err
+	}
+}
+
+// notify sends the members of the workspace to the notification webhook, to
+// let them know it will be deleted at deadline
+func (r *Reconciler) notify(ctx context.Context, workspace *tenancyv1alpha1.Workspace, deadline time.Time) error {
+	data, err := json.Marshal(notification{
+		Event:     terminatingEvent,
+		Workspace: workspace.Name,
+		Owner:     workspace.Namespace,
+		Members:   workspace.Spec.Members,
+		Deadline:  metav1.Time{Time: deadline},
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.Config.NotificationWebhookURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package workspaces
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	"github.com/kcp-dev/logicalcluster/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/config"
+)
+
+// fakeCluster serves all logical clusters from the same fake clientset
+type fakeCluster struct {
+	*kubefake.Clientset
+}
+
+func (c fakeCluster) Cluster(logicalcluster.Name) kubernetes.Interface {
+	return c.Clientset
+}
+
+// newTerminateTest returns a reconciler of workspace, the core clientset
+// archives are stored with and the requests of the notification webhook
+func newTerminateTest(t *testing.T, workspace *tenancyv1alpha1.Workspace, webhookStatus int) (*Reconciler, *kubefake.Clientset, *[]notification) {
+	scheme := runtime.NewScheme()
+	if err := tenancyv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	var notifications []notification
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var n notification
+		if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+			t.Error(err)
+		}
+		notifications = append(notifications, n)
+		w.WriteHeader(webhookStatus)
+	}))
+	t.Cleanup(webhook.Close)
+
+	coreClient := kubefake.NewSimpleClientset()
+	return &Reconciler{
+		Client:      fake.NewClientBuilder().WithScheme(scheme).WithObjects(workspace).Build(),
+		Scheme:      scheme,
+		Config:      &config.ControllerConfig{ControllersTenantWorkspace: "root:faros:service:tenants", NotificationWebhookURL: webhook.URL},
+		CoreClients: fakeCluster{coreClient},
+	}, coreClient, &notifications
+}
+
+// terminatingWorkspace returns a workspace deleted through the hub api, to
+// be deleted at deadline
+func terminatingWorkspace(deadline time.Time) *tenancyv1alpha1.Workspace {
+	return &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "fleet",
+			Namespace:   "jane",
+			Annotations: map[string]string{tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation: deadline.UTC().Format(time.RFC3339)},
+		},
+		Spec: tenancyv1alpha1.WorkspaceSpec{Members: []string{"jane@example.com"}},
+	}
+}
+
+func TestTerminateBeforeDeadline(t *testing.T) {
+	ctx := context.Background()
+	deadline := time.Now().Add(time.Hour)
+	r, coreClient, notifications := newTerminateTest(t, terminatingWorkspace(deadline), http.StatusOK)
+
+	workspace := &tenancyv1alpha1.Workspace{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: "jane", Name: "fleet"}, workspace); err != nil {
+		t.Fatal(err)
+	}
+	result, err := r.terminate(ctx, logr.Discard(), workspace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// requeued for the end of the grace period
+	if result.RequeueAfter <= 59*time.Minute || result.RequeueAfter > time.Hour {
+		t.Errorf("expected requeue at the deadline, in about an hour, got %v", result.RequeueAfter)
+	}
+
+	if err := r.Get(ctx, client.ObjectKeyFromObject(workspace), workspace); err != nil {
+		t.Fatalf("expected workspace to be kept until the deadline, got %v", err)
+	}
+	if workspace.Status.Phase != tenancyv1alpha1.WorkspacePhaseTerminating || workspace.Status.DeletionDeadline == nil || !workspace.Status.DeletionDeadline.Time.Equal(deadline.Truncate(time.Second)) {
+		t.Errorf("expected phase %s until %v, got %s until %v", tenancyv1alpha1.WorkspacePhaseTerminating, deadline, workspace.Status.Phase, workspace.Status.DeletionDeadline)
+	}
+	if !conditions.IsTrue(workspace, tenancyv1alpha1.WorkspaceArchived) || workspace.Status.Archive != "fleet-archive" {
+		t.Errorf("expected workspace to be archived to fleet-archive, got %q", workspace.Status.Archive)
+	}
+	secret, err := coreClient.CoreV1().Secrets("jane").Get(ctx, "fleet-archive", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret.Labels[archiveWorkspaceLabel] != "fleet" || len(secret.Data[archiveDataKey]) == 0 {
+		t.Errorf("expected archive of fleet, got labels %v and keys %v", secret.Labels, len(secret.Data))
+	}
+
+	if !conditions.IsTrue(workspace, tenancyv1alpha1.WorkspaceMembersNotified) || len(*notifications) != 1 {
+		t.Fatalf("expected members to be notified once, got %d notifications", len(*notifications))
+	}
+	if n := (*notifications)[0]; n.Event != terminatingEvent || n.Owner != "jane" || !reflect.DeepEqual(n.Members, []string{"jane@example.com"}) {
+		t.Errorf("unexpected notification %#v", n)
+	}
+
+	// members are notified once
+	if _, err := r.terminate(ctx, logr.Discard(), workspace); err != nil {
+		t.Fatal(err)
+	}
+	if len(*notifications) != 1 {
+		t.Errorf("expected members to be notified once, got %d notifications", len(*notifications))
+	}
+}
+
+func TestTerminateArchiveFailed(t *testing.T) {
+	ctx := context.Background()
+	r, coreClient, _ := newTerminateTest(t, terminatingWorkspace(time.Now().Add(-time.Minute)), http.StatusInternalServerError)
+	coreClient.PrependReactor("create", "secrets", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("unavailable")
+	})
+
+	workspace := &tenancyv1alpha1.Workspace{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: "jane", Name: "fleet"}, workspace); err != nil {
+		t.Fatal(err)
+	}
+	result, err := r.terminate(ctx, logr.Discard(), workspace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RequeueAfter != 30*time.Second {
+		t.Errorf("expected archiving to be retried in 30s, got %v", result.RequeueAfter)
+	}
+
+	// the grace period passed, but the workspace is not archived
+	if err := r.Get(ctx, client.ObjectKeyFromObject(workspace), workspace); err != nil {
+		t.Fatalf("expected workspace not to be deleted before it is archived, got %v", err)
+	}
+	if condition := conditions.Get(workspace, tenancyv1alpha1.WorkspaceArchived); condition == nil || condition.Status != corev1.ConditionFalse ||
+		condition.Reason != tenancyv1alpha1.WorkspaceArchiveFailedReason || condition.Severity != conditionsv1alpha1.ConditionSeverityError {
+		t.Errorf("expected %s condition to be false with reason %s, got %#v", tenancyv1alpha1.WorkspaceArchived, tenancyv1alpha1.WorkspaceArchiveFailedReason, condition)
+	}
+	if condition := conditions.Get(workspace, tenancyv1alpha1.WorkspaceMembersNotified); condition == nil || condition.Reason != tenancyv1alpha1.WorkspaceNotificationFailedReason {
+		t.Errorf("expected %s condition to be false with reason %s, got %#v", tenancyv1alpha1.WorkspaceMembersNotified, tenancyv1alpha1.WorkspaceNotificationFailedReason, condition)
+	}
+
+	// deleted once archived
+	coreClient.ReactionChain = coreClient.ReactionChain[1:]
+	if _, err := r.terminate(ctx, logr.Discard(), workspace); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(workspace), workspace); !apierrors.IsNotFound(err) {
+		t.Errorf("expected archived workspace past its deadline to be deleted, got %v", err)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	terminating := terminatingWorkspace(time.Now().Add(time.Hour))
+	delete(terminating.Annotations, tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation)
+	terminating.Status = tenancyv1alpha1.WorkspaceStatus{
+		Phase:            tenancyv1alpha1.WorkspacePhaseTerminating,
+		DeletionDeadline: &metav1.Time{Time: time.Now().Add(time.Hour)},
+		Archive:          "fleet-archive",
+	}
+	conditions.MarkTrue(terminating, tenancyv1alpha1.WorkspaceArchived)
+	conditions.MarkTrue(terminating, tenancyv1alpha1.WorkspaceMembersNotified)
+	r, coreClient, _ := newTerminateTest(t, terminating, http.StatusOK)
+	if _, err := coreClient.CoreV1().Secrets("jane").Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "fleet-archive", Namespace: "jane"}}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	workspace := &tenancyv1alpha1.Workspace{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: "jane", Name: "fleet"}, workspace); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.restore(ctx, logr.Discard(), workspace); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Get(ctx, client.ObjectKeyFromObject(workspace), workspace); err != nil {
+		t.Fatal(err)
+	}
+	if workspace.Status.Phase != "" || workspace.Status.DeletionDeadline != nil || workspace.Status.Archive != "" {
+		t.Errorf("expected phase, deadline and archive to be cleared, got %#v", workspace.Status)
+	}
+	if conditions.Has(workspace, tenancyv1alpha1.WorkspaceArchived) || conditions.Has(workspace, tenancyv1alpha1.WorkspaceMembersNotified) {
+		t.Errorf("expected termination conditions to be removed, got %v", workspace.Status.Conditions)
+	}
+	if _, err := coreClient.CoreV1().Secrets("jane").Get(ctx, "fleet-archive", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected archive to be deleted, got %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package workspaces
 
 import (
 	"context"
+	"time"
 
 	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
 	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
@@ -11,7 +12,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
 	"github.com/faroshq/faros-hub/pkg/config"
@@ -24,6 +27,10 @@ import (
 
 var finalizerName = "workspaces.tenancy.faros.sh/finalizer"
 
+// resyncPeriod is how often provisioned workspaces are reconciled, to restore
+// their quota and check them for drift from their template
+const resyncPeriod = 5 * time.Minute
+
 // Reconciler reconciles an object
 type Reconciler struct {
 	client.Client
@@ -82,5 +89,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&tenancyv1alpha1.Workspace{}).
+		Watches(&source.Kind{Type: &tenancyv1alpha1.User{}}, handler.EnqueueRequestsFromMapFunc(r.workspacesOfUser)).
+		Watches(&source.Kind{Type: &tenancyv1alpha1.Organization{}}, handler.EnqueueRequestsFromMapFunc(r.workspacesOfOrganization)).
+		Watches(&source.Kind{Type: &tenancyv1alpha1.Team{}}, handler.EnqueueRequestsFromMapFunc(r.workspacesOfOrganization)).
 		Complete(r)
 }
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/faroshq/faros-hub/pkg/controllers/service/organizations"
 	"github.com/faroshq/faros-hub/pkg/controllers/service/users"
 	"github.com/faroshq/faros-hub/pkg/controllers/service/workspaces"
 	"github.com/phayes/freeport"
@@ -98,6 +99,16 @@ func (c *controllerManager) runSystem(ctx context.Context) error {
 		return err
 	}
 
+	if err = (&organizations.Reconciler{
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Config:      c.config,
+		CoreClients: coreClient,
+	}).SetupWithManager(mgr); err != nil {
+		klog.Error(err, "unable to create controller", "organizations.tenancy.faros.sh")
+		return err
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		klog.Error(err, "unable to set up health check")
 		return err
@@ -31,6 +31,10 @@ func (r *Reconciler) createOrUpdate(ctx context.Context, logger logr.Logger, reg
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	if _, ok := registration.Annotations[edgev1alpha1.RegistrationRotateTokenAnnotation]; ok {
+		return r.rotateToken(ctx, logger, registration)
+	}
+
 	registrationOwnersReferences := []metav1.OwnerReference{{
 		APIVersion: workloadv1alpha1.SchemeGroupVersion.String(),
 		Kind:       edgev1alpha1.RegistrationKind,
@@ -1,5 +1,9 @@
 package registration
 
+import (
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+)
+
 func getRegistrationResourceName(name string) string {
-	return "registration-" + name
+	return edgev1alpha1.RegistrationServiceAccountName(name)
 }
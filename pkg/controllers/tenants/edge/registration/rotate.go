@@ -0,0 +1,49 @@
+package registration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+)
+
+// rotateToken revokes the token of the registration by deleting its secret.
+// The secret, and a new token, are created on the next reconcile.
+func (r *Reconciler) rotateToken(ctx context.Context, logger logr.Logger, registration *edgev1alpha1.Registration) (ctrl.Result, error) {
+	resourceName := getRegistrationResourceName(registration.Name)
+
+	logger.Info("rotating registration token", "name", resourceName)
+	err := r.Delete(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourceName,
+			Namespace: registration.Namespace,
+		},
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{RequeueAfter: time.Second * 30}, fmt.Errorf("failed to delete Secret: %s", err)
+	}
+
+	patch := client.MergeFrom(registration.DeepCopy())
+	registration.Status.Token = ""
+	conditions.MarkFalse(registration, conditionsv1alpha1.ReadyCondition, edgev1alpha1.RegistrationTokenRotatingReason, conditionsv1alpha1.ConditionSeverityInfo, "issuing a new token")
+	if err := r.Status().Patch(ctx, registration, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	delete(registration.Annotations, edgev1alpha1.RegistrationRotateTokenAnnotation)
+	if err := r.Update(ctx, registration); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{Requeue: true}, nil
+}
@@ -9,14 +9,13 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
-	"time"
 
 	"github.com/aojea/h2rev2"
 	"golang.org/x/net/http2"
 
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
-	"k8s.io/utils/clock"
+
+	"github.com/faroshq/faros-hub/pkg/util/backoff"
 )
 
 type Client struct {
@@ -83,26 +82,15 @@ func New(upstreamURL, downstreamURL, clientCertFile, clientCertKeyFile, clientID
 // startTunnel blocks until the context is cancelled trying to establish a tunnel against the specified target
 func (c *Client) Run(ctx context.Context) {
 	// connect to create the reverse tunnels
-	var (
-		initBackoff   = 5 * time.Second
-		maxBackoff    = 5 * time.Minute
-		resetDuration = 1 * time.Minute
-		backoffFactor = 2.0
-		jitter        = 1.0
-		clock         = &clock.RealClock{}
-		sliding       = true
-	)
-
-	backoffMgr := wait.NewExponentialBackoffManager(initBackoff, maxBackoff, resetDuration, backoffFactor, jitter, clock)
 	logger := klog.FromContext(ctx)
 
-	wait.BackoffUntil(func() {
+	backoff.Until(ctx, func() {
 		logger.V(2).Info("starting tunnel")
 		err := c.startTunneler(ctx)
 		if err != nil {
 			logger.Error(err, "failed to create tunnel")
 		}
-	}, backoffMgr, sliding, ctx.Done())
+	})
 }
 
 func (c *Client) startTunneler(ctx context.Context) error {
@@ -113,7 +101,7 @@ func (c *Client) startTunneler(ctx context.Context) error {
 
 	l, err := h2rev2.NewListener(c.upstreamClient, c.upstreamURL, c.clientID)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	// client --> local dev instance
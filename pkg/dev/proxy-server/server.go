@@ -2,6 +2,9 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
 	"net/http"
 	"time"
 
@@ -24,26 +27,60 @@ type Service struct {
 	certFile string
 }
 
-func New(addr, certFile, keyFile string) (*Service, error) {
+// New returns the dev reverse proxy server. Clients authenticate with a
+// certificate signed by clientCAFile, and their common name is the id of their
+// tunnel. Requests outside of the tunnel paths are proxied to defaultID, for
+// clients with the certificate of defaultID.
+func New(addr, certFile, keyFile, clientCAFile, defaultID string) (*Service, error) {
 	s := &Service{
 		addr:     addr,
 		keyFile:  keyFile,
 		certFile: certFile,
 	}
 
-	revPool := revdial.NewReversePool()
+	clientCA, err := ioutil.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	clientCert, err := x509.ParseCertificate(clientCA)
+	if err != nil {
+		return nil, err
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	revPool := revdial.NewReversePool(
+		revdial.WithAuthenticator(revdial.ClientCertAuthenticator()),
+		revdial.WithDefaultID(defaultID),
+	)
 	mux := http.NewServeMux()
+	mux.Handle("/debug/revdial", requireClientCert(revPool.DebugHandler()))
 	mux.Handle("/", revPool)
 
 	server := http.Server{
 		Addr:    addr,
 		Handler: mux,
+		TLSConfig: &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+		},
 	}
 	s.server = &server
 
 	return s, nil
 }
 
+// requireClientCert rejects requests without a verified client certificate
+func requireClientCert(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
 func (s *Service) Run(ctx context.Context) error {
 	klog.V(2).Infof("Starting remote server on %s", s.addr)
 
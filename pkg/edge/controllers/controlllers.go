@@ -5,8 +5,12 @@ import (
 	"net/http"
 	"time"
 
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog"
@@ -18,7 +22,9 @@ import (
 	"github.com/faroshq/faros-hub/pkg/config"
 	"github.com/faroshq/faros-hub/pkg/edge/controllers/agent"
 	"github.com/faroshq/faros-hub/pkg/edge/controllers/register"
+	"github.com/faroshq/faros-hub/pkg/edge/tunnel"
 	utilhttp "github.com/faroshq/faros-hub/pkg/util/http"
+	"github.com/faroshq/faros-hub/pkg/util/version"
 )
 
 var (
@@ -129,6 +135,45 @@ func (c *controllers) Run(ctx context.Context) error {
 		return err
 	}
 
+	if err := c.runTunnel(ctx, farosClient); err != nil {
+		return err
+	}
+
+	go c.runHeartbeat(ctx, farosClient)
+
 	klog.Info("starting manager")
 	return mgr.Start(ctx)
 }
+
+// runHeartbeat reports the agent is alive, and its version, until ctx is done
+func (c *controllers) runHeartbeat(ctx context.Context, farosClient farosclient.Interface) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		now := metav1.Now()
+		if err := tunnel.UpdateAgentStatus(ctx, farosClient, c.config.Namespace, c.config.Name, func(agent *edgev1alpha1.Agent) {
+			agent.Status.Version = version.Get()
+			agent.Status.LastHeartbeatTime = &now
+		}); err != nil {
+			klog.Errorf("failed to report agent heartbeat: %v", err)
+		}
+	}, c.config.HeartbeatInterval)
+}
+
+// runTunnel starts the reverse tunnel to the hub in the background, if one is
+// configured
+func (c *controllers) runTunnel(ctx context.Context, farosClient farosclient.Interface) error {
+	if c.config.TunnelURL == "" {
+		klog.Info("tunnel url not configured, tunnel disabled")
+		return tunnel.UpdateAgentStatus(ctx, farosClient, c.config.Namespace, c.config.Name, func(agent *edgev1alpha1.Agent) {
+			conditions.MarkFalse(agent, edgev1alpha1.AgentTunnelConnected, edgev1alpha1.AgentTunnelDisabledReason, conditionsv1alpha1.ConditionSeverityInfo, "tunnel url not configured")
+		})
+	}
+
+	t, err := tunnel.New(c.config, tunnel.AgentStatusFunc(farosClient, c.config.Namespace, c.config.Name))
+	if err != nil {
+		return err
+	}
+
+	klog.Infof("starting tunnel %s", t.ID())
+	go t.Run(ctx)
+	return nil
+}
@@ -7,9 +7,11 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
 
 	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
 	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
+	utilkubernetes "github.com/faroshq/faros-hub/pkg/util/kubernetes"
 )
 
 type Register interface {
@@ -34,10 +36,25 @@ func New(config *rest.Config) (Register, error) {
 }
 
 func (r *register) Register(ctx context.Context, name, namespace string) error {
-	_, err := r.client.EdgeV1alpha1().Agents(namespace).Create(ctx, &edgev1alpha1.Agent{
+	// the hub only accepts tunnels of the agent with the token of the
+	// registration it was created with
+	labels := map[string]string{}
+	token, err := utilkubernetes.BearerToken(r.config)
+	if err == nil {
+		var registration string
+		if registration, err = edgev1alpha1.RegistrationOfToken(token); err == nil {
+			labels[edgev1alpha1.AgentRegistrationLabel] = registration
+		}
+	}
+	if err != nil {
+		klog.Warningf("agent is not registered with the token of a registration, tunnels will be rejected: %v", err)
+	}
+
+	_, err = r.client.EdgeV1alpha1().Agents(namespace).Create(ctx, &edgev1alpha1.Agent{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
+			Labels:    labels,
 		},
 	}, metav1.CreateOptions{})
 	if err != nil && !apierrors.IsAlreadyExists(err) {
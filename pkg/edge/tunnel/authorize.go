@@ -0,0 +1,74 @@
+package tunnel
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// HeaderAuthorization carries the authorization of the hub for a single
+	// tunnel request, as <expiry>.<signature>
+	HeaderAuthorization = "X-Faros-Tunnel-Authorization"
+
+	// authorizationTTL is how long authorizations are valid after signing
+	authorizationTTL = time.Minute
+)
+
+// Authorize signs req with key, the session key the hub issued for the
+// tunnel, authorizing its method, path and query for the agent
+func Authorize(req *http.Request, key string) {
+	expiry := time.Now().Add(authorizationTTL).Unix()
+	req.Header.Set(HeaderAuthorization, strconv.FormatInt(expiry, 10)+"."+signature(key, req, expiry))
+}
+
+// requireAuthorization serves requests to next only if the hub authorized
+// them with key. Callers reaching the tunnel otherwise, even with the
+// registration token of the agent, are rejected.
+func requireAuthorization(key string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := checkAuthorization(key, r); err != nil {
+			klog.V(2).Infof("rejected tunnel request %s %s: %v", r.Method, r.URL.Path, err)
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// checkAuthorization returns an error if r is not authorized with key
+func checkAuthorization(key string, r *http.Request) error {
+	if key == "" {
+		return fmt.Errorf("the hub issued no session key")
+	}
+	value, sig, ok := strings.Cut(r.Header.Get(HeaderAuthorization), ".")
+	if !ok {
+		return fmt.Errorf("missing %s header", HeaderAuthorization)
+	}
+	expiry, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s header", HeaderAuthorization)
+	}
+	if time.Now().Unix() > expiry {
+		return fmt.Errorf("authorization expired")
+	}
+	if !hmac.Equal([]byte(sig), []byte(signature(key, r, expiry))) {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// signature returns the hex encoded HMAC of the method, path and query of r
+// and expiry
+func signature(key string, r *http.Request, expiry int64) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%d", r.Method, r.URL.Path, r.URL.RawQuery, expiry)
+	return hex.EncodeToString(mac.Sum(nil))
+}
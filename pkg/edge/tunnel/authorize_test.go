@@ -0,0 +1,56 @@
+package tunnel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRequireAuthorization(t *testing.T) {
+	handler := requireAuthorization("key", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	request := func(key string, tamper func(*http.Request)) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/exec?command=sh", nil)
+		if key != "" {
+			Authorize(r, key)
+		}
+		if tamper != nil {
+			tamper(r)
+		}
+		return r
+	}
+	expired := func(r *http.Request) {
+		expiry := time.Now().Add(-time.Second).Unix()
+		r.Header.Set(HeaderAuthorization, strconv.FormatInt(expiry, 10)+"."+signature("key", r, expiry))
+	}
+
+	for _, tt := range []struct {
+		name    string
+		request *http.Request
+		status  int
+	}{
+		{name: "authorized", request: request("key", nil), status: http.StatusOK},
+		{name: "not authorized", request: request("", nil), status: http.StatusForbidden},
+		{name: "other key", request: request("other", nil), status: http.StatusForbidden},
+		{name: "other query", request: request("key", func(r *http.Request) { r.URL.RawQuery = "command=rm" }), status: http.StatusForbidden},
+		{name: "other path", request: request("key", func(r *http.Request) { r.URL.Path = PathPortForward }), status: http.StatusForbidden},
+		{name: "expired", request: request("key", expired), status: http.StatusForbidden},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, tt.request)
+			if w.Code != tt.status {
+				t.Errorf("expected status %d, got %d", tt.status, w.Code)
+			}
+		})
+	}
+
+	// tunnels the hub issued no key for serve nothing
+	w := httptest.NewRecorder()
+	requireAuthorization("", http.NotFoundHandler()).ServeHTTP(w, request("", nil))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d without session key, got %d", http.StatusForbidden, w.Code)
+	}
+}
@@ -0,0 +1,206 @@
+package tunnel
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"sync"
+
+	"k8s.io/klog/v2"
+
+	"github.com/faroshq/faros-hub/pkg/util/remoteshell"
+)
+
+// PathExec is the tunnel path commands are executed at, as
+// /exec?command=<arg>&command=<arg>&tty=true. The upgraded connection carries
+// remoteshell frames.
+const PathExec = "/exec"
+
+// ExecHandler starts the requested command, in a terminal if requested, and
+// streams its input and output over the upgraded tunnel connection. Sessions
+// are authorized, and timed out, by the hub before the request reaches the
+// agent.
+func ExecHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		command := r.URL.Query()["command"]
+		if len(command) == 0 || command[0] == "" {
+			http.Error(w, "command required", http.StatusBadRequest)
+			return
+		}
+		tty := r.URL.Query().Get("tty") == "true"
+
+		if err := checkUpgrade(w, r); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		s, err := startSession(command, tty)
+		if err != nil {
+			klog.V(2).Infof("starting %q failed: %v", command[0], err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		conn, err := upgrade(w, r)
+		if err != nil {
+			klog.V(2).Infof("upgrading session for %q failed: %v", command[0], err)
+			s.kill()
+			s.wait() //nolint:errcheck
+			return
+		}
+		defer conn.Close()
+
+		klog.V(2).Infof("started session for %q, tty %t", command[0], tty)
+		s.serve(conn)
+		klog.V(2).Infof("session for %q closed", command[0])
+	})
+}
+
+// session is a running command and its streams
+type session struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout io.Reader
+	stderr io.Reader
+	// ptmx is the terminal of the command, if started with a TTY
+	ptmx *os.File
+}
+
+func startSession(command []string, tty bool) (*session, error) {
+	cmd := exec.Command(command[0], command[1:]...)
+	s := &session{cmd: cmd}
+
+	if tty {
+		ptmx, pts, err := openPTY()
+		if err != nil {
+			return nil, err
+		}
+		defer pts.Close()
+
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = pts, pts, pts
+		cmd.Env = os.Environ()
+		if os.Getenv("TERM") == "" {
+			cmd.Env = append(cmd.Env, "TERM=xterm")
+		}
+		setControllingTerminal(cmd)
+		if err := cmd.Start(); err != nil {
+			ptmx.Close()
+			return nil, err
+		}
+		s.ptmx, s.stdin, s.stdout = ptmx, ptmx, ptmx
+		return s, nil
+	}
+
+	var err error
+	if s.stdin, err = cmd.StdinPipe(); err != nil {
+		return nil, err
+	}
+	if s.stdout, err = cmd.StdoutPipe(); err != nil {
+		return nil, err
+	}
+	if s.stderr, err = cmd.StderrPipe(); err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// serve relays frames between conn and the command until the command exits,
+// and sends its exit status. The command is killed if conn is closed.
+func (s *session) serve(conn net.Conn) {
+	fw := remoteshell.NewFrameWriter(conn)
+
+	var wg sync.WaitGroup
+	relay := func(channel byte, r io.Reader) {
+		defer wg.Done()
+		// reading a terminal fails with EIO once the command exits
+		io.Copy(fw.ChannelWriter(channel), r) //nolint:errcheck
+	}
+	wg.Add(1)
+	go relay(remoteshell.StdoutChannel, s.stdout)
+	if s.stderr != nil {
+		wg.Add(1)
+		go relay(remoteshell.StderrChannel, s.stderr)
+	}
+
+	exited := make(chan struct{})
+	go func() {
+		for {
+			channel, payload, err := remoteshell.ReadFrame(conn)
+			if err != nil {
+				select {
+				case <-exited:
+				default:
+					klog.V(2).Infof("session connection closed, killing command: %v", err)
+					s.kill()
+				}
+				return
+			}
+			s.handleFrame(channel, payload)
+		}
+	}()
+
+	wg.Wait()
+	err := s.wait()
+	close(exited)
+
+	status := remoteshell.Status{}
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &exitErr):
+		status.ExitCode = exitErr.ExitCode()
+	case err != nil:
+		status.ExitCode = -1
+		status.Message = err.Error()
+	}
+	payload, _ := json.Marshal(status)
+	fw.WriteFrame(remoteshell.StatusChannel, payload) //nolint:errcheck
+}
+
+func (s *session) handleFrame(channel byte, payload []byte) {
+	switch channel {
+	case remoteshell.StdinChannel:
+		// an empty frame closes stdin
+		if len(payload) == 0 {
+			if s.ptmx == nil {
+				s.stdin.Close()
+			}
+			return
+		}
+		s.stdin.Write(payload) //nolint:errcheck
+	case remoteshell.ResizeChannel:
+		var size remoteshell.TerminalSize
+		if err := json.Unmarshal(payload, &size); err != nil {
+			klog.V(2).Infof("invalid terminal size: %v", err)
+			return
+		}
+		if s.ptmx != nil {
+			if err := setTerminalSize(s.ptmx, size); err != nil {
+				klog.V(2).Infof("resizing terminal failed: %v", err)
+			}
+		}
+	}
+}
+
+// kill kills the command, and with a TTY all processes of its session
+func (s *session) kill() {
+	if s.ptmx != nil {
+		killProcessGroup(s.cmd.Process) //nolint:errcheck
+		return
+	}
+	s.cmd.Process.Kill() //nolint:errcheck
+}
+
+func (s *session) wait() error {
+	err := s.cmd.Wait()
+	if s.ptmx != nil {
+		s.ptmx.Close()
+	}
+	return err
+}
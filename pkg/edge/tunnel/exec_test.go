@@ -0,0 +1,106 @@
+package tunnel
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/faroshq/faros-hub/pkg/util/remoteshell"
+)
+
+func TestExec(t *testing.T) {
+	server := httptest.NewServer(ExecHandler())
+	defer server.Close()
+
+	dial := func(query url.Values) (net.Conn, error) {
+		conn, err := net.Dial("tcp", server.Listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		upgraded, err := DialUpgrade(ctx, conn, "key", PathExec, query)
+		if err != nil {
+			conn.Close()
+		}
+		return upgraded, err
+	}
+
+	conn, err := dial(url.Values{"command": []string{"sh", "-c", "cat; echo oops >&2; exit 3"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second)) //nolint:errcheck
+
+	if err := remoteshell.WriteFrame(conn, remoteshell.StdinChannel, []byte("hello\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := remoteshell.WriteFrame(conn, remoteshell.StdinChannel, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr strings.Builder
+	var status remoteshell.Status
+	for done := false; !done; {
+		channel, payload, err := remoteshell.ReadFrame(conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch channel {
+		case remoteshell.StdoutChannel:
+			stdout.Write(payload)
+		case remoteshell.StderrChannel:
+			stderr.Write(payload)
+		case remoteshell.StatusChannel:
+			if err := json.Unmarshal(payload, &status); err != nil {
+				t.Fatal(err)
+			}
+			done = true
+		}
+	}
+
+	if stdout.String() != "hello\n" {
+		t.Errorf("expected stdout %q, got %q", "hello\n", stdout.String())
+	}
+	if stderr.String() != "oops\n" {
+		t.Errorf("expected stderr %q, got %q", "oops\n", stderr.String())
+	}
+	if status.ExitCode != 3 {
+		t.Errorf("expected exit code 3, got %d", status.ExitCode)
+	}
+
+	// terminal
+	tty, err := dial(url.Values{"command": []string{"sh", "-c", "tty; stty size"}, "tty": []string{"true"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tty.Close()
+	tty.SetDeadline(time.Now().Add(10 * time.Second)) //nolint:errcheck
+
+	stdout.Reset()
+	for done := false; !done; {
+		channel, payload, err := remoteshell.ReadFrame(tty)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch channel {
+		case remoteshell.StdoutChannel:
+			stdout.Write(payload)
+		case remoteshell.StatusChannel:
+			done = true
+		}
+	}
+	if !strings.HasPrefix(stdout.String(), "/dev/pts/") {
+		t.Errorf("expected terminal, got %q", stdout.String())
+	}
+
+	if _, err := dial(url.Values{"command": []string{"/does/not/exist"}}); err == nil || !strings.Contains(err.Error(), "400") {
+		t.Errorf("expected bad request, got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package tunnel
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// PathPortForward is the tunnel path TCP connections are forwarded at, as
+	// /portforward?host=<host>&port=<port>
+	PathPortForward = "/portforward"
+
+	dialTimeout = 10 * time.Second
+)
+
+// PortForwardHandler dials the requested target and relays the upgraded
+// tunnel connection to it. Targets are authorized by the hub before the
+// request reaches the agent.
+func PortForwardHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.URL.Query().Get("host")
+		port, err := strconv.Atoi(r.URL.Query().Get("port"))
+		if host == "" || err != nil || port < 1 || port > 65535 {
+			http.Error(w, "host and port required", http.StatusBadRequest)
+			return
+		}
+		address := net.JoinHostPort(host, strconv.Itoa(port))
+
+		if err := checkUpgrade(w, r); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		target, err := net.DialTimeout("tcp", address, dialTimeout)
+		if err != nil {
+			klog.V(2).Infof("port forward to %s failed: %v", address, err)
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		conn, err := upgrade(w, r)
+		if err != nil {
+			klog.V(2).Infof("upgrading port forward to %s failed: %v", address, err)
+			target.Close()
+			return
+		}
+
+		klog.V(2).Infof("forwarding connection to %s", address)
+		Pipe(conn, target)
+		klog.V(4).Infof("forwarded connection to %s closed", address)
+	})
+}
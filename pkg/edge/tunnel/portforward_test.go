@@ -0,0 +1,113 @@
+package tunnel
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPortForward(t *testing.T) {
+	// echo target
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+	go func() {
+		for {
+			c, err := target.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				io.Copy(c, c) //nolint:errcheck
+			}()
+		}
+	}()
+
+	server := httptest.NewServer(PortForwardHandler())
+	defer server.Close()
+
+	dial := func(query url.Values) (net.Conn, error) {
+		conn, err := net.Dial("tcp", server.Listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		upgraded, err := DialUpgrade(ctx, conn, "key", PathPortForward, query)
+		if err != nil {
+			conn.Close()
+		}
+		return upgraded, err
+	}
+
+	_, port, _ := net.SplitHostPort(target.Addr().String())
+	conn, err := dial(url.Values{"host": []string{"127.0.0.1"}, "port": []string{port}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	got := make([]byte, 5)
+	if _, err := io.ReadFull(conn, got); err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hello" {
+		t.Errorf("expected %q, got %q", "hello", got)
+	}
+
+	// closed port
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedPort := closed.Addr().(*net.TCPAddr).Port
+	closed.Close()
+	if _, err := dial(url.Values{"host": []string{"127.0.0.1"}, "port": []string{strconv.Itoa(closedPort)}}); err == nil || !strings.Contains(err.Error(), "502") {
+		t.Errorf("expected bad gateway, got %v", err)
+	}
+
+	if _, err := dial(url.Values{"host": []string{"127.0.0.1"}}); err == nil || !strings.Contains(err.Error(), "400") {
+		t.Errorf("expected bad request, got %v", err)
+	}
+
+	// requests which are not upgrades are rejected before the target is dialed
+	dialed := make(chan struct{}, 1)
+	probe, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer probe.Close()
+	go func() {
+		if c, err := probe.Accept(); err == nil {
+			c.Close()
+			dialed <- struct{}{}
+		}
+	}()
+	_, probePort, _ := net.SplitHostPort(probe.Addr().String())
+	resp, err := http.Get(server.URL + PathPortForward + "?" + url.Values{"host": []string{"127.0.0.1"}, "port": []string{probePort}}.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+	select {
+	case <-dialed:
+		t.Error("target dialed for a request which is not an upgrade")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,26 @@
+//go:build !linux
+
+package tunnel
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/faroshq/faros-hub/pkg/util/remoteshell"
+)
+
+func openPTY() (ptmx, pts *os.File, err error) {
+	return nil, nil, fmt.Errorf("terminals are not supported on %s", runtime.GOOS)
+}
+
+func setControllingTerminal(cmd *exec.Cmd) {}
+
+func setTerminalSize(ptmx *os.File, size remoteshell.TerminalSize) error {
+	return nil
+}
+
+func killProcessGroup(p *os.Process) error {
+	return p.Kill()
+}
@@ -0,0 +1,45 @@
+package tunnel
+
+import (
+	"context"
+
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
+)
+
+// AgentStatusFunc returns a StatusFunc reporting the tunnel state as the
+// TunnelConnected condition of the agent
+func AgentStatusFunc(client farosclient.Interface, namespace, name string) StatusFunc {
+	return func(ctx context.Context, connected bool, err error) {
+		if uerr := UpdateAgentStatus(ctx, client, namespace, name, func(agent *edgev1alpha1.Agent) {
+			if connected {
+				conditions.MarkTrue(agent, edgev1alpha1.AgentTunnelConnected)
+				return
+			}
+			conditions.MarkFalse(agent, edgev1alpha1.AgentTunnelConnected, edgev1alpha1.AgentTunnelDisconnectedReason, conditionsv1alpha1.ConditionSeverityWarning, "%v", err)
+		}); uerr != nil {
+			klog.Errorf("failed to update agent tunnel status: %v", uerr)
+		}
+	}
+}
+
+// UpdateAgentStatus applies mark to the status of the agent, retrying on
+// conflicts with the agent controller
+func UpdateAgentStatus(ctx context.Context, client farosclient.Interface, namespace, name string, mark func(*edgev1alpha1.Agent)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		agent, err := client.EdgeV1alpha1().Agents(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		agentCopy := agent.DeepCopy()
+		mark(agentCopy)
+		_, err = client.EdgeV1alpha1().Agents(namespace).UpdateStatus(ctx, agentCopy, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -0,0 +1,253 @@
+package tunnel
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+
+	"github.com/kcp-dev/kcp/pkg/cliplugins/helpers"
+	"golang.org/x/net/http2"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/config"
+	"github.com/faroshq/faros-hub/pkg/util/backoff"
+	utilkubernetes "github.com/faroshq/faros-hub/pkg/util/kubernetes"
+	"github.com/faroshq/faros-hub/pkg/util/revdial"
+)
+
+const (
+	// ServiceKubeAPIServer is the name the local kube-apiserver is exposed as
+	ServiceKubeAPIServer = "kube-apiserver"
+
+	// pathServices is the prefix local services are served at, as
+	// /services/<name>/<path>
+	pathServices = "/services/"
+)
+
+// StatusFunc is called every time the tunnel connects or disconnects. err is
+// the reason the tunnel is not connected.
+type StatusFunc func(ctx context.Context, connected bool, err error)
+
+// Tunnel keeps a reverse tunnel to the hub open and serves local services
+// over it
+type Tunnel struct {
+	id       string
+	url      string
+	client   *http.Client
+	mux      *http.ServeMux
+	onStatus StatusFunc
+
+	mu       sync.Mutex
+	services []string
+}
+
+// New returns a Tunnel for the agent. It authenticates with the registration
+// token of the agent kubeconfig, and exposes the local kube-apiserver when
+// running in a cluster.
+func New(c *config.AgentConfig, onStatus StatusFunc) (*Tunnel, error) {
+	if c.TunnelURL == "" {
+		return nil, fmt.Errorf("tunnel url is not configured")
+	}
+	u, err := url.Parse(c.TunnelURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tunnel url %q: %w", c.TunnelURL, err)
+	}
+
+	_, clusterName, err := helpers.ParseClusterURL(c.RestConfig.Host)
+	if err != nil {
+		return nil, fmt.Errorf("agent kubeconfig %q does not point to cluster workspace", c.RestConfig.Host)
+	}
+
+	token, err := utilkubernetes.BearerToken(c.RestConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := newTransport(u, c.TunnelCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if onStatus == nil {
+		onStatus = func(context.Context, bool, error) {}
+	}
+
+	t := &Tunnel{
+		id:  edgev1alpha1.AgentTunnelID(clusterName.String(), c.Namespace, c.Name),
+		url: c.TunnelURL,
+		client: &http.Client{
+			Transport: &bearerRoundTripper{token: token, rt: transport},
+		},
+		mux:      http.NewServeMux(),
+		onStatus: onStatus,
+	}
+	t.Handle(PathPortForward, PortForwardHandler())
+	t.Handle(PathExec, ExecHandler())
+
+	if local, err := rest.InClusterConfig(); err == nil {
+		if err := t.AddRESTService(ServiceKubeAPIServer, local); err != nil {
+			return nil, err
+		}
+	} else {
+		klog.V(2).Infof("not running in cluster, %s is not exposed through the tunnel", ServiceKubeAPIServer)
+	}
+
+	for name, target := range c.TunnelServices {
+		u, err := url.Parse(target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid url %q for tunnel service %s: %w", target, name, err)
+		}
+		t.AddService(name, u, http.DefaultTransport)
+	}
+
+	return t, nil
+}
+
+// ID returns the id the tunnel registers with on the hub
+func (t *Tunnel) ID() string { return t.id }
+
+// Handle registers a handler served through the tunnel
+func (t *Tunnel) Handle(pattern string, handler http.Handler) {
+	t.mux.Handle(pattern, handler)
+}
+
+// AddService exposes the service at target through the tunnel as
+// /services/<name>/
+func (t *Tunnel) AddService(name string, target *url.URL, transport http.RoundTripper) {
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	proxy.Transport = transport
+	proxy.FlushInterval = -1
+
+	prefix := pathServices + name
+	t.Handle(prefix+"/", http.StripPrefix(prefix, proxy))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.services = append(t.services, name)
+	klog.V(2).Infof("exposing tunnel service %s at %s", name, target.Redacted())
+}
+
+// AddRESTService exposes the api server of config through the tunnel,
+// authenticating with the credentials of config
+func (t *Tunnel) AddRESTService(name string, config *rest.Config) error {
+	target, err := url.Parse(config.Host)
+	if err != nil {
+		return err
+	}
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		return err
+	}
+	t.AddService(name, target, transport)
+	return nil
+}
+
+// Services returns the names of the services exposed through the tunnel
+func (t *Tunnel) Services() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.services...)
+}
+
+// Run blocks until the context is cancelled, keeping the tunnel connected
+// and reconnecting with backoff when it is lost
+func (t *Tunnel) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx).WithValues("id", t.id, "url", t.url)
+
+	backoff.Until(ctx, func() {
+		logger.V(2).Info("starting tunnel")
+		err := t.serve(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = fmt.Errorf("tunnel closed by the hub")
+		}
+		logger.Error(err, "tunnel disconnected")
+		t.onStatus(ctx, false, err)
+	})
+}
+
+// serve connects the tunnel and serves it until it is lost
+func (t *Tunnel) serve(ctx context.Context) error {
+	l, err := revdial.NewListener(ctx, t.client, t.url, t.id)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	klog.V(2).Infof("tunnel %s connected to %s", t.id, t.url)
+	t.onStatus(ctx, true, nil)
+
+	// only requests the hub authorized with the key of this connection are
+	// served, not whatever reaches the tunnel
+	server := &http.Server{Handler: requireAuthorization(l.SessionKey(), t.mux)}
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-l.Done():
+		}
+		server.Close()
+	}()
+
+	err = server.Serve(l)
+	if err == http.ErrServerClosed || err == revdial.ErrListenerClosed {
+		return nil
+	}
+	return err
+}
+
+// newTransport returns an HTTP/2 transport, required for the full duplex
+// tunnel. The hub certificate is verified against caFile or the system
+// roots.
+func newTransport(u *url.URL, caFile string) (http.RoundTripper, error) {
+	switch u.Scheme {
+	case "https":
+	case "http":
+		// plain text HTTP/2, only for local development
+		return &http2.Transport{
+			AllowHTTP: true,
+			DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+				return net.Dial(network, addr)
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported tunnel url scheme %q", u.Scheme)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if caFile != "" {
+		ca, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return &http2.Transport{TLSClientConfig: tlsConfig}, nil
+}
+
+type bearerRoundTripper struct {
+	token string
+	rt    http.RoundTripper
+}
+
+func (b *bearerRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+b.token)
+	return b.rt.RoundTrip(r)
+}
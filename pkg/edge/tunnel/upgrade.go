@@ -0,0 +1,128 @@
+package tunnel
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UpgradeProtocol is the protocol tunnel connections are upgraded to, after
+// which they carry raw bytes of the requested stream
+const UpgradeProtocol = "faros-tunnel"
+
+// DialUpgrade requests path on conn, a connection to the agent tunnel
+// server, authorized with key, and returns conn upgraded to a raw stream once
+// the agent accepts the request
+func DialUpgrade(ctx context.Context, conn net.Conn, key, path string, query url.Values) (net.Conn, error) {
+	u := &url.URL{Scheme: "http", Host: "agent", Path: path, RawQuery: query.Encode()}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", UpgradeProtocol)
+	Authorize(req, key)
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)          //nolint:errcheck
+		defer conn.SetDeadline(time.Time{}) //nolint:errcheck
+	}
+
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		defer resp.Body.Close()
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("agent refused %s: %s: %s", path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return &bufferedConn{Conn: conn, r: br}, nil
+}
+
+// checkUpgrade returns an error if r is not an upgrade request the connection
+// of which can be hijacked. Handlers check it before acting on requests.
+func checkUpgrade(w http.ResponseWriter, r *http.Request) error {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), UpgradeProtocol) {
+		return fmt.Errorf("upgrade to %s required", UpgradeProtocol)
+	}
+	if _, ok := w.(http.Hijacker); !ok {
+		return fmt.Errorf("connection can not be upgraded")
+	}
+	return nil
+}
+
+// upgrade hijacks the connection of a request checked with checkUpgrade,
+// returning it as a raw stream after confirming the upgrade. The response
+// must not be written to afterwards, even on errors.
+func upgrade(w http.ResponseWriter, r *http.Request) (net.Conn, error) {
+	conn, brw, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := brw.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: " + UpgradeProtocol + "\r\n\r\n"); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := brw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &bufferedConn{Conn: conn, r: brw.Reader}, nil
+}
+
+// bufferedConn is a net.Conn reading through the buffer used to parse the
+// upgrade, which may hold already received stream bytes
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// Pipe copies data between a and b until both directions are done, closing
+// both of them
+func Pipe(a, b io.ReadWriteCloser) {
+	var once sync.Once
+	closeBoth := func() {
+		a.Close()
+		b.Close()
+	}
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		io.Copy(a, b) //nolint:errcheck
+		closeWrite(a, closeBoth, &once)
+	}()
+	go func() {
+		defer wg.Done()
+		io.Copy(b, a) //nolint:errcheck
+		closeWrite(b, closeBoth, &once)
+	}()
+	wg.Wait()
+	once.Do(closeBoth)
+}
+
+// closeWrite half closes c if supported, or closes both ends otherwise
+func closeWrite(c io.ReadWriteCloser, closeBoth func(), once *sync.Once) {
+	if cw, ok := c.(interface{ CloseWrite() error }); ok {
+		cw.CloseWrite() //nolint:errcheck
+		return
+	}
+	once.Do(closeBoth)
+}
@@ -13,7 +13,7 @@ var GroupName = "config.faros.sh"
 const CLIConfigKind = "CLIConfig"
 
 type CLIConfig struct {
-	metav1.TypeMeta   `json:",inline",yaml:",inline"`
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" yaml:"metadata,omitempty"`
 
 	Spec CLIConfigSpec `json:"spec,omitempty" yaml:"spec,omitempty"`
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/kcp-dev/logicalcluster/v2"
+	"golang.org/x/net/websocket"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/strings/slices"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/edge/tunnel"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+)
+
+const (
+	pathAgent            = "/clusters/{cluster}/namespaces/{namespace}/agents/{agent}"
+	pathAgentPortForward = pathAgent + "/portforward"
+)
+
+// portForwardHandler relays a websocket to a TCP target reachable from the agent
+// GET - faros.sh/api/v1alpha1/clusters/<cluster>/namespaces/<namespace>/agents/<agent>/portforward?host=<host>&port=<port>
+func (s *Service) portForwardHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	authenticated, user, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	if !authenticated {
+		apistatus.WriteError(w, r, errUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	clusterName, namespace, agent := vars["cluster"], vars["namespace"], vars["agent"]
+
+	host := r.URL.Query().Get("host")
+	port, err := strconv.Atoi(r.URL.Query().Get("port"))
+	if host == "" || err != nil || port < 1 || port > 65535 {
+		apistatus.WriteError(w, r, apierrors.NewBadRequest("host and port query parameters required"))
+		return
+	}
+
+	if _, err := s.getAgentsWorkspace(ctx, r, user, clusterName); err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	if err := s.authorizePortForward(ctx, *user, clusterName, namespace, agent, host, int32(port)); err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	conn, err := s.dialAgent(ctx, clusterName, namespace, agent, tunnel.PathPortForward, url.Values{
+		"host": []string{host},
+		"port": []string{strconv.Itoa(port)},
+	})
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	klog.V(2).Infof("%s forwarding to %s:%d through agent %s/%s/%s", user.Spec.Email, host, port, clusterName, namespace, agent)
+	websocket.Server{Handler: func(ws *websocket.Conn) {
+		ws.PayloadType = websocket.BinaryFrame
+		tunnel.Pipe(ws, conn)
+	}}.ServeHTTP(w, r)
+	// the handshake failed if the handler did not run
+	conn.Close()
+}
+
+// authorizePortForward checks an Access plugin of the agent allows user to
+// forward to host:port
+func (s *Service) authorizePortForward(ctx context.Context, user tenancyv1alpha1.User, clusterName, namespace, agent, host string, port int32) error {
+	accesses, err := s.farosClient.Cluster(logicalcluster.New(clusterName)).PluginsV1alpha1().Accesses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, access := range accesses.Items {
+		if access.Spec.Agent != agent {
+			continue
+		}
+		for _, forward := range access.Spec.PortForwards {
+			if forward.Host != host || forward.Port != port {
+				continue
+			}
+			if len(forward.AllowedUsers) == 0 || slices.Contains(forward.AllowedUsers, user.Spec.Email) {
+				return nil
+			}
+		}
+	}
+	return apierrors.NewForbidden(agentResource, agent, fmt.Errorf("no access allows %s to forward to %s:%d", user.Spec.Email, host, port))
+}
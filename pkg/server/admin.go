@@ -0,0 +1,296 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	"github.com/kcp-dev/kcp/pkg/cliplugins/helpers"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/endpoints/handlers/negotiation"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/strings/slices"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+)
+
+// adminContextKey is the context key of the administrator of admin requests
+type adminContextKey struct{}
+
+// adminOnly authenticates requests of the admin route group and rejects them
+// unless they are made by a hub administrator, who handlers get with adminFrom
+func (s *Service) adminOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authenticated, user, err := s.authenticator.Authenticate(r)
+		if err != nil {
+			apistatus.WriteError(w, r, err)
+			return
+		}
+
+		if !authenticated {
+			apistatus.WriteError(w, r, errUnauthorized)
+			return
+		}
+
+		if !s.isAdmin(user) {
+			err := apierrors.NewForbidden(schema.GroupResource{}, "", fmt.Errorf("user %s is not an administrator", user.Spec.Email))
+			apistatus.WriteError(w, r, err)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), adminContextKey{}, user)))
+	})
+}
+
+// adminFrom returns the administrator authenticated by adminOnly
+func adminFrom(ctx context.Context) *tenancyv1alpha1.User {
+	user, _ := ctx.Value(adminContextKey{}).(*tenancyv1alpha1.User)
+	return user
+}
+
+// isAdmin returns whether user is a hub administrator, by their email or
+// identity provider groups
+func (s *Service) isAdmin(user *tenancyv1alpha1.User) bool {
+	if user == nil {
+		return false
+	}
+	if user.Spec.Email != "" && slices.Contains(s.config.AdminEmails, user.Spec.Email) {
+		return true
+	}
+	for _, group := range user.Spec.Groups {
+		if slices.Contains(s.config.AdminGroups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// adminWorkspacesHandler is a http handler for workspaces of all tenants, for
+// hub administrators
+// GET - faros.sh/admin/workspaces?search=<text>&owner=<email> - list workspaces
+// GET - faros.sh/admin/workspaces/<namespace>/<workspace> - get a workspace
+// POST - faros.sh/admin/workspaces/<namespace>/<workspace>/reconcile - force a reconcile of a workspace
+func (s *Service) adminWorkspacesHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	workspaces := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces
+	vars := mux.Vars(r)
+	namespace, name := vars["namespace"], vars["workspace"]
+
+	var err error
+	var workspace *tenancyv1alpha1.Workspace
+	switch {
+	case name == "":
+		var list *tenancyv1alpha1.WorkspaceList
+		if list, err = s.adminListWorkspaces(ctx, r.URL.Query().Get("search"), r.URL.Query().Get("owner")); err == nil {
+			responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, list)
+			return
+		}
+	case r.Method == http.MethodPost:
+		var patch []byte
+		if patch, err = reconcilePatch(time.Now()); err == nil {
+			workspace, err = workspaces(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		}
+	default:
+		workspace, err = workspaces(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, workspace)
+}
+
+// adminListWorkspaces returns workspaces of all tenants matching search, of
+// the user with email owner if not empty
+func (s *Service) adminListWorkspaces(ctx context.Context, search, owner string) (*tenancyv1alpha1.WorkspaceList, error) {
+	namespace := metav1.NamespaceAll
+	if owner != "" {
+		user, err := s.getUserByEmail(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		namespace = user.Name
+	}
+
+	workspaces, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	items := workspaces.Items[:0]
+	for _, workspace := range workspaces.Items {
+		values := append([]string{workspace.Namespace, workspace.Name, workspace.Spec.Description}, workspace.Spec.Members...)
+		if matches(search, values...) {
+			items = append(items, workspace)
+		}
+	}
+	workspaces.Items = items
+	return workspaces, nil
+}
+
+// adminAgentsHandler is a http handler for agents of all workspaces, for hub
+// administrators. Agents are annotated with their workspace.
+// GET - faros.sh/admin/agents?search=<text>&workspace=<namespace>/<workspace> - list agents
+func (s *Service) adminAgentsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	workspaces, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	search, filter := r.URL.Query().Get("search"), r.URL.Query().Get("workspace")
+	agents := &edgev1alpha1.AgentList{}
+	for _, workspace := range workspaces.Items {
+		qualifiedName := workspace.Namespace + "/" + workspace.Name
+		if workspace.Status.WorkspaceURL == "" || (filter != "" && filter != qualifiedName) {
+			continue
+		}
+		_, cluster, err := helpers.ParseClusterURL(workspace.Status.WorkspaceURL)
+		if err != nil {
+			klog.Errorf("failed to parse url of workspace %s: %v", qualifiedName, err)
+			continue
+		}
+
+		list, err := s.farosClient.Cluster(cluster).EdgeV1alpha1().Agents(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			// workspaces being provisioned or deleted have no agents api
+			klog.Errorf("failed to list agents of workspace %s: %v", qualifiedName, err)
+			continue
+		}
+		for _, agent := range list.Items {
+			if !matches(search, qualifiedName, agent.Namespace, agent.Name) {
+				continue
+			}
+			if agent.Annotations == nil {
+				agent.Annotations = map[string]string{}
+			}
+			agent.Annotations[tenancyv1alpha1.AgentWorkspaceAnnotation] = qualifiedName
+			agents.Items = append(agents.Items, agent)
+		}
+	}
+
+	responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, edgev1alpha1.SchemeGroupVersion, w, r, http.StatusOK, agents)
+}
+
+// adminErrorsHandler is a http handler for errors of controllers, for hub
+// administrators
+// GET - faros.sh/admin/errors - list users, workspaces and organizations which failed to reconcile
+func (s *Service) adminErrorsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	client := s.farosClient.Cluster(s.cluster).TenancyV1alpha1()
+	errs := &tenancyv1alpha1.ReconcileErrorList{}
+
+	users, err := client.Users().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	for i := range users.Items {
+		if e, ok := reconcileErrorOf("users", &users.Items[i]); ok {
+			errs.Items = append(errs.Items, e)
+		}
+	}
+
+	workspaces, err := client.Workspaces(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	for i := range workspaces.Items {
+		if e, ok := reconcileErrorOf("workspaces", &workspaces.Items[i]); ok {
+			errs.Items = append(errs.Items, e)
+		}
+	}
+
+	organizations, err := client.Organizations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	for i := range organizations.Items {
+		if e, ok := reconcileErrorOf("organizations", &organizations.Items[i]); ok {
+			errs.Items = append(errs.Items, e)
+		}
+	}
+
+	// most recent errors first
+	sort.SliceStable(errs.Items, func(i, j int) bool {
+		return errs.Items[j].LastTransitionTime.Before(&errs.Items[i].LastTransitionTime)
+	})
+
+	responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, errs)
+}
+
+// conditionsObject is an object with conditions
+type conditionsObject interface {
+	metav1.Object
+	conditions.Getter
+}
+
+// reconcileErrorOf returns the reconcile error of obj of resource, if
+// controllers failed to reconcile it. Controllers record errors as a failed
+// Ready condition of severity Error.
+func reconcileErrorOf(resource string, obj conditionsObject) (tenancyv1alpha1.ReconcileError, bool) {
+	ready := conditions.Get(obj, conditionsv1alpha1.ReadyCondition)
+	if ready == nil || ready.Status != corev1.ConditionFalse || ready.Severity != conditionsv1alpha1.ConditionSeverityError {
+		return tenancyv1alpha1.ReconcileError{}, false
+	}
+
+	return tenancyv1alpha1.ReconcileError{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       tenancyv1alpha1.ReconcileErrorKind,
+			APIVersion: tenancyv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+		},
+		Resource:           resource,
+		Reason:             ready.Reason,
+		Message:            ready.Message,
+		LastTransitionTime: ready.LastTransitionTime,
+	}, true
+}
+
+// reconcilePatch returns a merge patch annotating objects with now as the time
+// a reconcile was requested, which the update of the object triggers
+func reconcilePatch(now time.Time) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				tenancyv1alpha1.ReconcileRequestedAnnotation: now.UTC().Format(time.RFC3339Nano),
+			},
+		},
+	})
+}
+
+// matches returns whether any of values contains search, ignoring case. Empty
+// searches match everything.
+func matches(search string, values ...string) bool {
+	if search == "" {
+		return true
+	}
+	search = strings.ToLower(search)
+	for _, value := range values {
+		if strings.Contains(strings.ToLower(value), search) {
+			return true
+		}
+	}
+	return false
+}
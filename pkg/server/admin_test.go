@@ -0,0 +1,67 @@
+package server
+
+import (
+	"testing"
+
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/config"
+)
+
+func TestIsAdmin(t *testing.T) {
+	s := &Service{config: &config.APIConfig{
+		AdminEmails: []string{"admin@example.com"},
+		AdminGroups: []string{"operators"},
+	}}
+
+	for _, test := range []struct {
+		name     string
+		user     *tenancyv1alpha1.User
+		expected bool
+	}{
+		{name: "no user"},
+		{name: "admin email", user: &tenancyv1alpha1.User{Spec: tenancyv1alpha1.UserSpec{Email: "admin@example.com"}}, expected: true},
+		{name: "admin group", user: &tenancyv1alpha1.User{Spec: tenancyv1alpha1.UserSpec{Email: "jane@example.com", Groups: []string{"developers", "operators"}}}, expected: true},
+		{name: "other user", user: &tenancyv1alpha1.User{Spec: tenancyv1alpha1.UserSpec{Email: "jane@example.com", Groups: []string{"developers"}}}},
+	} {
+		if admin := s.isAdmin(test.user); admin != test.expected {
+			t.Errorf("%s: expected admin %t, got %t", test.name, test.expected, admin)
+		}
+	}
+}
+
+func TestReconcileErrorOf(t *testing.T) {
+	workspace := &tenancyv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "jane"}}
+	if _, ok := reconcileErrorOf("workspaces", workspace); ok {
+		t.Error("expected no error for workspace without conditions")
+	}
+
+	conditions.MarkTrue(workspace, conditionsv1alpha1.ReadyCondition)
+	if _, ok := reconcileErrorOf("workspaces", workspace); ok {
+		t.Error("expected no error for ready workspace")
+	}
+
+	conditions.MarkFalse(workspace, conditionsv1alpha1.ReadyCondition, "Failed", conditionsv1alpha1.ConditionSeverityError, "Error configuring Workspace: %v", "boom")
+	e, ok := reconcileErrorOf("workspaces", workspace)
+	if !ok {
+		t.Fatal("expected error for failed workspace")
+	}
+	if e.Name != "fleet" || e.Namespace != "jane" || e.Resource != "workspaces" || e.Message != "Error configuring Workspace: boom" {
+		t.Errorf("unexpected error %+v", e)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	if !matches("", "anything") {
+		t.Error("expected empty search to match")
+	}
+	if !matches("JANE", "fleet", "jane@example.com") {
+		t.Error("expected search to match ignoring case")
+	}
+	if matches("john", "fleet", "jane@example.com") {
+		t.Error("expected search not to match")
+	}
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/kcp-dev/kcp/pkg/cliplugins/helpers"
+	"github.com/kcp-dev/logicalcluster/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/edge/tunnel"
+	"github.com/faroshq/faros-hub/pkg/util/roles"
+)
+
+// getAgentsWorkspace returns the workspace backed by the logical cluster,
+// among the workspaces of the organization in the org query parameter or of
+// user, if user can use its agents. Admins and editors of the workspace can,
+// viewers can't.
+func (s *Service) getAgentsWorkspace(ctx context.Context, r *http.Request, user *tenancyv1alpha1.User, clusterName string) (*tenancyv1alpha1.Workspace, error) {
+	namespace, err := s.workspacesNamespace(ctx, r, user, false)
+	if err != nil {
+		return nil, err
+	}
+	workspaces, err := s.listWorkspaces(ctx, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+
+	roleOf, err := s.workspaceRoles(ctx, user, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := tenancyv1alpha1.Resource("workspaces")
+	for i := range workspaces.Items {
+		workspace := &workspaces.Items[i]
+		if !backedBy(workspace, clusterName) {
+			continue
+		}
+		if roles.Rank(roleOf(workspace)) < roles.Rank(tenancyv1alpha1.TeamRoleEdit) {
+			return nil, apierrors.NewForbidden(resource, workspace.Name, fmt.Errorf("%s can't use agents of the workspace", user.Spec.Email))
+		}
+		return workspace, nil
+	}
+	return nil, apierrors.NewNotFound(resource, clusterName)
+}
+
+// backedBy returns whether workspace is backed by the logical cluster
+func backedBy(workspace *tenancyv1alpha1.Workspace, clusterName string) bool {
+	if workspace.Status.Path != "" {
+		return workspace.Status.Path == clusterName
+	}
+	if workspace.Status.WorkspaceURL == "" {
+		return false
+	}
+	_, current, err := helpers.ParseClusterURL(workspace.Status.WorkspaceURL)
+	return err == nil && current.String() == clusterName
+}
+
+// dialAgent opens a connection through the tunnel of the agent to its tunnel
+// server and upgrades it to a raw stream for path, authorized with the
+// session key of the tunnel
+func (s *Service) dialAgent(ctx context.Context, clusterName, namespace, name, path string, query url.Values) (net.Conn, error) {
+	_, err := s.farosClient.Cluster(logicalcluster.New(clusterName)).EdgeV1alpha1().Agents(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	d := s.tunnels.GetDialer(edgev1alpha1.AgentTunnelID(clusterName, namespace, name))
+	if d == nil {
+		return nil, apierrors.NewServiceUnavailable(fmt.Sprintf("agent %s/%s is not connected", namespace, name))
+	}
+
+	conn, err := d.Dial(ctx, "tcp", name)
+	if err != nil {
+		return nil, apierrors.NewServiceUnavailable(fmt.Sprintf("failed to reach agent %s/%s: %v", namespace, name, err))
+	}
+
+	upgraded, err := tunnel.DialUpgrade(ctx, conn, d.SessionKey(), path, query)
+	if err != nil {
+		conn.Close()
+		return nil, apierrors.NewInternalError(err)
+	}
+	return upgraded, nil
+}
+
+// agentResource is used for errors about agents
+var agentResource = schema.GroupResource{Group: edgev1alpha1.SchemeGroupVersion.Group, Resource: "agents"}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pluginsv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/plugins/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+func TestAgentsWorkspace(t *testing.T) {
+	const (
+		cluster    = "root:faros-tenants:acme:fleet"
+		bobCluster = "root:faros-tenants:bob:fleet"
+	)
+	namespace := tenancyv1alpha1.OrganizationNamespace("acme")
+	team := func(name string, role tenancyv1alpha1.TeamRole, member string, workspaces ...string) *tenancyv1alpha1.Team {
+		return &tenancyv1alpha1.Team{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       tenancyv1alpha1.TeamSpec{Role: role, Members: []string{member}, Workspaces: workspaces},
+		}
+	}
+	test := newHubTest(t,
+		testUser("jane", "jane@example.com"), testUser("john", "john@example.com"), testUser("mary", "mary@example.com"),
+		testUser("ann", "ann@example.com"), testUser("bob", "bob@example.com"),
+		&tenancyv1alpha1.Organization{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+			Spec:       tenancyv1alpha1.OrganizationSpec{Owners: []string{"jane@example.com"}},
+		},
+		team("ops", tenancyv1alpha1.TeamRoleEdit, "john@example.com"),
+		team("audit", tenancyv1alpha1.TeamRoleView, "mary@example.com"),
+		team("lab", tenancyv1alpha1.TeamRoleEdit, "ann@example.com", "lab"),
+		&tenancyv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: namespace},
+			Spec:       tenancyv1alpha1.WorkspaceSpec{Shell: &tenancyv1alpha1.WorkspaceShellSpec{Enabled: true}},
+			Status:     tenancyv1alpha1.WorkspaceStatus{WorkspaceURL: "https://hub.example.com/clusters/" + cluster},
+		},
+		&tenancyv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "bob"},
+			Spec: tenancyv1alpha1.WorkspaceSpec{
+				Members: []string{"bob@example.com"},
+				Shell:   &tenancyv1alpha1.WorkspaceShellSpec{Enabled: true},
+			},
+			Status: tenancyv1alpha1.WorkspaceStatus{WorkspaceURL: "https://hub.example.com/clusters/" + bobCluster},
+		},
+		&pluginsv1alpha1.Access{
+			ObjectMeta: metav1.ObjectMeta{Name: "shell", Namespace: "default"},
+			Spec:       pluginsv1alpha1.AccessSpec{Agent: "edge", Shell: &pluginsv1alpha1.ShellSpec{Enabled: true}},
+		},
+	)
+
+	for _, tt := range []struct {
+		user    string
+		cluster string
+		org     string
+		status  int
+		message string
+	}{
+		// allowed, the agent does not exist
+		{user: "jane", cluster: cluster, org: "acme", status: http.StatusNotFound, message: "agents"},
+		{user: "john", cluster: cluster, org: "acme", status: http.StatusNotFound, message: "agents"},
+		{user: "bob", cluster: bobCluster, status: http.StatusNotFound, message: "agents"},
+		// viewers and teams of other workspaces can't use agents
+		{user: "mary", cluster: cluster, org: "acme", status: http.StatusForbidden, message: "can't use agents of the workspace"},
+		{user: "ann", cluster: cluster, org: "acme", status: http.StatusForbidden, message: "can't use agents of the workspace"},
+		// workspaces of other tenants are not found
+		{user: "jane", cluster: cluster, status: http.StatusNotFound, message: "workspaces"},
+		{user: "jane", cluster: bobCluster, status: http.StatusNotFound, message: "workspaces"},
+		{user: "jane", cluster: bobCluster, org: "acme", status: http.StatusNotFound, message: "workspaces"},
+		{user: "bob", cluster: cluster, org: "acme", status: http.StatusNotFound, message: "organizations"},
+	} {
+		request, err := http.NewRequest(http.MethodGet, test.server.URL+path.Join(pathAPIVersion, "clusters", tt.cluster, "namespaces/default/agents/edge/exec"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.org != "" {
+			request.URL.RawQuery = "org=" + tt.org
+		}
+		request.Header.Set("Authorization", "Bearer "+tt.user)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var status metav1.Status
+		err = json.NewDecoder(response.Body).Decode(&status)
+		response.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.StatusCode != tt.status || !strings.Contains(status.Message, tt.message) {
+			t.Errorf("%s %s?org=%s: expected status %d %q, got %d %q", tt.user, tt.cluster, tt.org, tt.status, tt.message, response.StatusCode, status.Message)
+		}
+	}
+}
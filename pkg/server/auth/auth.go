@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"crypto/rand"
+	"crypto/subtle"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
@@ -11,6 +12,8 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
+	"path"
 	"strings"
 	"time"
 	"unicode"
@@ -25,9 +28,14 @@ import (
 	"github.com/kcp-dev/logicalcluster/v2"
 	"golang.org/x/oauth2"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
 
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+	"github.com/faroshq/faros-hub/pkg/util/pkce"
 	utiltls "github.com/faroshq/faros-hub/pkg/util/tls"
 
 	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
@@ -44,10 +52,14 @@ type Authenticator interface {
 	OIDCLogin(w http.ResponseWriter, r *http.Request)
 	// OIDCCallback will handle OIDC callback
 	OIDCCallback(w http.ResponseWriter, r *http.Request)
+	// OIDCToken exchanges one time login codes for the login result
+	OIDCToken(w http.ResponseWriter, r *http.Request)
 	// Authenticate will authenticate the request if user already exists
 	Authenticate(r *http.Request) (authenticated bool, user *tenancyv1alpha1.User, err error)
 	// ParseJWTToken will parse the JWT token and return the user
 	ParseJWTToken(ctx context.Context, token string) (user *tenancyv1alpha1.User, err error)
+	// CheckProvider checks the OIDC provider serves its discovery document
+	CheckProvider(ctx context.Context) error
 }
 
 // Static check
@@ -57,6 +69,7 @@ type AuthenticatorImpl struct {
 	config *config.APIConfig
 
 	oAuthSessions *sessions.CookieStore
+	loginCodes    *loginCodes
 	provider      *oidc.Provider
 	verifier      *oidc.IDTokenVerifier
 	redirectURL   string
@@ -97,6 +110,12 @@ func NewAuthenticator(cfg *config.APIConfig, coreClient kubernetes.ClusterInterf
 
 	redirectURL := cfg.ControllerExternalURL + callbackURLPrefix
 
+	// login sessions are shared by the login and callback routes
+	sessionStore, err := newSessionStore(cfg.OIDCAuthSessionKeys, path.Dir(callbackURLPrefix), strings.HasPrefix(cfg.ControllerExternalURL, "https://"))
+	if err != nil {
+		return nil, err
+	}
+
 	ctx := oidc.ClientContext(context.Background(), client)
 
 	provider, err := oidc.NewProvider(ctx, cfg.OIDCIssuerURL)
@@ -116,46 +135,81 @@ func NewAuthenticator(cfg *config.APIConfig, coreClient kubernetes.ClusterInterf
 		provider:      provider,
 		client:        client,
 		redirectURL:   redirectURL,
-		oAuthSessions: sessions.NewCookieStore([]byte(cfg.OIDCAuthSessionKey)),
+		oAuthSessions: sessionStore,
+		loginCodes:    newLoginCodes(),
 		cluster:       logicalcluster.New(cfg.ControllersTenantWorkspace),
 	}
 	return da, nil
 }
 
+// OIDCLogin redirects to the identity provider to log in, for the login
+// result to be sent to the redirect_uri of the request and handed to the
+// client holding the verifier of its code_challenge only
 func (a *AuthenticatorImpl) OIDCLogin(w http.ResponseWriter, r *http.Request) {
 	localRedirect := r.URL.Query().Get("redirect_uri")
+	if err := checkRedirectURI(localRedirect, a.config.OIDCRedirectURIs); err != nil {
+		apistatus.WriteError(w, r, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	// the login result is only handed to the client holding the verifier of
+	// its challenge
+	challenge := r.URL.Query().Get("code_challenge")
+	if method := r.URL.Query().Get("code_challenge_method"); method != pkce.Method || !pkce.ValidChallenge(challenge) {
+		apistatus.WriteError(w, r, apierrors.NewBadRequest(fmt.Sprintf("a code_challenge with the %s code_challenge_method is required", pkce.Method)))
+		return
+	}
 
 	var scopes []string
 
-	b := make([]byte, 16)
-	rand.Read(b)
-	state := base64.URLEncoding.EncodeToString(b)
+	state, err := randomString(16)
+	if err != nil {
+		apistatus.WriteError(w, r, fmt.Errorf("failed to generate state: %w", err))
+		return
+	}
+	verifier, err := pkce.NewVerifier()
+	if err != nil {
+		apistatus.WriteError(w, r, fmt.Errorf("failed to generate code verifier: %w", err))
+		return
+	}
 
-	// Getting the session, it's not an issue if we error here
-	session, err := a.oAuthSessions.Get(r, "sess")
+	// sessions which fail to decode, i.e. encrypted with a removed key, are
+	// replaced with a new one
+	session, err := a.oAuthSessions.Get(r, sessionName)
 	if err != nil {
-		// print error
+		klog.V(4).Infof("replacing invalid login session: %v", err)
 	}
 
 	session.Values["state"] = state
+	session.Values["code_verifier"] = verifier
 	session.Values["redirect_uri"] = localRedirect
+	session.Values["client_state"] = r.URL.Query().Get("state")
+	session.Values["code_challenge"] = challenge
 	err = a.oAuthSessions.Save(r, w, session)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("failed persist state: %q", r.Form), http.StatusBadRequest)
+		apistatus.WriteError(w, r, fmt.Errorf("failed to persist state: %w", err))
 		return
 	}
 
-	authCodeURL := ""
 	scopes = append(scopes, "openid", "profile", "email")
-	if r.FormValue("offline_access") != "yes" {
-		authCodeURL = a.oauth2Config(scopes).AuthCodeURL(state)
-	} else {
-		authCodeURL = a.oauth2Config(scopes).AuthCodeURL(state, oauth2.AccessTypeOffline)
+	if len(a.config.AdminGroups) > 0 {
+		// administrators are recognized by their groups
+		scopes = append(scopes, "groups")
+	}
+	options := []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", pkce.Challenge(verifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", pkce.Method),
 	}
+	if r.FormValue("offline_access") == "yes" {
+		options = append(options, oauth2.AccessTypeOffline)
+	}
+	authCodeURL := a.oauth2Config(scopes).AuthCodeURL(state, options...)
 
 	http.Redirect(w, r, authCodeURL, http.StatusSeeOther)
 }
 
+// OIDCCallback completes logins with the code of the identity provider,
+// sending a one time code for the login result to the redirect uri of the
+// login, or refreshes tokens posted with the refresh_token form field
 func (a *AuthenticatorImpl) OIDCCallback(w http.ResponseWriter, r *http.Request) {
 	var (
 		token *oauth2.Token
@@ -163,43 +217,45 @@ func (a *AuthenticatorImpl) OIDCCallback(w http.ResponseWriter, r *http.Request)
 
 	ctx := oidc.ClientContext(r.Context(), a.client)
 
-	var localRedirect string
+	var session *sessions.Session
 	oauth2Config := a.oauth2Config(nil)
 	switch r.Method {
 	case http.MethodGet:
 		// Authorization redirect callback from OAuth2 auth flow.
 		if errMsg := r.FormValue("error"); errMsg != "" {
-			http.Error(w, errMsg+": "+r.FormValue("error_description"), http.StatusBadRequest)
+			apistatus.WriteError(w, r, apierrors.NewBadRequest(fmt.Sprintf("identity provider failed the login: %s: %s", errMsg, r.FormValue("error_description"))))
 			return
 		}
 		code := r.FormValue("code")
 		if code == "" {
-			http.Error(w, fmt.Sprintf("no code in request: %q", r.Form), http.StatusBadRequest)
+			apistatus.WriteError(w, r, apierrors.NewBadRequest("no code in request"))
 			return
 		}
 
-		session, err := a.oAuthSessions.Get(r, "sess")
-		if err != nil {
-			http.Error(w, fmt.Sprintf("no session present: %q", r.Form), http.StatusBadRequest)
+		var err error
+		session, err = a.oAuthSessions.Get(r, sessionName)
+		if err != nil || session.IsNew {
+			apistatus.WriteError(w, r, apierrors.NewBadRequest("no login session present, start the login again"))
 			return
 		}
 
-		localRedirect = session.Values["redirect_uri"].(string)
-
-		if state := r.FormValue("state"); state != session.Values["state"] {
-			http.Error(w, fmt.Sprintf("expected state %q got %q", session.Values["state"], state), http.StatusBadRequest)
+		state, _ := session.Values["state"].(string)
+		if state == "" || subtle.ConstantTimeCompare([]byte(r.FormValue("state")), []byte(state)) != 1 {
+			apistatus.WriteError(w, r, apierrors.NewBadRequest("state does not match the login session, start the login again"))
 			return
 		}
-		token, err = oauth2Config.Exchange(ctx, code)
+		verifier, _ := session.Values["code_verifier"].(string)
+		token, err = oauth2Config.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", verifier))
 		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to get token: %v", err), http.StatusInternalServerError)
+			klog.Errorf("failed to exchange code: %v", err)
+			apistatus.WriteError(w, r, apierrors.NewUnauthorized("failed to exchange the code for a token, start the login again"))
 			return
 		}
 	case http.MethodPost:
 		// Form request from frontend to refresh a token.
 		refresh := r.FormValue("refresh_token")
 		if refresh == "" {
-			http.Error(w, fmt.Sprintf("no refresh_token in request: %q", r.Form), http.StatusBadRequest)
+			apistatus.WriteError(w, r, apierrors.NewBadRequest("no refresh_token in request"))
 			return
 		}
 		t := &oauth2.Token{
@@ -209,64 +265,129 @@ func (a *AuthenticatorImpl) OIDCCallback(w http.ResponseWriter, r *http.Request)
 		var err error
 		token, err = oauth2Config.TokenSource(ctx, t).Token()
 		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to get token: %v", err), http.StatusInternalServerError)
+			klog.Errorf("failed to refresh token: %v", err)
+			apistatus.WriteError(w, r, apierrors.NewUnauthorized("failed to refresh the token, log in again"))
 			return
 		}
 	default:
-		http.Error(w, fmt.Sprintf("method not implemented: %s", r.Method), http.StatusBadRequest)
+		apistatus.WriteError(w, r, apierrors.NewMethodNotSupported(schema.GroupResource{}, r.Method))
 		return
 	}
 
 	rawIDToken, ok := token.Extra("id_token").(string)
 	if !ok {
-		http.Error(w, "no id_token in token response", http.StatusInternalServerError)
+		apistatus.WriteError(w, r, errors.New("no id_token in token response"))
 		return
 	}
 
 	idToken, err := a.verifier.Verify(r.Context(), rawIDToken)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("failed to verify ID token: %v", err), http.StatusInternalServerError)
+		apistatus.WriteError(w, r, fmt.Errorf("failed to verify ID token: %w", err))
 		return
 	}
 
-	// TODO: extend
-	var claims struct {
-		Email         string `json:"email"`
-		EmailVerified bool   `json:"email_verified"`
+	email, err := verifiedEmail(idToken)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
 	}
-	if err := idToken.Claims(&claims); err != nil {
-		http.Error(w, fmt.Sprintf("failed to parse claim: %v", err), http.StatusInternalServerError)
+
+	groups, err := groupsOf(idToken, a.config.OIDCGroupsClaim)
+	if err != nil {
+		apistatus.WriteError(w, r, fmt.Errorf("failed to parse claim: %w", err))
 		return
 	}
 
-	_, err = a.registerOrUpdateUser(ctx, &tenancyv1alpha1.User{
+	user, err := a.registerOrUpdateUser(ctx, &tenancyv1alpha1.User{
 		Spec: tenancyv1alpha1.UserSpec{
-			Email: claims.Email,
+			Email:  email,
+			Groups: groups,
 		},
 	})
 	if err != nil {
-		http.Error(w, fmt.Sprintf("failed to register user: %v", err), http.StatusInternalServerError)
+		apistatus.WriteError(w, r, fmt.Errorf("failed to register user: %w", err))
+		return
+	}
+	if user.Spec.Disabled {
+		apistatus.WriteError(w, r, apierrors.NewForbidden(tenancyv1alpha1.Resource("users"), user.Name, errors.New("user is disabled")))
 		return
 	}
 
 	response := models.LoginResponse{
 		IDToken:       *idToken,
 		RawIDToken:    rawIDToken,
-		Email:         claims.Email,
+		Email:         email,
 		ServerBaseURL: fmt.Sprintf("%s/clusters", a.config.ControllerExternalURL),
 	}
 
-	data, err := json.Marshal(response)
+	// refreshed tokens are returned to the client posting the refresh token
+	if session == nil {
+		writeLoginResponse(w, &response)
+		return
+	}
+
+	// the login session is done
+	session.Options.MaxAge = -1
+	if err := a.oAuthSessions.Save(r, w, session); err != nil {
+		apistatus.WriteError(w, r, fmt.Errorf("failed to delete login session: %w", err))
+		return
+	}
+
+	// the redirect uri is checked again, in case the allowed ones changed
+	// since the login started
+	localRedirect, _ := session.Values["redirect_uri"].(string)
+	if err := checkRedirectURI(localRedirect, a.config.OIDCRedirectURIs); err != nil {
+		apistatus.WriteError(w, r, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	redirectURL, err := url.Parse(localRedirect)
+	if err != nil {
+		apistatus.WriteError(w, r, apierrors.NewBadRequest(fmt.Sprintf("invalid redirect_uri: %v", err)))
+		return
+	}
+
+	// tokens are not sent to the redirect uri, which could leak them in
+	// browser histories and logs, but a one time code the client exchanges
+	// for them
+	challenge, _ := session.Values["code_challenge"].(string)
+	code, err := a.loginCodes.add(challenge, response)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("failed to marshal response: %v", err), http.StatusInternalServerError)
+		apistatus.WriteError(w, r, fmt.Errorf("failed to generate login code: %w", err))
 		return
 	}
+	query := redirectURL.Query()
+	query.Set("code", code)
+	if clientState, _ := session.Values["client_state"].(string); clientState != "" {
+		query.Set("state", clientState)
+	}
+	redirectURL.RawQuery = query.Encode()
+
+	http.Redirect(w, r, redirectURL.String(), http.StatusSeeOther)
+}
 
-	base64.StdEncoding.EncodeToString(data)
+// OIDCToken exchanges the one time code of a login, posted with the code
+// verifier of the login in the code and code_verifier form fields, for the
+// login result
+func (a *AuthenticatorImpl) OIDCToken(w http.ResponseWriter, r *http.Request) {
+	code, verifier := r.PostFormValue("code"), r.PostFormValue("code_verifier")
+	if code == "" || verifier == "" {
+		apistatus.WriteError(w, r, apierrors.NewBadRequest("code and code_verifier are required"))
+		return
+	}
 
-	localRedirect = localRedirect + "?data=" + base64.StdEncoding.EncodeToString(data)
-	http.Redirect(w, r, localRedirect, http.StatusSeeOther)
+	response, err := a.loginCodes.exchange(code, verifier)
+	if err != nil {
+		apistatus.WriteError(w, r, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	writeLoginResponse(w, response)
+}
 
+// writeLoginResponse writes the login result, which must not be cached
+func writeLoginResponse(w http.ResponseWriter, response *models.LoginResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(response) //nolint:errcheck
 }
 
 func (a *AuthenticatorImpl) Authenticate(r *http.Request) (authenticated bool, user *tenancyv1alpha1.User, err error) {
@@ -278,8 +399,8 @@ func (a *AuthenticatorImpl) Authenticate(r *http.Request) (authenticated bool, u
 			return false, nil, err
 		}
 
-		// authenticated
-		return true, user, nil
+		// authenticated, unless disabled
+		return !user.Spec.Disabled, user, nil
 	}
 
 	if r.Header.Get("Authorization") == "" {
@@ -302,27 +423,86 @@ func (a *AuthenticatorImpl) Authenticate(r *http.Request) (authenticated bool, u
 		return false, nil, err
 	}
 
-	// authenticated
-	return true, user, nil
+	// authenticated, unless disabled
+	return !user.Spec.Disabled, user, nil
+}
+
+// CheckProvider fetches the discovery document of the OIDC provider, which
+// logins and token verification depend on
+func (a *AuthenticatorImpl) CheckProvider(ctx context.Context) error {
+	_, err := oidc.NewProvider(oidc.ClientContext(ctx, a.client), a.config.OIDCIssuerURL)
+	return err
 }
 
 // ParseJWTToken validates token's validity and returns models.User that the token belongs to
 func (a *AuthenticatorImpl) ParseJWTToken(ctx context.Context, token string) (user *tenancyv1alpha1.User, err error) {
 	idToken, err := a.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, apierrors.NewUnauthorized(fmt.Sprintf("invalid token: %v", err))
+	}
+
+	email, err := verifiedEmail(idToken)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: extend
+	user, err = a.getUser(ctx, email)
+	if err == errUserNotFound {
+		return nil, apierrors.NewUnauthorized("user of the token is not registered")
+	}
+	return user, err
+}
+
+// verifiedEmail returns the email of idToken. Emails identify users and hub
+// administrators, so emails the identity provider did not verify are
+// rejected.
+func verifiedEmail(idToken *oidc.IDToken) (string, error) {
 	var claims struct {
-		Email         string `json:"email"`
-		EmailVerified bool   `json:"email_verified"`
+		Email string `json:"email"`
+		// some providers send the claim as a string
+		EmailVerified interface{} `json:"email_verified"`
 	}
+	if err := idToken.Claims(&claims); err != nil {
+		return "", fmt.Errorf("failed to parse claim: %w", err)
+	}
+	if claims.Email == "" {
+		return "", apierrors.NewUnauthorized("token has no email claim")
+	}
+	if verified := claims.EmailVerified; verified != true && verified != "true" {
+		return "", apierrors.NewUnauthorized(fmt.Sprintf("email %s is not verified by the identity provider", claims.Email))
+	}
+	return claims.Email, nil
+}
+
+// groupsOf returns the groups in claim of idToken, none if it has no such
+// claim
+func groupsOf(idToken *oidc.IDToken, claim string) ([]string, error) {
+	if claim == "" {
+		return nil, nil
+	}
+
+	var claims map[string]interface{}
 	if err := idToken.Claims(&claims); err != nil {
 		return nil, err
 	}
 
-	return a.getUser(ctx, claims.Email)
+	values, _ := claims[claim].([]interface{})
+	var groups []string
+	for _, value := range values {
+		if group, ok := value.(string); ok {
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+// randomString returns a url safe string of n random bytes
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
 
 // return an HTTP client which trusts the provided root CAs.
@@ -398,7 +578,13 @@ func (a *AuthenticatorImpl) registerOrUpdateUser(ctx context.Context, user *tena
 	labelEmail := strings.Replace(user.Spec.Email, "@", "-at-", 1)
 
 	if current != nil {
+		// limits and lifecycle are set by hub administrators, not the
+		// identity provider
+		spec := current.Spec
 		current.Spec = user.Spec
+		current.Spec.Limits = spec.Limits
+		current.Spec.Disabled = spec.Disabled
+		current.Spec.TransferTo = spec.TransferTo
 		if current.Labels == nil {
 			current.Labels = make(map[string]string)
 		}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/faroshq/faros-hub/pkg/models"
+	"github.com/faroshq/faros-hub/pkg/util/pkce"
+)
+
+// loginCodeTTL is how long login codes can be exchanged for
+const loginCodeTTL = time.Minute
+
+var errInvalidLoginCode = errors.New("invalid or expired login code, log in again")
+
+// loginCode is a login result waiting to be exchanged by the client that
+// started the login
+type loginCode struct {
+	// challenge is the PKCE code challenge of the client
+	challenge string
+	response  models.LoginResponse
+	expires   time.Time
+}
+
+// loginCodes are one time codes sent to redirect uris instead of tokens,
+// which clients exchange for the login result with the verifier of their
+// code challenge. Codes are kept in memory, so exchanges must reach the
+// replica that completed the login.
+type loginCodes struct {
+	lock  sync.Mutex
+	codes map[string]loginCode
+
+	// now returns the current time, replaced in tests
+	now func() time.Time
+}
+
+func newLoginCodes() *loginCodes {
+	return &loginCodes{
+		codes: map[string]loginCode{},
+		now:   time.Now,
+	}
+}
+
+// add returns a new code for response, exchanged with the verifier of
+// challenge
+func (c *loginCodes) add(challenge string, response models.LoginResponse) (string, error) {
+	code, err := randomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.now()
+	for code, lc := range c.codes {
+		if now.After(lc.expires) {
+			delete(c.codes, code)
+		}
+	}
+	c.codes[code] = loginCode{challenge: challenge, response: response, expires: now.Add(loginCodeTTL)}
+	return code, nil
+}
+
+// exchange returns the login result of code if verifier matches its
+// challenge. Codes can be exchanged once, even if the verifier is wrong.
+func (c *loginCodes) exchange(code, verifier string) (*models.LoginResponse, error) {
+	c.lock.Lock()
+	lc, ok := c.codes[code]
+	delete(c.codes, code)
+	c.lock.Unlock()
+
+	if !ok || c.now().After(lc.expires) {
+		return nil, errInvalidLoginCode
+	}
+	if subtle.ConstantTimeCompare([]byte(pkce.Challenge(verifier)), []byte(lc.challenge)) != 1 {
+		return nil, errInvalidLoginCode
+	}
+	return &lc.response, nil
+}
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+)
+
+// checkRedirectURI returns an error unless logins can be sent to uri, which
+// is either a loopback address of the CLI on any port, or one of allowed
+func checkRedirectURI(uri string, allowed []string) error {
+	for _, a := range allowed {
+		if uri == a {
+			return nil
+		}
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("invalid redirect_uri: %w", err)
+	}
+	if u.Scheme != "http" || u.User != nil || u.Fragment != "" || u.Opaque != "" {
+		return fmt.Errorf("redirect_uri %q is not allowed, logins are sent to loopback addresses of the CLI only", uri)
+	}
+	if host := u.Hostname(); host != "localhost" {
+		if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+			return fmt.Errorf("redirect_uri %q is not allowed, logins are sent to loopback addresses of the CLI only", uri)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/sessions"
+)
+
+const (
+	// sessionName is the name of the cookie of login sessions
+	sessionName = "sess"
+	// sessionMaxAge is how long users have to log in with the identity
+	// provider
+	sessionMaxAge = 10 * time.Minute
+	// minSessionKeyLength is the minimal length of session keys
+	minSessionKeyLength = 32
+)
+
+// newSessionStore returns a store of login sessions in cookies under path,
+// signed and encrypted with keys derived from keys. Sessions are encoded with
+// the first key and decoded with any of them, so keys are rotated by adding
+// the new key in front of the old ones.
+func newSessionStore(keys []string, path string, secure bool) (*sessions.CookieStore, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no login session keys")
+	}
+
+	var pairs [][]byte
+	for i, key := range keys {
+		if len(key) < minSessionKeyLength {
+			return nil, fmt.Errorf("login session key %d is shorter than %d characters", i, minSessionKeyLength)
+		}
+		pairs = append(pairs, deriveKey(key, "authentication"), deriveKey(key, "encryption"))
+	}
+
+	store := sessions.NewCookieStore(pairs...)
+	store.Options = &sessions.Options{
+		Path:     path,
+		Secure:   secure,
+		HttpOnly: true,
+		// the identity provider redirects to the callback, which carries
+		// cookies only of lax sessions
+		SameSite: http.SameSiteLaxMode,
+	}
+	store.MaxAge(int(sessionMaxAge.Seconds()))
+	return store, nil
+}
+
+// deriveKey derives a 32 byte key for purpose from key, so the signing and
+// AES-256 encryption keys of sessions differ
+func deriveKey(key, purpose string) []byte {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte("faros login session " + purpose))
+	return mac.Sum(nil)
+}
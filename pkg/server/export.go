@@ -0,0 +1,347 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	"github.com/kcp-dev/kcp/pkg/cliplugins/helpers"
+	"github.com/kcp-dev/logicalcluster/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/endpoints/handlers/negotiation"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/strings/slices"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+	"github.com/faroshq/faros-hub/pkg/util/archive"
+)
+
+const (
+	// archiveContentType is the content type of workspace exports
+	archiveContentType = "application/gzip"
+
+	// importProvisionTimeout is how long imports wait for new workspaces to
+	// be provisioned
+	importProvisionTimeout = 2 * time.Minute
+)
+
+// exportWorkspaceHandler is a http handler for exporting workspaces
+// GET - faros.sh/workspaces/<workspace>/export - download a tarball of the faros objects of a workspace, ?org= of an organization
+func (s *Service) exportWorkspaceHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	authenticated, user, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	if !authenticated {
+		apistatus.WriteError(w, r, errUnauthorized)
+		return
+	}
+
+	namespace, err := s.workspacesNamespace(ctx, r, user, false)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	workspace, err := s.getReadableWorkspace(ctx, user, namespace, mux.Vars(r)["workspace"])
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	buf := &bytes.Buffer{}
+	if err := s.exportWorkspace(ctx, workspace, buf); err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", archiveContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", workspace.Name+".tar.gz"))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		klog.V(4).Infof("failed to write export of workspace %s: %v", workspace.Name, err)
+	}
+}
+
+// exportWorkspace writes an archive of workspace and its faros objects to w.
+// Status is not exported, so registration tokens and certificates are left
+// behind.
+func (s *Service) exportWorkspace(ctx context.Context, workspace *tenancyv1alpha1.Workspace, w io.Writer) error {
+	if workspace.Status.WorkspaceURL == "" {
+		return apierrors.NewConflict(tenancyv1alpha1.Resource("workspaces"), workspace.Name, fmt.Errorf("workspace is not provisioned yet"))
+	}
+	_, cluster, err := helpers.ParseClusterURL(workspace.Status.WorkspaceURL)
+	if err != nil {
+		return err
+	}
+
+	objects, err := archive.Export(ctx, s.dynamicClient.Cluster(cluster))
+	if err != nil {
+		return err
+	}
+
+	workspace = workspace.DeepCopy()
+	workspace.SetGroupVersionKind(tenancyv1alpha1.SchemeGroupVersion.WithKind(tenancyv1alpha1.WorkspaceKind))
+	// the lifecycle and kcp workspace of the workspace are not exported
+	workspace.Annotations = withoutManagedAnnotations(workspace.Annotations)
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(workspace)
+	if err != nil {
+		return err
+	}
+	u := archive.Clean(&unstructured.Unstructured{Object: content})
+	unstructured.RemoveNestedField(u.Object, "metadata", "namespace")
+
+	return archive.Write(w, u, objects)
+}
+
+// importWorkspaceHandler is a http handler for importing workspace exports
+// POST - faros.sh/workspaces/import?org=<org>&workspace=<workspace>&conflictPolicy=Fail|Skip|Overwrite - import an export into a new or existing workspace
+func (s *Service) importWorkspaceHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	authenticated, user, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	if !authenticated {
+		apistatus.WriteError(w, r, errUnauthorized)
+		return
+	}
+
+	if contentType := r.Header.Get("Content-Type"); contentType != archiveContentType {
+		err := apierrors.NewGenericServerResponse(http.StatusUnsupportedMediaType, "import", tenancyv1alpha1.Resource("workspaces"), "", fmt.Sprintf("unsupported content type %q, expected %s", contentType, archiveContentType), 0, false)
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	policy := tenancyv1alpha1.ImportConflictFail
+	if value := r.URL.Query().Get("conflictPolicy"); value != "" {
+		policy = tenancyv1alpha1.ImportConflictPolicy(value)
+	}
+	switch policy {
+	case tenancyv1alpha1.ImportConflictFail, tenancyv1alpha1.ImportConflictSkip, tenancyv1alpha1.ImportConflictOverwrite:
+	default:
+		err := apierrors.NewBadRequest(fmt.Sprintf("invalid conflictPolicy %q, expected one of %s, %s, %s", policy,
+			tenancyv1alpha1.ImportConflictFail, tenancyv1alpha1.ImportConflictSkip, tenancyv1alpha1.ImportConflictOverwrite))
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	limitedReader := &io.LimitedReader{R: r.Body, N: limit}
+	body, err := ioutil.ReadAll(limitedReader)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	export, err := archive.Read(bytes.NewReader(body), limit)
+	if errors.Is(err, archive.ErrTooLarge) {
+		apistatus.WriteError(w, r, apierrors.NewRequestEntityTooLargeError(fmt.Sprintf("archive is larger than %d bytes uncompressed", limit)))
+		return
+	}
+	if err != nil {
+		apistatus.WriteError(w, r, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+
+	name := r.URL.Query().Get("workspace")
+	if name == "" {
+		name = export.Manifest.Workspace
+	}
+	if name == "" {
+		apistatus.WriteError(w, r, apierrors.NewBadRequest("workspace is required, the export has none"))
+		return
+	}
+
+	namespace, err := s.workspacesNamespace(ctx, r, user, true)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	result, err := s.importWorkspace(ctx, *user, namespace, name, export, policy)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, result)
+}
+
+// importWorkspace creates the objects of export in the workspace name of
+// namespace, the one of user or an organization, creating the workspace from
+// the export if it does not exist. Existing objects are handled according to
+// policy.
+func (s *Service) importWorkspace(ctx context.Context, user tenancyv1alpha1.User, namespace, name string, export *archive.Archive, policy tenancyv1alpha1.ImportConflictPolicy) (*tenancyv1alpha1.WorkspaceImport, error) {
+	workspace, err := s.getWorkspace(ctx, namespace, name)
+	switch {
+	case apierrors.IsNotFound(err):
+		workspace, err = s.createImportedWorkspace(ctx, user, namespace, name, export.Workspace)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+	if _, ok := workspace.Annotations[tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation]; ok || !workspace.DeletionTimestamp.IsZero() {
+		return nil, apierrors.NewConflict(tenancyv1alpha1.Resource("workspaces"), name, fmt.Errorf("workspace is terminating"))
+	}
+
+	// wait for the workspace, and the APIs of the imported objects to be
+	// served in it
+	resources := map[schema.GroupVersionResource]bool{}
+	for _, obj := range export.Objects {
+		gvr, err := archive.ResourceOf(obj)
+		if err != nil {
+			return nil, apierrors.NewBadRequest(err.Error())
+		}
+		resources[gvr] = true
+	}
+	var cluster logicalcluster.Name
+	err = wait.PollImmediateWithContext(ctx, time.Second, importProvisionTimeout, func(ctx context.Context) (bool, error) {
+		workspace, err = s.getWorkspace(ctx, namespace, name)
+		if err != nil {
+			return false, err
+		}
+		if workspace.Status.WorkspaceURL == "" || !conditions.IsTrue(workspace, conditionsv1alpha1.ReadyCondition) {
+			return false, nil
+		}
+		if _, cluster, err = helpers.ParseClusterURL(workspace.Status.WorkspaceURL); err != nil {
+			return false, err
+		}
+		for gvr := range resources {
+			if _, err := s.dynamicClient.Cluster(cluster).Resource(gvr).List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, apierrors.NewServerTimeout(tenancyv1alpha1.Resource("workspaces"), "import", int(importProvisionTimeout.Seconds()))
+	}
+
+	result := &tenancyv1alpha1.WorkspaceImport{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       tenancyv1alpha1.WorkspaceImportKind,
+			APIVersion: tenancyv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workspace.Name,
+			Namespace: workspace.Namespace,
+		},
+		ConflictPolicy: policy,
+	}
+
+	client := s.dynamicClient.Cluster(cluster)
+	existing := map[int]*unstructured.Unstructured{}
+	var conflicts []string
+	for i, obj := range export.Objects {
+		gvr, _ := archive.ResourceOf(obj)
+		current, err := client.Resource(gvr).Namespace(obj.GetNamespace()).Get(ctx, obj.GetName(), metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			return nil, err
+		default:
+			existing[i] = current
+			conflicts = append(conflicts, importedName(gvr, obj))
+		}
+	}
+	if policy == tenancyv1alpha1.ImportConflictFail && len(conflicts) > 0 {
+		return nil, apierrors.NewConflict(tenancyv1alpha1.Resource("workspaces"), name, fmt.Errorf("objects exist already: %v", conflicts))
+	}
+
+	namespaces := sets.NewString()
+	for _, obj := range export.Objects {
+		if obj.GetNamespace() != "" {
+			namespaces.Insert(obj.GetNamespace())
+		}
+	}
+	for _, namespace := range namespaces.List() {
+		_, err := s.coreClients.Cluster(cluster).CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+	}
+
+	for i, obj := range export.Objects {
+		gvr, _ := archive.ResourceOf(obj)
+		current, ok := existing[i]
+		switch {
+		case !ok:
+			if _, err := client.Resource(gvr).Namespace(obj.GetNamespace()).Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+				return nil, err
+			}
+			result.Created = append(result.Created, importedName(gvr, obj))
+		case policy == tenancyv1alpha1.ImportConflictSkip:
+			result.Skipped = append(result.Skipped, importedName(gvr, obj))
+		default:
+			obj = obj.DeepCopy()
+			obj.SetResourceVersion(current.GetResourceVersion())
+			if _, err := client.Resource(gvr).Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+				return nil, err
+			}
+			result.Updated = append(result.Updated, importedName(gvr, obj))
+		}
+	}
+
+	return result, nil
+}
+
+// createImportedWorkspace creates the workspace name of namespace from the
+// exported one, if any
+func (s *Service) createImportedWorkspace(ctx context.Context, user tenancyv1alpha1.User, namespace, name string, exported *unstructured.Unstructured) (*tenancyv1alpha1.Workspace, error) {
+	workspace := &tenancyv1alpha1.Workspace{}
+	if exported != nil {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(exported.Object, workspace); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid workspace in export: %v", err))
+		}
+	}
+
+	// exports may be crafted, or of workspaces with managed annotations from
+	// before they were left out of exports
+	request := &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      workspace.Labels,
+			Annotations: withoutManagedAnnotations(workspace.Annotations),
+		},
+		Spec: workspace.Spec,
+	}
+	// owners of organizations are admins of its workspaces already
+	if namespace == user.Name && !slices.Contains(request.Spec.Members, user.Spec.Email) {
+		request.Spec.Members = append(request.Spec.Members, user.Spec.Email)
+	}
+
+	if err := s.checkWorkspacesQuota(ctx, &user, namespace, name); err != nil {
+		return nil, err
+	}
+	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(namespace).Create(ctx, request, metav1.CreateOptions{})
+}
+
+// importedName returns the name of obj in import results
+func importedName(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s", gvr.GroupResource(), obj.GetNamespace(), obj.GetName())
+}
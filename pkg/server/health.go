@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	health "github.com/InVisionApp/go-health/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	pathHealth  = "/healthz"
+	pathLivez   = "/livez"
+	pathReadyz  = "/readyz"
+	pathMetrics = "/metrics"
+
+	// healthCheckTimeout is how long a single check may take before it fails
+	healthCheckTimeout = 10 * time.Second
+)
+
+// checkFunc is a health check of a dependency of the hub api
+type checkFunc func(ctx context.Context) error
+
+// Status runs the check, implementing health.ICheckable
+func (f checkFunc) Status() (interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+	return nil, f(ctx)
+}
+
+// healthChecks returns the checks of the dependencies of the hub api. All are
+// fatal, the hub api can't serve requests without any of them.
+func (s *Service) healthChecks() []*health.Config {
+	checks := []*health.Config{
+		{Name: "kcp", Checker: checkFunc(s.checkKCP)},
+		{Name: "oidc", Checker: checkFunc(s.authenticator.CheckProvider)},
+		{Name: "tenants-workspace", Checker: checkFunc(s.checkTenantsWorkspace)},
+	}
+
+	for _, check := range checks {
+		check.Interval = s.config.HealthCheckInterval
+		check.Fatal = true
+		check.OnComplete = recordHealthCheck
+	}
+	return checks
+}
+
+// checkKCP checks kcp is reachable and ready
+func (s *Service) checkKCP(ctx context.Context) error {
+	return s.kcpRESTClient.Get().AbsPath("/readyz").Do(ctx).Error()
+}
+
+// checkTenantsWorkspace checks the tenants workspace exists and serves the
+// tenancy api users are stored in
+func (s *Service) checkTenantsWorkspace(ctx context.Context) error {
+	_, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Users().List(ctx, metav1.ListOptions{Limit: 1})
+	return err
+}
+
+// livezHandler reports the hub api process is serving requests, regardless of
+// its dependencies, so it is not restarted while they are unavailable
+// GET - faros.sh/api/v1alpha1/livez
+func (s *Service) livezHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok")) //nolint:errcheck
+}
+
+// readiness is the response of readyzHandler
+type readiness struct {
+	Status string                  `json:"status"`
+	Checks map[string]health.State `json:"checks"`
+}
+
+// readyzHandler reports whether all checks of dependencies of the hub api
+// passed. The hub api is not ready until all of them ran once.
+// GET - faros.sh/api/v1alpha1/readyz
+func (s *Service) readyzHandler(w http.ResponseWriter, r *http.Request) {
+	states, failed, err := s.health.State()
+	if err != nil {
+		failed = true
+	}
+	if len(states) < len(s.checks) {
+		failed = true
+	}
+
+	status, code := "ok", http.StatusOK
+	if failed {
+		status, code = "failed", http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(readiness{Status: status, Checks: states}) //nolint:errcheck
+}
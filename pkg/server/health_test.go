@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	health "github.com/InVisionApp/go-health/v2"
+)
+
+func TestHealth(t *testing.T) {
+	test := newHubTest(t, testUser("jane", "jane@example.com"))
+	s := test.service
+
+	var kcpDown int32
+	s.checks = []*health.Config{
+		{Name: "kcp", Checker: checkFunc(func(ctx context.Context) error {
+			if atomic.LoadInt32(&kcpDown) == 1 {
+				return errors.New("connection refused")
+			}
+			return nil
+		})},
+		{Name: "tenants-workspace", Checker: checkFunc(s.checkTenantsWorkspace)},
+	}
+	for _, check := range s.checks {
+		check.Interval = 10 * time.Millisecond
+		check.Fatal = true
+		check.OnComplete = recordHealthCheck
+	}
+	if err := s.health.AddChecks(s.checks); err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(route string) (int, string) {
+		response, err := http.Get(test.server.URL + path.Join(pathAPIVersion, route))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return response.StatusCode, string(body)
+	}
+	// waitFor waits for the readiness of the hub to be code
+	waitFor := func(code int) {
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			got, body := get(pathReadyz)
+			if got == code {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("expected readiness %d, got %d: %s", code, got, body)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	if code, _ := get(pathReadyz); code != http.StatusServiceUnavailable {
+		t.Errorf("expected the hub not to be ready before checks ran, got %d", code)
+	}
+
+	if err := s.health.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.health.Stop() //nolint:errcheck
+	waitFor(http.StatusOK)
+
+	atomic.StoreInt32(&kcpDown, 1)
+	waitFor(http.StatusServiceUnavailable)
+	if code, _ := get(pathLivez); code != http.StatusOK {
+		t.Errorf("expected the hub to be live while kcp is down, got %d", code)
+	}
+	if code, _ := get(pathHealth); code != http.StatusInternalServerError {
+		t.Errorf("expected the hub to be unhealthy while kcp is down, got %d", code)
+	}
+
+	atomic.StoreInt32(&kcpDown, 0)
+	waitFor(http.StatusOK)
+
+	if code, _ := get(pathWorkspaces); code != http.StatusUnauthorized {
+		t.Errorf("expected anonymous requests to be unauthorized, got %d", code)
+	}
+	// metrics are not served by the hub api
+	if code, _ := get(pathMetrics); code != http.StatusNotFound {
+		t.Errorf("expected metrics not to be served by the hub api, got %d", code)
+	}
+	recorder := httptest.NewRecorder()
+	metricsHandler(recorder, httptest.NewRequest(http.MethodGet, pathMetrics, nil))
+	metrics := recorder.Body.String()
+	for _, metric := range []string{
+		`faros_api_request_duration_seconds_count{code="401",method="GET",route="/faros.sh/api/v1alpha1/workspaces"}`,
+		`faros_api_auth_failures_total{reason="unauthenticated"}`,
+		`faros_api_health_check_status{check="kcp"} 1`,
+		`faros_api_health_check_status{check="tenants-workspace"} 1`,
+	} {
+		if !strings.Contains(metrics, metric) {
+			t.Errorf("expected metric %s, got:\n%s", metric, metrics)
+		}
+	}
+}
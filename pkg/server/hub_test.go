@@ -0,0 +1,448 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	health "github.com/InVisionApp/go-health/v2"
+	"github.com/gorilla/mux"
+	"github.com/kcp-dev/logicalcluster/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/kube-openapi/pkg/spec3"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
+	"github.com/faroshq/faros-hub/pkg/client/clientset/versioned/fake"
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+	"github.com/faroshq/faros-hub/pkg/config"
+	"github.com/faroshq/faros-hub/pkg/server/auth"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+	"github.com/faroshq/faros-hub/pkg/util/ratelimit"
+)
+
+// fakeCluster serves all logical clusters from the same fake clientset
+type fakeCluster struct {
+	*fake.Clientset
+}
+
+func (c fakeCluster) Cluster(logicalcluster.Name) farosclient.Interface {
+	return c.Clientset
+}
+
+// fakeAuthenticator authenticates users by their name as bearer token
+type fakeAuthenticator struct {
+	client farosclient.Interface
+}
+
+var _ auth.Authenticator = fakeAuthenticator{}
+
+func (a fakeAuthenticator) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	http.Redirect(w, r, "https://idp.example.com", http.StatusFound)
+}
+
+func (a fakeAuthenticator) OIDCCallback(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("code") == "" {
+		apistatus.WriteError(w, r, apierrors.NewBadRequest("no code in request"))
+		return
+	}
+	http.Redirect(w, r, r.URL.Query().Get("redirect_uri"), http.StatusFound)
+}
+
+func (a fakeAuthenticator) OIDCToken(w http.ResponseWriter, r *http.Request) {
+	apistatus.WriteError(w, r, apierrors.NewBadRequest("invalid or expired login code, log in again"))
+}
+
+func (a fakeAuthenticator) Authenticate(r *http.Request) (bool, *tenancyv1alpha1.User, error) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		return false, nil, nil
+	}
+	user, err := a.ParseJWTToken(r.Context(), token)
+	if apierrors.IsNotFound(err) {
+		return false, nil, nil
+	}
+	return err == nil, user, err
+}
+
+func (a fakeAuthenticator) ParseJWTToken(ctx context.Context, token string) (*tenancyv1alpha1.User, error) {
+	return a.client.TenancyV1alpha1().Users().Get(ctx, token, metav1.GetOptions{})
+}
+
+func (a fakeAuthenticator) CheckProvider(ctx context.Context) error {
+	return nil
+}
+
+func testUser(name, email string) *tenancyv1alpha1.User {
+	return &tenancyv1alpha1.User{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{auth.UserLabel: strings.Replace(email, "@", "-at-", 1)},
+		},
+		Spec: tenancyv1alpha1.UserSpec{Email: email},
+	}
+}
+
+// hubTest is an in-process hub api with clients of its users, recording the
+// routes clients called
+type hubTest struct {
+	service *Service
+	server  *httptest.Server
+
+	lock   sync.Mutex
+	called map[string]bool
+}
+
+func newHubTest(t *testing.T, objects ...runtime.Object) *hubTest {
+	client := fake.NewSimpleClientset(objects...)
+	s := &Service{
+		config: &config.APIConfig{
+			ControllersTenantWorkspace: "root:faros:tenants",
+			AdminEmails:                []string{"admin@example.com"},
+		},
+		cluster:        logicalcluster.New("root:faros:tenants"),
+		health:         health.New(),
+		farosClient:    fakeCluster{client},
+		authenticator:  cachedAuthenticator{instrumentedAuthenticator{fakeAuthenticator{client: client}}},
+		rateLimitStore: ratelimit.NewMemoryStore(),
+	}
+
+	var err error
+	if s.router, err = s.newRouter(); err != nil {
+		t.Fatal(err)
+	}
+
+	test := &hubTest{service: s, called: map[string]bool{}}
+	handler := s.handler()
+	test.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var match mux.RouteMatch
+		if s.router.Match(r, &match) && match.Route != nil {
+			template, _ := match.Route.GetPathTemplate()
+			test.lock.Lock()
+			test.called[r.Method+" "+template] = true
+			test.lock.Unlock()
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(test.server.Close)
+	return test
+}
+
+// client returns a client of the hub api authenticated as user, anonymous if
+// empty
+func (h *hubTest) client(t *testing.T, user string) *hub.Client {
+	client, err := hub.NewForConfig(&rest.Config{Host: h.server.URL, BearerToken: user})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// openAPI returns the specification served by the hub api
+func (h *hubTest) openAPI(t *testing.T) *spec3.OpenAPI {
+	data, err := h.client(t, "").RESTClient().Get().AbsPath(hub.OpenAPIPath).DoRaw(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	openAPI := &spec3.OpenAPI{}
+	if err := json.Unmarshal(data, openAPI); err != nil {
+		t.Fatal(err)
+	}
+	return openAPI
+}
+
+func TestOpenAPI(t *testing.T) {
+	test := newHubTest(t)
+	openAPI := test.openAPI(t)
+
+	ids := map[string]bool{}
+	for _, route := range test.service.routes() {
+		template, _ := openAPIPath(path.Join(pathAPIVersion, route.path))
+		operation := operationOf(openAPI, route.method, template)
+		if operation == nil {
+			t.Errorf("route %s %s is not documented", route.method, template)
+			continue
+		}
+		if operation.OperationId == "" || ids[operation.OperationId] {
+			t.Errorf("route %s %s has no unique operation id: %q", route.method, template, operation.OperationId)
+		}
+		ids[operation.OperationId] = true
+	}
+
+	// all references resolve to schemas of the specification
+	data, err := json.Marshal(openAPI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range strings.Split(string(data), `"$ref":"`)[1:] {
+		name := strings.TrimPrefix(ref[:strings.Index(ref, `"`)], "#/components/schemas/")
+		if _, ok := openAPI.Components.Schemas[name]; !ok {
+			t.Errorf("reference to undefined schema %s", name)
+		}
+	}
+
+	workspace := openAPI.Components.Schemas["tenancy.v1alpha1.Workspace"]
+	if workspace == nil {
+		t.Fatal("expected a schema for workspaces")
+	}
+	for _, property := range []string{"apiVersion", "kind", "metadata", "spec", "status"} {
+		if _, ok := workspace.Properties[property]; !ok {
+			t.Errorf("expected workspace property %s", property)
+		}
+	}
+}
+
+func operationOf(openAPI *spec3.OpenAPI, method, template string) *spec3.Operation {
+	item := openAPI.Paths.Paths[template]
+	if item == nil {
+		return nil
+	}
+	return map[string]*spec3.Operation{
+		http.MethodGet:    item.Get,
+		http.MethodPost:   item.Post,
+		http.MethodPut:    item.Put,
+		http.MethodPatch:  item.Patch,
+		http.MethodDelete: item.Delete,
+	}[method]
+}
+
+// TestHubClient runs the hub client against the hub api, checking it only
+// calls documented routes and covers the api
+func TestHubClient(t *testing.T) {
+	ctx := context.Background()
+	agent := &edgev1alpha1.Agent{ObjectMeta: metav1.ObjectMeta{Name: "edge", Namespace: "default"}}
+	test := newHubTest(t, testUser("jane", "jane@example.com"), testUser("admin", "admin@example.com"), agent)
+	jane, admin := test.client(t, "jane"), test.client(t, "admin")
+
+	// workspaces
+	watcher, err := jane.Workspaces("").Watch(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Stop()
+
+	workspace, err := jane.Workspaces("").Create(ctx, &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet"},
+		Spec:       tenancyv1alpha1.WorkspaceSpec{Description: "edge fleet"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if workspace.Namespace != "jane" || len(workspace.Spec.Members) != 1 || workspace.Spec.Members[0] != "jane@example.com" {
+		t.Errorf("expected workspace of jane with them as member, got %s/%s %v", workspace.Namespace, workspace.Name, workspace.Spec.Members)
+	}
+	if event := <-watcher.ResultChan(); event.Type != watch.Added || event.Object.(*tenancyv1alpha1.Workspace).Name != "fleet" {
+		t.Errorf("expected added event of fleet, got %s %#v", event.Type, event.Object)
+	}
+
+	if workspace, err = jane.Workspaces("").Patch(ctx, "fleet", []byte(`{"spec":{"description":"fleet"}}`)); err != nil {
+		t.Fatal(err)
+	} else if workspace.Spec.Description != "fleet" {
+		t.Errorf("expected patched description, got %q", workspace.Spec.Description)
+	}
+	if workspace, err = jane.Workspaces("").Delete(ctx, "fleet", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, ok := workspace.Annotations[tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation]; !ok {
+		t.Error("expected deleted workspace to have a deletion deadline")
+	}
+	if workspace, err = jane.Workspaces("").Restore(ctx, "fleet"); err != nil {
+		t.Fatal(err)
+	} else if _, ok := workspace.Annotations[tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation]; ok {
+		t.Error("expected restored workspace to have no deletion deadline")
+	}
+	if workspaces, err := jane.Workspaces("").List(ctx, metav1.ListOptions{}); err != nil {
+		t.Fatal(err)
+	} else if len(workspaces.Items) != 1 {
+		t.Errorf("expected 1 workspace, got %d", len(workspaces.Items))
+	}
+	if quota, err := jane.Quota().Get(ctx); err != nil {
+		t.Fatal(err)
+	} else if quota.Workspaces != 1 {
+		t.Errorf("expected 1 workspace in quota, got %d", quota.Workspaces)
+	}
+
+	// organizations and teams
+	if _, err := jane.Organizations().Create(ctx, &tenancyv1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "acme"}}); err != nil {
+		t.Fatal(err)
+	}
+	organization, err := jane.Organizations().Get(ctx, "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	organization.Spec.Owners = append(organization.Spec.Owners, "john@example.com")
+	if _, err := jane.Organizations().Update(ctx, organization); err != nil {
+		t.Fatal(err)
+	}
+	if organizations, err := jane.Organizations().List(ctx); err != nil {
+		t.Fatal(err)
+	} else if len(organizations.Items) != 1 || len(organizations.Items[0].Spec.Owners) != 2 {
+		t.Errorf("expected acme with 2 owners, got %v", organizations.Items)
+	}
+	team, err := jane.Teams("acme").Create(ctx, &tenancyv1alpha1.Team{
+		ObjectMeta: metav1.ObjectMeta{Name: "operators"},
+		Spec:       tenancyv1alpha1.TeamSpec{Role: tenancyv1alpha1.TeamRoleView},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	team.Spec.Role = tenancyv1alpha1.TeamRoleEdit
+	if _, err := jane.Teams("acme").Update(ctx, team); err != nil {
+		t.Fatal(err)
+	}
+	if team, err := jane.Teams("acme").Get(ctx, "operators"); err != nil {
+		t.Fatal(err)
+	} else if team.Spec.Role != tenancyv1alpha1.TeamRoleEdit {
+		t.Errorf("expected updated role, got %s", team.Spec.Role)
+	}
+	if teams, err := jane.Teams("acme").List(ctx); err != nil {
+		t.Fatal(err)
+	} else if len(teams.Items) != 1 {
+		t.Errorf("expected 1 team, got %d", len(teams.Items))
+	}
+	if err := jane.Teams("acme").Delete(ctx, "operators"); err != nil {
+		t.Fatal(err)
+	}
+	if err := jane.Organizations().Delete(ctx, "acme"); err != nil {
+		t.Fatal(err)
+	}
+
+	// administration
+	if _, err := jane.Admin().ListUsers(ctx, hub.AdminListOptions{}); !apierrors.IsForbidden(err) {
+		t.Errorf("expected users to be forbidden to non administrators, got %v", err)
+	}
+	if users, err := admin.Admin().ListUsers(ctx, hub.AdminListOptions{Search: "jane"}); err != nil {
+		t.Fatal(err)
+	} else if len(users.Items) != 1 {
+		t.Errorf("expected 1 user matching jane, got %d", len(users.Items))
+	}
+	if _, err := admin.Admin().GetUser(ctx, "jane@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if user, err := admin.Admin().UserAction(ctx, "jane@example.com", hub.UserActionReconcile, hub.UserActionOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, ok := user.Annotations[tenancyv1alpha1.ReconcileRequestedAnnotation]; !ok {
+		t.Error("expected user to be annotated with the reconcile request")
+	}
+	if workspaces, err := admin.Admin().ListWorkspaces(ctx, hub.AdminListOptions{Owner: "jane@example.com"}); err != nil {
+		t.Fatal(err)
+	} else if len(workspaces.Items) != 1 {
+		t.Errorf("expected 1 workspace of jane, got %d", len(workspaces.Items))
+	}
+	if _, err := admin.Admin().GetWorkspace(ctx, "jane", "fleet"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := admin.Admin().ReconcileWorkspace(ctx, "jane", "fleet"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := admin.Admin().ListAgents(ctx, hub.AdminListOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := admin.Admin().ListErrors(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// errors are statuses
+	if _, err := jane.Workspaces("").Get(ctx, "missing"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if _, err := test.client(t, "").Workspaces("").List(ctx, metav1.ListOptions{}); !apierrors.IsUnauthorized(err) {
+		t.Errorf("expected anonymous requests to be unauthorized, got %v", err)
+	}
+
+	// the client only calls documented routes, and covers the ones it can
+	openAPI := test.openAPI(t)
+	for called := range test.called {
+		method, template, _ := strings.Cut(called, " ")
+		template, _ = openAPIPath(template)
+		if operationOf(openAPI, method, template) == nil {
+			t.Errorf("client called undocumented route %s %s", method, template)
+		}
+	}
+	notCovered := map[string]bool{
+		// served to browsers
+		"getHealth": true, "getOpenAPI": true, "login": true, "loginCallback": true,
+		// probes, see TestHealth
+		"getLivez": true, "getReadyz": true,
+		// called by browsers and the login plugin, see TestRateLimit
+		"refreshToken": true, "exchangeLoginCode": true,
+		// websockets, dialed by the plugins
+		"portForwardAgent": true, "execAgent": true,
+		// need the dynamic client of workspaces
+		"exportWorkspace": true, "importWorkspace": true,
+	}
+	for _, route := range test.service.routes() {
+		if !notCovered[route.id] && !test.called[route.method+" "+path.Join(pathAPIVersion, route.path)] {
+			t.Errorf("route %s %s was not called by the client", route.method, route.path)
+		}
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	test := newHubTest(t, testUser("jane", "jane@example.com"))
+
+	request, err := http.NewRequest(http.MethodGet, test.server.URL+path.Join(pathAPIVersion, pathWorkspaces, "missing"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Authorization", "Bearer jane")
+	request.Header.Set(apistatus.RequestIDHeader, "1234")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	status := metav1.Status{}
+	if err := json.NewDecoder(response.Body).Decode(&status); err != nil {
+		t.Fatal(err)
+	}
+	if response.Header.Get(apistatus.RequestIDHeader) != "1234" || !apistatus.HasCause(status, apistatus.RequestIDCause) {
+		t.Errorf("expected request id 1234 in header and status, got %q and %v", response.Header.Get(apistatus.RequestIDHeader), status.Details)
+	}
+}
+
+func TestCORS(t *testing.T) {
+	test := newHubTest(t)
+	test.service.config.CORSAllowedOrigins = []string{"https://console.example.com"}
+
+	for origin, allowed := range map[string]string{
+		"https://console.example.com": "https://console.example.com",
+		"https://evil.example.com":    "",
+	} {
+		request, err := http.NewRequest(http.MethodOptions, test.server.URL+path.Join(pathAPIVersion, pathWorkspaces), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Origin", origin)
+		request.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		request.Header.Set("Access-Control-Request-Headers", "Authorization")
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+
+		if got := response.Header.Get("Access-Control-Allow-Origin"); got != allowed {
+			t.Errorf("expected origin %s to be allowed as %q, got %q", origin, allowed, got)
+		}
+		if got := response.Header.Get("Access-Control-Allow-Credentials"); got != "" {
+			t.Errorf("expected no credentials to be allowed for origin %s, got %q", origin, got)
+		}
+	}
+
+	for _, origin := range []string{"*", "https://*.example.com", "console.example.com", "https://console.example.com/"} {
+		if err := checkOrigins([]string{origin}); err == nil {
+			t.Errorf("expected origin %q to be invalid", origin)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	health "github.com/InVisionApp/go-health/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/server/auth"
+	"github.com/faroshq/faros-hub/pkg/util/roundtripper"
+)
+
+const metricsNamespace = "faros_api"
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "request_duration_seconds",
+		Help:      "Latency of hub api requests by route, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "code"})
+
+	authFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "auth_failures_total",
+		Help:      "Requests failing authentication by reason, missing credentials, invalid tokens or disabled users.",
+	}, []string{"reason"})
+
+	kcpClientErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "kcp_client_errors_total",
+		Help:      "Requests of the hub api to kcp failing with a server error, by status code, or without a response.",
+	}, []string{"code"})
+
+	rateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "rate_limited_total",
+		Help:      "Requests rejected by rate limits by route and scope, ip, user, route or failures.",
+	}, []string{"route", "scope"})
+
+	healthCheckStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "health_check_status",
+		Help:      "Result of the last health check of a dependency, 1 if it passed.",
+	}, []string{"check"})
+
+	// metricsRegistry holds the metrics of the hub api, served by
+	// metricsHandler
+	metricsRegistry = prometheus.NewRegistry()
+)
+
+func init() {
+	metricsRegistry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requestDuration,
+		authFailures,
+		kcpClientErrors,
+		rateLimited,
+		healthCheckStatus,
+	)
+}
+
+// metricsHandler serves the metrics of the hub api in the prometheus format,
+// on the metrics address rather than the hub api
+// GET - /metrics
+var metricsHandler = promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{}).ServeHTTP
+
+// observeRequest records the latency and status of a request to route
+func observeRequest(route, method string, code int, duration time.Duration) {
+	requestDuration.WithLabelValues(route, method, strconv.Itoa(code)).Observe(duration.Seconds())
+}
+
+// recordHealthCheck records the result of a health check
+func recordHealthCheck(state *health.State) {
+	value := 1.0
+	if state.Status == "failed" {
+		value = 0
+	}
+	healthCheckStatus.WithLabelValues(state.Name).Set(value)
+}
+
+// instrumentKCPTransport counts requests to kcp failing with server errors
+func instrumentKCPTransport(rt http.RoundTripper) http.RoundTripper {
+	return roundtripper.RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		resp, err := rt.RoundTrip(r)
+		switch {
+		case err != nil:
+			kcpClientErrors.WithLabelValues("error").Inc()
+		case resp.StatusCode >= http.StatusInternalServerError:
+			kcpClientErrors.WithLabelValues(strconv.Itoa(resp.StatusCode)).Inc()
+		}
+		return resp, err
+	})
+}
+
+// instrumentedAuthenticator counts requests failing authentication
+type instrumentedAuthenticator struct {
+	auth.Authenticator
+}
+
+func (a instrumentedAuthenticator) Authenticate(r *http.Request) (bool, *tenancyv1alpha1.User, error) {
+	authenticated, user, err := a.Authenticator.Authenticate(r)
+	switch {
+	case err != nil:
+		authFailures.WithLabelValues("invalid").Inc()
+	case user != nil && !authenticated:
+		authFailures.WithLabelValues("disabled").Inc()
+	case !authenticated:
+		authFailures.WithLabelValues("unauthenticated").Inc()
+	}
+	return authenticated, user, err
+}
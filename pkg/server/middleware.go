@@ -2,6 +2,7 @@ package server
 
 import (
 	"bufio"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -9,8 +10,11 @@ import (
 	"time"
 
 	"github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 	"k8s.io/klog/v2"
+
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
 )
 
 func Panic() func(http.Handler) http.Handler {
@@ -22,7 +26,7 @@ func Panic() func(http.Handler) http.Handler {
 					klog.Error(e)
 
 					klog.Error(string(debug.Stack()))
-					http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+					apistatus.WriteError(w, r, fmt.Errorf("panic: %v", e))
 				}
 			}()
 
@@ -77,6 +81,8 @@ func (rc *logReadCloser) Read(b []byte) (int, error) {
 	return n, err
 }
 
+// Log logs failed requests and records the latency and status of all requests
+// by route
 func Log() func(http.Handler) http.Handler {
 	return func(h http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -97,6 +103,7 @@ func Log() func(http.Handler) http.Handler {
 				zap.String("request_proto", r.Proto),
 				zap.String("request_remote_addr", r.RemoteAddr),
 				zap.String("request_user_agent", r.UserAgent()),
+				zap.String("request_id", apistatus.RequestIDFrom(ctx)),
 			)
 
 			// enrich context with logger and add back to request
@@ -104,6 +111,12 @@ func Log() func(http.Handler) http.Handler {
 			r = r.WithContext(ctx)
 
 			defer func() {
+				route := "unmatched"
+				if current := mux.CurrentRoute(r); current != nil {
+					route, _ = current.GetPathTemplate()
+				}
+				observeRequest(route, r.Method, w.(*logResponseWriter).statusCode, time.Since(t))
+
 				if shouldLog(w) {
 					logger.WithValues(
 						zap.Int("body_read_bytes", r.Body.(*logReadCloser).bytes),
@@ -118,6 +131,22 @@ func Log() func(http.Handler) http.Handler {
 	}
 }
 
+// RequestID sets the id of requests, taken from the X-Request-Id header if a
+// proxy set it, on their context and response. Errors report it to clients.
+func RequestID() func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(apistatus.RequestIDHeader)
+			if id == "" {
+				id = apistatus.NewRequestID()
+			}
+			w.Header().Set(apistatus.RequestIDHeader, id)
+
+			h.ServeHTTP(w, r.WithContext(apistatus.WithRequestID(r.Context(), id)))
+		})
+	}
+}
+
 func Gzip() func(http.Handler) http.Handler {
 	return func(h http.Handler) http.Handler {
 		return handlers.CompressHandler(h)
@@ -15,3 +15,10 @@ func (s *Service) oidcLogin(w http.ResponseWriter, r *http.Request) {
 func (s *Service) oidcCallback(w http.ResponseWriter, r *http.Request) {
 	s.authenticator.OIDCCallback(w, r)
 }
+
+// oidcToken is a http handler exchanging one time login codes for the login
+// result
+// /faros.sh/oidc/token
+func (s *Service) oidcToken(w http.ResponseWriter, r *http.Request) {
+	s.authenticator.OIDCToken(w, r)
+}
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/openapi"
+)
+
+const (
+	pathOpenAPI = "/openapi.json"
+
+	// bearerSecurityScheme is the name of the security scheme of routes
+	// requiring authentication
+	bearerSecurityScheme = "bearer"
+)
+
+// route is a route of the hub api. Routes are registered on the router and
+// documented in the openapi specification of the api.
+type route struct {
+	method string
+	// path is the mux path template of the route, relative to pathAPIVersion
+	path    string
+	handler http.HandlerFunc
+	// admin routes are for hub administrators only
+	admin bool
+	// public routes do not require authentication
+	public bool
+	// limitFailures counts failed requests of clients against their login
+	// failure limit, blocking them once it is exceeded
+	limitFailures bool
+
+	// id identifies the operation of the route in the specification
+	id      string
+	summary string
+	// params are the query parameters of the route
+	params []param
+	// request is an object of the type of request bodies, if any
+	request interface{}
+	// requestType is the content type of request bodies, json by default
+	requestType string
+	// response is an object of the type of successful responses, if any
+	response interface{}
+	// responseType is the content type of responses, json by default
+	responseType string
+	// status is the code of successful responses, 200 by default
+	status int
+}
+
+// param is a query parameter of a route
+type param struct {
+	name        string
+	description string
+}
+
+// pathVariable matches mux variables of path templates, with their optional
+// pattern
+var pathVariable = regexp.MustCompile(`{([^:}]+)(?::([^}]+))?}`)
+
+// enumPattern matches patterns of variables with a fixed set of values
+var enumPattern = regexp.MustCompile(`^[a-zA-Z0-9-]+(\|[a-zA-Z0-9-]+)*$`)
+
+// newOpenAPI returns the openapi v3 specification of routes. Schemas of
+// requests and responses are built from the go types of the api.
+func newOpenAPI(routes []route) *spec3.OpenAPI {
+	schemas := openapi.NewSchemas()
+	status := schemas.For(metav1.Status{})
+
+	paths := map[string]*spec3.Path{}
+	for _, route := range routes {
+		template, params := openAPIPath(path.Join(pathAPIVersion, route.path))
+		if paths[template] == nil {
+			paths[template] = &spec3.Path{}
+		}
+
+		operation := &spec3.Operation{OperationProps: spec3.OperationProps{
+			OperationId: route.id,
+			Summary:     route.summary,
+			Parameters:  params,
+			Responses: &spec3.Responses{ResponsesProps: spec3.ResponsesProps{
+				Default:             jsonResponse("Status of the failed request", status),
+				StatusCodeResponses: map[int]*spec3.Response{},
+			}},
+		}}
+		for _, p := range route.params {
+			operation.Parameters = append(operation.Parameters, &spec3.Parameter{ParameterProps: spec3.ParameterProps{
+				Name:        p.name,
+				In:          "query",
+				Description: p.description,
+				Schema:      spec.StringProperty(),
+			}})
+		}
+		if !route.public {
+			operation.SecurityRequirement = []*spec3.SecurityRequirement{{SecurityRequirementProps: spec3.SecurityRequirementProps{bearerSecurityScheme: {}}}}
+		}
+
+		if route.request != nil || route.requestType != "" {
+			operation.RequestBody = &spec3.RequestBody{RequestBodyProps: spec3.RequestBodyProps{
+				Required: true,
+				Content:  content(schemas, route.requestType, route.request),
+			}}
+		}
+
+		code := route.status
+		if code == 0 {
+			code = http.StatusOK
+		}
+		response := &spec3.Response{ResponseProps: spec3.ResponseProps{Description: http.StatusText(code)}}
+		if route.response != nil || route.responseType != "" {
+			response.Content = content(schemas, route.responseType, route.response)
+		}
+		operation.Responses.StatusCodeResponses[code] = response
+
+		switch route.method {
+		case http.MethodGet:
+			paths[template].Get = operation
+		case http.MethodPost:
+			paths[template].Post = operation
+		case http.MethodPut:
+			paths[template].Put = operation
+		case http.MethodPatch:
+			paths[template].Patch = operation
+		case http.MethodDelete:
+			paths[template].Delete = operation
+		}
+	}
+
+	return &spec3.OpenAPI{
+		Version: "3.0.0",
+		Info: &spec.Info{InfoProps: spec.InfoProps{
+			Title:       "Faros hub API",
+			Description: "Workspaces, organizations and access to agents of the faros hub. Errors are kubernetes Status objects.",
+			Version:     tenancyv1alpha1.SchemeGroupVersion.Version,
+		}},
+		Paths: &spec3.Paths{Paths: paths},
+		Components: &spec3.Components{
+			Schemas: schemas.Definitions(),
+			SecuritySchemes: spec3.SecuritySchemes{
+				bearerSecurityScheme: &spec3.SecurityScheme{SecuritySchemeProps: spec3.SecuritySchemeProps{
+					Type:         "http",
+					Scheme:       "bearer",
+					BearerFormat: "JWT",
+					Description:  "ID token of the hub identity provider, as written to kubeconfig by kubectl faros login",
+				}},
+			},
+		},
+	}
+}
+
+// openAPIPath converts a mux path template to an openapi one, returning the
+// parameters of its variables
+func openAPIPath(template string) (string, []*spec3.Parameter) {
+	var params []*spec3.Parameter
+	for _, match := range pathVariable.FindAllStringSubmatch(template, -1) {
+		schema := spec.StringProperty()
+		if match[2] != "" && enumPattern.MatchString(match[2]) {
+			values := strings.Split(match[2], "|")
+			sort.Strings(values)
+			for _, value := range values {
+				schema.Enum = append(schema.Enum, value)
+			}
+		}
+		params = append(params, &spec3.Parameter{ParameterProps: spec3.ParameterProps{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		}})
+	}
+	return pathVariable.ReplaceAllString(template, "{$1}"), params
+}
+
+// content returns the content of requests or responses of obj, as
+// contentType. Non json content is binary.
+func content(schemas *openapi.Schemas, contentType string, obj interface{}) map[string]*spec3.MediaType {
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	schema := spec.StrFmtProperty("binary")
+	if obj != nil {
+		schema = schemas.For(obj)
+	}
+	return map[string]*spec3.MediaType{contentType: {MediaTypeProps: spec3.MediaTypeProps{Schema: schema}}}
+}
+
+func jsonResponse(description string, schema *spec.Schema) *spec3.Response {
+	return &spec3.Response{ResponseProps: spec3.ResponseProps{
+		Description: description,
+		Content:     map[string]*spec3.MediaType{"application/json": {MediaTypeProps: spec3.MediaTypeProps{Schema: schema}}},
+	}}
+}
+
+// openAPIHandler serves the openapi specification of the hub api
+// GET - faros.sh/api/v1alpha1/openapi.json
+func (s *Service) openAPIHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	w.Write(s.openAPI) //nolint:errcheck
+}
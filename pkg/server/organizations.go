@@ -0,0 +1,370 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apiserver/pkg/endpoints/handlers/negotiation"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/utils/strings/slices"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+	"github.com/faroshq/faros-hub/pkg/util/roles"
+)
+
+// organizationsHandler is a http handler for organizations operations
+// GET - faros.sh/orgs - list organizations the user owns or is in a team of
+// GET - faros.sh/orgs/<org> - get organization details
+// POST - faros.sh/orgs - create an organization owned by the user
+// PUT - faros.sh/orgs/<org> - update an organization, for its owners
+// DELETE - faros.sh/orgs/<org> - delete an organization without workspaces, for its owners
+func (s *Service) organizationsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	authenticated, user, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	if !authenticated {
+		apistatus.WriteError(w, r, errUnauthorized)
+		return
+	}
+
+	name := mux.Vars(r)["org"]
+
+	var obj runtime.Object
+	status := http.StatusOK
+	switch {
+	case r.Method == http.MethodGet && name == "":
+		obj, err = s.listOrganizations(ctx, user)
+	case r.Method == http.MethodGet:
+		obj, err = s.getOrganization(ctx, user, name, false)
+	case r.Method == http.MethodPost:
+		request := &tenancyv1alpha1.Organization{}
+		if err = decodeRequest(r, request); err == nil {
+			obj, err = s.createOrganization(ctx, user, request)
+			status = http.StatusCreated
+		}
+	case r.Method == http.MethodPut:
+		request := &tenancyv1alpha1.Organization{}
+		if err = decodeRequest(r, request); err == nil {
+			obj, err = s.updateOrganization(ctx, user, name, request)
+		}
+	case r.Method == http.MethodDelete:
+		obj, err = s.deleteOrganization(ctx, user, name)
+	}
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, status, obj)
+}
+
+// teamsHandler is a http handler for teams of organizations
+// GET - faros.sh/orgs/<org>/teams - list teams of an organization
+// GET - faros.sh/orgs/<org>/teams/<team> - get team details
+// POST - faros.sh/orgs/<org>/teams - create a team, for owners of the organization
+// PUT - faros.sh/orgs/<org>/teams/<team> - update a team, for owners of the organization
+// DELETE - faros.sh/orgs/<org>/teams/<team> - delete a team, for owners of the organization
+func (s *Service) teamsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	authenticated, user, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	if !authenticated {
+		apistatus.WriteError(w, r, errUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	manage := r.Method != http.MethodGet
+	organization, err := s.getOrganization(ctx, user, vars["org"], manage)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	teams := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Teams(tenancyv1alpha1.OrganizationNamespace(organization.Name))
+	name := vars["team"]
+
+	var obj runtime.Object
+	status := http.StatusOK
+	switch {
+	case r.Method == http.MethodGet && name == "":
+		obj, err = teams.List(ctx, metav1.ListOptions{})
+	case r.Method == http.MethodGet:
+		obj, err = teams.Get(ctx, name, metav1.GetOptions{})
+	case r.Method == http.MethodPost:
+		request := &tenancyv1alpha1.Team{}
+		if err = decodeRequest(r, request); err == nil {
+			if err = validateTeam(request); err == nil {
+				request.Namespace = tenancyv1alpha1.OrganizationNamespace(organization.Name)
+				obj, err = teams.Create(ctx, request, metav1.CreateOptions{})
+				status = http.StatusCreated
+			}
+		}
+	case r.Method == http.MethodPut:
+		request := &tenancyv1alpha1.Team{}
+		if err = decodeRequest(r, request); err == nil {
+			if err = validateTeam(request); err == nil {
+				var team *tenancyv1alpha1.Team
+				if team, err = teams.Get(ctx, name, metav1.GetOptions{}); err == nil {
+					team.Spec = request.Spec
+					obj, err = teams.Update(ctx, team, metav1.UpdateOptions{})
+				}
+			}
+		}
+	case r.Method == http.MethodDelete:
+		var team *tenancyv1alpha1.Team
+		if team, err = teams.Get(ctx, name, metav1.GetOptions{}); err == nil {
+			err = teams.Delete(ctx, name, metav1.DeleteOptions{})
+			obj = team
+		}
+	}
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, status, obj)
+}
+
+// listOrganizations returns the organizations user owns or is in a team of
+func (s *Service) listOrganizations(ctx context.Context, user *tenancyv1alpha1.User) (*tenancyv1alpha1.OrganizationList, error) {
+	organizations, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Organizations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Teams(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := map[string]bool{}
+	for _, team := range teams.Items {
+		if slices.Contains(team.Spec.Members, user.Spec.Email) {
+			namespaces[team.Namespace] = true
+		}
+	}
+
+	result := &tenancyv1alpha1.OrganizationList{ListMeta: organizations.ListMeta}
+	for _, organization := range organizations.Items {
+		if slices.Contains(organization.Spec.Owners, user.Spec.Email) || namespaces[tenancyv1alpha1.OrganizationNamespace(organization.Name)] {
+			result.Items = append(result.Items, organization)
+		}
+	}
+	return result, nil
+}
+
+// getOrganization returns the organization name, if user owns or, unless
+// manage is set, is in a team of it. Hub administrators can manage all
+// organizations.
+func (s *Service) getOrganization(ctx context.Context, user *tenancyv1alpha1.User, name string, manage bool) (*tenancyv1alpha1.Organization, error) {
+	organization, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Organizations().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if s.isAdmin(user) || slices.Contains(organization.Spec.Owners, user.Spec.Email) {
+		return organization, nil
+	}
+
+	if !manage {
+		teams, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Teams(tenancyv1alpha1.OrganizationNamespace(name)).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, team := range teams.Items {
+			if slices.Contains(team.Spec.Members, user.Spec.Email) {
+				return organization, nil
+			}
+		}
+		// organizations are not disclosed to outsiders
+		return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("organizations"), name)
+	}
+	return nil, apierrors.NewForbidden(tenancyv1alpha1.Resource("organizations"), name, fmt.Errorf("user %s is not an owner of the organization", user.Spec.Email))
+}
+
+// createOrganization creates an organization, with user as one of its owners
+func (s *Service) createOrganization(ctx context.Context, user *tenancyv1alpha1.User, request *tenancyv1alpha1.Organization) (*tenancyv1alpha1.Organization, error) {
+	if err := validateOrganizationName(request.Name); err != nil {
+		return nil, err
+	}
+
+	organization := &tenancyv1alpha1.Organization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   request.Name,
+			Labels: request.Labels,
+		},
+		Spec: request.Spec,
+	}
+	if !slices.Contains(organization.Spec.Owners, user.Spec.Email) {
+		organization.Spec.Owners = append(organization.Spec.Owners, user.Spec.Email)
+	}
+	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Organizations().Create(ctx, organization, metav1.CreateOptions{})
+}
+
+// updateOrganization replaces the spec of organization name with the one of
+// request. Organizations can't be left without owners.
+func (s *Service) updateOrganization(ctx context.Context, user *tenancyv1alpha1.User, name string, request *tenancyv1alpha1.Organization) (*tenancyv1alpha1.Organization, error) {
+	organization, err := s.getOrganization(ctx, user, name, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Spec.Owners) == 0 {
+		return nil, apierrors.NewBadRequest("organizations must have at least one owner")
+	}
+
+	organization.Spec = request.Spec
+	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Organizations().Update(ctx, organization, metav1.UpdateOptions{})
+}
+
+// deleteOrganization deletes organization name with its teams. Organizations
+// with workspaces can't be deleted, so workspaces are always deleted through
+// their grace period.
+func (s *Service) deleteOrganization(ctx context.Context, user *tenancyv1alpha1.User, name string) (*tenancyv1alpha1.Organization, error) {
+	organization, err := s.getOrganization(ctx, user, name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces, err := s.listWorkspaces(ctx, tenancyv1alpha1.OrganizationNamespace(name), "")
+	if err != nil {
+		return nil, err
+	}
+	if len(workspaces.Items) > 0 {
+		return nil, apierrors.NewConflict(tenancyv1alpha1.Resource("organizations"), name, fmt.Errorf("organization has %d workspaces, delete them first", len(workspaces.Items)))
+	}
+
+	err = s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Organizations().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return organization, nil
+}
+
+// workspacesNamespace returns the namespace of the workspaces the request is
+// for: the one of the organization in the org query parameter, or the one of
+// user. Team members can read the workspaces they have a role in, see
+// workspaceReader, owners can manage them too.
+func (s *Service) workspacesNamespace(ctx context.Context, r *http.Request, user *tenancyv1alpha1.User, manage bool) (string, error) {
+	name := r.URL.Query().Get("org")
+	if name == "" {
+		return user.Name, nil
+	}
+	organization, err := s.getOrganization(ctx, user, name, manage)
+	if err != nil {
+		return "", err
+	}
+	return tenancyv1alpha1.OrganizationNamespace(organization.Name), nil
+}
+
+// workspaceRoles returns a function returning the role of user in workspaces
+// of namespace, granted by their members, the owners of the organization of
+// namespace and its teams
+func (s *Service) workspaceRoles(ctx context.Context, user *tenancyv1alpha1.User, namespace string) (func(*tenancyv1alpha1.Workspace) tenancyv1alpha1.TeamRole, error) {
+	var organization *tenancyv1alpha1.Organization
+	var teams []tenancyv1alpha1.Team
+	if name, ok := roles.OrganizationOfNamespace(namespace); ok {
+		var err error
+		organization, err = s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Organizations().Get(ctx, name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			organization = nil
+		case err != nil:
+			return nil, err
+		}
+
+		list, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Teams(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		teams = list.Items
+	}
+	return func(workspace *tenancyv1alpha1.Workspace) tenancyv1alpha1.TeamRole {
+		return roles.Of(workspace, organization, teams, nil).Role(user.Spec.Email)
+	}, nil
+}
+
+// workspaceReader returns a function reporting whether user can read a
+// workspace of namespace. Users read all workspaces of their own namespace,
+// in organizations they need a role in the workspace. Hub administrators read
+// all workspaces.
+func (s *Service) workspaceReader(ctx context.Context, user *tenancyv1alpha1.User, namespace string) (func(*tenancyv1alpha1.Workspace) bool, error) {
+	if namespace == user.Name || s.isAdmin(user) {
+		return func(*tenancyv1alpha1.Workspace) bool { return true }, nil
+	}
+	roleOf, err := s.workspaceRoles(ctx, user, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return func(workspace *tenancyv1alpha1.Workspace) bool {
+		return roleOf(workspace) != ""
+	}, nil
+}
+
+// getReadableWorkspace returns workspace name of namespace, if user can read
+// it. Workspaces user can't read are not found.
+func (s *Service) getReadableWorkspace(ctx context.Context, user *tenancyv1alpha1.User, namespace, name string) (*tenancyv1alpha1.Workspace, error) {
+	workspace, err := s.getWorkspace(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	canRead, err := s.workspaceReader(ctx, user, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !canRead(workspace) {
+		return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspaces"), name)
+	}
+	return workspace, nil
+}
+
+// validateOrganizationName returns an error if name can't be used for an
+// organization. The namespace of organizations must be a valid namespace
+// name, which can't clash with the namespace of a user.
+func validateOrganizationName(name string) error {
+	if name == "" {
+		return apierrors.NewBadRequest("organization name is required")
+	}
+	if errs := validation.IsDNS1123Label(tenancyv1alpha1.OrganizationNamespace(name)); len(errs) > 0 {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid organization name %q: %s", name, strings.Join(errs, ", ")))
+	}
+	if strings.Contains(name, "-at-") {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid organization name %q: must not contain -at-", name))
+	}
+	return nil
+}
+
+// validateTeam returns an error if the spec of team is invalid
+func validateTeam(team *tenancyv1alpha1.Team) error {
+	switch team.Spec.Role {
+	case tenancyv1alpha1.TeamRoleAdmin, tenancyv1alpha1.TeamRoleEdit, tenancyv1alpha1.TeamRoleView:
+		return nil
+	}
+	return apierrors.NewBadRequest(fmt.Sprintf("invalid team role %q, must be one of %s, %s or %s", team.Spec.Role,
+		tenancyv1alpha1.TeamRoleAdmin, tenancyv1alpha1.TeamRoleEdit, tenancyv1alpha1.TeamRoleView))
+}
+
+// decodeRequest decodes the body of r into obj
+func decodeRequest(r *http.Request, obj runtime.Object) error {
+	limitedReader := &io.LimitedReader{R: r.Body, N: limit}
+	body, err := ioutil.ReadAll(limitedReader)
+	if err != nil {
+		return err
+	}
+	return runtime.DecodeInto(codecs.UniversalDecoder(), body, obj)
+}
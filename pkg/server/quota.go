@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/kcp-dev/kcp/pkg/cliplugins/helpers"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/handlers/negotiation"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+	"github.com/faroshq/faros-hub/pkg/util/quota"
+)
+
+// quotaHandler is a http handler for usage of users against their limits
+// GET - faros.sh/quota - get usage of the user
+func (s *Service) quotaHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	authenticated, user, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	if !authenticated {
+		apistatus.WriteError(w, r, errUnauthorized)
+		return
+	}
+
+	userQuota, err := s.getUserQuota(ctx, *user)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, userQuota)
+}
+
+// getUserQuota returns the usage of user against their limits. Agents and
+// requests are counted in each workspace of the user.
+func (s *Service) getUserQuota(ctx context.Context, user tenancyv1alpha1.User) (*tenancyv1alpha1.UserQuota, error) {
+	workspaces, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(user.Name).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	userQuota := &tenancyv1alpha1.UserQuota{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       tenancyv1alpha1.UserQuotaKind,
+			APIVersion: tenancyv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: user.Name,
+		},
+		Limits:     quota.Limits(&user, s.config.LimitsConfig),
+		Workspaces: int32(len(workspaces.Items)),
+	}
+
+	for _, workspace := range workspaces.Items {
+		usage := tenancyv1alpha1.WorkspaceUsage{Name: workspace.Name}
+		if workspace.Status.WorkspaceURL != "" {
+			_, cluster, err := helpers.ParseClusterURL(workspace.Status.WorkspaceURL)
+			if err != nil {
+				return nil, err
+			}
+			agents, err := s.farosClient.Cluster(cluster).EdgeV1alpha1().Agents(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			requests, err := s.farosClient.Cluster(cluster).AccessV1alpha1().Requests(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			usage.Agents = int32(len(agents.Items))
+			usage.Requests = int32(len(requests.Items))
+		}
+		userQuota.WorkspaceUsage = append(userQuota.WorkspaceUsage, usage)
+	}
+
+	return userQuota, nil
+}
+
+// checkWorkspacesQuota returns a forbidden error if no more workspaces can be
+// created in namespace, the one of user or of an organization. Organizations
+// have the hub default limits.
+func (s *Service) checkWorkspacesQuota(ctx context.Context, user *tenancyv1alpha1.User, namespace string, name string) error {
+	owner := user
+	if namespace != user.Name {
+		owner = nil
+	}
+	limits := quota.Limits(owner, s.config.LimitsConfig)
+	if limits.Workspaces == nil || *limits.Workspaces <= 0 {
+		return nil
+	}
+
+	workspaces, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	used := int32(len(workspaces.Items))
+	if !quota.Exceeded(limits.Workspaces, used) {
+		return nil
+	}
+
+	return newQuotaExceededError(tenancyv1alpha1.Resource("workspaces"), name, "workspaces", used, *limits.Workspaces)
+}
+
+// newQuotaExceededError returns a forbidden error with a cause naming the
+// exceeded limit, so clients can tell it apart from authorization errors
+func newQuotaExceededError(resource schema.GroupResource, name, limit string, used, hard int32) *apierrors.StatusError {
+	message := fmt.Sprintf("exceeded quota: %s, used: %d, limited: %d", limit, used, hard)
+	err := apierrors.NewForbidden(resource, name, fmt.Errorf("%s", message))
+	err.ErrStatus.Details.Causes = append(err.ErrStatus.Details.Causes, metav1.StatusCause{
+		Type:    apistatus.QuotaExceededCause,
+		Message: message,
+		Field:   limit,
+	})
+	return err
+}
@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/config"
+	"github.com/faroshq/faros-hub/pkg/server/auth"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+	"github.com/faroshq/faros-hub/pkg/util/ratelimit"
+)
+
+// rateLimits are the limits of requests to the hub api
+type rateLimits struct {
+	store ratelimit.Store
+
+	perIP   ratelimit.Limit
+	perUser ratelimit.Limit
+	// routes are the limits of routes by id
+	routes        map[string]ratelimit.Limit
+	loginFailures ratelimit.Limit
+
+	trustForwardedFor bool
+}
+
+// newRateLimits parses the limits of routes in cfg, kept in store
+func newRateLimits(cfg config.RateLimitConfig, routes []route, store ratelimit.Store) (*rateLimits, error) {
+	limits := &rateLimits{
+		store:             store,
+		routes:            map[string]ratelimit.Limit{},
+		trustForwardedFor: cfg.RateLimitTrustForwardedFor,
+	}
+
+	var err error
+	if limits.perIP, err = ratelimit.ParseLimit(cfg.RateLimitPerIP); err != nil {
+		return nil, fmt.Errorf("rate limit per ip: %w", err)
+	}
+	if limits.perUser, err = ratelimit.ParseLimit(cfg.RateLimitPerUser); err != nil {
+		return nil, fmt.Errorf("rate limit per user: %w", err)
+	}
+	if limits.loginFailures, err = ratelimit.ParseLimit(cfg.LoginFailureLimit); err != nil {
+		return nil, fmt.Errorf("login failure limit: %w", err)
+	}
+
+	ids := map[string]bool{}
+	for _, route := range routes {
+		ids[route.id] = true
+	}
+	for id, limit := range cfg.RateLimitRoutes {
+		if !ids[id] {
+			return nil, fmt.Errorf("rate limit of unknown route %q", id)
+		}
+		if limits.routes[id], err = ratelimit.ParseLimit(limit); err != nil {
+			return nil, fmt.Errorf("rate limit of route %s: %w", id, err)
+		}
+	}
+	return limits, nil
+}
+
+// take takes a token of key, returning how long to wait for one if there is
+// none. Requests are allowed if the store fails.
+func (l *rateLimits) take(ctx context.Context, key string, limit ratelimit.Limit) time.Duration {
+	wait, err := l.store.Take(ctx, key, limit)
+	if err != nil {
+		klog.Errorf("failed to take rate limit token of %s: %v", key, err)
+		return 0
+	}
+	return wait
+}
+
+// wait returns how long to wait for a token of key
+func (l *rateLimits) wait(ctx context.Context, key string, limit ratelimit.Limit) time.Duration {
+	wait, err := l.store.Wait(ctx, key, limit)
+	if err != nil {
+		klog.Errorf("failed to get rate limit of %s: %v", key, err)
+		return 0
+	}
+	return wait
+}
+
+// clientIP returns the address of the client of r
+func (l *rateLimits) clientIP(r *http.Request) string {
+	if forwarded := r.Header.Values("X-Forwarded-For"); l.trustForwardedFor && len(forwarded) > 0 {
+		// the ingress appends the address of the client to the last header
+		addrs := strings.Split(forwarded[len(forwarded)-1], ",")
+		return strings.TrimSpace(addrs[len(addrs)-1])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// bucket is a token bucket requests take a token of
+type bucket struct {
+	scope string
+	key   string
+	limit ratelimit.Limit
+}
+
+// rateLimit limits requests to route per client address, per user and per
+// route, and blocks client addresses with too many failed requests to routes
+// limiting failures
+func (s *Service) rateLimit(limits *rateLimits, route route, h http.Handler) http.Handler {
+	routeLimit := limits.routes[route.id]
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		ip := limits.clientIP(r)
+		failures := "failures:" + ip
+
+		if route.limitFailures {
+			if wait := limits.wait(ctx, failures, limits.loginFailures); wait > 0 {
+				writeTooManyRequests(w, r, route, "failures", wait)
+				return
+			}
+		}
+
+		client := "ip:" + ip
+		buckets := []bucket{{scope: "ip", key: client, limit: limits.perIP}}
+		if !route.public && (!limits.perUser.Unlimited() || !routeLimit.Unlimited()) {
+			authenticated, user, err := s.authenticator.Authenticate(r)
+			r = r.WithContext(withAuthentication(ctx, authentication{authenticated: authenticated, user: user, err: err}))
+			if authenticated {
+				client = "user:" + user.Name
+				buckets = append(buckets, bucket{scope: "user", key: client, limit: limits.perUser})
+			}
+		}
+		buckets = append(buckets, bucket{scope: "route", key: "route:" + route.id + ":" + client, limit: routeLimit})
+
+		for _, bucket := range buckets {
+			if wait := limits.take(ctx, bucket.key, bucket.limit); wait > 0 {
+				writeTooManyRequests(w, r, route, bucket.scope, wait)
+				return
+			}
+		}
+
+		if !route.limitFailures {
+			h.ServeHTTP(w, r)
+			return
+		}
+		recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		h.ServeHTTP(recorder, r)
+		if recorder.statusCode >= http.StatusBadRequest && recorder.statusCode < http.StatusInternalServerError {
+			limits.take(ctx, failures, limits.loginFailures)
+		}
+	})
+}
+
+// writeTooManyRequests writes a too many requests error, with how long to
+// wait before retrying
+func writeTooManyRequests(w http.ResponseWriter, r *http.Request, route route, scope string, wait time.Duration) {
+	rateLimited.WithLabelValues(route.id, scope).Inc()
+
+	seconds := int(math.Ceil(wait.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	message := "too many requests, retry later"
+	if scope == "failures" {
+		message = "too many failed logins, retry later"
+	}
+	apistatus.WriteError(w, r, apierrors.NewTooManyRequests(message, seconds))
+}
+
+// statusRecorder records the status code of responses
+type statusRecorder struct {
+	http.ResponseWriter
+
+	statusCode int
+}
+
+func (w *statusRecorder) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// authentication is the result of authenticating a request
+type authentication struct {
+	authenticated bool
+	user          *tenancyv1alpha1.User
+	err           error
+}
+
+type authenticationContextKey struct{}
+
+// withAuthentication returns ctx with the authentication of its request
+func withAuthentication(ctx context.Context, a authentication) context.Context {
+	return context.WithValue(ctx, authenticationContextKey{}, a)
+}
+
+// cachedAuthenticator returns the authentication of requests the rate limits
+// authenticated already, so handlers don't authenticate them again
+type cachedAuthenticator struct {
+	auth.Authenticator
+}
+
+func (a cachedAuthenticator) Authenticate(r *http.Request) (bool, *tenancyv1alpha1.User, error) {
+	if cached, ok := r.Context().Value(authenticationContextKey{}).(authentication); ok {
+		return cached.authenticated, cached.user, cached.err
+	}
+	return a.Authenticator.Authenticate(r)
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/faroshq/faros-hub/pkg/config"
+)
+
+func TestRateLimit(t *testing.T) {
+	// limited returns a hub api with limits, and a function requesting route
+	// as user, returning the status code and Retry-After header
+	limited := func(t *testing.T, limits config.RateLimitConfig) func(method, route, user string) (int, string) {
+		test := newHubTest(t, testUser("jane", "jane@example.com"), testUser("john", "john@example.com"))
+		test.service.config.RateLimitConfig = limits
+		var err error
+		if test.service.router, err = test.service.newRouter(); err != nil {
+			t.Fatal(err)
+		}
+
+		client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}}
+		return func(method, route, user string) (int, string) {
+			request, err := http.NewRequest(method, test.server.URL+path.Join(pathAPIVersion, route), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if user != "" {
+				request.Header.Set("Authorization", "Bearer "+user)
+			}
+			response, err := client.Do(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			response.Body.Close()
+			return response.StatusCode, response.Header.Get("Retry-After")
+		}
+	}
+	expect := func(t *testing.T, code int, retryAfter string, wantCode int, wantRetryAfter string) {
+		t.Helper()
+		if code != wantCode || retryAfter != wantRetryAfter {
+			t.Errorf("expected %d with Retry-After %q, got %d with %q", wantCode, wantRetryAfter, code, retryAfter)
+		}
+	}
+
+	t.Run("per ip", func(t *testing.T) {
+		request := limited(t, config.RateLimitConfig{RateLimitPerIP: "3/1m"})
+		for i := 0; i < 3; i++ {
+			code, retryAfter := request(http.MethodGet, pathLivez, "")
+			expect(t, code, retryAfter, http.StatusOK, "")
+		}
+		code, retryAfter := request(http.MethodGet, pathLivez, "")
+		expect(t, code, retryAfter, http.StatusTooManyRequests, "20")
+	})
+
+	t.Run("per user", func(t *testing.T) {
+		request := limited(t, config.RateLimitConfig{RateLimitPerUser: "2/1m"})
+		for i := 0; i < 2; i++ {
+			code, retryAfter := request(http.MethodGet, pathQuota, "jane")
+			expect(t, code, retryAfter, http.StatusOK, "")
+		}
+		code, retryAfter := request(http.MethodGet, pathWorkspaces, "jane")
+		expect(t, code, retryAfter, http.StatusTooManyRequests, "30")
+		code, retryAfter = request(http.MethodGet, pathQuota, "john")
+		expect(t, code, retryAfter, http.StatusOK, "")
+	})
+
+	t.Run("per route", func(t *testing.T) {
+		request := limited(t, config.RateLimitConfig{RateLimitRoutes: map[string]string{"getQuota": "1/1m"}})
+		code, retryAfter := request(http.MethodGet, pathQuota, "jane")
+		expect(t, code, retryAfter, http.StatusOK, "")
+		code, retryAfter = request(http.MethodGet, pathQuota, "jane")
+		expect(t, code, retryAfter, http.StatusTooManyRequests, "60")
+		code, retryAfter = request(http.MethodGet, pathWorkspaces, "jane")
+		expect(t, code, retryAfter, http.StatusOK, "")
+		code, retryAfter = request(http.MethodGet, pathQuota, "john")
+		expect(t, code, retryAfter, http.StatusOK, "")
+	})
+
+	t.Run("login failures", func(t *testing.T) {
+		request := limited(t, config.RateLimitConfig{LoginFailureLimit: "2/1m"})
+		callback := pathOIDCCallback + "?" + url.Values{"code": {"1234"}, "redirect_uri": {"http://127.0.0.1:8000"}}.Encode()
+
+		code, retryAfter := request(http.MethodGet, callback, "")
+		expect(t, code, retryAfter, http.StatusFound, "")
+		for i := 0; i < 2; i++ {
+			code, retryAfter := request(http.MethodGet, pathOIDCCallback, "")
+			expect(t, code, retryAfter, http.StatusBadRequest, "")
+		}
+		// blocked, even with a valid code
+		code, retryAfter = request(http.MethodGet, callback, "")
+		expect(t, code, retryAfter, http.StatusTooManyRequests, "30")
+		code, retryAfter = request(http.MethodPost, pathOIDCCallback, "")
+		expect(t, code, retryAfter, http.StatusTooManyRequests, "30")
+	})
+
+	t.Run("unknown route", func(t *testing.T) {
+		test := newHubTest(t)
+		test.service.config.RateLimitRoutes = map[string]string{"missing": "1/1m"}
+		if _, err := test.service.newRouter(); err == nil || !strings.Contains(err.Error(), "missing") {
+			t.Errorf("expected limits of unknown routes to fail, got %v", err)
+		}
+	})
+}
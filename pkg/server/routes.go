@@ -0,0 +1,148 @@
+package server
+
+import (
+	"net/http"
+	"path"
+
+	healthhandlers "github.com/InVisionApp/go-health/v2/handlers"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/models"
+)
+
+var (
+	orgParam    = param{name: "org", description: "Organization owning the workspaces, workspaces of the user if empty"}
+	searchParam = param{name: "search", description: "Only list objects whose names contain the text, ignoring case"}
+)
+
+// routes returns the routes of the hub api, relative to pathAPIVersion
+func (s *Service) routes() []route {
+	pathWorkspace := path.Join(pathWorkspaces, "{workspace}")
+	pathOrg := path.Join(pathOrgs, "{org}")
+	pathTeams := path.Join(pathOrg, "teams")
+	pathAdminUser := path.Join(pathAdmin, pathUsers, "{user}")
+	pathAdminWorkspace := path.Join(pathAdmin, pathWorkspaces, "{namespace}", "{workspace}")
+
+	routes := []route{
+		{method: http.MethodGet, path: pathHealth, handler: healthhandlers.NewJSONHandlerFunc(s.health, nil), public: true,
+			id: "getHealth", summary: "Get the health of the hub api and its dependencies"},
+		{method: http.MethodGet, path: pathLivez, handler: s.livezHandler, public: true, responseType: "text/plain",
+			id: "getLivez", summary: "Check the hub api is serving requests"},
+		{method: http.MethodGet, path: pathReadyz, handler: s.readyzHandler, public: true, response: readiness{},
+			id: "getReadyz", summary: "Check kcp, the identity provider and the tenants workspace are ready"},
+		{method: http.MethodGet, path: pathOpenAPI, handler: s.openAPIHandler, public: true,
+			id: "getOpenAPI", summary: "Get the openapi specification of the hub api"},
+		{method: http.MethodGet, path: pathOIDCLogin, handler: s.oidcLogin, public: true, status: http.StatusSeeOther,
+			id: "login", summary: "Redirect to the identity provider to log in",
+			params: []param{
+				{name: "redirect_uri", description: "Loopback address of the CLI, or allowed address, the login code is sent to"},
+				{name: "state", description: "State sent back to the redirect_uri with the login code"},
+				{name: "code_challenge", description: "PKCE code challenge of the code verifier the login code is exchanged with"},
+				{name: "code_challenge_method", description: "PKCE code challenge method, S256"},
+				{name: "offline_access", description: "Request a refresh token with yes"},
+			}},
+		{method: http.MethodGet, path: pathOIDCCallback, handler: s.oidcCallback, public: true, limitFailures: true, status: http.StatusSeeOther,
+			id: "loginCallback", summary: "Complete a login, called by the identity provider, and send a one time login code to the redirect_uri"},
+		{method: http.MethodPost, path: pathOIDCCallback, handler: s.oidcCallback, public: true, limitFailures: true, response: models.LoginResponse{},
+			id: "refreshToken", summary: "Refresh a token with the refresh_token form field",
+			requestType: "application/x-www-form-urlencoded"},
+		{method: http.MethodPost, path: pathOIDCToken, handler: s.oidcToken, public: true, limitFailures: true, response: models.LoginResponse{},
+			id: "exchangeLoginCode", summary: "Exchange a login code for the login result with the code and code_verifier form fields",
+			requestType: "application/x-www-form-urlencoded"},
+
+		{method: http.MethodGet, path: pathWorkspaces, handler: s.workspacesHandler, response: tenancyv1alpha1.WorkspaceList{},
+			id: "listWorkspaces", summary: "List workspaces, or watch them with watch=true",
+			params: []param{orgParam,
+				{name: "labelSelector", description: "Only list workspaces with matching labels"},
+				{name: "watch", description: "Stream watch events of workspaces instead"},
+				{name: "resourceVersion", description: "Resource version to watch from"},
+				{name: "fieldSelector", description: "Only watch workspaces with matching fields"},
+				{name: "timeoutSeconds", description: "Timeout of watches"},
+			}},
+		{method: http.MethodPost, path: pathWorkspaces, handler: s.workspacesHandler, status: http.StatusCreated,
+			request: tenancyv1alpha1.Workspace{}, response: tenancyv1alpha1.Workspace{}, params: []param{orgParam},
+			id: "createWorkspace", summary: "Create a workspace"},
+		{method: http.MethodGet, path: pathWorkspace, handler: s.workspacesHandler, response: tenancyv1alpha1.Workspace{}, params: []param{orgParam},
+			id: "getWorkspace", summary: "Get a workspace"},
+		{method: http.MethodPatch, path: pathWorkspace, handler: s.workspacesHandler, params: []param{orgParam},
+			request: tenancyv1alpha1.Workspace{}, requestType: "application/merge-patch+json", response: tenancyv1alpha1.Workspace{},
+			id: "patchWorkspace", summary: "Update a workspace with a json merge patch"},
+		{method: http.MethodDelete, path: pathWorkspace, handler: s.workspacesHandler, response: tenancyv1alpha1.Workspace{},
+			id: "deleteWorkspace", summary: "Delete a workspace after its grace period",
+			params: []param{orgParam, {name: "gracePeriodSeconds", description: "Shortens the grace period of the hub"}}},
+		{method: http.MethodPost, path: path.Join(pathWorkspace, "restore"), handler: s.restoreWorkspaceHandler, response: tenancyv1alpha1.Workspace{}, params: []param{orgParam},
+			id: "restoreWorkspace", summary: "Cancel the deletion of a workspace"},
+		{method: http.MethodGet, path: path.Join(pathWorkspace, "export"), handler: s.exportWorkspaceHandler, responseType: archiveContentType, params: []param{orgParam},
+			id: "exportWorkspace", summary: "Download an export of a workspace and its faros objects"},
+		{method: http.MethodPost, path: path.Join(pathWorkspaces, "import"), handler: s.importWorkspaceHandler,
+			requestType: archiveContentType, response: tenancyv1alpha1.WorkspaceImport{},
+			id: "importWorkspace", summary: "Import an export into a new or existing workspace",
+			params: []param{orgParam,
+				{name: "workspace", description: "Workspace to import into, the exported one if empty"},
+				{name: "conflictPolicy", description: "Fail, Skip or Overwrite existing objects, Fail by default"},
+			}},
+
+		{method: http.MethodGet, path: pathQuota, handler: s.quotaHandler, response: tenancyv1alpha1.UserQuota{},
+			id: "getQuota", summary: "Get usage of the user against their limits"},
+
+		{method: http.MethodGet, path: pathOrgs, handler: s.organizationsHandler, response: tenancyv1alpha1.OrganizationList{},
+			id: "listOrganizations", summary: "List organizations the user owns or is in a team of"},
+		{method: http.MethodPost, path: pathOrgs, handler: s.organizationsHandler, status: http.StatusCreated,
+			request: tenancyv1alpha1.Organization{}, response: tenancyv1alpha1.Organization{},
+			id: "createOrganization", summary: "Create an organization owned by the user"},
+		{method: http.MethodGet, path: pathOrg, handler: s.organizationsHandler, response: tenancyv1alpha1.Organization{},
+			id: "getOrganization", summary: "Get an organization"},
+		{method: http.MethodPut, path: pathOrg, handler: s.organizationsHandler,
+			request: tenancyv1alpha1.Organization{}, response: tenancyv1alpha1.Organization{},
+			id: "updateOrganization", summary: "Update an organization, for its owners"},
+		{method: http.MethodDelete, path: pathOrg, handler: s.organizationsHandler, response: tenancyv1alpha1.Organization{},
+			id: "deleteOrganization", summary: "Delete an organization without workspaces, for its owners"},
+		{method: http.MethodGet, path: pathTeams, handler: s.teamsHandler, response: tenancyv1alpha1.TeamList{},
+			id: "listTeams", summary: "List teams of an organization"},
+		{method: http.MethodPost, path: pathTeams, handler: s.teamsHandler, status: http.StatusCreated,
+			request: tenancyv1alpha1.Team{}, response: tenancyv1alpha1.Team{},
+			id: "createTeam", summary: "Create a team, for owners of the organization"},
+		{method: http.MethodGet, path: path.Join(pathTeams, "{team}"), handler: s.teamsHandler, response: tenancyv1alpha1.Team{},
+			id: "getTeam", summary: "Get a team"},
+		{method: http.MethodPut, path: path.Join(pathTeams, "{team}"), handler: s.teamsHandler,
+			request: tenancyv1alpha1.Team{}, response: tenancyv1alpha1.Team{},
+			id: "updateTeam", summary: "Update a team, for owners of the organization"},
+		{method: http.MethodDelete, path: path.Join(pathTeams, "{team}"), handler: s.teamsHandler, response: tenancyv1alpha1.Team{},
+			id: "deleteTeam", summary: "Delete a team, for owners of the organization"},
+
+		{method: http.MethodGet, path: path.Join(pathAdmin, pathUsers), handler: s.usersHandler, admin: true, response: tenancyv1alpha1.UserList{},
+			id: "adminListUsers", summary: "List users of the hub",
+			params: []param{searchParam}},
+		{method: http.MethodGet, path: pathAdminUser, handler: s.usersHandler, admin: true, response: tenancyv1alpha1.User{},
+			id: "adminGetUser", summary: "Get a user by email"},
+		{method: http.MethodPost, path: path.Join(pathAdminUser, "{action:disable|enable|offboard|reconcile}"), handler: s.usersHandler, admin: true, response: tenancyv1alpha1.User{},
+			id: "adminUserAction", summary: "Disable, enable, offboard or reconcile a user",
+			params: []param{{name: "transferTo", description: "Email of the user workspaces of offboarded users are transferred to"}}},
+		{method: http.MethodGet, path: path.Join(pathAdmin, pathWorkspaces), handler: s.adminWorkspacesHandler, admin: true, response: tenancyv1alpha1.WorkspaceList{},
+			id: "adminListWorkspaces", summary: "List workspaces of all tenants",
+			params: []param{searchParam, {name: "owner", description: "Only list workspaces of the user with the email"}}},
+		{method: http.MethodGet, path: pathAdminWorkspace, handler: s.adminWorkspacesHandler, admin: true, response: tenancyv1alpha1.Workspace{},
+			id: "adminGetWorkspace", summary: "Get a workspace of any tenant"},
+		{method: http.MethodPost, path: path.Join(pathAdminWorkspace, "reconcile"), handler: s.adminWorkspacesHandler, admin: true, response: tenancyv1alpha1.Workspace{},
+			id: "adminReconcileWorkspace", summary: "Force a reconcile of a workspace"},
+		{method: http.MethodGet, path: path.Join(pathAdmin, pathAgents), handler: s.adminAgentsHandler, admin: true, response: edgev1alpha1.AgentList{},
+			id: "adminListAgents", summary: "List agents of all workspaces",
+			params: []param{searchParam, {name: "workspace", description: "Only list agents of the workspace, as <namespace>/<workspace>"}}},
+		{method: http.MethodGet, path: path.Join(pathAdmin, pathErrors), handler: s.adminErrorsHandler, admin: true, response: tenancyv1alpha1.ReconcileErrorList{},
+			id: "adminListErrors", summary: "List objects controllers failed to reconcile"},
+
+		{method: http.MethodGet, path: pathAgentPortForward, handler: s.portForwardHandler, status: http.StatusSwitchingProtocols,
+			id: "portForwardAgent", summary: "Forward a websocket to a TCP target reachable from an agent",
+			params: []param{orgParam, {name: "host", description: "Host to forward to"}, {name: "port", description: "Port to forward to"}}},
+		{method: http.MethodGet, path: pathAgentExec, handler: s.execHandler, status: http.StatusSwitchingProtocols,
+			id: "execAgent", summary: "Execute a command on an agent over a websocket",
+			params: []param{orgParam, {name: "command", description: "Command and its arguments, repeated"}, {name: "tty", description: "Allocate a terminal"}}},
+	}
+
+	if s.config.TunnelsDebug {
+		routes = append(routes, route{method: http.MethodGet, path: pathDebugTunnels, handler: s.tunnelsDebugHandler, admin: true,
+			id: "debugTunnels", summary: "Get the state of agent tunnels of all tenants, for hub administrators"})
+	}
+	return routes
+}
@@ -2,15 +2,21 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 	"path"
+	"strings"
 	"time"
 
 	health "github.com/InVisionApp/go-health/v2"
-	healthhandlers "github.com/InVisionApp/go-health/v2/handlers"
 	farosclient "github.com/faroshq/faros-hub/pkg/client/clientset/versioned"
 	"github.com/faroshq/faros-hub/pkg/server/auth"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+	"github.com/faroshq/faros-hub/pkg/util/ratelimit"
 	"github.com/faroshq/faros-hub/pkg/util/recover"
+	"github.com/faroshq/faros-hub/pkg/util/revdial"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 
@@ -19,16 +25,25 @@ import (
 	kcpclient "github.com/kcp-dev/kcp/pkg/client/clientset/versioned"
 	"github.com/kcp-dev/logicalcluster/v2"
 	"go.uber.org/zap"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport"
 	"k8s.io/klog"
 
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
 	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
 	"github.com/faroshq/faros-hub/pkg/config"
 )
 
 func init() {
 	utilruntime.Must(tenancyv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(edgev1alpha1.AddToScheme(scheme))
 }
 
 var _ Interface = &Service{}
@@ -40,23 +55,50 @@ type Interface interface {
 const (
 	pathAPIVersion   = "/faros.sh/api/v1alpha1"
 	pathWorkspaces   = "/workspaces"
+	pathQuota        = "/quota"
+	pathAdmin        = "/admin"
+	pathUsers        = "/users"
+	pathAgents       = "/agents"
+	pathErrors       = "/errors"
+	pathOrgs         = "/orgs"
 	pathOIDC         = "/oidc"
 	pathOIDCLogin    = "/oidc/login"
 	pathOIDCCallback = "/oidc/callback"
+	pathOIDCToken    = "/oidc/token"
+	pathDebugTunnels = "/debug/tunnels"
+
+	// pathTunnels is where agents connect their reverse tunnels
+	pathTunnels = "/faros.sh/tunnels"
 )
 
+// errUnauthorized is the error of requests without a valid token of an
+// enabled user
+var errUnauthorized = apierrors.NewUnauthorized("a valid token of an enabled user is required")
+
 type Service struct {
 	config        *config.APIConfig
 	authenticator auth.Authenticator
 	server        *http.Server
+	metricsServer *http.Server
 	router        *mux.Router
 	health        *health.Health
-	cluster       logicalcluster.Name
+	// checks are the health checks of dependencies of the hub api
+	checks  []*health.Config
+	cluster logicalcluster.Name
 
 	// tunneling tooling
-	kcpClient   kcpclient.ClusterInterface
-	farosClient farosclient.ClusterInterface
-	coreClients kubernetes.ClusterInterface
+	kcpClient kcpclient.ClusterInterface
+	// kcpRESTClient checks the health of kcp
+	kcpRESTClient rest.Interface
+	farosClient   farosclient.ClusterInterface
+	coreClients   kubernetes.ClusterInterface
+	// dynamicClient is used for faros objects of all kinds, i.e. in exports
+	dynamicClient *dynamic.Cluster
+	tunnels       *revdial.ReversePool
+	// openAPI is the specification of the hub api, in json
+	openAPI []byte
+	// rateLimitStore keeps the rate limits of clients
+	rateLimitStore ratelimit.Store
 
 	//proxy       *httputil.ReverseProxy
 }
@@ -64,17 +106,35 @@ type Service struct {
 func New(config *config.APIConfig) (*Service, error) {
 	//p := newKubeConfigProxy(config.RestConfig)
 
-	kcpClient, err := kcpclient.NewClusterForConfig(config.KCPClusterRestConfig)
+	if err := checkOrigins(config.CORSAllowedOrigins); err != nil {
+		return nil, err
+	}
+
+	// count errors of all clients of kcp
+	kcpConfig := rest.CopyConfig(config.KCPClusterRestConfig)
+	kcpConfig.WrapTransport = transport.Wrappers(kcpConfig.WrapTransport, instrumentKCPTransport)
+
+	kcpClient, err := kcpclient.NewClusterForConfig(kcpConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	kcpRESTClient, err := kubernetes.NewForConfig(kcpConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	farosClient, err := farosclient.NewClusterForConfig(kcpConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	farosClient, err := farosclient.NewClusterForConfig(config.KCPClusterRestConfig)
+	coreClient, err := kubernetes.NewClusterForConfig(kcpConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	coreClient, err := kubernetes.NewClusterForConfig(config.KCPClusterRestConfig)
+	dynamicClient, err := dynamic.NewClusterForConfig(kcpConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -93,39 +153,118 @@ func New(config *config.APIConfig) (*Service, error) {
 	s := &Service{
 		config: config,
 		//proxy:         proxy,
-		cluster:       logicalcluster.New(config.ControllersTenantWorkspace),
-		health:        health.New(),
-		kcpClient:     kcpClient,
-		farosClient:   farosClient,
-		coreClients:   coreClient,
-		authenticator: authenticator,
+		cluster:        logicalcluster.New(config.ControllersTenantWorkspace),
+		health:         health.New(),
+		kcpClient:      kcpClient,
+		kcpRESTClient:  kcpRESTClient.Discovery().RESTClient(),
+		farosClient:    farosClient,
+		coreClients:    coreClient,
+		dynamicClient:  dynamicClient,
+		authenticator:  cachedAuthenticator{instrumentedAuthenticator{authenticator}},
+		rateLimitStore: ratelimit.NewMemoryStore(),
 	}
+	s.tunnels = s.newTunnelsPool()
 
-	s.router = setupRouter()
-	apiRouter := s.router.PathPrefix(pathAPIVersion).Subrouter()
-	apiRouter.HandleFunc("/healthz", healthhandlers.NewJSONHandlerFunc(s.health, nil))
-	apiRouter.HandleFunc(pathOIDCLogin, s.oidcLogin)
-	apiRouter.HandleFunc(pathOIDCCallback, s.oidcCallback)
+	s.checks = s.healthChecks()
+	if err := s.health.AddChecks(s.checks); err != nil {
+		return nil, err
+	}
 
-	apiRouter.HandleFunc(pathWorkspaces, s.workspacesHandler).Methods(http.MethodGet)
-	apiRouter.HandleFunc(path.Join(pathWorkspaces, "{workspace}"), s.workspacesHandler).Methods(http.MethodGet)
-	apiRouter.HandleFunc(path.Join(pathWorkspaces, "{workspace}"), s.workspacesHandler).Methods(http.MethodDelete)
-	apiRouter.HandleFunc(pathWorkspaces, s.workspacesHandler).Methods(http.MethodPost)
+	s.router, err = s.newRouter()
+	if err != nil {
+		return nil, err
+	}
 
+	// tunnels stream full duplex, which requires HTTP/2 behind the TLS
+	// terminating ingress
 	s.server = &http.Server{
-		Addr: config.Addr,
-		Handler: handlers.CORS(
-			handlers.AllowCredentials(),
-			handlers.AllowedHeaders([]string{"Content-Type"}),
-			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "PATCH", "DELETE"}),
-		)(s),
+		Addr:    config.Addr,
+		Handler: h2c.NewHandler(s.handler(), &http2.Server{}),
+	}
+
+	if config.MetricsAddr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.HandleFunc(pathMetrics, metricsHandler)
+		s.metricsServer = &http.Server{
+			Addr:    config.MetricsAddr,
+			Handler: metricsMux,
+		}
 	}
 
 	return s, nil
 }
 
+// handler returns the handler of the server, adding CORS headers for allowed
+// origins and request ids to all responses. Requests are authenticated with
+// bearer tokens, so cross origin requests are not sent with credentials.
+func (s *Service) handler() http.Handler {
+	return handlers.CORS(
+		handlers.AllowedOriginValidator(s.allowedOrigin),
+		handlers.AllowedHeaders([]string{"Authorization", "Content-Type"}),
+		handlers.AllowedMethods([]string{"GET", "POST", "PUT", "PATCH", "DELETE"}),
+		handlers.ExposedHeaders([]string{apistatus.RequestIDHeader}),
+	)(RequestID()(s))
+}
+
+// allowedOrigin returns whether browser applications of origin can call the
+// hub api
+func (s *Service) allowedOrigin(origin string) bool {
+	for _, allowed := range s.config.CORSAllowedOrigins {
+		if origin == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// checkOrigins returns an error unless origins are schemes and hosts of
+// browser applications, without wildcards
+func checkOrigins(origins []string) error {
+	for _, origin := range origins {
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.Contains(u.Host, "*") ||
+			u.User != nil || u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+			return fmt.Errorf("invalid CORS origin %q, expected <scheme>://<host>[:<port>]", origin)
+		}
+	}
+	return nil
+}
+
+// newRouter returns the router of the hub api routes, and stores their
+// openapi specification for the openapi route
+func (s *Service) newRouter() (*mux.Router, error) {
+	routes := s.routes()
+
+	openAPI, err := json.Marshal(newOpenAPI(routes))
+	if err != nil {
+		return nil, err
+	}
+	s.openAPI = openAPI
+
+	limits, err := newRateLimits(s.config.RateLimitConfig, routes, s.rateLimitStore)
+	if err != nil {
+		return nil, err
+	}
+
+	router := setupRouter()
+	apiRouter := router.PathPrefix(pathAPIVersion).Subrouter()
+	for _, route := range routes {
+		var handler http.Handler = route.handler
+		if route.admin {
+			handler = s.adminOnly(handler)
+		}
+		handler = s.rateLimit(limits, route, handler)
+		apiRouter.Handle(route.path, handler).Methods(route.method)
+	}
+	return router, nil
+}
+
 func (s *Service) Run(ctx context.Context) error {
 	klog.Info("Starting API Service")
+	if err := s.health.Start(); err != nil {
+		return err
+	}
+
 	go func() {
 		defer recover.Panic()
 		<-ctx.Done()
@@ -134,9 +273,15 @@ func (s *Service) Run(ctx context.Context) error {
 		if err != nil {
 			klog.Error(err)
 		}
+		s.tunnels.Close()
 
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
+		if s.metricsServer != nil {
+			if err := s.metricsServer.Shutdown(ctx); err != nil {
+				klog.Error("metrics shutdown error", zap.Error(err))
+			}
+		}
 		err = s.server.Shutdown(ctx)
 		if err != nil {
 			klog.Error("api shutdown error", zap.Error(err))
@@ -144,11 +289,27 @@ func (s *Service) Run(ctx context.Context) error {
 		klog.Info("Stopped API Service")
 	}()
 
+	if s.metricsServer != nil {
+		go func() {
+			defer recover.Panic()
+			klog.Info("Metrics will now listen", "url", s.config.MetricsAddr)
+			if err := s.metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				klog.Error("metrics server error", zap.Error(err))
+			}
+		}()
+	}
+
 	klog.Info("Server will now listen", "url", s.config.Addr)
 	return s.server.ListenAndServe()
 }
 
 func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// tunnels bypass the router middlewares, which buffer and compress
+	// responses and would break streaming
+	if strings.HasPrefix(r.URL.Path, pathTunnels+"/") {
+		http.StripPrefix(pathTunnels, s.tunnels).ServeHTTP(w, r)
+		return
+	}
 	s.router.ServeHTTP(w, r)
 }
 
@@ -159,8 +320,10 @@ func setupRouter() *mux.Router {
 	r.Use(Log())
 
 	r.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
+		apistatus.WriteError(w, r, apierrors.NewNotFound(schema.GroupResource{}, r.URL.Path))
+	})
+	r.MethodNotAllowedHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apistatus.WriteError(w, r, apierrors.NewMethodNotSupported(schema.GroupResource{}, r.Method))
 	})
 
 	return r
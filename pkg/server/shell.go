@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kcp-dev/logicalcluster/v2"
+	"golang.org/x/net/websocket"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/strings/slices"
+
+	pluginsv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/plugins/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/edge/tunnel"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+	"github.com/faroshq/faros-hub/pkg/util/remoteshell"
+)
+
+const (
+	pathAgentExec = pathAgent + "/exec"
+
+	defaultShellSessionTimeout = time.Hour
+	defaultShellIdleTimeout    = 15 * time.Minute
+)
+
+var defaultShellCommand = []string{"/bin/sh"}
+
+// execHandler relays a websocket to a command executed by the agent. Messages
+// are binary, prefixed with the remoteshell channel byte. Browsers, which can
+// not set headers on websockets, authenticate with the _t query parameter.
+// GET - faros.sh/api/v1alpha1/clusters/<cluster>/namespaces/<namespace>/agents/<agent>/exec?command=<arg>&tty=true
+func (s *Service) execHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	authenticated, user, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	if !authenticated {
+		apistatus.WriteError(w, r, errUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	clusterName, namespace, agent := vars["cluster"], vars["namespace"], vars["agent"]
+
+	tty, _ := strconv.ParseBool(r.URL.Query().Get("tty"))
+
+	workspace, err := s.getAgentsWorkspace(ctx, r, user, clusterName)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	if workspace.Spec.Shell == nil || !workspace.Spec.Shell.Enabled {
+		apistatus.WriteError(w, r, apierrors.NewForbidden(agentResource, agent, fmt.Errorf("remote shell is not enabled for the workspace %s", workspace.Name)))
+		return
+	}
+
+	shell, err := s.authorizeShell(ctx, *user, clusterName, namespace, agent)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	command := r.URL.Query()["command"]
+	if len(command) == 0 {
+		command = shell.Command
+		if len(command) == 0 {
+			command = defaultShellCommand
+		}
+	}
+
+	sessionTimeout, idleTimeout := defaultShellSessionTimeout, defaultShellIdleTimeout
+	if shell.SessionTimeout != nil && shell.SessionTimeout.Duration > 0 {
+		sessionTimeout = shell.SessionTimeout.Duration
+	}
+	if shell.IdleTimeout != nil && shell.IdleTimeout.Duration > 0 {
+		idleTimeout = shell.IdleTimeout.Duration
+	}
+
+	conn, err := s.dialAgent(ctx, clusterName, namespace, agent, tunnel.PathExec, url.Values{
+		"command": command,
+		"tty":     []string{strconv.FormatBool(tty)},
+	})
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	klog.Infof("%s started shell session %q, tty %t, on agent %s/%s/%s", user.Spec.Email, command, tty, clusterName, namespace, agent)
+	websocket.Server{Handler: func(ws *websocket.Conn) {
+		ws.PayloadType = websocket.BinaryFrame
+		relayShell(ws, conn, sessionTimeout, idleTimeout)
+	}}.ServeHTTP(w, r)
+	// the handshake failed if the handler did not run
+	conn.Close()
+	klog.Infof("%s closed shell session on agent %s/%s/%s", user.Spec.Email, clusterName, namespace, agent)
+}
+
+// relayShell relays websocket messages to remoteshell frames on conn and back,
+// until the session ends or times out
+func relayShell(ws *websocket.Conn, conn net.Conn, sessionTimeout, idleTimeout time.Duration) {
+	fw := remoteshell.NewFrameWriter(conn)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			channel, payload, err := remoteshell.ReadFrame(conn)
+			if err != nil {
+				return
+			}
+			if err := websocket.Message.Send(ws, append([]byte{channel}, payload...)); err != nil {
+				return
+			}
+			if channel == remoteshell.StatusChannel {
+				return
+			}
+		}
+	}()
+
+	input := make(chan struct{}, 1)
+	go func() {
+		// closing conn makes the agent kill the command
+		defer conn.Close()
+		for {
+			var msg []byte
+			if err := websocket.Message.Receive(ws, &msg); err != nil {
+				return
+			}
+			if len(msg) == 0 {
+				continue
+			}
+			if err := fw.WriteFrame(msg[0], msg[1:]); err != nil {
+				return
+			}
+			if msg[0] == remoteshell.StdinChannel {
+				select {
+				case input <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	session := time.NewTimer(sessionTimeout)
+	defer session.Stop()
+	idle := time.NewTimer(idleTimeout)
+	defer idle.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-input:
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(idleTimeout)
+		case <-idle.C:
+			terminateShell(ws, conn, fmt.Sprintf("session idle for %s", idleTimeout))
+			return
+		case <-session.C:
+			terminateShell(ws, conn, fmt.Sprintf("session exceeded %s", sessionTimeout))
+			return
+		}
+	}
+}
+
+// terminateShell notifies the client the session was terminated and closes
+// the connection to the agent
+func terminateShell(ws *websocket.Conn, conn net.Conn, message string) {
+	payload, _ := json.Marshal(remoteshell.Status{ExitCode: 1, Message: message})
+	websocket.Message.Send(ws, append([]byte{remoteshell.StatusChannel}, payload...)) //nolint:errcheck
+	conn.Close()
+}
+
+// authorizeShell returns the shell configuration of an Access plugin of the
+// agent allowing user to start sessions
+func (s *Service) authorizeShell(ctx context.Context, user tenancyv1alpha1.User, clusterName, namespace, agent string) (*pluginsv1alpha1.ShellSpec, error) {
+	accesses, err := s.farosClient.Cluster(logicalcluster.New(clusterName)).PluginsV1alpha1().Accesses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	enabled := false
+	for _, access := range accesses.Items {
+		shell := access.Spec.Shell
+		if access.Spec.Agent != agent || shell == nil || !shell.Enabled {
+			continue
+		}
+		enabled = true
+		if len(shell.AllowedUsers) == 0 || slices.Contains(shell.AllowedUsers, user.Spec.Email) {
+			return shell, nil
+		}
+	}
+	if !enabled {
+		return nil, apierrors.NewForbidden(agentResource, agent, fmt.Errorf("remote shell is not enabled for the agent"))
+	}
+	return nil, apierrors.NewForbidden(agentResource, agent, fmt.Errorf("%s is not allowed to start remote shell sessions", user.Spec.Email))
+}
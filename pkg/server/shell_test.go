@@ -0,0 +1,67 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pluginsv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/plugins/v1alpha1"
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+func TestShellEnabled(t *testing.T) {
+	const cluster = "root:faros-tenants:jane:fleet"
+	workspace := func(name string, shell bool) *tenancyv1alpha1.Workspace {
+		return &tenancyv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "jane"},
+			Spec: tenancyv1alpha1.WorkspaceSpec{
+				Members: []string{"jane@example.com"},
+				Shell:   &tenancyv1alpha1.WorkspaceShellSpec{Enabled: shell},
+			},
+			Status: tenancyv1alpha1.WorkspaceStatus{WorkspaceURL: "https://hub.example.com/clusters/" + cluster + name},
+		}
+	}
+	access := &pluginsv1alpha1.Access{
+		ObjectMeta: metav1.ObjectMeta{Name: "shell", Namespace: "default"},
+		Spec: pluginsv1alpha1.AccessSpec{
+			Agent: "edge",
+			Shell: &pluginsv1alpha1.ShellSpec{Enabled: true},
+		},
+	}
+	test := newHubTest(t, testUser("jane", "jane@example.com"), workspace("", false), workspace("-shell", true), access)
+
+	for _, tt := range []struct {
+		cluster string
+		agent   string
+		status  int
+		message string
+	}{
+		{cluster: cluster, agent: "edge", status: http.StatusForbidden, message: "remote shell is not enabled for the workspace"},
+		{cluster: cluster + "-shell", agent: "other", status: http.StatusForbidden, message: "remote shell is not enabled for the agent"},
+		// enabled for both, the agent does not exist
+		{cluster: cluster + "-shell", agent: "edge", status: http.StatusNotFound},
+	} {
+		request, err := http.NewRequest(http.MethodGet, test.server.URL+path.Join(pathAPIVersion, "clusters", tt.cluster, "namespaces/default/agents", tt.agent, "exec"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Authorization", "Bearer jane")
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var status metav1.Status
+		err = json.NewDecoder(response.Body).Decode(&status)
+		response.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.StatusCode != tt.status || !strings.Contains(status.Message, tt.message) {
+			t.Errorf("%s/%s: expected status %d %q, got %d %q", tt.cluster, tt.agent, tt.status, tt.message, response.StatusCode, status.Message)
+		}
+	}
+}
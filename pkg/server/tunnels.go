@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+
+	"github.com/kcp-dev/logicalcluster/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/revdial"
+)
+
+// newTunnelsPool returns the pool agents connect their reverse tunnels to.
+// Agents authenticate with the token of the Registration they registered with
+// and can only register the tunnel id of their own Agent. Registration tokens
+// are readable by viewers of the workspace, so tunnels are only dialed by the
+// hub, which authorizes users itself, and not proxied to.
+func (s *Service) newTunnelsPool() *revdial.ReversePool {
+	return revdial.NewReversePool(
+		revdial.WithAuthenticator(revdial.BearerTokenAuthenticator(s.validateAgentToken)),
+		revdial.WithoutProxy(),
+	)
+}
+
+// validateAgentToken checks token belongs to the Registration the agent the
+// tunnel id refers to was registered with
+func (s *Service) validateAgentToken(ctx context.Context, id, token string) (string, error) {
+	clusterName, namespace, name, err := edgev1alpha1.ParseAgentTunnelID(id)
+	if err != nil {
+		return "", revdial.ErrForbidden
+	}
+	cluster := logicalcluster.New(clusterName)
+
+	registrationName, err := edgev1alpha1.RegistrationOfToken(token)
+	if err != nil {
+		return "", revdial.ErrUnauthorized
+	}
+
+	registration, err := s.farosClient.Cluster(cluster).EdgeV1alpha1().Registrations(namespace).Get(ctx, registrationName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+			return "", revdial.ErrUnauthorized
+		}
+		return "", err
+	}
+	if registration.Status.Token == "" ||
+		subtle.ConstantTimeCompare([]byte(registration.Status.Token), []byte(token)) != 1 {
+		return "", revdial.ErrUnauthorized
+	}
+	owner := fmt.Sprintf("%s:%s:registration/%s", clusterName, namespace, registration.Name)
+
+	agent, err := s.farosClient.Cluster(cluster).EdgeV1alpha1().Agents(namespace).Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		klog.V(2).Infof("%s requested tunnel for unknown agent %s", owner, id)
+		return "", revdial.ErrForbidden
+	case err != nil:
+		return "", err
+	}
+	if agent.Labels[edgev1alpha1.AgentRegistrationLabel] != registration.Name {
+		klog.V(2).Infof("%s requested tunnel for agent %s of another registration", owner, id)
+		return "", revdial.ErrForbidden
+	}
+	return owner, nil
+}
+
+// tunnelsDebugHandler serves the state of the agent tunnels of all tenants,
+// for hub administrators
+// GET - faros.sh/api/v1alpha1/debug/tunnels
+func (s *Service) tunnelsDebugHandler(w http.ResponseWriter, r *http.Request) {
+	s.tunnels.DebugHandler().ServeHTTP(w, r)
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/edge/tunnel"
+	"github.com/faroshq/faros-hub/pkg/util/revdial"
+)
+
+// testRegistrationToken returns a token shaped like the service account
+// token of registration
+func testRegistrationToken(registration, signature string) string {
+	payload := `{"kubernetes.io/serviceaccount/service-account.name":"` + edgev1alpha1.RegistrationServiceAccountName(registration) + `"}`
+	return "e30." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + signature
+}
+
+func TestValidateAgentToken(t *testing.T) {
+	ctx := context.Background()
+
+	registration := func(name string) *edgev1alpha1.Registration {
+		return &edgev1alpha1.Registration{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status:     edgev1alpha1.RegistrationStatus{Token: testRegistrationToken(name, "signature")},
+		}
+	}
+	agent := func(name, registration string) *edgev1alpha1.Agent {
+		return &edgev1alpha1.Agent{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{edgev1alpha1.AgentRegistrationLabel: registration},
+		}}
+	}
+	test := newHubTest(t,
+		registration("edge"), registration("lab"),
+		agent("edge-1", "edge"), agent("lab-1", "lab"),
+	)
+
+	for _, tt := range []struct {
+		name    string
+		agent   string
+		token   string
+		wantErr error
+	}{
+		{name: "token of the registration of the agent", agent: "edge-1", token: testRegistrationToken("edge", "signature")},
+		{name: "token of another registration", agent: "lab-1", token: testRegistrationToken("edge", "signature"), wantErr: revdial.ErrForbidden},
+		{name: "forged token", agent: "edge-1", token: testRegistrationToken("edge", "forged"), wantErr: revdial.ErrUnauthorized},
+		{name: "token of an unknown registration", agent: "edge-1", token: testRegistrationToken("other", "signature"), wantErr: revdial.ErrUnauthorized},
+		{name: "not a service account token", agent: "edge-1", token: "token", wantErr: revdial.ErrUnauthorized},
+		{name: "unknown agent", agent: "edge-2", token: testRegistrationToken("edge", "signature"), wantErr: revdial.ErrForbidden},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			id := edgev1alpha1.AgentTunnelID("root:faros-tenants:jane:fleet", "default", tt.agent)
+			owner, err := test.service.validateAgentToken(ctx, id, tt.token)
+			if err != tt.wantErr {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && owner != "root:faros-tenants:jane:fleet:default:registration/edge" {
+				t.Errorf("unexpected owner %q", owner)
+			}
+		})
+	}
+}
+
+func TestTunnelsNotProxied(t *testing.T) {
+	ctx := context.Background()
+	token := testRegistrationToken("edge", "signature")
+	test := newHubTest(t,
+		&edgev1alpha1.Registration{
+			ObjectMeta: metav1.ObjectMeta{Name: "edge", Namespace: "default"},
+			Status:     edgev1alpha1.RegistrationStatus{Token: token},
+		},
+		&edgev1alpha1.Agent{ObjectMeta: metav1.ObjectMeta{
+			Name:      "edge-1",
+			Namespace: "default",
+			Labels:    map[string]string{edgev1alpha1.AgentRegistrationLabel: "edge"},
+		}},
+	)
+	test.service.tunnels = test.service.newTunnelsPool()
+	defer test.service.tunnels.Close()
+
+	// tunnels stream full duplex, which requires HTTP/2
+	hub := httptest.NewUnstartedServer(test.service.handler())
+	hub.EnableHTTP2 = true
+	hub.StartTLS()
+	defer hub.Close()
+
+	// the agent, serving whatever reaches the tunnel
+	var served int32
+	id := edgev1alpha1.AgentTunnelID("root:faros-tenants:jane:fleet", "default", "edge-1")
+	client := &http.Client{Transport: &bearerRoundTripper{token: token, rt: hub.Client().Transport}}
+	l, err := revdial.NewListener(ctx, client, hub.URL+pathTunnels, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go http.Serve(l, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { //nolint:errcheck
+		atomic.AddInt32(&served, 1)
+	}))
+
+	var d *revdial.Dialer
+	for deadline := time.Now().Add(5 * time.Second); d == nil && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+		d = test.service.tunnels.GetDialer(id)
+	}
+	if d == nil {
+		t.Fatal("tunnel of the agent was not registered")
+	}
+	if d.SessionKey() == "" || d.SessionKey() != l.SessionKey() {
+		t.Errorf("expected the hub to issue the session key of the tunnel to the agent")
+	}
+
+	// the registration token is readable by viewers, it must not reach the
+	// agent
+	for _, path := range []string{"/proxy/" + id + tunnel.PathExec, tunnel.PathExec} {
+		request, err := http.NewRequest(http.MethodGet, hub.URL+pathTunnels+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+		response, err := hub.Client().Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		if response.StatusCode != http.StatusNotFound {
+			t.Errorf("%s: expected status %d, got %d", path, http.StatusNotFound, response.StatusCode)
+		}
+	}
+	if n := atomic.LoadInt32(&served); n != 0 {
+		t.Errorf("expected no requests to reach the agent, got %d", n)
+	}
+}
+
+// bearerRoundTripper sends token with every request
+type bearerRoundTripper struct {
+	token string
+	rt    http.RoundTripper
+}
+
+func (b *bearerRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+b.token)
+	return b.rt.RoundTrip(r)
+}
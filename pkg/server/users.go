@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/endpoints/handlers/negotiation"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/server/auth"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+)
+
+// usersHandler is a http handler for managing users, for hub administrators
+// GET - faros.sh/admin/users?search=<text> - list all users
+// GET - faros.sh/admin/users/<email> - get a user
+// POST - faros.sh/admin/users/<email>/disable - block login of a user and remove them from all workspaces
+// POST - faros.sh/admin/users/<email>/enable - enable a disabled user
+// POST - faros.sh/admin/users/<email>/offboard?transferTo=<email> - disable and delete a user, transferring their workspaces
+// POST - faros.sh/admin/users/<email>/reconcile - force a reconcile of a user
+func (s *Service) usersHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := adminFrom(ctx)
+
+	vars := mux.Vars(r)
+	email, action := vars["user"], vars["action"]
+	if email == "" {
+		users, err := s.listUsers(ctx, r.URL.Query().Get("search"))
+		if err != nil {
+			apistatus.WriteError(w, r, err)
+			return
+		}
+		responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, users)
+		return
+	}
+
+	target, err := s.getUserByEmail(ctx, email)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	switch action {
+	case "":
+	case "disable":
+		target, err = s.setUserDisabled(ctx, target, true)
+	case "enable":
+		target, err = s.setUserDisabled(ctx, target, false)
+	case "offboard":
+		target, err = s.offboardUser(ctx, user, target, r.URL.Query().Get("transferTo"))
+	case "reconcile":
+		var patch []byte
+		if patch, err = reconcilePatch(time.Now()); err == nil {
+			target, err = s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Users().Patch(ctx, target.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		}
+	default:
+		err = apierrors.NewNotFound(schema.GroupResource{}, action)
+	}
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, target)
+}
+
+// listUsers returns users whose name, email or display name matches search
+func (s *Service) listUsers(ctx context.Context, search string) (*tenancyv1alpha1.UserList, error) {
+	users, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Users().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	items := users.Items[:0]
+	for _, user := range users.Items {
+		if matches(search, user.Name, user.Spec.Email, user.Spec.DisplayName) {
+			items = append(items, user)
+		}
+	}
+	users.Items = items
+	return users, nil
+}
+
+// getUserByEmail returns the user with email
+func (s *Service) getUserByEmail(ctx context.Context, email string) (*tenancyv1alpha1.User, error) {
+	users, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Users().List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", auth.UserLabel, strings.Replace(email, "@", "-at-", 1)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(users.Items) != 1 {
+		return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("users"), email)
+	}
+	return &users.Items[0], nil
+}
+
+// setUserDisabled disables or enables user. The workspaces controller
+// removes disabled users from, and adds enabled ones back to, the bindings of
+// their workspaces.
+func (s *Service) setUserDisabled(ctx context.Context, user *tenancyv1alpha1.User, disabled bool) (*tenancyv1alpha1.User, error) {
+	if user.Spec.Disabled == disabled {
+		return user, nil
+	}
+	user.Spec.Disabled = disabled
+	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Users().Update(ctx, user, metav1.UpdateOptions{})
+}
+
+// offboardUser disables and deletes user. Before the user is deleted, the
+// users controller transfers their workspaces to transferTo, or to their first
+// other active member if empty.
+func (s *Service) offboardUser(ctx context.Context, admin, user *tenancyv1alpha1.User, transferTo string) (*tenancyv1alpha1.User, error) {
+	if admin.Name == user.Name {
+		return nil, apierrors.NewBadRequest("administrators can't offboard themselves")
+	}
+	if transferTo != "" {
+		if transferTo == user.Spec.Email {
+			return nil, apierrors.NewBadRequest("workspaces can't be transferred to the offboarded user")
+		}
+		target, err := s.getUserByEmail(ctx, transferTo)
+		if err != nil {
+			return nil, err
+		}
+		if target.Spec.Disabled {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("user %s to transfer workspaces to is disabled", transferTo))
+		}
+	}
+
+	user.Spec.Disabled = true
+	user.Spec.TransferTo = transferTo
+	user, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Users().Update(ctx, user, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Users().Delete(ctx, user.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
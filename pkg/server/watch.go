@@ -0,0 +1,94 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog/v2"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
+)
+
+// watchWorkspaces streams watch events of the workspaces in namespace, the
+// one of a user or an organization, in the format of kubernetes watch
+// responses, leaving out workspaces canRead rejects. resourceVersion,
+// fieldSelector, labelSelector and timeoutSeconds query parameters are passed
+// to the watch.
+func (s *Service) watchWorkspaces(w http.ResponseWriter, r *http.Request, namespace string, canRead func(*tenancyv1alpha1.Workspace) bool) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	opts := metav1.ListOptions{
+		ResourceVersion: query.Get("resourceVersion"),
+		FieldSelector:   query.Get("fieldSelector"),
+		LabelSelector:   query.Get("labelSelector"),
+	}
+	if timeout := query.Get("timeoutSeconds"); timeout != "" {
+		seconds, err := strconv.ParseInt(timeout, 10, 64)
+		if err != nil {
+			apistatus.WriteError(w, r, err)
+			return
+		}
+		opts.TimeoutSeconds = &seconds
+	}
+
+	watcher, err := s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(namespace).Watch(ctx, opts)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	defer watcher.Stop()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		apistatus.WriteError(w, r, errors.New("streaming not supported"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+			if workspace, ok := event.Object.(*tenancyv1alpha1.Workspace); ok && !canRead(workspace) {
+				continue
+			}
+			if err := encoder.Encode(watchEvent(event)); err != nil {
+				klog.V(4).Infof("failed to write watch event: %v", err)
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// watchEvent converts event to its wire representation. Objects carry their
+// type, so clients can decode them.
+func watchEvent(event watch.Event) *metav1.WatchEvent {
+	if workspace, ok := event.Object.(*tenancyv1alpha1.Workspace); ok {
+		workspace = workspace.DeepCopy()
+		workspace.Kind = tenancyv1alpha1.WorkspaceKind
+		workspace.APIVersion = tenancyv1alpha1.SchemeGroupVersion.String()
+		workspace.ManagedFields = nil
+		event.Object = workspace
+	}
+
+	return &metav1.WatchEvent{
+		Type:   string(event.Type),
+		Object: runtime.RawExtension{Object: event.Object},
+	}
+}
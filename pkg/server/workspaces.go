@@ -2,24 +2,30 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"path"
+	"strconv"
 	"strings"
+	"time"
 
-	"github.com/davecgh/go-spew/spew"
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/gorilla/mux"
 	"github.com/kcp-dev/logicalcluster/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apiserver/pkg/endpoints/handlers/negotiation"
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
-	"k8s.io/klog/v2"
 	"k8s.io/utils/strings/slices"
 
 	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/util/apistatus"
 )
 
 var (
@@ -28,23 +34,31 @@ var (
 	limit  int64 = 1024 * 1024 * 10
 )
 
-// workspacesHandler is a http handler for workspaces operations
+// workspacesHandler is a http handler for workspaces operations. Workspaces of
+// an organization are used with ?org=<organization>.
 // GET -  faros.sh/workspaces - list all workspaces for users
+// GET -  faros.sh/workspaces?watch=true - stream watch events of workspaces of users
 // GET -  faros.sh/workspaces/<workspace> - get workspace details
-// DELETE - faros.sh/workspaces/<workspace> - delete a workspace
+// DELETE - faros.sh/workspaces/<workspace> - delete a workspace after its grace period, ?gracePeriodSeconds= shortens it
+// PATCH - faros.sh/workspaces/<workspace> - update a workspace with a json merge patch
 // POST - faros.sh/workspaces - create new workspace
 func (s *Service) workspacesHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	authenticated, user, err := s.authenticator.Authenticate(r)
 	if err != nil {
-		klog.Error(err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		apistatus.WriteError(w, r, err)
 		return
 	}
 
 	if !authenticated {
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		apistatus.WriteError(w, r, errUnauthorized)
+		return
+	}
+
+	namespace, err := s.workspacesNamespace(ctx, r, user, r.Method != http.MethodGet)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
 		return
 	}
 
@@ -52,19 +66,26 @@ func (s *Service) workspacesHandler(w http.ResponseWriter, r *http.Request) {
 	// list/get
 	case http.MethodGet:
 		parts := strings.Split(r.URL.Path, path.Join(pathAPIVersion, pathWorkspaces))
-		spew.Dump(parts)
-		if len(parts) == 2 && parts[1] == "" { // no workspace name - list all workspaces
-			workspaces, err := s.listWorkspaces(ctx, *user)
+		if len(parts) == 2 && parts[1] == "" && r.URL.Query().Get("watch") == "true" { // watch workspaces
+			canRead, err := s.workspaceReader(ctx, user, namespace)
+			if err != nil {
+				apistatus.WriteError(w, r, err)
+				return
+			}
+			s.watchWorkspaces(w, r, namespace, canRead)
+			return
+		} else if len(parts) == 2 && parts[1] == "" { // no workspace name - list all workspaces
+			workspaces, err := s.listReadableWorkspaces(ctx, user, namespace, r.URL.Query().Get("labelSelector"))
 			if err != nil {
-				responsewriters.ErrorNegotiated(err, codecs, schema.GroupVersion{}, w, r)
+				apistatus.WriteError(w, r, err)
 				return
 			}
 			responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, workspaces)
 			return
 		} else if len(parts) == 2 && parts[1] != "" { // workspace name - get workspace details
-			workspace, err := s.getWorkspace(ctx, *user, strings.TrimPrefix(parts[1], "/"))
+			workspace, err := s.getReadableWorkspace(ctx, user, namespace, strings.TrimPrefix(parts[1], "/"))
 			if err != nil {
-				responsewriters.ErrorNegotiated(err, codecs, schema.GroupVersion{}, w, r)
+				apistatus.WriteError(w, r, err)
 				return
 			}
 			responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, workspace)
@@ -76,37 +97,78 @@ func (s *Service) workspacesHandler(w http.ResponseWriter, r *http.Request) {
 		limitedReader := &io.LimitedReader{R: r.Body, N: limit}
 		body, err := ioutil.ReadAll(limitedReader)
 		if err != nil {
-			responsewriters.ErrorNegotiated(err, codecs, schema.GroupVersion{}, w, r)
+			apistatus.WriteError(w, r, err)
 			return
 		}
 		if err := runtime.DecodeInto(codecs.UniversalDecoder(), body, request); err != nil {
-			responsewriters.ErrorNegotiated(err, codecs, schema.GroupVersion{}, w, r)
+			apistatus.WriteError(w, r, err)
+			return
+		}
+
+		// the kcp workspace and lifecycle of workspaces are managed by the hub
+		if err := checkManagedAnnotations(request.Annotations); err != nil {
+			apistatus.WriteError(w, r, err)
 			return
 		}
+		request.Finalizers, request.OwnerReferences = nil, nil
 
-		request.Namespace = user.Name
-		if !slices.Contains(request.Spec.Members, user.Spec.Email) {
+		// owners of organizations are admins of its workspaces already
+		request.Namespace = namespace
+		if namespace == user.Name && !slices.Contains(request.Spec.Members, user.Spec.Email) {
 			request.Spec.Members = append(request.Spec.Members, user.Spec.Email)
 		}
 
+		if err := s.checkWorkspacesQuota(ctx, user, namespace, request.Name); err != nil {
+			apistatus.WriteError(w, r, err)
+			return
+		}
+
 		cluster := logicalcluster.New(s.config.ControllersTenantWorkspace)
-		workspace, err := s.farosClient.Cluster(cluster).TenancyV1alpha1().Workspaces(user.Name).Create(ctx, request, metav1.CreateOptions{})
+		workspace, err := s.farosClient.Cluster(cluster).TenancyV1alpha1().Workspaces(namespace).Create(ctx, request, metav1.CreateOptions{})
 		if err != nil {
-			responsewriters.ErrorNegotiated(err, codecs, schema.GroupVersion{}, w, r)
+			apistatus.WriteError(w, r, err)
 			return
 		}
 		responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusCreated, workspace)
 	case http.MethodDelete:
 		parts := strings.Split(r.URL.Path, path.Join(pathAPIVersion, pathWorkspaces))
 		if len(parts) == 2 && parts[1] != "" {
-			workspace, err := s.getWorkspace(ctx, *user, strings.TrimPrefix(parts[1], "/"))
+			gracePeriod := s.config.WorkspaceDeletionGracePeriod
+			if value := r.URL.Query().Get("gracePeriodSeconds"); value != "" {
+				seconds, err := strconv.ParseInt(value, 10, 64)
+				if err != nil || seconds < 0 {
+					apistatus.WriteError(w, r, apierrors.NewBadRequest(fmt.Sprintf("invalid gracePeriodSeconds %q", value)))
+					return
+				}
+				if period := time.Duration(seconds) * time.Second; period < gracePeriod {
+					gracePeriod = period
+				}
+			}
+			workspace, err := s.deleteWorkspace(ctx, namespace, strings.TrimPrefix(parts[1], "/"), gracePeriod)
 			if err != nil {
-				responsewriters.ErrorNegotiated(err, codecs, schema.GroupVersion{}, w, r)
+				apistatus.WriteError(w, r, err)
 				return
 			}
-			err = s.deleteWorkspace(ctx, *user, workspace.Name)
+			responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, workspace)
+			return
+		}
+	case http.MethodPatch:
+		parts := strings.Split(r.URL.Path, path.Join(pathAPIVersion, pathWorkspaces))
+		if len(parts) == 2 && parts[1] != "" {
+			if contentType := r.Header.Get("Content-Type"); contentType != string(types.MergePatchType) {
+				err := apierrors.NewGenericServerResponse(http.StatusUnsupportedMediaType, "patch", tenancyv1alpha1.Resource("workspaces"), "", fmt.Sprintf("unsupported content type %q, expected %s", contentType, types.MergePatchType), 0, false)
+				apistatus.WriteError(w, r, err)
+				return
+			}
+			limitedReader := &io.LimitedReader{R: r.Body, N: limit}
+			patch, err := ioutil.ReadAll(limitedReader)
+			if err != nil {
+				apistatus.WriteError(w, r, err)
+				return
+			}
+			workspace, err := s.patchWorkspace(ctx, *user, namespace, strings.TrimPrefix(parts[1], "/"), patch)
 			if err != nil {
-				responsewriters.ErrorNegotiated(err, codecs, schema.GroupVersion{}, w, r)
+				apistatus.WriteError(w, r, err)
 				return
 			}
 			responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, workspace)
@@ -115,15 +177,212 @@ func (s *Service) workspacesHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (s *Service) listWorkspaces(ctx context.Context, user tenancyv1alpha1.User) (*tenancyv1alpha1.WorkspaceList, error) {
-	spew.Dump(user)
-	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(user.Name).List(ctx, metav1.ListOptions{})
+// checkManagedAnnotations returns a bad request error if annotations has
+// annotations managed by the hub, which users can't set
+func checkManagedAnnotations(annotations map[string]string) error {
+	for key := range managedAnnotations(annotations) {
+		return apierrors.NewBadRequest(fmt.Sprintf("annotation %s is managed by the hub and can't be set", key))
+	}
+	return nil
+}
+
+// withoutManagedAnnotations returns annotations without the annotations
+// managed by the hub
+func withoutManagedAnnotations(annotations map[string]string) map[string]string {
+	if annotations == nil {
+		return nil
+	}
+	result := map[string]string{}
+	for key, value := range annotations {
+		if !strings.HasPrefix(key, tenancyv1alpha1.WorkspaceAnnotationPrefix) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func (s *Service) listWorkspaces(ctx context.Context, namespace string, labelSelector string) (*tenancyv1alpha1.WorkspaceList, error) {
+	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+}
+
+// listReadableWorkspaces lists the workspaces of namespace user can read
+func (s *Service) listReadableWorkspaces(ctx context.Context, user *tenancyv1alpha1.User, namespace string, labelSelector string) (*tenancyv1alpha1.WorkspaceList, error) {
+	workspaces, err := s.listWorkspaces(ctx, namespace, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	canRead, err := s.workspaceReader(ctx, user, namespace)
+	if err != nil {
+		return nil, err
+	}
+	items := workspaces.Items[:0]
+	for i := range workspaces.Items {
+		if canRead(&workspaces.Items[i]) {
+			items = append(items, workspaces.Items[i])
+		}
+	}
+	workspaces.Items = items
+	return workspaces, nil
+}
+
+func (s *Service) getWorkspace(ctx context.Context, namespace string, name string) (*tenancyv1alpha1.Workspace, error) {
+	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// patchWorkspace applies a json merge patch to the spec, labels and
+// annotations of a workspace in namespace. Its lifecycle, finalizers, owners
+// and annotations managed by the hub can't be patched, and workspaces of
+// users keep the user as a member.
+func (s *Service) patchWorkspace(ctx context.Context, user tenancyv1alpha1.User, namespace string, name string, patch []byte) (*tenancyv1alpha1.Workspace, error) {
+	if err := checkWorkspacePatch(patch); err != nil {
+		return nil, err
+	}
+
+	workspace, err := s.getWorkspace(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := json.Marshal(workspace)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := jsonpatch.MergePatch(current, patch)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid patch: %v", err))
+	}
+
+	result := &tenancyv1alpha1.Workspace{}
+	if err := json.Unmarshal(patched, result); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid patch: %v", err))
+	}
+	for key := range managedAnnotations(workspace.Annotations, result.Annotations) {
+		if workspace.Annotations[key] != result.Annotations[key] {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("annotation %s is managed by the hub and can't be patched", key))
+		}
+	}
+
+	updated := workspace.DeepCopy()
+	updated.Spec = result.Spec
+	updated.Labels = result.Labels
+	updated.Annotations = result.Annotations
+	if namespace == user.Name && !slices.Contains(updated.Spec.Members, user.Spec.Email) {
+		updated.Spec.Members = append(updated.Spec.Members, user.Spec.Email)
+	}
+
+	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(namespace).Update(ctx, updated, metav1.UpdateOptions{})
+}
+
+// checkWorkspacePatch returns a bad request error unless patch only patches
+// the spec, labels and annotations of workspaces
+func checkWorkspacePatch(patch []byte) error {
+	errNotPatchable := apierrors.NewBadRequest("only the spec, labels and annotations of workspaces can be patched")
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid patch: %v", err))
+	}
+	for field, value := range fields {
+		switch field {
+		case "spec":
+		case "metadata":
+			var metadata map[string]json.RawMessage
+			if err := json.Unmarshal(value, &metadata); err != nil {
+				return errNotPatchable
+			}
+			for key := range metadata {
+				if key != "labels" && key != "annotations" {
+					return errNotPatchable
+				}
+			}
+		default:
+			return errNotPatchable
+		}
+	}
+	return nil
 }
 
-func (s *Service) getWorkspace(ctx context.Context, user tenancyv1alpha1.User, name string) (*tenancyv1alpha1.Workspace, error) {
-	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(user.Name).Get(ctx, name, metav1.GetOptions{})
+// managedAnnotations returns the keys of annotations managed by the hub in
+// any of annotations
+func managedAnnotations(annotations ...map[string]string) map[string]bool {
+	keys := map[string]bool{}
+	for _, a := range annotations {
+		for key := range a {
+			if strings.HasPrefix(key, tenancyv1alpha1.WorkspaceAnnotationPrefix) {
+				keys[key] = true
+			}
+		}
+	}
+	return keys
 }
 
-func (s *Service) deleteWorkspace(ctx context.Context, user tenancyv1alpha1.User, name string) error {
-	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(user.Name).Delete(ctx, name, metav1.DeleteOptions{})
+// deleteWorkspace marks a workspace in namespace terminating, to be deleted by
+// the workspaces controller once gracePeriod passed. Deleting a terminating
+// workspace again can only bring its deadline forward.
+func (s *Service) deleteWorkspace(ctx context.Context, namespace string, name string, gracePeriod time.Duration) (*tenancyv1alpha1.Workspace, error) {
+	workspace, err := s.getWorkspace(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(gracePeriod).UTC()
+	if current, ok := workspace.Annotations[tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation]; ok {
+		if currentDeadline, err := time.Parse(time.RFC3339, current); err == nil && currentDeadline.Before(deadline) {
+			return workspace, nil
+		}
+	}
+
+	if workspace.Annotations == nil {
+		workspace.Annotations = map[string]string{}
+	}
+	workspace.Annotations[tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation] = deadline.Format(time.RFC3339)
+	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(namespace).Update(ctx, workspace, metav1.UpdateOptions{})
+}
+
+// restoreWorkspaceHandler is a http handler for restoring terminating workspaces
+// POST - faros.sh/workspaces/<workspace>/restore - cancel deletion of a workspace, ?org= of an organization
+func (s *Service) restoreWorkspaceHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	authenticated, user, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	if !authenticated {
+		apistatus.WriteError(w, r, errUnauthorized)
+		return
+	}
+
+	namespace, err := s.workspacesNamespace(ctx, r, user, true)
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+
+	workspace, err := s.restoreWorkspace(ctx, namespace, mux.Vars(r)["workspace"])
+	if err != nil {
+		apistatus.WriteError(w, r, err)
+		return
+	}
+	responsewriters.WriteObjectNegotiated(codecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, w, r, http.StatusOK, workspace)
+}
+
+// restoreWorkspace cancels deletion of a terminating workspace in namespace,
+// unless its grace period passed and it is being deleted already
+func (s *Service) restoreWorkspace(ctx context.Context, namespace string, name string) (*tenancyv1alpha1.Workspace, error) {
+	workspace, err := s.getWorkspace(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if !workspace.DeletionTimestamp.IsZero() {
+		return nil, apierrors.NewConflict(tenancyv1alpha1.Resource("workspaces"), name, fmt.Errorf("workspace is being deleted and can't be restored"))
+	}
+	if _, ok := workspace.Annotations[tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation]; !ok {
+		return workspace, nil
+	}
+
+	delete(workspace.Annotations, tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation)
+	return s.farosClient.Cluster(s.cluster).TenancyV1alpha1().Workspaces(namespace).Update(ctx, workspace, metav1.UpdateOptions{})
 }
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/client/hub"
+	"github.com/faroshq/faros-hub/pkg/util/archive"
+)
+
+func TestManagedWorkspaceFields(t *testing.T) {
+	ctx := context.Background()
+	test := newHubTest(t, testUser("jane", "jane@example.com"))
+	jane := test.client(t, "jane")
+
+	_, err := jane.Workspaces("").Create(ctx, &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "fleet",
+			Annotations: map[string]string{"tenancy.faros.sh/path": "root:faros-tenants:john:fleet"},
+		},
+	})
+	if !apierrors.IsBadRequest(err) {
+		t.Errorf("expected workspaces with hub annotations to be rejected, got %v", err)
+	}
+
+	workspace, err := jane.Workspaces("").Create(ctx, &tenancyv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "fleet"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, patch := range []string{
+		`{"metadata":{"finalizers":null}}`,
+		`{"metadata":{"ownerReferences":[{"apiVersion":"v1","kind":"Secret","name":"other","uid":"1"}]}}`,
+		`{"metadata":{"annotations":{"tenancy.faros.sh/path":"root:faros-tenants:john:fleet"}}}`,
+		`{"metadata":{"annotations":{"tenancy.faros.sh/deletion-deadline":"2022-01-01T00:00:00Z"}}}`,
+		`{"metadata":{"name":"other"}}`,
+		`{"status":{"path":"root:faros-tenants:john:fleet"}}`,
+	} {
+		if _, err := jane.Workspaces("").Patch(ctx, "fleet", []byte(patch)); !apierrors.IsBadRequest(err) {
+			t.Errorf("expected patch %s to be rejected, got %v", patch, err)
+		}
+	}
+
+	patch := `{"spec":{"description":"fleet"},"metadata":{"labels":{"team":"edge"},"annotations":{"example.com/note":"kept"}}}`
+	if workspace, err = jane.Workspaces("").Patch(ctx, "fleet", []byte(patch)); err != nil {
+		t.Fatal(err)
+	}
+	if workspace.Spec.Description != "fleet" || workspace.Labels["team"] != "edge" || workspace.Annotations["example.com/note"] != "kept" {
+		t.Errorf("expected spec, labels and annotations to be patched, got %#v", workspace)
+	}
+}
+
+func TestImportedWorkspaceAnnotations(t *testing.T) {
+	jane := testUser("jane", "jane@example.com")
+	test := newHubTest(t, jane)
+
+	exported := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": tenancyv1alpha1.SchemeGroupVersion.String(),
+		"kind":       tenancyv1alpha1.WorkspaceKind,
+		"metadata": map[string]interface{}{
+			"name": "fleet",
+			"annotations": map[string]interface{}{
+				"example.com/note":                "kept",
+				"tenancy.faros.sh/path":           "root:faros-tenants:john:fleet",
+				"tenancy.faros.sh/transferred-to": "john/fleet",
+			},
+		},
+	}}
+	workspace, err := test.service.createImportedWorkspace(context.Background(), *jane, "jane", "copy", exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := map[string]string{"example.com/note": "kept"}; !reflect.DeepEqual(workspace.Annotations, expected) {
+		t.Errorf("expected annotations %v without hub annotations, got %v", expected, workspace.Annotations)
+	}
+}
+
+func TestTeamWorkspaces(t *testing.T) {
+	ctx := context.Background()
+	namespace := tenancyv1alpha1.OrganizationNamespace("acme")
+	workspace := func(name string) *tenancyv1alpha1.Workspace {
+		return &tenancyv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	}
+	test := newHubTest(t,
+		testUser("jane", "jane@example.com"), testUser("mary", "mary@example.com"),
+		&tenancyv1alpha1.Organization{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+			Spec:       tenancyv1alpha1.OrganizationSpec{Owners: []string{"jane@example.com"}},
+		},
+		&tenancyv1alpha1.Team{
+			ObjectMeta: metav1.ObjectMeta{Name: "audit", Namespace: namespace},
+			Spec:       tenancyv1alpha1.TeamSpec{Role: tenancyv1alpha1.TeamRoleView, Members: []string{"mary@example.com"}, Workspaces: []string{"a"}},
+		},
+		workspace("a"), workspace("b"),
+	)
+
+	for user, expected := range map[string][]string{"jane": {"a", "b"}, "mary": {"a"}} {
+		workspaces, err := test.client(t, user).Workspaces("acme").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, workspace := range workspaces.Items {
+			names = append(names, workspace.Name)
+		}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("%s: expected workspaces %v, got %v", user, expected, names)
+		}
+	}
+
+	mary := test.client(t, "mary")
+	if _, err := mary.Workspaces("acme").Get(ctx, "a"); err != nil {
+		t.Errorf("expected workspace of the team to be readable, got %v", err)
+	}
+	if _, err := mary.Workspaces("acme").Get(ctx, "b"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected workspace outside of the team to be not found, got %v", err)
+	}
+	if _, err := mary.Workspaces("acme").Export(ctx, "b"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected export of workspace outside of the team to be not found, got %v", err)
+	}
+}
+
+func TestImportOrganizationWorkspace(t *testing.T) {
+	ctx := context.Background()
+	namespace := tenancyv1alpha1.OrganizationNamespace("acme")
+	jane := testUser("jane", "jane@example.com")
+	test := newHubTest(t,
+		jane, testUser("mary", "mary@example.com"),
+		&tenancyv1alpha1.Organization{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+			Spec:       tenancyv1alpha1.OrganizationSpec{Owners: []string{"jane@example.com"}},
+		},
+		&tenancyv1alpha1.Team{
+			ObjectMeta: metav1.ObjectMeta{Name: "ops", Namespace: namespace},
+			Spec:       tenancyv1alpha1.TeamSpec{Role: tenancyv1alpha1.TeamRoleEdit, Members: []string{"mary@example.com"}},
+		},
+		&tenancyv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "fleet",
+			Namespace:   namespace,
+			Annotations: map[string]string{tenancyv1alpha1.WorkspaceDeletionDeadlineAnnotation: "2022-01-01T00:00:00Z"},
+		}},
+	)
+
+	exported := &unstructured.Unstructured{}
+	exported.SetGroupVersionKind(tenancyv1alpha1.SchemeGroupVersion.WithKind(tenancyv1alpha1.WorkspaceKind))
+	exported.SetName("fleet")
+	buf := &bytes.Buffer{}
+	if err := archive.Write(buf, exported, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// only owners manage workspaces of organizations
+	_, err := test.client(t, "mary").Imports().Create(ctx, buf.Bytes(), hub.ImportOptions{Org: "acme"})
+	if !apierrors.IsForbidden(err) {
+		t.Errorf("expected import by a team member to be forbidden, got %v", err)
+	}
+	// the terminating workspace of the organization is imported into
+	_, err = test.client(t, "jane").Imports().Create(ctx, buf.Bytes(), hub.ImportOptions{Org: "acme"})
+	if !apierrors.IsConflict(err) {
+		t.Errorf("expected import into the terminating workspace of the organization to conflict, got %v", err)
+	}
+
+	workspace, err := test.service.createImportedWorkspace(ctx, *jane, namespace, "copy", exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if workspace.Namespace != namespace || len(workspace.Spec.Members) != 0 {
+		t.Errorf("expected workspace of the organization without members, got %s/%s %v", workspace.Namespace, workspace.Name, workspace.Spec.Members)
+	}
+}
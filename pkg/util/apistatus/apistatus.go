@@ -0,0 +1,92 @@
+package apistatus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// RequestIDHeader is the header carrying the id of hub api requests. It
+	// is set on all responses, and taken from requests if set by a proxy.
+	RequestIDHeader = "X-Request-Id"
+
+	// RequestIDCause is the cause of errors holding the id of the failed
+	// request, to be quoted when reporting it
+	RequestIDCause metav1.CauseType = "RequestID"
+)
+
+// requestIDContextKey is the context key of request ids
+type requestIDContextKey struct{}
+
+// WithRequestID returns ctx with the request id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, id)
+}
+
+// RequestIDFrom returns the request id of ctx, empty if it has none
+func RequestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// NewRequestID returns a new random request id
+func NewRequestID() string {
+	return uuid.New().String()
+}
+
+// WriteError writes err as a metav1.Status with the request id of r as a
+// cause. Internal errors are logged and written without their message, so
+// their details never leak to clients.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	id := RequestIDFrom(r.Context())
+	status := ToStatus(err, id)
+	if status.Reason == metav1.StatusReasonInternalError {
+		klog.Errorf("request %s %s %s failed: %v", id, r.Method, r.URL.Path, err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(int(status.Code))
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		klog.Errorf("request %s: failed to write status: %v", id, err)
+	}
+}
+
+// ToStatus returns the status of err for the request with id. Errors which
+// are not api errors are internal errors.
+func ToStatus(err error, id string) metav1.Status {
+	status := metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   http.StatusInternalServerError,
+		Reason: metav1.StatusReasonInternalError,
+	}
+	var apiStatus apierrors.APIStatus
+	if errors.As(err, &apiStatus) {
+		// errors may be shared, i.e. package level ones
+		apiStatus := apiStatus.Status()
+		status = *apiStatus.DeepCopy()
+	}
+
+	status.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	if status.Reason == metav1.StatusReasonInternalError {
+		status.Message = "internal error"
+		status.Details = nil
+	}
+	if id != "" {
+		if status.Details == nil {
+			status.Details = &metav1.StatusDetails{}
+		}
+		status.Details.Causes = append(status.Details.Causes, metav1.StatusCause{
+			Type:    RequestIDCause,
+			Message: id,
+		})
+	}
+	return status
+}
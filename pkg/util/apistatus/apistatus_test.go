@@ -0,0 +1,77 @@
+package apistatus
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestWriteError(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/faros.sh/api/v1alpha1/workspaces", nil)
+	r = r.WithContext(WithRequestID(r.Context(), "1234"))
+
+	w := httptest.NewRecorder()
+	WriteError(w, r, errors.New("dial tcp 10.0.0.1:6443: connection refused"))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected code %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+	if strings.Contains(w.Body.String(), "10.0.0.1") {
+		t.Errorf("expected internal error not to leak, got %s", w.Body.String())
+	}
+
+	var status metav1.Status
+	if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+		t.Fatal(err)
+	}
+	if status.Kind != "Status" || status.Reason != metav1.StatusReasonInternalError || requestIDOf(status) != "1234" {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
+
+func TestToStatus(t *testing.T) {
+	err := apierrors.NewNotFound(schema.GroupResource{Group: "tenancy.faros.sh", Resource: "workspaces"}, "fleet")
+
+	status := ToStatus(err, "1234")
+	if status.Code != http.StatusNotFound || status.Reason != metav1.StatusReasonNotFound || status.Message != err.Error() {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if requestIDOf(status) != "1234" {
+		t.Errorf("expected request id 1234, got %q", requestIDOf(status))
+	}
+
+	// errors may be shared between requests
+	ToStatus(err, "5678")
+	if len(err.ErrStatus.Details.Causes) != 0 {
+		t.Errorf("expected error not to be modified, got causes %v", err.ErrStatus.Details.Causes)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	quota := apierrors.NewForbidden(schema.GroupResource{Resource: "workspaces"}, "fleet", errors.New("exceeded quota"))
+	quota.ErrStatus.Details.Causes = append(quota.ErrStatus.Details.Causes, metav1.StatusCause{Type: QuotaExceededCause})
+
+	internal := ToStatus(errors.New("boom"), "1234")
+
+	for _, test := range []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{name: "not a status", err: errors.New("boom"), expected: "boom"},
+		{name: "unauthorized", err: apierrors.NewUnauthorized("invalid token"), expected: `invalid token: log in again with "kubectl faros login"`},
+		{name: "quota", err: quota, expected: `kubectl faros quota`},
+		{name: "internal", err: &apierrors.StatusError{ErrStatus: internal}, expected: "internal error: report request id 1234"},
+	} {
+		if described := Describe(test.err).Error(); !strings.Contains(described, test.expected) {
+			t.Errorf("%s: expected %q in %q", test.name, test.expected, described)
+		}
+	}
+}
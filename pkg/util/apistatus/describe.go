@@ -0,0 +1,76 @@
+package apistatus
+
+import (
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuotaExceededCause is the cause of errors of requests exceeding limits
+const QuotaExceededCause metav1.CauseType = "QuotaExceeded"
+
+// Describe returns err with a hint on how to resolve it if it is a status
+// returned by the hub api, and the request id to report server errors with.
+// Other errors are returned as they are.
+func Describe(err error) error {
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) {
+		return err
+	}
+	status := apiStatus.Status()
+
+	message := status.Message
+	if message == "" {
+		message = string(status.Reason)
+	}
+
+	switch status.Reason {
+	case metav1.StatusReasonUnauthorized:
+		return fmt.Errorf("%s: log in again with \"kubectl faros login\"", message)
+	case metav1.StatusReasonForbidden:
+		if HasCause(status, QuotaExceededCause) {
+			return fmt.Errorf("%s: see usage with \"kubectl faros quota\" or ask a hub administrator to raise your limits", message)
+		}
+		return errors.New(message)
+	case metav1.StatusReasonTooManyRequests:
+		if status.Details != nil && status.Details.RetryAfterSeconds > 0 {
+			return fmt.Errorf("%s: retry in %d seconds", message, status.Details.RetryAfterSeconds)
+		}
+		return errors.New(message)
+	}
+
+	if status.Code >= 500 {
+		if id := requestIDOf(status); id != "" {
+			return fmt.Errorf("%s: report request id %s to the hub administrators if it persists", message, id)
+		}
+	}
+	return errors.New(message)
+}
+
+// HasCause returns whether status has a cause of type t
+func HasCause(status metav1.Status, t metav1.CauseType) bool {
+	if status.Details == nil {
+		return false
+	}
+	for _, cause := range status.Details.Causes {
+		if cause.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// requestIDOf returns the id of the request which failed with status
+func requestIDOf(status metav1.Status) string {
+	if status.Details == nil {
+		return ""
+	}
+	for _, cause := range status.Details.Causes {
+		if cause.Type == RequestIDCause {
+			return cause.Message
+		}
+	}
+	return ""
+}
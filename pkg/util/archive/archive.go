@@ -0,0 +1,297 @@
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+
+	accessv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/access/v1alpha1"
+	edgev1alpha1 "github.com/faroshq/faros-hub/pkg/apis/edge/v1alpha1"
+	pluginsv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/plugins/v1alpha1"
+)
+
+const (
+	// ManifestFile is the file of the manifest in archives
+	ManifestFile = "manifest.yaml"
+	// WorkspaceFile is the file of the workspace object in archives
+	WorkspaceFile = "workspace.yaml"
+
+	// Version is the version of the archive format
+	Version = 1
+)
+
+// Archive is the content of a workspace archive
+type Archive struct {
+	Manifest  Manifest
+	Workspace *unstructured.Unstructured
+	Objects   []*unstructured.Unstructured
+}
+
+// Manifest describes the content of an archive
+type Manifest struct {
+	// Version is the version of the archive format
+	Version int `json:"version"`
+	// Workspace is the name of the archived workspace
+	Workspace string `json:"workspace,omitempty"`
+	// Created is when the archive was written
+	Created metav1.Time `json:"created"`
+	// Resources is the number of archived objects of each resource
+	Resources map[string]int `json:"resources,omitempty"`
+}
+
+// Resources are the faros resources archived from workspaces, in the order
+// they are restored
+var Resources = []schema.GroupVersionResource{
+	edgev1alpha1.SchemeGroupVersion.WithResource("registrations"),
+	edgev1alpha1.SchemeGroupVersion.WithResource("agents"),
+	accessv1alpha1.SchemeGroupVersion.WithResource("requests"),
+	pluginsv1alpha1.SchemeGroupVersion.WithResource("accesses"),
+	pluginsv1alpha1.SchemeGroupVersion.WithResource("containerruntimes"),
+	pluginsv1alpha1.SchemeGroupVersion.WithResource("monitorings"),
+	pluginsv1alpha1.SchemeGroupVersion.WithResource("networks"),
+	pluginsv1alpha1.SchemeGroupVersion.WithResource("notifications"),
+}
+
+// Export returns the faros objects of the workspace client points to, without
+// fields set by the server. Resources whose APIs are not bound in the
+// workspace are skipped.
+func Export(ctx context.Context, client dynamic.Interface) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+	for _, gvr := range Resources {
+		list, err := client.Resource(gvr).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", gvr.GroupResource(), err)
+		}
+
+		sort.Slice(list.Items, func(i, j int) bool {
+			return objectFile(gvr, &list.Items[i]) < objectFile(gvr, &list.Items[j])
+		})
+		for i := range list.Items {
+			objects = append(objects, Clean(&list.Items[i]))
+		}
+	}
+	return objects, nil
+}
+
+// Clean returns a copy of obj without fields set by the server, so it can be
+// created again
+func Clean(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp",
+		"deletionGracePeriodSeconds", "managedFields", "ownerReferences", "finalizers", "selfLink"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	annotations := obj.GetAnnotations()
+	for key := range annotations {
+		if strings.HasPrefix(key, "kcp.dev/") || strings.HasSuffix(key, ".kcp.dev/cluster") {
+			delete(annotations, key)
+		}
+	}
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	} else {
+		obj.SetAnnotations(annotations)
+	}
+	return obj
+}
+
+// Write writes a gzipped tarball of workspace and objects to w, with a
+// manifest of its content. The manifest is written to ManifestFile, the
+// workspace to WorkspaceFile and objects to
+// <resource>.<group>/<namespace>/<name>.yaml.
+func Write(w io.Writer, workspace *unstructured.Unstructured, objects []*unstructured.Unstructured) error {
+	manifest := Manifest{
+		Version:   Version,
+		Created:   metav1.Now(),
+		Resources: map[string]int{},
+	}
+	if workspace != nil {
+		manifest.Workspace = workspace.GetName()
+	}
+	files := make([]string, len(objects))
+	for i, obj := range objects {
+		gvr, err := ResourceOf(obj)
+		if err != nil {
+			return err
+		}
+		files[i] = objectFile(gvr, obj)
+		manifest.Resources[gvr.GroupResource().String()]++
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	write := func(name string, obj interface{}) error {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0600,
+			Size:    int64(len(data)),
+			ModTime: time.Now(),
+		}); err != nil {
+			return err
+		}
+		_, err = tw.Write(data)
+		return err
+	}
+
+	if err := write(ManifestFile, manifest); err != nil {
+		return err
+	}
+	if workspace != nil {
+		if err := write(WorkspaceFile, workspace.Object); err != nil {
+			return err
+		}
+	}
+	for i, obj := range objects {
+		if err := write(files[i], obj.Object); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// ErrTooLarge is the error of reading archives larger than their limit
+var ErrTooLarge = errors.New("archive is too large")
+
+// limitedReader reads up to n bytes of r, failing with ErrTooLarge after
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n <= 0 {
+		// archives of exactly limit bytes are fine, if r ends there
+		n, err := l.r.Read(make([]byte, 1))
+		if n > 0 {
+			return 0, ErrTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(p)) > l.n {
+		p = p[:l.n]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	return n, err
+}
+
+// Read reads a gzipped tarball written by Write, with objects in the order of
+// Resources. Archives larger than limit bytes uncompressed fail to read, so
+// small archives can't decompress to exhaust memory.
+func Read(r io.Reader, limit int64) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive: %w", err)
+	}
+	defer gz.Close()
+
+	archive := &Archive{}
+	byResource := map[schema.GroupVersionResource][]*unstructured.Unstructured{}
+	tr := tar.NewReader(&limitedReader{r: gz, n: limit})
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if errors.Is(err, ErrTooLarge) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg || path.Ext(header.Name) != ".yaml" {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		if header.Name == ManifestFile {
+			if err := yaml.Unmarshal(data, &archive.Manifest); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", header.Name, err)
+			}
+			if archive.Manifest.Version != Version {
+				return nil, fmt.Errorf("unsupported archive version %d, expected %d", archive.Manifest.Version, Version)
+			}
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(data, &obj.Object); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", header.Name, err)
+		}
+
+		if header.Name == WorkspaceFile {
+			archive.Workspace = obj
+			continue
+		}
+		gvr, err := ResourceOf(obj)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", header.Name, err)
+		}
+		byResource[gvr] = append(byResource[gvr], obj)
+	}
+
+	if archive.Manifest.Version == 0 {
+		return nil, fmt.Errorf("invalid archive: %s not found", ManifestFile)
+	}
+	for _, gvr := range Resources {
+		archive.Objects = append(archive.Objects, byResource[gvr]...)
+	}
+	return archive, nil
+}
+
+// ResourceOf returns the archived resource of obj
+func ResourceOf(obj *unstructured.Unstructured) (schema.GroupVersionResource, error) {
+	gvk := obj.GroupVersionKind()
+	for _, gvr := range Resources {
+		if gvr.GroupVersion() == gvk.GroupVersion() && strings.EqualFold(gvr.Resource, plural(gvk.Kind)) {
+			return gvr, nil
+		}
+	}
+	return schema.GroupVersionResource{}, fmt.Errorf("unsupported kind %s", gvk)
+}
+
+// plural returns the resource of faros kinds, i.e. accesses for Access
+func plural(kind string) string {
+	kind = strings.ToLower(kind)
+	if strings.HasSuffix(kind, "s") {
+		return kind + "es"
+	}
+	return kind + "s"
+}
+
+// objectFile returns the file of obj in archives
+func objectFile(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) string {
+	return path.Join(gvr.GroupResource().String(), obj.GetNamespace(), obj.GetName()+".yaml")
+}
@@ -0,0 +1,118 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestWriteRead(t *testing.T) {
+	workspace := object("tenancy.faros.sh/v1alpha1", "Workspace", "user", "fleet")
+	agent := object("edge.faros.sh/v1alpha1", "Agent", "default", "agent1")
+	access := object("plugins.faros.sh/v1alpha1", "Access", "default", "access1")
+	registration := object("edge.faros.sh/v1alpha1", "Registration", "default", "fleet")
+	registration.SetAnnotations(map[string]string{"kcp.dev/cluster": "root:faros-tenants:user:fleet", "team": "edge"})
+	registration.SetResourceVersion("42")
+	registration.SetFinalizers([]string{"registration.edge.faros.sh/finalizer"})
+	if err := unstructured.SetNestedField(registration.Object, "token", "status", "token"); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+	objects := []*unstructured.Unstructured{Clean(access), Clean(agent), Clean(registration)}
+	if err := Write(buf, Clean(workspace), objects); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := Read(buf, 1024*1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if archive.Workspace == nil || archive.Workspace.GetName() != "fleet" {
+		t.Fatalf("expected workspace fleet, got %v", archive.Workspace)
+	}
+	if archive.Manifest.Workspace != "fleet" || archive.Manifest.Resources["agents.edge.faros.sh"] != 1 {
+		t.Errorf("expected manifest of fleet with 1 agent, got %+v", archive.Manifest)
+	}
+	read := archive.Objects
+
+	var kinds []string
+	for _, obj := range read {
+		kinds = append(kinds, obj.GetKind())
+	}
+	if len(kinds) != 3 || kinds[0] != "Registration" || kinds[1] != "Agent" || kinds[2] != "Access" {
+		t.Fatalf("expected objects in restore order, got %v", kinds)
+	}
+
+	cleaned := read[0]
+	if cleaned.GetResourceVersion() != "" || len(cleaned.GetFinalizers()) != 0 {
+		t.Errorf("expected server fields to be removed, got %v", cleaned.Object["metadata"])
+	}
+	if _, ok := cleaned.Object["status"]; ok {
+		t.Error("expected status to be removed")
+	}
+	if annotations := cleaned.GetAnnotations(); len(annotations) != 1 || annotations["team"] != "edge" {
+		t.Errorf("expected kcp annotations only to be removed, got %v", annotations)
+	}
+}
+
+func TestWriteUnsupportedKind(t *testing.T) {
+	if err := Write(&bytes.Buffer{}, nil, []*unstructured.Unstructured{object("v1", "ConfigMap", "default", "a")}); err == nil {
+		t.Error("expected unsupported kind to fail")
+	}
+}
+
+func TestReadWithoutManifest(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	if err := tar.NewWriter(gz).Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Read(buf, 1024*1024); err == nil {
+		t.Error("expected archive without manifest to fail")
+	}
+}
+
+func TestReadTooLarge(t *testing.T) {
+	// a small gzip of a large entry
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	data := make([]byte, 1024*1024)
+	if err := tw.WriteHeader(&tar.Header{Name: "workspace.yaml", Mode: 0600, Size: int64(len(data))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() > 64*1024 {
+		t.Fatalf("expected a small archive, got %d bytes", buf.Len())
+	}
+
+	if _, err := Read(buf, 64*1024); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("expected archive larger than the limit to fail, got %v", err)
+	}
+}
+
+func object(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
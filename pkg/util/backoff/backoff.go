@@ -0,0 +1,27 @@
+package backoff
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+)
+
+// Until calls f until ctx is cancelled, waiting between calls with an
+// exponential backoff from 5 seconds up to 5 minutes. The backoff is reset
+// once f ran for a minute, so long lived connections reconnect quickly.
+func Until(ctx context.Context, f func()) {
+	var (
+		initBackoff   = 5 * time.Second
+		maxBackoff    = 5 * time.Minute
+		resetDuration = 1 * time.Minute
+		backoffFactor = 2.0
+		jitter        = 1.0
+		clock         = &clock.RealClock{}
+		sliding       = true
+	)
+
+	backoffMgr := wait.NewExponentialBackoffManager(initBackoff, maxBackoff, resetDuration, backoffFactor, jitter, clock)
+	wait.BackoffUntil(f, backoffMgr, sliding, ctx.Done())
+}
@@ -127,7 +127,7 @@ func createResourceFromFS(ctx context.Context, client dynamic.Interface, mapper
 		return fmt.Errorf("failed to execute manifest: %w", err)
 	}
 
-	obj, gvk, err := extensionsapiserver.Codecs.UniversalDeserializer().Decode(buf.Bytes(), nil, &unstructured.Unstructured{})
+	obj, _, err := extensionsapiserver.Codecs.UniversalDeserializer().Decode(buf.Bytes(), nil, &unstructured.Unstructured{})
 	if err != nil {
 		return fmt.Errorf("could not decode raw: %w", err)
 	}
@@ -136,6 +136,12 @@ func createResourceFromFS(ctx context.Context, client dynamic.Interface, mapper
 		return fmt.Errorf("decoded into incorrect type, got %T, wanted %T", obj, &unstructured.Unstructured{})
 	}
 
+	return CreateOrUpdateResource(ctx, client, mapper, u)
+}
+
+// CreateOrUpdateResource creates the object, or updates it if it already exists.
+func CreateOrUpdateResource(ctx context.Context, client dynamic.Interface, mapper meta.RESTMapper, u *unstructured.Unstructured) error {
+	gvk := u.GroupVersionKind()
 	m, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
 	if err != nil {
 		return fmt.Errorf("could not get REST mapping for %s: %w", gvk, err)
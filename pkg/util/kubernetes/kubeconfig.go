@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/ghodss/yaml"
 	"k8s.io/client-go/rest"
@@ -18,6 +19,22 @@ import (
 	"github.com/faroshq/faros-hub/pkg/util/validation"
 )
 
+// BearerToken returns the token of config, read from its token file if it has
+// one, so rotated tokens are picked up
+func BearerToken(config *rest.Config) (string, error) {
+	if config.BearerTokenFile != "" {
+		b, err := os.ReadFile(config.BearerTokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read token file: %w", err)
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+	if config.BearerToken != "" {
+		return config.BearerToken, nil
+	}
+	return "", fmt.Errorf("kubeconfig does not contain a token")
+}
+
 func GetRestConfigFromURL(url string) (*rest.Config, error) {
 	var data []byte
 	if validation.IsValidUrl(url) {
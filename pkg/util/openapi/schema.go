@@ -0,0 +1,174 @@
+package openapi
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+// SchemaRefPrefix is the prefix of references to schemas in components of
+// openapi v3 documents
+const SchemaRefPrefix = "#/components/schemas/"
+
+// Schemas builds openapi schemas of go types from their json encoding. Named
+// struct types are defined once and referenced by name.
+type Schemas struct {
+	definitions map[string]*spec.Schema
+}
+
+// NewSchemas returns empty Schemas
+func NewSchemas() *Schemas {
+	return &Schemas{definitions: map[string]*spec.Schema{}}
+}
+
+// Definitions returns the schemas of the named types referenced so far,
+// by name
+func (s *Schemas) Definitions() map[string]*spec.Schema {
+	return s.definitions
+}
+
+// For returns the schema of the type of obj, a reference for named structs
+func (s *Schemas) For(obj interface{}) *spec.Schema {
+	return s.schemaOf(reflect.TypeOf(obj))
+}
+
+// Name returns the name t is defined with, its type name prefixed with the
+// last two elements of its package path, i.e. tenancy.v1alpha1.Workspace
+func Name(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	elements := strings.Split(t.PkgPath(), "/")
+	if len(elements) > 2 {
+		elements = elements[len(elements)-2:]
+	}
+	return strings.Join(append(elements, t.Name()), ".")
+}
+
+// knownSchemas are schemas of types with custom json encodings
+var knownSchemas = map[reflect.Type]func() *spec.Schema{
+	reflect.TypeOf(time.Time{}):            dateTimeSchema,
+	reflect.TypeOf(metav1.Time{}):          dateTimeSchema,
+	reflect.TypeOf(metav1.MicroTime{}):     dateTimeSchema,
+	reflect.TypeOf(metav1.Duration{}):      stringSchema,
+	reflect.TypeOf(resource.Quantity{}):    intOrStringSchema,
+	reflect.TypeOf(intstr.IntOrString{}):   intOrStringSchema,
+	reflect.TypeOf(runtime.RawExtension{}): objectSchema,
+	reflect.TypeOf(metav1.FieldsV1{}):      objectSchema,
+}
+
+func (s *Schemas) schemaOf(t reflect.Type) *spec.Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if known, ok := knownSchemas[t]; ok {
+		return known()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.structSchema(t)
+		}
+		name := Name(t)
+		if _, ok := s.definitions[name]; !ok {
+			// defined before its fields, which may refer to it
+			s.definitions[name] = &spec.Schema{}
+			*s.definitions[name] = *s.structSchema(t)
+		}
+		return spec.RefSchema(SchemaRefPrefix + name)
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return spec.StrFmtProperty("byte")
+		}
+		return spec.ArrayProperty(s.schemaOf(t.Elem()))
+	case reflect.Map:
+		return spec.MapProperty(s.schemaOf(t.Elem()))
+	case reflect.String:
+		return stringSchema()
+	case reflect.Bool:
+		return spec.BoolProperty()
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return spec.Int32Property()
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return spec.Int64Property()
+	case reflect.Float32:
+		return spec.Float32Property()
+	case reflect.Float64:
+		return spec.Float64Property()
+	default:
+		return &spec.Schema{}
+	}
+}
+
+// structSchema returns the object schema of the json fields of struct t.
+// Fields without omitempty are required.
+func (s *Schemas) structSchema(t reflect.Type) *spec.Schema {
+	schema := &spec.Schema{}
+	schema.Typed("object", "")
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		// embedded structs without a name are inlined
+		if field.Anonymous && name == "" || strings.Contains(options, "inline") {
+			embedded := s.structSchema(indirect(field.Type))
+			for property, value := range embedded.Properties {
+				schema.SetProperty(property, value)
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		schema.SetProperty(name, *s.schemaOf(field.Type))
+		if !strings.Contains(options, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+func stringSchema() *spec.Schema {
+	return spec.StringProperty()
+}
+
+func dateTimeSchema() *spec.Schema {
+	return spec.DateTimeProperty()
+}
+
+func intOrStringSchema() *spec.Schema {
+	schema := &spec.Schema{}
+	schema.AddExtension("x-kubernetes-int-or-string", true)
+	return schema
+}
+
+func objectSchema() *spec.Schema {
+	schema := &spec.Schema{}
+	schema.Typed("object", "")
+	schema.AddExtension("x-kubernetes-preserve-unknown-fields", true)
+	return schema
+}
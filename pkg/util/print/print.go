@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"reflect"
 	"strings"
@@ -13,7 +14,12 @@ import (
 )
 
 func DefaultTable() *tablewriter.Table {
-	table := tablewriter.NewWriter(os.Stdout)
+	return NewTable(os.Stdout)
+}
+
+// NewTable returns a table in the default style writing to out
+func NewTable(out io.Writer) *tablewriter.Table {
+	table := tablewriter.NewWriter(out)
 	table.SetAutoWrapText(false)
 	table.SetAutoFormatHeaders(true)
 	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
@@ -30,6 +36,11 @@ func DefaultTable() *tablewriter.Table {
 }
 
 func PrintWithFormat(obj interface{}, format string) error {
+	return FprintWithFormat(os.Stdout, obj, format)
+}
+
+// FprintWithFormat prints obj to w as json, a json stream or yaml
+func FprintWithFormat(w io.Writer, obj interface{}, format string) error {
 	switch format {
 	case FormatJSONStream:
 		if reflect.TypeOf(obj).Kind() != reflect.Slice {
@@ -43,7 +54,7 @@ func PrintWithFormat(obj interface{}, format string) error {
 			if err != nil {
 				return err
 			}
-			fmt.Println(string(bytes))
+			fmt.Fprintln(w, string(bytes))
 		}
 		return nil
 
@@ -52,7 +63,7 @@ func PrintWithFormat(obj interface{}, format string) error {
 		if err != nil {
 			return err
 		}
-		fmt.Println(string(bytes))
+		fmt.Fprintln(w, string(bytes))
 		return nil
 
 	case FormatYAML:
@@ -60,7 +71,7 @@ func PrintWithFormat(obj interface{}, format string) error {
 		if err != nil {
 			return err
 		}
-		fmt.Println(string(bytes))
+		fmt.Fprintln(w, string(bytes))
 		return nil
 	default:
 		return fmt.Errorf("format (%s) not supported", format)
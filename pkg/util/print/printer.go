@@ -0,0 +1,420 @@
+package print
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Column is a column of the table printed for a type
+type Column struct {
+	// Name is the header of the column
+	Name string
+	// Wide columns are printed with -o wide only
+	Wide bool
+	// Value returns the value of the column for an object
+	Value func(obj runtime.Object) string
+}
+
+// NameColumn prints the name of objects
+var NameColumn = Column{
+	Name: "NAME",
+	Value: func(obj runtime.Object) string {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return ""
+		}
+		return accessor.GetName()
+	},
+}
+
+// AgeColumn prints the age of objects
+var AgeColumn = Column{
+	Name: "AGE",
+	Value: func(obj runtime.Object) string {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return ""
+		}
+		return Since(accessor.GetCreationTimestamp().Time).String()
+	},
+}
+
+// ConditionColumn prints the status of condition t of objects, Unknown if
+// they have no such condition yet
+func ConditionColumn(name string, t conditionsv1alpha1.ConditionType) Column {
+	return Column{
+		Name: name,
+		Value: func(obj runtime.Object) string {
+			getter, ok := obj.(conditions.Getter)
+			if !ok {
+				return ""
+			}
+			return ConditionStatus(getter, t)
+		},
+	}
+}
+
+// ConditionReasonColumn prints the reason of condition t of objects
+func ConditionReasonColumn(name string, t conditionsv1alpha1.ConditionType) Column {
+	return conditionFieldColumn(name, t, func(c *conditionsv1alpha1.Condition) string { return c.Reason })
+}
+
+// ConditionMessageColumn prints the message of condition t of objects
+func ConditionMessageColumn(name string, t conditionsv1alpha1.ConditionType) Column {
+	return conditionFieldColumn(name, t, func(c *conditionsv1alpha1.Condition) string { return c.Message })
+}
+
+func conditionFieldColumn(name string, t conditionsv1alpha1.ConditionType, field func(c *conditionsv1alpha1.Condition) string) Column {
+	return Column{
+		Name: name,
+		Value: func(obj runtime.Object) string {
+			getter, ok := obj.(conditions.Getter)
+			if !ok {
+				return ""
+			}
+			if c := conditions.Get(getter, t); c != nil {
+				return field(c)
+			}
+			return ""
+		},
+	}
+}
+
+// Wide returns column printed with -o wide only
+func Wide(column Column) Column {
+	column.Wide = true
+	return column
+}
+
+// ConditionStatus returns the status of condition t of obj, Unknown if it has
+// no such condition yet
+func ConditionStatus(obj conditions.Getter, t conditionsv1alpha1.ConditionType) string {
+	c := conditions.Get(obj, t)
+	if c == nil {
+		return string(metav1.ConditionUnknown)
+	}
+	return string(c.Status)
+}
+
+// ValueOrNone returns s, or <none> if s is empty
+func ValueOrNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
+
+// Printer prints objects in the format selected with -o: a table built from
+// Columns, names, custom columns, a jsonpath template, json or yaml
+type Printer struct {
+	Out io.Writer
+	// Format is the output format, i.e. table, wide or custom-columns=<spec>
+	Format string
+	// Resource is the qualified resource printed with -o name, i.e.
+	// agent.edge.faros.sh
+	Resource string
+	// Columns of the table
+	Columns []Column
+	// NoHeaders omits headers of tables
+	NoHeaders bool
+	// SortBy is a jsonpath expression items are sorted by
+	SortBy string
+}
+
+// ValidateFormat returns an error if format is not a supported output format
+func ValidateFormat(format string) error {
+	switch {
+	case format == FormatTable, format == FormatWide, format == FormatName,
+		format == FormatJSON, format == FormatJSONStream, format == FormatYAML:
+		return nil
+	case strings.HasPrefix(format, FormatCustomColumns+"="):
+		_, err := parseCustomColumns(strings.TrimPrefix(format, FormatCustomColumns+"="))
+		return err
+	case strings.HasPrefix(format, FormatJSONPath+"="):
+		_, err := parseJSONPath(strings.TrimPrefix(format, FormatJSONPath+"="))
+		return err
+	default:
+		return fmt.Errorf("invalid output format: %s, supported formats are %s", format,
+			strings.Join([]string{FormatTable, FormatWide, FormatName, FormatJSON, FormatYAML, FormatCustomColumns + "=", FormatJSONPath + "="}, ","))
+	}
+}
+
+// Print prints obj, a single object or a list of them
+func (p *Printer) Print(obj runtime.Object) error {
+	items := []runtime.Object{obj}
+	isList := meta.IsListType(obj)
+	if isList {
+		var err error
+		if items, err = meta.ExtractList(obj); err != nil {
+			return err
+		}
+	}
+
+	if p.SortBy != "" {
+		if err := sortObjects(items, p.SortBy); err != nil {
+			return err
+		}
+		if isList {
+			if err := meta.SetList(obj, items); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch {
+	case p.Format == "" || p.Format == FormatTable:
+		return p.printTable(items, p.columns(false))
+	case p.Format == FormatWide:
+		return p.printTable(items, p.columns(true))
+	case p.Format == FormatName:
+		for _, item := range items {
+			accessor, err := meta.Accessor(item)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(p.Out, "%s/%s\n", p.Resource, accessor.GetName())
+		}
+		return nil
+	case strings.HasPrefix(p.Format, FormatCustomColumns+"="):
+		columns, err := parseCustomColumns(strings.TrimPrefix(p.Format, FormatCustomColumns+"="))
+		if err != nil {
+			return err
+		}
+		return p.printTable(items, columns)
+	case strings.HasPrefix(p.Format, FormatJSONPath+"="):
+		j, err := parseJSONPath(strings.TrimPrefix(p.Format, FormatJSONPath+"="))
+		if err != nil {
+			return err
+		}
+		data, err := toUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		if err := j.Execute(p.Out, data); err != nil {
+			return err
+		}
+		fmt.Fprintln(p.Out)
+		return nil
+	case p.Format == FormatJSONStream:
+		return FprintWithFormat(p.Out, items, p.Format)
+	default:
+		return FprintWithFormat(p.Out, obj, p.Format)
+	}
+}
+
+// PrintWatch prints objects of watch events until the watch ends or ctx is
+// done. Tables are printed without headers, continuing a printed list.
+func (p *Printer) PrintWatch(ctx context.Context, w watch.Interface) error {
+	defer w.Stop()
+
+	continued := *p
+	continued.NoHeaders = true
+	continued.SortBy = ""
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			if event.Type == watch.Error {
+				return apierrors.FromObject(event.Object)
+			}
+			if event.Type == watch.Bookmark {
+				continue
+			}
+			if err := continued.Print(event.Object); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// columns returns the columns of the table, including wide columns if wide
+func (p *Printer) columns(wide bool) []Column {
+	var columns []Column
+	for _, column := range p.Columns {
+		if !column.Wide || wide {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+func (p *Printer) printTable(items []runtime.Object, columns []Column) error {
+	table := NewTable(p.Out)
+	if !p.NoHeaders {
+		var headers []string
+		for _, column := range columns {
+			headers = append(headers, column.Name)
+		}
+		table.SetHeader(headers)
+	}
+
+	for _, item := range items {
+		var row []string
+		for _, column := range columns {
+			row = append(row, column.Value(item))
+		}
+		table.Append(row)
+	}
+	table.Render()
+	return nil
+}
+
+// parseCustomColumns parses a custom-columns spec, i.e.
+// NAME:.metadata.name,READY:.status.conditions[?(@.type=="Ready")].status
+func parseCustomColumns(spec string) ([]Column, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("custom-columns format requires a spec, i.e. custom-columns=NAME:.metadata.name")
+	}
+
+	var columns []Column
+	for _, part := range splitColumns(spec) {
+		name, expression, ok := strings.Cut(part, ":")
+		if !ok || name == "" || expression == "" {
+			return nil, fmt.Errorf("invalid custom column %q, expected <header>:<jsonpath>", part)
+		}
+		j, err := parseJSONPath(expression)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, Column{
+			Name: name,
+			Value: func(obj runtime.Object) string {
+				value, err := evaluate(j, obj)
+				if err != nil || value == "" {
+					return "<none>"
+				}
+				return value
+			},
+		})
+	}
+	return columns, nil
+}
+
+// splitColumns splits a custom-columns spec on the commas separating its
+// columns, leaving the ones inside {...} templates and their quoted strings,
+// i.e. {.metadata.name}{","}{.metadata.namespace}
+func splitColumns(spec string) []string {
+	var parts []string
+	depth, start := 0, 0
+	var quote rune
+	for i, c := range spec {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case depth > 0 && (c == '"' || c == '\''):
+			quote = c
+		case c == '{':
+			depth++
+		case c == '}' && depth > 0:
+			depth--
+		case c == ',' && depth == 0:
+			parts = append(parts, spec[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, spec[start:])
+}
+
+// parseJSONPath parses a jsonpath expression, braces around it are optional
+func parseJSONPath(expression string) (*jsonpath.JSONPath, error) {
+	if !strings.Contains(expression, "{") {
+		if !strings.HasPrefix(expression, ".") {
+			expression = "." + expression
+		}
+		expression = "{" + expression + "}"
+	}
+
+	j := jsonpath.New("output").AllowMissingKeys(true)
+	if err := j.Parse(expression); err != nil {
+		return nil, fmt.Errorf("invalid jsonpath %q: %w", expression, err)
+	}
+	return j, nil
+}
+
+func evaluate(j *jsonpath.JSONPath, obj runtime.Object) (string, error) {
+	data, err := toUnstructured(obj)
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	if err := j.Execute(&out, data); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// sortObjects sorts items by the value of the jsonpath expression, numerically
+// if all values are numbers
+func sortObjects(items []runtime.Object, expression string) error {
+	j, err := parseJSONPath(expression)
+	if err != nil {
+		return err
+	}
+
+	values := make([]string, len(items))
+	numeric := true
+	for i, item := range items {
+		if values[i], err = evaluate(j, item); err != nil {
+			return err
+		}
+		if _, err := strconv.ParseFloat(values[i], 64); err != nil {
+			numeric = false
+		}
+	}
+
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		va, vb := values[indexes[a]], values[indexes[b]]
+		if numeric {
+			fa, _ := strconv.ParseFloat(va, 64)
+			fb, _ := strconv.ParseFloat(vb, 64)
+			return fa < fb
+		}
+		return va < vb
+	})
+
+	sorted := make([]runtime.Object, len(items))
+	for i, index := range indexes {
+		sorted[i] = items[index]
+	}
+	copy(items, sorted)
+	return nil
+}
+
+// toUnstructured converts obj into its json representation, as evaluated by
+// jsonpath expressions
+func toUnstructured(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,155 @@
+package print
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+func TestPrinter(t *testing.T) {
+	columns := []Column{
+		NameColumn,
+		ConditionColumn("READY", conditionsv1alpha1.ReadyCondition),
+		Wide(Column{Name: "URL", Value: func(obj runtime.Object) string {
+			return ValueOrNone(obj.(*tenancyv1alpha1.Workspace).Status.WorkspaceURL)
+		}}),
+	}
+
+	for _, tt := range []struct {
+		name      string
+		format    string
+		noHeaders bool
+		sortBy    string
+		expected  string
+	}{
+		{
+			name:     "table handles missing conditions",
+			format:   FormatTable,
+			expected: "NAME READY\nb    True\na    Unknown\n",
+		},
+		{
+			name:     "wide",
+			format:   FormatWide,
+			expected: "NAME READY   URL\nb    True    https://kcp/clusters/b\na    Unknown <none>\n",
+		},
+		{
+			name:      "no headers sorted by name",
+			format:    FormatTable,
+			noHeaders: true,
+			sortBy:    "{.metadata.name}",
+			expected:  "a Unknown\nb True\n",
+		},
+		{
+			name:     "sort by number",
+			format:   FormatName,
+			sortBy:   ".metadata.generation",
+			expected: "workspace.tenancy.faros.sh/a\nworkspace.tenancy.faros.sh/b\n",
+		},
+		{
+			name:     "custom columns",
+			format:   "custom-columns=WORKSPACE:.metadata.name,DESCRIPTION:.spec.description",
+			expected: "WORKSPACE DESCRIPTION\nb         <none>\na         first\n",
+		},
+		{
+			name:     "custom columns with commas in templates",
+			format:   `custom-columns=WORKSPACE:{.metadata.name}{","}{.metadata.generation},DESCRIPTION:{.spec.description}{"}"}`,
+			expected: "WORKSPACE DESCRIPTION\nb,10      }\na,9       first}\n",
+		},
+		{
+			name:     "jsonpath",
+			format:   `jsonpath={range .items[*]}{.metadata.name}{" "}{end}`,
+			expected: "b a \n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateFormat(tt.format); err != nil {
+				t.Fatal(err)
+			}
+
+			var out bytes.Buffer
+			p := &Printer{
+				Out:       &out,
+				Format:    tt.format,
+				Resource:  "workspace.tenancy.faros.sh",
+				Columns:   columns,
+				NoHeaders: tt.noHeaders,
+				SortBy:    tt.sortBy,
+			}
+			if err := p.Print(workspaces()); err != nil {
+				t.Fatal(err)
+			}
+
+			// tables pad columns with trailing spaces
+			if got := trimLines(out.String()); got != trimLines(tt.expected) {
+				t.Errorf("unexpected output:\n%s\nexpected:\n%s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateFormat(t *testing.T) {
+	for _, format := range []string{"xml", "custom-columns=", "custom-columns=NAME", "jsonpath={.metadata.name"} {
+		if err := ValidateFormat(format); err == nil {
+			t.Errorf("expected error for format %q", format)
+		}
+	}
+}
+
+func TestPrintWatch(t *testing.T) {
+	w := watch.NewFake()
+	go func() {
+		items := workspaces().Items
+		w.Add(&items[0])
+		w.Action(watch.Bookmark, &tenancyv1alpha1.Workspace{})
+		w.Modify(&items[1])
+		w.Stop()
+	}()
+
+	var out bytes.Buffer
+	p := &Printer{Out: &out, Format: FormatTable, Columns: []Column{NameColumn}, SortBy: ".metadata.name"}
+	if err := p.PrintWatch(context.Background(), w); err != nil {
+		t.Fatal(err)
+	}
+	if got := trimLines(out.String()); got != "b\na\n" {
+		t.Errorf("unexpected output:\n%s", got)
+	}
+}
+
+func workspaces() *tenancyv1alpha1.WorkspaceList {
+	created := metav1.NewTime(time.Now())
+	return &tenancyv1alpha1.WorkspaceList{
+		Items: []tenancyv1alpha1.Workspace{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "b", Generation: 10, CreationTimestamp: created},
+				Status: tenancyv1alpha1.WorkspaceStatus{
+					WorkspaceURL: "https://kcp/clusters/b",
+					Conditions: conditionsv1alpha1.Conditions{
+						{Type: conditionsv1alpha1.ReadyCondition, Status: corev1.ConditionTrue},
+					},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "a", Generation: 9, CreationTimestamp: created},
+				Spec:       tenancyv1alpha1.WorkspaceSpec{Description: "first"},
+			},
+		},
+	}
+}
+
+func trimLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " ")
+	}
+	return strings.Join(lines, "\n")
+}
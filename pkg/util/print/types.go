@@ -1,8 +1,12 @@
 package print
 
 const (
-	FormatTable      string = "table"
-	FormatJSON       string = "json"
-	FormatJSONStream string = "json-stream"
-	FormatYAML       string = "yaml"
+	FormatTable         string = "table"
+	FormatWide          string = "wide"
+	FormatName          string = "name"
+	FormatJSON          string = "json"
+	FormatJSONStream    string = "json-stream"
+	FormatYAML          string = "yaml"
+	FormatCustomColumns string = "custom-columns"
+	FormatJSONPath      string = "jsonpath"
 )
@@ -0,0 +1,104 @@
+package quota
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/config"
+)
+
+const (
+	// ResourceQuotaName is the name of the quota created in workspaces to
+	// enforce per workspace limits
+	ResourceQuotaName = "faros-limits"
+	// ResourceQuotaNamespace is the namespace of the quota. The quota is
+	// cluster scoped, so it counts objects in all namespaces of the workspace.
+	ResourceQuotaNamespace = metav1.NamespaceDefault
+
+	// ResourceAgents is the number of agents in a workspace
+	ResourceAgents corev1.ResourceName = "count/agents.edge.faros.sh"
+	// ResourceRequests is the number of access requests in a workspace
+	ResourceRequests corev1.ResourceName = "count/requests.access.faros.sh"
+
+	// ClusterScopedAnnotation makes kcp count objects of the whole workspace
+	// against a quota
+	ClusterScopedAnnotation = "experimental.quota.kcp.dev/cluster-scoped"
+)
+
+// Limits returns the effective limits of user, its own limits with unset ones
+// defaulted from the hub configuration
+func Limits(user *tenancyv1alpha1.User, defaults config.LimitsConfig) tenancyv1alpha1.UserLimits {
+	limits := tenancyv1alpha1.UserLimits{
+		Workspaces:           pointer.Int32(defaults.WorkspacesLimit),
+		AgentsPerWorkspace:   pointer.Int32(defaults.AgentsPerWorkspaceLimit),
+		RequestsPerWorkspace: pointer.Int32(defaults.RequestsPerWorkspaceLimit),
+	}
+	if user == nil || user.Spec.Limits == nil {
+		return limits
+	}
+	if user.Spec.Limits.Workspaces != nil {
+		limits.Workspaces = pointer.Int32(*user.Spec.Limits.Workspaces)
+	}
+	if user.Spec.Limits.AgentsPerWorkspace != nil {
+		limits.AgentsPerWorkspace = pointer.Int32(*user.Spec.Limits.AgentsPerWorkspace)
+	}
+	if user.Spec.Limits.RequestsPerWorkspace != nil {
+		limits.RequestsPerWorkspace = pointer.Int32(*user.Spec.Limits.RequestsPerWorkspace)
+	}
+	return limits
+}
+
+// Exceeded returns whether creating one more object when used exist exceeds
+// limit. Unset and 0 limits are unlimited.
+func Exceeded(limit *int32, used int32) bool {
+	return limit != nil && *limit > 0 && used >= *limit
+}
+
+// String returns limit for printing
+func String(limit *int32) string {
+	if limit == nil || *limit <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprint(*limit)
+}
+
+// ResourceQuota returns the quota enforcing per workspace limits in a
+// workspace
+func ResourceQuota(limits tenancyv1alpha1.UserLimits) *corev1.ResourceQuota {
+	hard := corev1.ResourceList{}
+	if limits.AgentsPerWorkspace != nil && *limits.AgentsPerWorkspace > 0 {
+		hard[ResourceAgents] = *resource.NewQuantity(int64(*limits.AgentsPerWorkspace), resource.DecimalSI)
+	}
+	if limits.RequestsPerWorkspace != nil && *limits.RequestsPerWorkspace > 0 {
+		hard[ResourceRequests] = *resource.NewQuantity(int64(*limits.RequestsPerWorkspace), resource.DecimalSI)
+	}
+
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ResourceQuotaName,
+			Namespace: ResourceQuotaNamespace,
+			Annotations: map[string]string{
+				ClusterScopedAnnotation: "true",
+			},
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: hard,
+		},
+	}
+}
+
+// DrainedResourceQuota returns the quota blocking new agents and requests in a
+// terminating workspace
+func DrainedResourceQuota() *corev1.ResourceQuota {
+	resourceQuota := ResourceQuota(tenancyv1alpha1.UserLimits{})
+	resourceQuota.Spec.Hard = corev1.ResourceList{
+		ResourceAgents:   *resource.NewQuantity(0, resource.DecimalSI),
+		ResourceRequests: *resource.NewQuantity(0, resource.DecimalSI),
+	}
+	return resourceQuota
+}
@@ -0,0 +1,59 @@
+package quota
+
+import (
+	"testing"
+
+	"k8s.io/utils/pointer"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+	"github.com/faroshq/faros-hub/pkg/config"
+)
+
+func TestLimits(t *testing.T) {
+	defaults := config.LimitsConfig{
+		WorkspacesLimit:           10,
+		AgentsPerWorkspaceLimit:   50,
+		RequestsPerWorkspaceLimit: 20,
+	}
+
+	limits := Limits(nil, defaults)
+	if *limits.Workspaces != 10 || *limits.AgentsPerWorkspace != 50 || *limits.RequestsPerWorkspace != 20 {
+		t.Errorf("expected defaults, got %s/%s/%s", String(limits.Workspaces), String(limits.AgentsPerWorkspace), String(limits.RequestsPerWorkspace))
+	}
+
+	user := &tenancyv1alpha1.User{
+		Spec: tenancyv1alpha1.UserSpec{
+			Limits: &tenancyv1alpha1.UserLimits{
+				Workspaces:         pointer.Int32(2),
+				AgentsPerWorkspace: pointer.Int32(0),
+			},
+		},
+	}
+	limits = Limits(user, defaults)
+	if *limits.Workspaces != 2 || *limits.AgentsPerWorkspace != 0 || *limits.RequestsPerWorkspace != 20 {
+		t.Errorf("expected user limits over defaults, got %s/%s/%s", String(limits.Workspaces), String(limits.AgentsPerWorkspace), String(limits.RequestsPerWorkspace))
+	}
+
+	*user.Spec.Limits.Workspaces = 3
+	if *limits.Workspaces != 2 {
+		t.Error("expected limits not to share pointers with the user")
+	}
+
+	if !Exceeded(limits.Workspaces, 2) || Exceeded(limits.Workspaces, 1) {
+		t.Error("expected 2 workspaces to exceed the limit of 2 only")
+	}
+	if Exceeded(limits.AgentsPerWorkspace, 1000) || Exceeded(nil, 1000) {
+		t.Error("expected 0 and unset limits to be unlimited")
+	}
+
+	quota := ResourceQuota(limits)
+	if _, ok := quota.Spec.Hard[ResourceAgents]; ok {
+		t.Error("expected unlimited agents not to be limited by the quota")
+	}
+	if hard := quota.Spec.Hard[ResourceRequests]; hard.Value() != 20 {
+		t.Errorf("expected quota of 20 requests, got %s", hard.String())
+	}
+	if quota.Annotations[ClusterScopedAnnotation] != "true" {
+		t.Error("expected quota to count objects of all namespaces")
+	}
+}
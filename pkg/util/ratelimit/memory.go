@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often full buckets are removed from memory stores
+const sweepInterval = time.Minute
+
+// bucket is a token bucket. Tokens are not stored, but derived from the time
+// the bucket is full at.
+type bucket struct {
+	// full is when the bucket has all its tokens again
+	full time.Time
+}
+
+// MemoryStore keeps token buckets in memory, limiting requests to a single
+// replica of the hub api
+type MemoryStore struct {
+	lock      sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+
+	// now returns the current time, replaced in tests
+	now func() time.Time
+}
+
+var _ Store = &MemoryStore{}
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// Take takes a token from the bucket of key
+func (s *MemoryStore) Take(ctx context.Context, key string, limit Limit) (time.Duration, error) {
+	if limit.Unlimited() {
+		return 0, nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	b := s.buckets[key]
+	if b == nil || b.full.Before(now) {
+		b = &bucket{full: now}
+		s.buckets[key] = b
+	}
+
+	// the bucket is empty when it is full only after a whole period
+	full := b.full.Add(limit.interval())
+	if wait := full.Sub(now) - limit.Period; wait > 0 {
+		return wait, nil
+	}
+	b.full = full
+	return 0, nil
+}
+
+// Wait returns how long to wait for the bucket of key to have a token
+func (s *MemoryStore) Wait(ctx context.Context, key string, limit Limit) (time.Duration, error) {
+	if limit.Unlimited() {
+		return 0, nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	b := s.buckets[key]
+	if b == nil {
+		return 0, nil
+	}
+	if wait := b.full.Add(limit.interval()).Sub(s.now()) - limit.Period; wait > 0 {
+		return wait, nil
+	}
+	return 0, nil
+}
+
+// sweep removes full buckets, which are the same as missing ones
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if b.full.Before(now) {
+			delete(s.buckets, key)
+		}
+	}
+}
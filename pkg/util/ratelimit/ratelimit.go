@@ -0,0 +1,67 @@
+// Package ratelimit limits requests with token buckets kept in a Store, in
+// memory by default.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit allows Requests per Period, in bursts of up to Requests. The zero
+// Limit is unlimited.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseLimit parses limits as <requests>/<period>, i.e. 100/1m. Empty limits
+// are unlimited.
+func ParseLimit(s string) (Limit, error) {
+	if s == "" {
+		return Limit{}, nil
+	}
+	requests, period, ok := strings.Cut(s, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid limit %q, expected <requests>/<period>", s)
+	}
+	n, err := strconv.Atoi(requests)
+	if err != nil || n <= 0 {
+		return Limit{}, fmt.Errorf("invalid limit %q, requests must be a positive number", s)
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("invalid limit %q, period must be a positive duration", s)
+	}
+	return Limit{Requests: n, Period: d}, nil
+}
+
+// Unlimited returns whether l allows any number of requests
+func (l Limit) Unlimited() bool {
+	return l.Requests == 0
+}
+
+func (l Limit) String() string {
+	if l.Unlimited() {
+		return ""
+	}
+	return fmt.Sprintf("%d/%s", l.Requests, l.Period)
+}
+
+// interval is how long the bucket of l takes to refill one token
+func (l Limit) interval() time.Duration {
+	return l.Period / time.Duration(l.Requests)
+}
+
+// Store keeps the token buckets of keys. Implementations shared by replicas
+// of the hub api can replace the in-memory one.
+type Store interface {
+	// Take takes a token from the bucket of key. If the bucket is empty no
+	// token is taken, and it returns how long to wait for one.
+	Take(ctx context.Context, key string, limit Limit) (time.Duration, error)
+	// Wait returns how long to wait for the bucket of key to have a token,
+	// without taking it
+	Wait(ctx context.Context, key string, limit Limit) (time.Duration, error)
+}
@@ -0,0 +1,74 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestParseLimit(t *testing.T) {
+	for _, test := range []struct {
+		limit   string
+		want    Limit
+		wantErr bool
+	}{
+		{limit: "", want: Limit{}},
+		{limit: "100/1m", want: Limit{Requests: 100, Period: time.Minute}},
+		{limit: "5/10s", want: Limit{Requests: 5, Period: 10 * time.Second}},
+		{limit: "100", wantErr: true},
+		{limit: "0/1m", wantErr: true},
+		{limit: "10/forever", wantErr: true},
+	} {
+		got, err := ParseLimit(test.limit)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%q: unexpected error %v", test.limit, err)
+		}
+		if got != test.want {
+			t.Errorf("%q: expected %v, got %v", test.limit, test.want, got)
+		}
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	limit := Limit{Requests: 3, Period: 3 * time.Second}
+
+	// the burst is the whole limit
+	for i := 0; i < 3; i++ {
+		if wait, _ := store.Take(ctx, "jane", limit); wait != 0 {
+			t.Fatalf("request %d: expected to be allowed, got wait %s", i, wait)
+		}
+	}
+	if wait, _ := store.Take(ctx, "jane", limit); wait != time.Second {
+		t.Errorf("expected to wait 1s for a token, got %s", wait)
+	}
+	if wait, _ := store.Wait(ctx, "jane", limit); wait != time.Second {
+		t.Errorf("expected to wait 1s without taking a token, got %s", wait)
+	}
+	if wait, _ := store.Take(ctx, "john", limit); wait != 0 {
+		t.Errorf("expected buckets of keys to be separate, got wait %s", wait)
+	}
+
+	// tokens refill one per interval
+	now = now.Add(time.Second)
+	if wait, _ := store.Take(ctx, "jane", limit); wait != 0 {
+		t.Errorf("expected a token to refill, got wait %s", wait)
+	}
+	if wait, _ := store.Take(ctx, "jane", limit); wait != time.Second {
+		t.Errorf("expected to wait 1s for the next token, got %s", wait)
+	}
+
+	// full buckets are swept
+	now = now.Add(sweepInterval)
+	store.Take(ctx, "john", limit) //nolint:errcheck
+	if len(store.buckets) != 1 {
+		t.Errorf("expected full buckets to be swept, got %d buckets", len(store.buckets))
+	}
+
+	if wait, _ := store.Take(ctx, "jane", Limit{}); wait != 0 {
+		t.Errorf("expected unlimited requests to be allowed, got wait %s", wait)
+	}
+}
@@ -0,0 +1,108 @@
+// Package remoteshell implements the wire format of remote shell sessions.
+//
+// Websocket clients exchange binary messages prefixed by a channel byte. The
+// hub relays them to the agent over the tunnel as length prefixed frames,
+// since the tunnel stream does not preserve message boundaries.
+package remoteshell
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const (
+	// StdinChannel carries input of the command
+	StdinChannel byte = iota
+	// StdoutChannel carries output of the command, and all output with a TTY
+	StdoutChannel
+	// StderrChannel carries error output of the command without a TTY
+	StderrChannel
+	// StatusChannel carries the JSON encoded Status once the command exits
+	StatusChannel
+	// ResizeChannel carries the JSON encoded TerminalSize of the client
+	ResizeChannel
+)
+
+// maxFrameSize bounds frames read from the tunnel
+const maxFrameSize = 1 << 20
+
+// TerminalSize is the size of the client terminal
+type TerminalSize struct {
+	Width  uint16 `json:"width"`
+	Height uint16 `json:"height"`
+}
+
+// Status is sent when the command exits or the session is terminated
+type Status struct {
+	// ExitCode of the command
+	ExitCode int `json:"exitCode"`
+	// Message describes why the session ended, if not by exit of the command
+	Message string `json:"message,omitempty"`
+}
+
+// WriteFrame writes a frame for channel
+func WriteFrame(w io.Writer, channel byte, payload []byte) error {
+	hdr := make([]byte, 5, 5+len(payload))
+	hdr[0] = channel
+	binary.BigEndian.PutUint32(hdr[1:], uint32(len(payload)))
+	_, err := w.Write(append(hdr, payload...))
+	return err
+}
+
+// ReadFrame reads the next frame
+func ReadFrame(r io.Reader) (channel byte, payload []byte, err error) {
+	var hdr [5]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return 0, nil, err
+	}
+	n := binary.BigEndian.Uint32(hdr[1:])
+	if n > maxFrameSize {
+		return 0, nil, fmt.Errorf("remoteshell: frame of %d bytes exceeds limit", n)
+	}
+	payload = make([]byte, n)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, err
+	}
+	return hdr[0], payload, nil
+}
+
+// FrameWriter serializes frames written from multiple goroutines
+type FrameWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewFrameWriter returns a FrameWriter writing to w
+func NewFrameWriter(w io.Writer) *FrameWriter {
+	return &FrameWriter{w: w}
+}
+
+// WriteFrame writes a frame for channel
+func (f *FrameWriter) WriteFrame(channel byte, payload []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return WriteFrame(f.w, channel, payload)
+}
+
+// ChannelWriter returns an io.Writer writing frames for channel
+func (f *FrameWriter) ChannelWriter(channel byte) io.Writer {
+	return channelWriter{f: f, channel: channel}
+}
+
+type channelWriter struct {
+	f       *FrameWriter
+	channel byte
+}
+
+func (c channelWriter) Write(p []byte) (int, error) {
+	if err := c.f.WriteFrame(c.channel, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
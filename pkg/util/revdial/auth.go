@@ -0,0 +1,100 @@
+package revdial
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrUnauthorized is returned when the caller did not present valid credentials
+	ErrUnauthorized = errors.New("revdial: unauthorized")
+	// ErrForbidden is returned when the caller is authenticated but does not own the id
+	ErrForbidden = errors.New("revdial: forbidden")
+)
+
+// Authenticator authenticates clients of the ReversePool
+type Authenticator interface {
+	// Authenticate returns the identity of the caller if it is allowed to use
+	// reverse connections for id. It returns ErrUnauthorized or ErrForbidden
+	// when it is not.
+	Authenticate(r *http.Request, id string) (owner string, err error)
+}
+
+// AuthenticatorFunc is an adapter to allow the use of ordinary functions as
+// Authenticator
+type AuthenticatorFunc func(r *http.Request, id string) (string, error)
+
+// Authenticate calls f(r, id)
+func (f AuthenticatorFunc) Authenticate(r *http.Request, id string) (string, error) {
+	return f(r, id)
+}
+
+// ClientCertAuthenticator authenticates callers by the common name of their
+// TLS client certificate, which must match the id. The server must be
+// configured to request client certificates and verify them against a
+// trusted CA.
+func ClientCertAuthenticator() Authenticator {
+	return AuthenticatorFunc(func(r *http.Request, id string) (string, error) {
+		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+			return "", ErrUnauthorized
+		}
+		cn := r.TLS.PeerCertificates[0].Subject.CommonName
+		if cn != id {
+			return "", ErrForbidden
+		}
+		return cn, nil
+	})
+}
+
+// TokenValidator validates a bearer token for id and returns the identity it
+// belongs to
+type TokenValidator func(ctx context.Context, id, token string) (owner string, err error)
+
+// BearerTokenAuthenticator authenticates callers by the bearer token in the
+// Authorization header
+func BearerTokenAuthenticator(validate TokenValidator) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request, id string) (string, error) {
+		authorization := r.Header.Get("Authorization")
+		if !strings.HasPrefix(authorization, "Bearer ") {
+			return "", ErrUnauthorized
+		}
+		token := strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+		if token == "" {
+			return "", ErrUnauthorized
+		}
+		return validate(r.Context(), id, token)
+	})
+}
+
+// AnyAuthenticator returns an Authenticator which accepts the caller if any
+// of the given authenticators does. A forbidden result takes precedence over
+// an unauthorized one, so callers get the most specific error back.
+func AnyAuthenticator(authenticators ...Authenticator) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request, id string) (string, error) {
+		err := ErrUnauthorized
+		for _, a := range authenticators {
+			owner, aerr := a.Authenticate(r, id)
+			if aerr == nil {
+				return owner, nil
+			}
+			if errors.Is(aerr, ErrForbidden) || !errors.Is(err, ErrForbidden) {
+				err = aerr
+			}
+		}
+		return "", err
+	})
+}
+
+// authStatus maps authentication errors to http status codes
+func authStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrUnauthorized):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrForbidden):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/klog/v2"
@@ -28,6 +29,21 @@ type Dialer struct {
 	donec        chan struct{}
 	closeOnce    sync.Once
 	revClient    *http.Client
+
+	// session is set for dialers multiplexing all connections over the
+	// control plane connection
+	session *Session
+
+	// sessionKey is shared with the listener of multiplexed dialers only
+	sessionKey string
+
+	// owner is the authenticated identity which established the dialer
+	owner      string
+	remoteAddr string
+	created    time.Time
+	// active and total count the reverse connections of legacy dialers
+	active int64
+	total  int64
 }
 
 // NewDialer returns the side of the connection which will initiate
@@ -40,11 +56,29 @@ func NewDialer(id string, conn net.Conn) *Dialer {
 		connReady:    make(chan bool),
 		pickupFailed: make(chan error),
 		incomingConn: make(chan net.Conn),
+		created:      time.Now(),
 	}
 	go d.serve()
 	return d
 }
 
+// NewMuxDialer returns a Dialer which opens new connections as streams
+// multiplexed over the already established reverse connection, without a
+// control plane round trip per connection.
+func NewMuxDialer(id string, session *Session) *Dialer {
+	d := &Dialer{
+		id:      id,
+		donec:   make(chan struct{}),
+		session: session,
+		created: time.Now(),
+	}
+	go func() {
+		<-session.Done()
+		d.Close()
+	}()
+	return d
+}
+
 // serve blocks and runs the control message loop, keeping the peer
 // alive and notifying the peer when new connections are available.
 func (d *Dialer) serve() error {
@@ -102,6 +136,12 @@ func (d *Dialer) sendMessage(m controlMsg) error {
 	return err
 }
 
+// SessionKey returns the secret the pool issued to the listener of a
+// multiplexed dialer, empty for other dialers. Only the pool and the
+// listener know it, so listeners can use it to tell connections of this
+// process apart from the ones of other callers.
+func (d *Dialer) SessionKey() string { return d.sessionKey }
+
 // Done returns a channel which is closed when d is closed (either by
 // this process on purpose, by a local error, or close or error from
 // the peer).
@@ -114,10 +154,22 @@ func (d *Dialer) Close() error {
 }
 
 func (d *Dialer) close() {
-	d.conn.Close()
+	if d.session != nil {
+		d.session.Close()
+	} else {
+		d.conn.Close()
+	}
 	close(d.donec)
 }
 
+// connOpened and connClosed track the reverse connections of legacy dialers
+func (d *Dialer) connOpened() {
+	atomic.AddInt64(&d.active, 1)
+	atomic.AddInt64(&d.total, 1)
+}
+
+func (d *Dialer) connClosed() { atomic.AddInt64(&d.active, -1) }
+
 // reverseClient caches the reverse http client
 func (d *Dialer) reverseClient() *http.Client {
 	if d.revClient == nil {
@@ -129,6 +181,11 @@ func (d *Dialer) reverseClient() *http.Client {
 			DisableKeepAlives:   true,   // one connection per reverse connection
 			MaxIdleConnsPerHost: -1,
 		}
+		if d.session != nil {
+			// streams are cheap and can be reused between requests
+			tr.DisableKeepAlives = false
+			tr.MaxIdleConnsPerHost = 0
+		}
 
 		client := http.Client{
 			Transport: tr,
@@ -142,7 +199,24 @@ func (d *Dialer) reverseClient() *http.Client {
 // Dial creates a new connection back to the Listener.
 func (d *Dialer) Dial(ctx context.Context, network string, address string) (net.Conn, error) {
 	now := time.Now()
-	defer klog.V(5).Infof("dial to %s took %v", address, time.Since(now))
+	defer func() {
+		klog.V(5).Infof("dial to %s took %v", address, time.Since(now))
+	}()
+
+	if d.session != nil {
+		select {
+		case <-d.donec:
+			return nil, errors.New("revdial.Dialer closed")
+		default:
+		}
+		st, err := d.session.Open(ctx)
+		if err != nil {
+			return nil, err
+		}
+		atomic.AddInt64(&d.total, 1)
+		return st, nil
+	}
+
 	// First, tell serve that we want a connection:
 	select {
 	case d.connReady <- true:
@@ -0,0 +1,120 @@
+package revdial
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var _ net.Listener = (*Listener)(nil)
+
+// ErrListenerClosed is returned by Accept after Close has been called.
+var ErrListenerClosed = errors.New("revdial: Listener closed")
+
+// Listener is a net.Listener, returning new streams opened by the
+// corresponding mux Dialer over a single reverse connection.
+type Listener struct {
+	session    *Session
+	sessionKey string
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+// NewListener dials the ReversePool at host and returns a Listener serving
+// multiplexed streams over that single connection.
+// - client: http client, must support HTTP/2 for full duplex streaming
+// - host: a URL to the base of the reverse handler on the Dialer
+// - id: identify this listener
+func NewListener(ctx context.Context, client *http.Client, host, id string) (*Listener, error) {
+	u, err := muxURL(host, id)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		pw.Close()
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		pw.Close()
+		return nil, fmt.Errorf("revdial: unexpected status code %d from %s", resp.StatusCode, host)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &Listener{
+		session:    NewSession(&pipeConn{ReadCloser: resp.Body, w: pw}, false),
+		sessionKey: resp.Header.Get(HeaderSessionKey),
+		ctx:        ctx,
+		cancel:     cancel,
+	}, nil
+}
+
+// SessionKey returns the secret the pool issued for the reverse connection,
+// see Dialer.SessionKey
+func (ln *Listener) SessionKey() string { return ln.sessionKey }
+
+// Accept blocks and returns a new connection, or an error.
+func (ln *Listener) Accept() (net.Conn, error) {
+	st, err := ln.session.Accept(ln.ctx)
+	if err != nil {
+		return nil, ErrListenerClosed
+	}
+	return st, nil
+}
+
+// Done returns a channel which is closed when the reverse connection is gone
+func (ln *Listener) Done() <-chan struct{} { return ln.session.Done() }
+
+// Close closes the Listener and its reverse connection
+func (ln *Listener) Close() error {
+	ln.cancel()
+	return ln.session.Close()
+}
+
+// Addr returns a dummy address. This exists only to conform to the
+// net.Listener interface.
+func (ln *Listener) Addr() net.Addr { return connAddr{} }
+
+// pipeConn joins the response body and the request body pipe of a streaming
+// HTTP request into a single io.ReadWriteCloser
+type pipeConn struct {
+	io.ReadCloser
+	w io.WriteCloser
+}
+
+func (c *pipeConn) Write(b []byte) (int, error) {
+	return c.w.Write(b)
+}
+
+func (c *pipeConn) Close() error {
+	c.w.Close()
+	return c.ReadCloser.Close()
+}
+
+// muxURL builds the destination url with the query parameter
+func muxURL(host, id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("id can not be empty")
+	}
+	hostURL, err := url.Parse(host)
+	if err != nil {
+		return "", fmt.Errorf("wrong url format, expected https://host<:port>/<path>: %w", err)
+	}
+	if hostURL.Host == "" {
+		return "", fmt.Errorf("wrong url format, expected https://host<:port>/<path>")
+	}
+	return strings.TrimRight(host, "/") + "/" + pathRevMux + "?" + urlParamKey + "=" + url.QueryEscape(id), nil
+}
@@ -3,6 +3,8 @@ package revdial
 // Based on https://github.com/aojea/h2rev2
 
 import (
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"io"
 	"net"
@@ -17,9 +19,13 @@ import (
 
 const (
 	pathRevDial  = "revdial"
+	pathRevMux   = "mux"
 	pathRevProxy = "proxy"
 	urlParamKey  = "id"
-	identity     = "faros-dev"
+
+	// HeaderSessionKey is the response header the pool issues the session key
+	// of multiplexed reverse connections in
+	HeaderSessionKey = "X-Revdial-Session-Key"
 )
 
 type controlMsg struct {
@@ -30,19 +36,60 @@ type controlMsg struct {
 
 // ReversePool contains a pool of Dialers to create reverse connections
 // It exposes an http.Handler to handle the clients.
-// 	pool := h2rev2.NewReversePool()
-// 	mux := http.NewServeMux()
+//
+//	pool := revdial.NewReversePool(revdial.WithAuthenticator(a))
+//	mux := http.NewServeMux()
 //	mux.Handle("", pool)
 type ReversePool struct {
 	mu   sync.Mutex
 	pool map[string]*Dialer
+
+	// authenticator authenticates callers, nil allows everybody
+	authenticator Authenticator
+	// defaultID is the dialer requests outside of the known paths are proxied to
+	defaultID string
+	// noProxy rejects the proxy path and the default id
+	noProxy bool
+}
+
+// PoolOption configures a ReversePool
+type PoolOption func(*ReversePool)
+
+// WithAuthenticator sets the authenticator used to check that callers own
+// the id they connect or proxy to
+func WithAuthenticator(a Authenticator) PoolOption {
+	return func(rp *ReversePool) {
+		rp.authenticator = a
+	}
+}
+
+// WithDefaultID proxies requests which do not match any of the pool paths to
+// the dialer with id. Used to expose a whole server through the pool. Callers
+// are authenticated for id, like the callers of the proxy path.
+func WithDefaultID(id string) PoolOption {
+	return func(rp *ReversePool) {
+		rp.defaultID = id
+	}
+}
+
+// WithoutProxy rejects the proxy path and requests outside of the pool paths,
+// so dialers are only used in process through GetDialer. Used when callers
+// authenticating for an id must not reach what is served behind its dialer.
+func WithoutProxy() PoolOption {
+	return func(rp *ReversePool) {
+		rp.noProxy = true
+	}
 }
 
 // NewReversePool returns a ReversePool
-func NewReversePool() *ReversePool {
-	return &ReversePool{
+func NewReversePool(opts ...PoolOption) *ReversePool {
+	rp := &ReversePool{
 		pool: map[string]*Dialer{},
 	}
+	for _, opt := range opts {
+		opt(rp)
+	}
+	return rp
 }
 
 // Close the Reverse pool and all its dialers
@@ -61,18 +108,24 @@ func (rp *ReversePool) GetDialer(id string) *Dialer {
 	return rp.pool[id]
 }
 
-// CreateDialer creates a reverse dialer with id
-// it's a noop if a dialer already exists
+// CreateDialer creates a reverse dialer with id.
+// An existing dialer for the same id is evicted and closed.
 func (rp *ReversePool) CreateDialer(id string, conn net.Conn) *Dialer {
-	rp.mu.Lock()
-	defer rp.mu.Unlock()
-	if d, ok := rp.pool[id]; ok {
-		return d
-	}
 	d := NewDialer(id, conn)
-	rp.pool[id] = d
+	rp.ReplaceDialer(id, d)
 	return d
+}
 
+// ReplaceDialer registers d for id, closing the dialer it replaces if any
+func (rp *ReversePool) ReplaceDialer(id string, d *Dialer) {
+	rp.mu.Lock()
+	old, ok := rp.pool[id]
+	rp.pool[id] = d
+	rp.mu.Unlock()
+	if ok && old != d {
+		klog.V(2).Infof("evicting stale dialer %s", id)
+		old.Close()
+	}
 }
 
 // DeleteDialer delete the reverse dialer for the id
@@ -82,8 +135,34 @@ func (rp *ReversePool) DeleteDialer(id string) {
 	delete(rp.pool, id)
 }
 
-// HTTP Handler that handles reverse connections and reverse proxy requests using 2 different paths:
+// deleteDialerIfCurrent deletes the dialer for id only if it is still d, so
+// a dialer going away does not remove the one which replaced it
+func (rp *ReversePool) deleteDialerIfCurrent(id string, d *Dialer) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	if rp.pool[id] == d {
+		delete(rp.pool, id)
+	}
+}
+
+// authenticate checks the caller is allowed to use reverse connections for id
+func (rp *ReversePool) authenticate(w http.ResponseWriter, r *http.Request, id string) (string, bool) {
+	if rp.authenticator == nil {
+		return "", true
+	}
+	owner, err := rp.authenticator.Authenticate(r, id)
+	if err != nil {
+		klog.V(2).Infof("rejected reverse connection for %s from %s: %v", id, r.RemoteAddr, err)
+		status := authStatus(err)
+		http.Error(w, http.StatusText(status), status)
+		return "", false
+	}
+	return owner, true
+}
+
+// HTTP Handler that handles reverse connections and reverse proxy requests using 3 different paths:
 // path base/revdial?key=id establish reverse connections and queue them so it can be consumed by the dialer
+// path base/mux?key=id establish a single reverse connection all connections are multiplexed over
 // path base/proxy/id/(path) proxies the (path) through the reverse connection identified by id
 func (rp *ReversePool) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// recover panic
@@ -104,68 +183,149 @@ func (rp *ReversePool) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// process path
 	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
-	if len(path) == 0 {
-		http.Error(w, "", http.StatusNotFound)
-		return
-	}
 
-	// Forward proxy /base/proxy/id/..proxied path...
-	if path[0] == pathRevDial {
-		d := rp.GetDialer(identity)
-		// First flush response headers
-		w.WriteHeader(http.StatusOK)
-		if f, ok := w.(http.Flusher); ok {
-			f.Flush()
+	switch path[0] {
+	case pathRevMux, pathRevDial:
+		// The caller identify itself by the value of the key
+		// https://server/revdial?id=dialerUniq
+		id := r.URL.Query().Get(urlParamKey)
+		if id == "" {
+			http.Error(w, "only reverse connections with id supported", http.StatusBadRequest)
+			return
 		}
-		// first connection to register the dialer and start the control loop
-		if d == nil || isClosedChan(d.Done()) {
-			conn := newConn(r.Body, flushWriter{w})
-			rp.DeleteDialer(identity)
-			d = rp.CreateDialer(identity, conn)
-			// start control loop
-			<-conn.Done()
-			klog.V(5).Infof("stoped dialer %s control connection ", identity)
+		owner, ok := rp.authenticate(w, r, id)
+		if !ok {
 			return
+		}
+		if path[0] == pathRevMux {
+			rp.serveMux(w, r, id, owner)
+		} else {
+			rp.serveRevDial(w, r, id, owner)
+		}
 
+	case pathRevProxy:
+		if rp.noProxy {
+			http.Error(w, "", http.StatusNotFound)
+			return
 		}
-		// create a reverse connection
-		klog.V(5).Infof("created reverse connection to %s %s id %s", r.RequestURI, r.RemoteAddr, identity)
-		conn := newConn(r.Body, flushWriter{w})
-		select {
-		case d.incomingConn <- conn:
-		case <-d.Done():
-			http.Error(w, "Reverse dialer closed", http.StatusInternalServerError)
+		// Forward proxy /base/proxy/id/..proxied path...
+		if len(path) < 2 || path[1] == "" {
+			http.Error(w, "proxy: reverse path id required", http.StatusBadRequest)
 			return
 		}
-		// keep the handler alive until the connection is closed
-		<-conn.Done()
-		klog.V(5).Infof("Connection from %s done", r.RemoteAddr)
-	} else {
-
-		target, err := url.Parse("http://" + identity)
-		if err != nil {
-			http.Error(w, "wrong url", http.StatusInternalServerError)
+		id := path[1]
+		if _, ok := rp.authenticate(w, r, id); !ok {
 			return
 		}
+		r.URL.Path = "/" + strings.Join(path[2:], "/")
+		r.URL.RawPath = ""
+		rp.proxy(w, r, id)
 
-		d := rp.GetDialer(identity)
-		if d == nil {
-			http.Error(w, "not reverse connections for this id available", http.StatusInternalServerError)
+	default:
+		if rp.defaultID == "" || rp.noProxy {
+			http.Error(w, "", http.StatusNotFound)
 			return
 		}
-		transport := d.reverseClient().Transport
-		proxy := httputil.NewSingleHostReverseProxy(target)
-		originalDirector := proxy.Director
-		proxy.Transport = transport
-		proxy.Director = func(req *http.Request) {
-			req.Host = target.Host
-			originalDirector(req)
+		if _, ok := rp.authenticate(w, r, rp.defaultID); !ok {
+			return
 		}
-		proxy.FlushInterval = -1
-		proxy.ServeHTTP(w, r)
-		klog.V(5).Infof("proxy server closed %v ", err)
+		rp.proxy(w, r, rp.defaultID)
+	}
+}
+
+// serveMux establishes a multiplexed reverse connection, which lives as long
+// as the session
+func (rp *ReversePool) serveMux(w http.ResponseWriter, r *http.Request, id, owner string) {
+	key, err := newSessionKey()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(HeaderSessionKey, key)
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	session := NewSession(&pipeConn{ReadCloser: r.Body, w: flushWriter{w}}, true)
+	d := NewMuxDialer(id, session)
+	// set before the dialer is visible to other goroutines
+	d.owner = owner
+	d.remoteAddr = r.RemoteAddr
+	d.sessionKey = key
+	rp.ReplaceDialer(id, d)
+	defer rp.deleteDialerIfCurrent(id, d)
+
+	klog.V(5).Infof("created multiplexed reverse connection to %s %s id %s", r.RequestURI, r.RemoteAddr, id)
+	select {
+	case <-d.Done():
+	case <-r.Context().Done():
+		d.Close()
+	}
+	klog.V(5).Infof("stoped multiplexed dialer %s", id)
+}
+
+// serveRevDial establishes the control connection, or a data connection when
+// a dialer for the id already exists
+func (rp *ReversePool) serveRevDial(w http.ResponseWriter, r *http.Request, id, owner string) {
+	d := rp.GetDialer(id)
+	// First flush response headers
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	// first connection to register the dialer and start the control loop
+	if d == nil || isClosedChan(d.Done()) {
+		conn := newConn(r.Body, flushWriter{w})
+		d = NewDialer(id, conn)
+		d.owner = owner
+		d.remoteAddr = r.RemoteAddr
+		rp.ReplaceDialer(id, d)
+		defer rp.deleteDialerIfCurrent(id, d)
+		// start control loop
+		<-conn.Done()
+		klog.V(5).Infof("stoped dialer %s control connection ", id)
+		return
 	}
+	// create a reverse connection
+	klog.V(5).Infof("created reverse connection to %s %s id %s", r.RequestURI, r.RemoteAddr, id)
+	conn := newConn(r.Body, flushWriter{w})
+	select {
+	case d.incomingConn <- conn:
+	case <-d.Done():
+		http.Error(w, "Reverse dialer closed", http.StatusInternalServerError)
+		return
+	}
+	d.connOpened()
+	defer d.connClosed()
+	// keep the handler alive until the connection is closed
+	<-conn.Done()
+	klog.V(5).Infof("Connection from %s done", r.RemoteAddr)
+}
 
+// proxy proxies the request through the reverse connection identified by id
+func (rp *ReversePool) proxy(w http.ResponseWriter, r *http.Request, id string) {
+	target, err := url.Parse("http://" + url.PathEscape(id))
+	if err != nil {
+		http.Error(w, "wrong url", http.StatusInternalServerError)
+		return
+	}
+
+	d := rp.GetDialer(id)
+	if d == nil || isClosedChan(d.Done()) {
+		http.Error(w, "not reverse connections for this id available", http.StatusBadGateway)
+		return
+	}
+	transport := d.reverseClient().Transport
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	originalDirector := proxy.Director
+	proxy.Transport = transport
+	proxy.Director = func(req *http.Request) {
+		req.Host = target.Host
+		originalDirector(req)
+	}
+	proxy.FlushInterval = -1
+	proxy.ServeHTTP(w, r)
+	klog.V(5).Infof("proxy server closed for %s", id)
 }
 
 type flushWriter struct {
@@ -183,3 +343,13 @@ func (fw flushWriter) Write(p []byte) (n int, err error) {
 func (fw flushWriter) Close() error {
 	return nil
 }
+
+// newSessionKey returns a random key shared by the dialer and the listener of
+// a multiplexed reverse connection
+func newSessionKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,691 @@
+package revdial
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aojea/h2rev2"
+)
+
+const testID = "test-dialer"
+
+// pipePair returns two connected io.ReadWriteClosers
+func pipePair() (io.ReadWriteCloser, io.ReadWriteCloser) {
+	r1, w1 := io.Pipe()
+	r2, w2 := io.Pipe()
+	return &pipeConn{ReadCloser: r1, w: w2}, &pipeConn{ReadCloser: r2, w: w1}
+}
+
+func sessionPair() (*Session, *Session) {
+	a, b := pipePair()
+	return NewSession(a, true), NewSession(b, false)
+}
+
+func TestSessionStreams(t *testing.T) {
+	ctx := context.Background()
+	dialer, listener := sessionPair()
+	defer dialer.Close()
+	defer listener.Close()
+
+	var wg sync.WaitGroup
+	go func() {
+		for {
+			st, err := listener.Accept(ctx)
+			if err != nil {
+				return
+			}
+			go func() {
+				defer st.Close()
+				io.Copy(st, st) //nolint:errcheck
+			}()
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			st, err := dialer.Open(ctx)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer st.Close()
+
+			// larger than the window to exercise flow control
+			want := make([]byte, 3*int(initialStreamWindow)+i)
+			rand.Read(want) //nolint:errcheck
+
+			go func() {
+				if _, err := st.Write(want); err != nil {
+					t.Error(err)
+				}
+			}()
+
+			got := make([]byte, len(want))
+			if _, err := io.ReadFull(st, got); err != nil {
+				t.Error(err)
+				return
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("stream %d: echoed data does not match", st.ID())
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestStreamClose(t *testing.T) {
+	ctx := context.Background()
+	dialer, listener := sessionPair()
+	defer dialer.Close()
+	defer listener.Close()
+
+	st, err := dialer.Open(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := st.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	peer, err := listener.Accept(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hello" {
+		t.Errorf("expected %q, got %q", "hello", got)
+	}
+	if err := peer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := st.Write([]byte("again")); err != ErrStreamClosed {
+		t.Errorf("expected %v, got %v", ErrStreamClosed, err)
+	}
+
+	// both sides closed, streams are released
+	if err := waitFor(func() bool { return dialer.NumStreams() == 0 && listener.NumStreams() == 0 }); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStreamCloseWrite(t *testing.T) {
+	ctx := context.Background()
+	dialer, listener := sessionPair()
+	defer dialer.Close()
+	defer listener.Close()
+
+	st, err := dialer.Open(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := st.Write([]byte("ping")); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.CloseWrite(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the peer reads until FIN and can still answer the half-closed stream
+	peer, err := listener.Accept(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "ping" {
+		t.Errorf("expected %q, got %q", "ping", got)
+	}
+	if _, err := peer.Write([]byte("pong")); err != nil {
+		t.Fatal(err)
+	}
+	peer.Close()
+
+	got, err = ioutil.ReadAll(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "pong" {
+		t.Errorf("expected %q, got %q", "pong", got)
+	}
+}
+
+func TestStreamCloseResetsWriter(t *testing.T) {
+	ctx := context.Background()
+	dialer, listener := sessionPair()
+	defer dialer.Close()
+	defer listener.Close()
+
+	st, err := dialer.Open(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := st.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	peer, err := listener.Accept(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := waitFor(func() bool {
+		peer.recvMu.Lock()
+		defer peer.recvMu.Unlock()
+		return peer.recvBuf.Len() > 0
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// closing with unread data resets the stream, so the writer does not
+	// block forever on the window
+	if err := peer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	errc := make(chan error, 1)
+	go func() {
+		_, err := st.Write(make([]byte, 2*initialStreamWindow))
+		errc <- err
+	}()
+	select {
+	case err := <-errc:
+		if err != ErrStreamReset {
+			t.Errorf("expected %v, got %v", ErrStreamReset, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("writer blocked on a closed stream")
+	}
+}
+
+func TestSessionCloseBlockedWriter(t *testing.T) {
+	// nobody reads the other end, so writes to the connection block
+	a, _ := net.Pipe()
+	session := NewSession(a, true)
+	go session.Open(context.Background()) //nolint:errcheck
+
+	closed := make(chan struct{})
+	go func() {
+		session.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("session close blocked on a writer")
+	}
+}
+
+func TestSessionReadsWhileWriteBlocked(t *testing.T) {
+	// the peer only writes, so ping acks can't be written to the connection
+	a, b := net.Pipe()
+	session := NewSession(a, true)
+	defer session.Close()
+
+	var hdr header
+	hdr.encode(framePing, flagSYN, 0, 0)
+	b.SetWriteDeadline(time.Now().Add(5 * time.Second)) //nolint:errcheck
+	for i := 0; i < 10; i++ {
+		if _, err := b.Write(hdr[:]); err != nil {
+			t.Fatalf("session stopped reading after %d pings: %v", i, err)
+		}
+	}
+
+	b.SetReadDeadline(time.Now().Add(5 * time.Second)) //nolint:errcheck
+	for i := 0; i < 10; i++ {
+		if _, err := io.ReadFull(b, hdr[:]); err != nil {
+			t.Fatal(err)
+		}
+		if hdr.frameType() != framePing || hdr.flags() != flagACK {
+			t.Fatalf("expected ping ack, got frame %d with flags %d", hdr.frameType(), hdr.flags())
+		}
+	}
+}
+
+func TestSessionRejectsLocalStreamIDs(t *testing.T) {
+	for _, dialer := range []bool{true, false} {
+		a, b := net.Pipe()
+		session := NewSession(a, dialer)
+		go io.Copy(io.Discard, b) //nolint:errcheck
+
+		// streams of the dialer have odd ids, the ones of the listener even
+		id := uint32(2)
+		if dialer {
+			id = 1
+		}
+		var hdr header
+		hdr.encode(frameWindowUpdate, flagSYN, id, 0)
+		if _, err := b.Write(hdr[:]); err != nil {
+			t.Fatal(err)
+		}
+
+		select {
+		case <-session.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatalf("dialer %v: session accepted stream %d of its own", dialer, id)
+		}
+		if _, err := session.Accept(context.Background()); err == nil || !strings.Contains(err.Error(), "id of this side") {
+			t.Errorf("dialer %v: expected the stream id to be rejected, got %v", dialer, err)
+		}
+		b.Close()
+	}
+}
+
+func TestStreamReadDeadline(t *testing.T) {
+	ctx := context.Background()
+	dialer, listener := sessionPair()
+	defer dialer.Close()
+	defer listener.Close()
+
+	st, err := dialer.Open(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st.SetReadDeadline(time.Now().Add(10 * time.Millisecond)) //nolint:errcheck
+	if _, err := st.Read(make([]byte, 1)); !isTimeout(err) {
+		t.Errorf("expected timeout, got %v", err)
+	}
+}
+
+func TestSessionClose(t *testing.T) {
+	ctx := context.Background()
+	dialer, listener := sessionPair()
+
+	st, err := dialer.Open(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener.Close()
+
+	select {
+	case <-dialer.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("session was not closed after the peer went away")
+	}
+	if _, err := st.Read(make([]byte, 1)); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+	if _, err := dialer.Open(ctx); err != ErrSessionClosed {
+		t.Errorf("expected %v, got %v", ErrSessionClosed, err)
+	}
+}
+
+func TestMuxDialer(t *testing.T) {
+	client, uri, stop := setupMux(t)
+	defer stop()
+
+	for i := 0; i < 5; i++ {
+		resp, err := client.Get(uri)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != "Hello world" {
+			t.Errorf("expected %q, got %q", "Hello world", body)
+		}
+	}
+}
+
+func TestPoolAuthentication(t *testing.T) {
+	pool := NewReversePool(WithAuthenticator(BearerTokenAuthenticator(func(ctx context.Context, id, token string) (string, error) {
+		switch {
+		case token != "secret":
+			return "", ErrUnauthorized
+		case id != testID:
+			return "", ErrForbidden
+		}
+		return "owner", nil
+	})), WithDefaultID(testID))
+	public := httptest.NewUnstartedServer(pool)
+	public.EnableHTTP2 = true
+	public.StartTLS()
+	defer public.Close()
+	defer pool.Close()
+
+	for _, tt := range []struct {
+		name  string
+		token string
+		id    string
+		want  int
+	}{
+		{name: "no token", id: testID, want: http.StatusUnauthorized},
+		{name: "wrong token", token: "wrong", id: testID, want: http.StatusUnauthorized},
+		{name: "foreign id", token: "secret", id: "other", want: http.StatusForbidden},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			client := public.Client()
+			if tt.token != "" {
+				client = bearerClient(public.Client(), tt.token)
+			}
+			_, err := NewListener(context.Background(), client, public.URL, tt.id)
+			if err == nil || !strings.Contains(err.Error(), fmt.Sprint(tt.want)) {
+				t.Errorf("expected status %d, got %v", tt.want, err)
+			}
+			if pool.GetDialer(tt.id) != nil {
+				t.Errorf("dialer registered for rejected client")
+			}
+		})
+	}
+
+	l, err := NewListener(context.Background(), bearerClient(public.Client(), "secret"), public.URL, testID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	if err := waitFor(func() bool { return pool.GetDialer(testID) != nil }); err != nil {
+		t.Fatal(err)
+	}
+	if owner := pool.GetDialer(testID).Stats().Owner; owner != "owner" {
+		t.Errorf("expected owner %q, got %q", "owner", owner)
+	}
+
+	// proxying requires credentials too, including to the default id
+	for _, path := range []string{"/proxy/" + testID + "/", "/"} {
+		resp, err := public.Client().Get(public.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("%s: expected status %d, got %d", path, http.StatusUnauthorized, resp.StatusCode)
+		}
+	}
+}
+
+func TestPoolWithoutProxy(t *testing.T) {
+	pool := NewReversePool(WithAuthenticator(BearerTokenAuthenticator(func(ctx context.Context, id, token string) (string, error) {
+		if token != "secret" {
+			return "", ErrUnauthorized
+		}
+		return "owner", nil
+	})), WithDefaultID(testID), WithoutProxy())
+	public := httptest.NewUnstartedServer(pool)
+	public.EnableHTTP2 = true
+	public.StartTLS()
+	defer public.Close()
+	defer pool.Close()
+
+	client := bearerClient(public.Client(), "secret")
+	l, err := NewListener(context.Background(), client, public.URL, testID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go http.Serve(l, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})) //nolint:errcheck
+	if err := waitFor(func() bool { return pool.GetDialer(testID) != nil }); err != nil {
+		t.Fatal(err)
+	}
+	if key := pool.GetDialer(testID).SessionKey(); key == "" || key != l.SessionKey() {
+		t.Errorf("expected dialer and listener to share the session key, got %q and %q", key, l.SessionKey())
+	}
+
+	// the owner of the id can't proxy to it either
+	for _, path := range []string{"/proxy/" + testID + "/", "/"} {
+		resp, err := client.Get(public.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("%s: expected status %d, got %d", path, http.StatusNotFound, resp.StatusCode)
+		}
+	}
+
+	// dialing in process works
+	conn, err := pool.GetDialer(testID).Dial(context.Background(), "tcp", testID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+}
+
+func TestPoolEvictsStaleDialer(t *testing.T) {
+	pool := NewReversePool()
+	public := httptest.NewUnstartedServer(pool)
+	public.EnableHTTP2 = true
+	public.StartTLS()
+	defer public.Close()
+	defer pool.Close()
+
+	first, err := NewListener(context.Background(), public.Client(), public.URL, testID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer first.Close()
+	if err := waitFor(func() bool { return pool.GetDialer(testID) != nil }); err != nil {
+		t.Fatal(err)
+	}
+	stale := pool.GetDialer(testID)
+
+	second, err := NewListener(context.Background(), public.Client(), public.URL, testID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+	if err := waitFor(func() bool { return pool.GetDialer(testID) != stale }); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-first.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("stale listener was not disconnected")
+	}
+	if isClosedChan(second.Done()) {
+		t.Error("new listener was disconnected")
+	}
+	if stats := pool.Stats(); len(stats) != 1 || stats[0].State != StateConnected {
+		t.Errorf("expected a single connected dialer, got %+v", stats)
+	}
+}
+
+func TestDebugHandler(t *testing.T) {
+	pool, client, uri, stop := setupReverse(t, true)
+	defer stop()
+
+	resp, err := client.Get(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.Copy(io.Discard, resp.Body) //nolint:errcheck
+	resp.Body.Close()
+
+	rec := httptest.NewRecorder()
+	pool.DebugHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug", nil))
+
+	var stats []DialerStats
+	if err := json.Unmarshal(rec.Body.Bytes(), &stats); err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 1 {
+		t.Fatalf("expected a single dialer, got %+v", stats)
+	}
+	if stats[0].ID != testID || stats[0].Mode != ModeMux || stats[0].State != StateConnected || stats[0].TotalConnections == 0 {
+		t.Errorf("unexpected stats %+v", stats[0])
+	}
+}
+
+func isTimeout(err error) bool {
+	ne, ok := err.(net.Error)
+	return ok && ne.Timeout()
+}
+
+func waitFor(cond func() bool) error {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return fmt.Errorf("condition not met")
+}
+
+// setupReverse starts a backend served through a ReversePool over either
+// the multiplexed or the legacy transport
+func setupReverse(tb testing.TB, mux bool) (*ReversePool, *http.Client, string, func()) {
+	tb.Helper()
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "Hello world")
+	}))
+
+	pool := NewReversePool()
+	public := httptest.NewUnstartedServer(pool)
+	public.EnableHTTP2 = true
+	public.StartTLS()
+
+	var l net.Listener
+	var err error
+	if mux {
+		l, err = NewListener(context.Background(), public.Client(), public.URL, testID)
+	} else {
+		l, err = h2rev2.NewListener(public.Client(), public.URL, testID)
+	}
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	u, err := url.Parse(backend.URL)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	server := &http.Server{Handler: httputil.NewSingleHostReverseProxy(u)}
+	go server.Serve(l) //nolint:errcheck
+
+	if err := waitFor(func() bool { return pool.GetDialer(testID) != nil }); err != nil {
+		tb.Fatal(err)
+	}
+
+	stop := func() {
+		l.Close()
+		server.Close()
+		pool.Close()
+		public.Close()
+		backend.Close()
+	}
+	return pool, public.Client(), public.URL + "/proxy/" + testID + "/", stop
+}
+
+func setupMux(tb testing.TB) (*http.Client, string, func()) {
+	_, client, uri, stop := setupReverse(tb, true)
+	return client, uri, stop
+}
+
+// bearerClient returns a copy of client sending token with every request
+func bearerClient(client *http.Client, token string) *http.Client {
+	return &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		r = r.Clone(r.Context())
+		r.Header.Set("Authorization", "Bearer "+token)
+		return client.Transport.RoundTrip(r)
+	})}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func benchmarkThroughput(b *testing.B, w io.WriteCloser, r io.Reader) {
+	buf := make([]byte, 32*1024)
+	b.SetBytes(int64(len(buf)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	go func() {
+		for i := 0; i < b.N; i++ {
+			if _, err := w.Write(buf); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	}()
+
+	rbuf := make([]byte, len(buf))
+	for i := 0; i < b.N; i++ {
+		if _, err := io.ReadFull(r, rbuf); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkConnThroughput(b *testing.B) {
+	r1, w1 := io.Pipe()
+	r2, w2 := io.Pipe()
+	c1 := newConn(r1, w2)
+	c2 := newConn(r2, w1)
+	defer c1.Close()
+	defer c2.Close()
+
+	benchmarkThroughput(b, c1, c2)
+}
+
+func BenchmarkStreamThroughput(b *testing.B) {
+	dialer, listener := sessionPair()
+	defer dialer.Close()
+	defer listener.Close()
+
+	st, err := dialer.Open(context.Background())
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err := st.Write([]byte{0}); err != nil {
+		b.Fatal(err)
+	}
+	peer, err := listener.Accept(context.Background())
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err := io.ReadFull(peer, make([]byte, 1)); err != nil {
+		b.Fatal(err)
+	}
+
+	benchmarkThroughput(b, st, peer)
+}
+
+func benchmarkDialer(b *testing.B, mux bool) {
+	_, client, uri, stop := setupReverse(b, mux)
+	defer stop()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp, err := client.Get(uri)
+		if err != nil {
+			b.Fatal(err)
+		}
+		io.Copy(io.Discard, resp.Body) //nolint:errcheck
+		resp.Body.Close()
+	}
+}
+
+func BenchmarkDialerRequest(b *testing.B) {
+	benchmarkDialer(b, false)
+}
+
+func BenchmarkMuxDialerRequest(b *testing.B) {
+	benchmarkDialer(b, true)
+}
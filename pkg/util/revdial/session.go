@@ -0,0 +1,413 @@
+package revdial
+
+// Multiplexed transport inspired by https://github.com/hashicorp/yamux
+//
+// A Session carries many independent, flow-controlled streams over a single
+// reverse connection. Compared to one HTTP request per reverse connection it
+// removes the control plane round trip ("conn-ready"/pickup) from every Dial
+// and lets each stream buffer reads up to its receive window.
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	protoVersion uint8 = 0
+
+	// headerSize is the size of the frame header on the wire:
+	// version(1) type(1) flags(2) streamID(4) length(4)
+	headerSize = 12
+
+	// initialStreamWindow is the receive window every stream starts with
+	initialStreamWindow uint32 = 256 * 1024
+
+	// maxFrameSize caps the payload written in a single data frame so a
+	// single large Write does not starve other streams.
+	maxFrameSize = 32 * 1024
+
+	// keepAliveInterval is how often the session sends a ping to keep the
+	// reverse connection alive through proxies and NATs.
+	keepAliveInterval = 30 * time.Second
+
+	// goAwayTimeout is how long closing a session waits to notify the peer
+	goAwayTimeout = time.Second
+
+	// controlBacklog is how many control frames of the receive loop can wait
+	// for the connection to be writable
+	controlBacklog = 64
+)
+
+type frameType uint8
+
+const (
+	frameData frameType = iota
+	frameWindowUpdate
+	framePing
+	frameGoAway
+)
+
+const (
+	flagSYN uint16 = 1 << iota
+	flagACK
+	flagFIN
+	flagRST
+)
+
+var (
+	// ErrSessionClosed is returned when using a closed session
+	ErrSessionClosed = errors.New("revdial: session closed")
+	// ErrStreamClosed is returned when writing to a closed stream
+	ErrStreamClosed = errors.New("revdial: stream closed")
+	// ErrStreamReset is returned when the peer reset the stream
+	ErrStreamReset = errors.New("revdial: stream reset by peer")
+	// ErrStreamsExhausted is returned when no more stream IDs are available
+	ErrStreamsExhausted = errors.New("revdial: stream ids exhausted")
+
+	// errControlBacklog closes sessions whose peer does not read the control
+	// frames sent to it
+	errControlBacklog = errors.New("revdial: control frame backlog full")
+)
+
+type header [headerSize]byte
+
+func (h header) version() uint8       { return h[0] }
+func (h header) frameType() frameType { return frameType(h[1]) }
+func (h header) flags() uint16        { return binary.BigEndian.Uint16(h[2:4]) }
+func (h header) streamID() uint32     { return binary.BigEndian.Uint32(h[4:8]) }
+func (h header) length() uint32       { return binary.BigEndian.Uint32(h[8:12]) }
+
+func (h *header) encode(t frameType, flags uint16, streamID, length uint32) {
+	h[0] = protoVersion
+	h[1] = uint8(t)
+	binary.BigEndian.PutUint16(h[2:4], flags)
+	binary.BigEndian.PutUint32(h[4:8], streamID)
+	binary.BigEndian.PutUint32(h[8:12], length)
+}
+
+// control is a frame without payload sent in response to a received one
+type control struct {
+	t     frameType
+	flags uint16
+	id    uint32
+}
+
+// Session multiplexes streams over a single io.ReadWriteCloser
+type Session struct {
+	rwc    io.ReadWriteCloser
+	dialer bool
+
+	// wrMu serializes frames written to rwc
+	wrMu sync.Mutex
+	hdr  header
+	wbuf []byte
+
+	mu       sync.Mutex // guards below
+	streams  map[uint32]*Stream
+	nextID   uint32
+	shutdown bool
+
+	acceptCh chan *Stream
+	// controlCh queues control frames of the receive loop, which must not
+	// block on writes: peers both writing would stop reading each other
+	controlCh chan control
+	donec     chan struct{}
+	once      sync.Once
+	err       error
+}
+
+// NewSession returns a Session over rwc. The dialer side opens streams with
+// odd ids and the listener side with even ids, so both ends can open
+// streams without coordination.
+func NewSession(rwc io.ReadWriteCloser, dialer bool) *Session {
+	s := &Session{
+		rwc:       rwc,
+		dialer:    dialer,
+		streams:   map[uint32]*Stream{},
+		acceptCh:  make(chan *Stream, 64),
+		controlCh: make(chan control, controlBacklog),
+		donec:     make(chan struct{}),
+	}
+	if dialer {
+		s.nextID = 1
+	} else {
+		s.nextID = 2
+	}
+	go s.recvLoop()
+	go s.sendLoop()
+	go s.keepAlive()
+	return s
+}
+
+// Open creates a new stream to the peer
+func (s *Session) Open(ctx context.Context) (*Stream, error) {
+	s.mu.Lock()
+	if s.shutdown {
+		s.mu.Unlock()
+		return nil, ErrSessionClosed
+	}
+	id := s.nextID
+	if id >= ^uint32(0)-1 {
+		s.mu.Unlock()
+		return nil, ErrStreamsExhausted
+	}
+	s.nextID += 2
+	st := newStream(s, id)
+	s.streams[id] = st
+	s.mu.Unlock()
+
+	if err := s.writeFrame(frameWindowUpdate, flagSYN, id, 0, nil); err != nil {
+		s.forgetStream(id)
+		return nil, err
+	}
+
+	// The peer starts with the same default window, so the stream is usable
+	// right away. We still honour cancellation of the caller.
+	select {
+	case <-ctx.Done():
+		st.Close()
+		return nil, ctx.Err()
+	default:
+	}
+	return st, nil
+}
+
+// Accept blocks until the peer opens a new stream
+func (s *Session) Accept(ctx context.Context) (*Stream, error) {
+	select {
+	case st := <-s.acceptCh:
+		return st, nil
+	case <-s.donec:
+		return nil, s.closeErr()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// NumStreams returns the number of currently open streams
+func (s *Session) NumStreams() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.streams)
+}
+
+// Done returns a channel which is closed when the session is closed
+func (s *Session) Done() <-chan struct{} { return s.donec }
+
+// Close closes the session and all its streams
+func (s *Session) Close() error {
+	s.closeWithErr(ErrSessionClosed)
+	return nil
+}
+
+func (s *Session) closeWithErr(err error) {
+	s.once.Do(func() {
+		s.mu.Lock()
+		s.shutdown = true
+		s.err = err
+		streams := s.streams
+		s.streams = map[uint32]*Stream{}
+		s.mu.Unlock()
+
+		// best effort notification of the peer. A writer blocked on the
+		// connection holds wrMu, so don't wait for it longer than
+		// goAwayTimeout, closing the connection unblocks it.
+		sent := make(chan struct{})
+		go func() {
+			s.writeFrame(frameGoAway, 0, 0, 0, nil) //nolint:errcheck
+			close(sent)
+		}()
+		timer := time.NewTimer(goAwayTimeout)
+		select {
+		case <-sent:
+		case <-timer.C:
+		}
+		timer.Stop()
+		s.rwc.Close()
+		close(s.donec)
+
+		for _, st := range streams {
+			st.forceClose()
+		}
+	})
+}
+
+func (s *Session) closeErr() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		return ErrSessionClosed
+	}
+	return s.err
+}
+
+func (s *Session) forgetStream(id uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.streams, id)
+}
+
+// writeFrame writes a single frame to the underlying connection
+func (s *Session) writeFrame(t frameType, flags uint16, id, length uint32, payload []byte) error {
+	s.wrMu.Lock()
+	defer s.wrMu.Unlock()
+
+	if isClosedChan(s.donec) && t != frameGoAway {
+		return ErrSessionClosed
+	}
+
+	// a single Write per frame, the reverse connection flushes on every Write
+	s.hdr.encode(t, flags, id, length)
+	s.wbuf = append(append(s.wbuf[:0], s.hdr[:]...), payload...)
+	_, err := s.rwc.Write(s.wbuf)
+	return err
+}
+
+// sendControl queues a control frame for sendLoop. It does not block, the
+// session fails if the peer lets too many control frames pile up.
+func (s *Session) sendControl(t frameType, flags uint16, id uint32) error {
+	select {
+	case s.controlCh <- control{t: t, flags: flags, id: id}:
+		return nil
+	default:
+		return errControlBacklog
+	}
+}
+
+// sendLoop writes the control frames queued by the receive loop
+func (s *Session) sendLoop() {
+	for {
+		select {
+		case c := <-s.controlCh:
+			if err := s.writeFrame(c.t, c.flags, c.id, 0, nil); err != nil {
+				s.closeWithErr(err)
+				return
+			}
+		case <-s.donec:
+			return
+		}
+	}
+}
+
+// isLocalID returns whether id is of the streams this side opens
+func (s *Session) isLocalID(id uint32) bool {
+	return (id%2 == 1) == s.dialer
+}
+
+// keepAlive sends periodic pings so idle reverse connections are not reaped
+func (s *Session) keepAlive() {
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.writeFrame(framePing, flagSYN, 0, 0, nil); err != nil {
+				s.closeWithErr(err)
+				return
+			}
+		case <-s.donec:
+			return
+		}
+	}
+}
+
+// recvLoop reads frames from the connection and dispatches them to streams
+func (s *Session) recvLoop() {
+	var hdr header
+	for {
+		if _, err := io.ReadFull(s.rwc, hdr[:]); err != nil {
+			if err != io.EOF {
+				klog.V(5).Infof("revdial session read failed: %v", err)
+			}
+			s.closeWithErr(err)
+			return
+		}
+		if hdr.version() != protoVersion {
+			s.closeWithErr(fmt.Errorf("revdial: unsupported protocol version %d", hdr.version()))
+			return
+		}
+
+		var err error
+		switch hdr.frameType() {
+		case frameData, frameWindowUpdate:
+			err = s.handleStreamFrame(hdr)
+		case framePing:
+			if hdr.flags()&flagSYN != 0 {
+				err = s.sendControl(framePing, flagACK, 0)
+			}
+		case frameGoAway:
+			err = io.EOF
+		default:
+			err = fmt.Errorf("revdial: unknown frame type %d", hdr.frameType())
+		}
+		if err != nil {
+			s.closeWithErr(err)
+			return
+		}
+	}
+}
+
+func (s *Session) handleStreamFrame(hdr header) error {
+	id := hdr.streamID()
+	flags := hdr.flags()
+
+	// the peer opens streams with ids of its own parity only, so they can't
+	// collide with streams of this side
+	if flags&flagSYN != 0 && (id == 0 || s.isLocalID(id)) {
+		return fmt.Errorf("revdial: peer opened stream %d with an id of this side", id)
+	}
+
+	s.mu.Lock()
+	st, ok := s.streams[id]
+	if !ok && flags&flagSYN != 0 {
+		if s.shutdown {
+			s.mu.Unlock()
+			return ErrSessionClosed
+		}
+		st = newStream(s, id)
+		s.streams[id] = st
+		ok = true
+		s.mu.Unlock()
+
+		select {
+		case s.acceptCh <- st:
+		default:
+			// backlog is full, refuse the stream
+			klog.V(5).Infof("revdial session accept backlog full, resetting stream %d", id)
+			s.forgetStream(id)
+			if err := s.sendControl(frameWindowUpdate, flagRST, id); err != nil {
+				return err
+			}
+			return s.discard(hdr)
+		}
+	} else {
+		s.mu.Unlock()
+	}
+
+	if !ok {
+		// frame for a stream we already forgot about
+		return s.discard(hdr)
+	}
+
+	if hdr.frameType() == frameWindowUpdate {
+		st.incrSendWindow(hdr.length(), flags)
+		return nil
+	}
+	return st.readData(hdr, s.rwc)
+}
+
+// discard drops the payload of a data frame
+func (s *Session) discard(hdr header) error {
+	if hdr.frameType() != frameData || hdr.length() == 0 {
+		return nil
+	}
+	_, err := io.CopyN(io.Discard, s.rwc, int64(hdr.length()))
+	return err
+}
@@ -0,0 +1,85 @@
+package revdial
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// ModeMux dialers multiplex all connections over a single reverse connection
+	ModeMux = "mux"
+	// ModeLegacy dialers use a reverse connection per connection
+	ModeLegacy = "legacy"
+
+	// StateConnected dialers are able to open new connections
+	StateConnected = "connected"
+	// StateClosed dialers have lost their reverse connection
+	StateClosed = "closed"
+)
+
+// DialerStats describes a dialer of the ReversePool
+type DialerStats struct {
+	ID                string    `json:"id"`
+	Owner             string    `json:"owner,omitempty"`
+	Mode              string    `json:"mode"`
+	State             string    `json:"state"`
+	ActiveConnections int64     `json:"activeConnections"`
+	TotalConnections  int64     `json:"totalConnections"`
+	ConnectedSince    time.Time `json:"connectedSince"`
+	RemoteAddr        string    `json:"remoteAddr,omitempty"`
+}
+
+// Stats returns the state of the dialer
+func (d *Dialer) Stats() DialerStats {
+	stats := DialerStats{
+		ID:                d.id,
+		Owner:             d.owner,
+		Mode:              ModeLegacy,
+		State:             StateConnected,
+		ActiveConnections: atomic.LoadInt64(&d.active),
+		TotalConnections:  atomic.LoadInt64(&d.total),
+		ConnectedSince:    d.created,
+		RemoteAddr:        d.remoteAddr,
+	}
+	if d.session != nil {
+		stats.Mode = ModeMux
+		stats.ActiveConnections = int64(d.session.NumStreams())
+	}
+	if isClosedChan(d.Done()) {
+		stats.State = StateClosed
+	}
+	return stats
+}
+
+// Stats returns the state of all dialers in the pool, sorted by id
+func (rp *ReversePool) Stats() []DialerStats {
+	rp.mu.Lock()
+	dialers := make([]*Dialer, 0, len(rp.pool))
+	for _, d := range rp.pool {
+		dialers = append(dialers, d)
+	}
+	rp.mu.Unlock()
+
+	stats := make([]DialerStats, 0, len(dialers))
+	for _, d := range dialers {
+		stats = append(stats, d.Stats())
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].ID < stats[j].ID })
+	return stats
+}
+
+// DebugHandler returns an http.Handler serving the pool dialer stats as JSON.
+// It must be mounted behind the caller's own authentication.
+func (rp *ReversePool) DebugHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(rp.Stats()) //nolint:errcheck
+	})
+}
@@ -0,0 +1,353 @@
+package revdial
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+var _ net.Conn = (*Stream)(nil)
+
+// framePool holds buffers used to read data frames off the wire
+var framePool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, maxFrameSize)
+		return &b
+	},
+}
+
+// Stream is a single bidirectional, flow-controlled connection inside a
+// Session. It implements net.Conn.
+type Stream struct {
+	id      uint32
+	session *Session
+
+	recvMu       sync.Mutex // guards recvBuf, recvWindow, recvConsumed and state
+	recvBuf      bytes.Buffer
+	recvWindow   uint32 // bytes the peer may still send us
+	recvConsumed uint32 // bytes read by the application not yet returned to the peer
+	localClosed  bool   // we sent FIN
+	remoteClosed bool   // peer sent FIN
+	readClosed   bool   // the stream was closed, received data is discarded
+	reset        bool   // stream was reset or the session went away
+
+	sendMu     sync.Mutex // guards sendWindow
+	sendWindow uint32     // bytes we may still send to the peer
+
+	wrMu sync.Mutex // serializes Write calls
+
+	recvNotify chan struct{}
+	sendNotify chan struct{}
+
+	readDeadline  *connDeadline
+	writeDeadline *connDeadline
+}
+
+func newStream(s *Session, id uint32) *Stream {
+	return &Stream{
+		id:            id,
+		session:       s,
+		recvWindow:    initialStreamWindow,
+		sendWindow:    initialStreamWindow,
+		recvNotify:    make(chan struct{}, 1),
+		sendNotify:    make(chan struct{}, 1),
+		readDeadline:  makeConnDeadline(),
+		writeDeadline: makeConnDeadline(),
+	}
+}
+
+// ID returns the stream id within its session
+func (st *Stream) ID() uint32 { return st.id }
+
+func notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// readData reads the payload of a data frame into the receive buffer
+func (st *Stream) readData(hdr header, r io.Reader) error {
+	length := hdr.length()
+	flags := hdr.flags()
+
+	if length > 0 {
+		if length > maxFrameSize {
+			return fmt.Errorf("revdial: frame of %d bytes exceeds maximum %d", length, maxFrameSize)
+		}
+		bp := framePool.Get().(*[]byte)
+		defer framePool.Put(bp)
+		buf := (*bp)[:length]
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return err
+		}
+
+		st.recvMu.Lock()
+		if length > st.recvWindow {
+			st.recvMu.Unlock()
+			return fmt.Errorf("revdial: stream %d exceeded receive window", st.id)
+		}
+		st.recvWindow -= length
+		if st.readClosed && !st.reset {
+			// nobody reads the stream anymore, reset it so the peer stops
+			// sending instead of waiting for window updates
+			st.reset = true
+			st.recvMu.Unlock()
+			st.session.forgetStream(st.id)
+			return st.session.sendControl(frameWindowUpdate, flagRST, st.id)
+		}
+		if !st.reset {
+			st.recvBuf.Write(buf)
+		}
+		st.recvMu.Unlock()
+	}
+
+	st.handleFlags(flags)
+	notify(st.recvNotify)
+	return nil
+}
+
+// incrSendWindow is called when the peer returns window to us
+func (st *Stream) incrSendWindow(delta uint32, flags uint16) {
+	st.handleFlags(flags)
+
+	st.sendMu.Lock()
+	st.sendWindow += delta
+	st.sendMu.Unlock()
+	notify(st.sendNotify)
+}
+
+func (st *Stream) handleFlags(flags uint16) {
+	if flags&(flagFIN|flagRST) == 0 {
+		return
+	}
+
+	st.recvMu.Lock()
+	if flags&flagFIN != 0 {
+		st.remoteClosed = true
+	}
+	if flags&flagRST != 0 {
+		st.reset = true
+	}
+	done := st.reset || (st.localClosed && st.remoteClosed)
+	st.recvMu.Unlock()
+
+	if done {
+		st.session.forgetStream(st.id)
+	}
+	notify(st.recvNotify)
+	notify(st.sendNotify)
+}
+
+// Read reads data from the stream
+func (st *Stream) Read(b []byte) (int, error) {
+	for {
+		st.recvMu.Lock()
+		if st.recvBuf.Len() > 0 {
+			n, _ := st.recvBuf.Read(b)
+			st.recvConsumed += uint32(n)
+			update := st.windowUpdateLocked()
+			st.recvMu.Unlock()
+
+			if update > 0 {
+				// an error here surfaces on the next Read or Write
+				st.session.writeFrame(frameWindowUpdate, 0, st.id, update, nil) //nolint:errcheck
+			}
+			return n, nil
+		}
+		switch {
+		case st.reset:
+			st.recvMu.Unlock()
+			return 0, ErrStreamReset
+		case st.remoteClosed, st.readClosed:
+			st.recvMu.Unlock()
+			return 0, io.EOF
+		}
+		st.recvMu.Unlock()
+
+		select {
+		case <-st.recvNotify:
+		case <-st.readDeadline.wait():
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+}
+
+// windowUpdateLocked returns the window to hand back to the peer, if it is
+// worth sending an update. Must be called with recvMu held.
+func (st *Stream) windowUpdateLocked() uint32 {
+	if st.recvConsumed < initialStreamWindow/2 || st.remoteClosed {
+		return 0
+	}
+	update := st.recvConsumed
+	st.recvConsumed = 0
+	st.recvWindow += update
+	return update
+}
+
+// Write writes data to the stream, blocking while the peer window is full
+func (st *Stream) Write(b []byte) (int, error) {
+	st.wrMu.Lock()
+	defer st.wrMu.Unlock()
+
+	total := 0
+	for total < len(b) {
+		n, err := st.write(b[total:])
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+func (st *Stream) write(b []byte) (int, error) {
+	for {
+		st.recvMu.Lock()
+		closed, reset := st.localClosed, st.reset
+		st.recvMu.Unlock()
+		switch {
+		case reset:
+			return 0, ErrStreamReset
+		case closed:
+			return 0, ErrStreamClosed
+		case isClosedChan(st.session.donec):
+			return 0, io.ErrClosedPipe
+		}
+
+		st.sendMu.Lock()
+		window := st.sendWindow
+		if window > 0 {
+			n := uint32(len(b))
+			if n > window {
+				n = window
+			}
+			if n > maxFrameSize {
+				n = maxFrameSize
+			}
+			st.sendWindow -= n
+			st.sendMu.Unlock()
+
+			if err := st.session.writeFrame(frameData, 0, st.id, n, b[:n]); err != nil {
+				return 0, err
+			}
+			return int(n), nil
+		}
+		st.sendMu.Unlock()
+
+		select {
+		case <-st.sendNotify:
+		case <-st.session.donec:
+			return 0, io.ErrClosedPipe
+		case <-st.writeDeadline.wait():
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+}
+
+// CloseWrite half-closes the stream by sending FIN to the peer, after the
+// data already written. The stream can still be read until the peer closes
+// its side.
+func (st *Stream) CloseWrite() error {
+	st.recvMu.Lock()
+	if st.localClosed || st.reset {
+		st.recvMu.Unlock()
+		return nil
+	}
+	st.localClosed = true
+	done := st.remoteClosed
+	st.recvMu.Unlock()
+
+	return st.sendClose(flagFIN, done)
+}
+
+// Close closes the stream. Data already written is delivered to the peer,
+// followed by FIN. Pending data in the receive buffer is discarded, and the
+// stream is reset if the peer is still sending, so its writes fail rather
+// than block on a window which is never returned.
+func (st *Stream) Close() error {
+	st.recvMu.Lock()
+	if st.readClosed || st.reset {
+		st.recvMu.Unlock()
+		return nil
+	}
+	st.readClosed = true
+	unread := st.recvBuf.Len() > 0
+	st.recvBuf.Reset()
+
+	var flags uint16
+	switch {
+	case unread && !st.remoteClosed:
+		st.reset = true
+		flags = flagRST
+	case !st.localClosed:
+		flags = flagFIN
+	}
+	st.localClosed = true
+	done := st.reset || st.remoteClosed
+	st.recvMu.Unlock()
+
+	if flags == 0 {
+		notify(st.recvNotify)
+		return nil
+	}
+	return st.sendClose(flags, done)
+}
+
+// sendClose sends flags to the peer once pending writes are done and forgets
+// the stream if done
+func (st *Stream) sendClose(flags uint16, done bool) error {
+	// wake up a Write blocked on the send window and wait for it, so FIN
+	// goes after its data
+	notify(st.sendNotify)
+	st.wrMu.Lock()
+	err := st.session.writeFrame(frameWindowUpdate, flags, st.id, 0, nil)
+	st.wrMu.Unlock()
+
+	if done {
+		st.session.forgetStream(st.id)
+	}
+	notify(st.recvNotify)
+	if err == ErrSessionClosed {
+		return nil
+	}
+	return err
+}
+
+// forceClose is used when the session terminates. Buffered data can still
+// be read, after which Read returns io.EOF.
+func (st *Stream) forceClose() {
+	st.recvMu.Lock()
+	st.remoteClosed = true
+	st.recvMu.Unlock()
+	notify(st.recvNotify)
+	notify(st.sendNotify)
+}
+
+func (st *Stream) LocalAddr() net.Addr {
+	return connAddr{}
+}
+
+func (st *Stream) RemoteAddr() net.Addr {
+	return connAddr{}
+}
+
+func (st *Stream) SetDeadline(t time.Time) error {
+	st.readDeadline.set(t)
+	st.writeDeadline.set(t)
+	return nil
+}
+
+func (st *Stream) SetReadDeadline(t time.Time) error {
+	st.readDeadline.set(t)
+	return nil
+}
+
+func (st *Stream) SetWriteDeadline(t time.Time) error {
+	st.writeDeadline.set(t)
+	return nil
+}
@@ -0,0 +1,115 @@
+package roles
+
+import (
+	"sort"
+	"strings"
+
+	"k8s.io/utils/strings/slices"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+// Workspace are the emails of the users with each role in a workspace
+type Workspace struct {
+	Admins  []string
+	Editors []string
+	Viewers []string
+}
+
+// All returns the users with any role in the workspace
+func (w Workspace) All() []string {
+	var all []string
+	all = append(all, w.Admins...)
+	all = append(all, w.Editors...)
+	return append(all, w.Viewers...)
+}
+
+// Role returns the role of the user with email in the workspace, empty if
+// they have none
+func (w Workspace) Role(email string) tenancyv1alpha1.TeamRole {
+	switch {
+	case slices.Contains(w.Admins, email):
+		return tenancyv1alpha1.TeamRoleAdmin
+	case slices.Contains(w.Editors, email):
+		return tenancyv1alpha1.TeamRoleEdit
+	case slices.Contains(w.Viewers, email):
+		return tenancyv1alpha1.TeamRoleView
+	}
+	return ""
+}
+
+// Of returns the users with a role in the workspace, granted by its members,
+// the owners of organization and teams, without disabled users. Members of
+// the workspace and owners of its organization are admins, members of teams
+// of its organization have the role of their team. Users get the highest
+// role granted to them.
+func Of(workspace *tenancyv1alpha1.Workspace, organization *tenancyv1alpha1.Organization, teams []tenancyv1alpha1.Team, disabled map[string]bool) Workspace {
+	granted := map[string]tenancyv1alpha1.TeamRole{}
+	grant := func(email string, role tenancyv1alpha1.TeamRole) {
+		if disabled[email] || Rank(granted[email]) >= Rank(role) {
+			return
+		}
+		granted[email] = role
+	}
+
+	for _, member := range workspace.Spec.Members {
+		grant(member, tenancyv1alpha1.TeamRoleAdmin)
+	}
+	if organization != nil {
+		for _, owner := range organization.Spec.Owners {
+			grant(owner, tenancyv1alpha1.TeamRoleAdmin)
+		}
+	}
+	for _, team := range teams {
+		if len(team.Spec.Workspaces) > 0 && !slices.Contains(team.Spec.Workspaces, workspace.Name) {
+			continue
+		}
+		for _, member := range team.Spec.Members {
+			grant(member, team.Spec.Role)
+		}
+	}
+
+	var roles Workspace
+	for email, role := range granted {
+		switch role {
+		case tenancyv1alpha1.TeamRoleAdmin:
+			roles.Admins = append(roles.Admins, email)
+		case tenancyv1alpha1.TeamRoleEdit:
+			roles.Editors = append(roles.Editors, email)
+		case tenancyv1alpha1.TeamRoleView:
+			roles.Viewers = append(roles.Viewers, email)
+		}
+	}
+	sort.Strings(roles.Admins)
+	sort.Strings(roles.Editors)
+	sort.Strings(roles.Viewers)
+	return roles
+}
+
+// Rank orders roles by the permissions they grant, unknown roles grant none
+func Rank(role tenancyv1alpha1.TeamRole) int {
+	switch role {
+	case tenancyv1alpha1.TeamRoleAdmin:
+		return 3
+	case tenancyv1alpha1.TeamRoleEdit:
+		return 2
+	case tenancyv1alpha1.TeamRoleView:
+		return 1
+	}
+	return 0
+}
+
+// OrganizationOf returns the name of the organization owning the workspace,
+// if it is owned by one rather than a user
+func OrganizationOf(workspace *tenancyv1alpha1.Workspace) (string, bool) {
+	return OrganizationOfNamespace(workspace.Namespace)
+}
+
+// OrganizationOfNamespace returns the name of the organization the namespace
+// holds the workspaces of, if it is the namespace of an organization
+func OrganizationOfNamespace(namespace string) (string, bool) {
+	if !strings.HasPrefix(namespace, tenancyv1alpha1.OrganizationNamespacePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(namespace, tenancyv1alpha1.OrganizationNamespacePrefix), true
+}
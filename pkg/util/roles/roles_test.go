@@ -0,0 +1,52 @@
+package roles
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	tenancyv1alpha1 "github.com/faroshq/faros-hub/pkg/apis/tenancy/v1alpha1"
+)
+
+func TestOf(t *testing.T) {
+	workspace := &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "org-acme"},
+		Spec:       tenancyv1alpha1.WorkspaceSpec{Members: []string{"creator@acme.com", "gone@acme.com"}},
+	}
+	organization := &tenancyv1alpha1.Organization{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+		Spec:       tenancyv1alpha1.OrganizationSpec{Owners: []string{"owner@acme.com"}},
+	}
+	teams := []tenancyv1alpha1.Team{
+		{Spec: tenancyv1alpha1.TeamSpec{Role: tenancyv1alpha1.TeamRoleView, Members: []string{"auditor@acme.com", "operator@acme.com", "owner@acme.com"}}},
+		{Spec: tenancyv1alpha1.TeamSpec{Role: tenancyv1alpha1.TeamRoleEdit, Members: []string{"operator@acme.com"}, Workspaces: []string{"fleet"}}},
+		{Spec: tenancyv1alpha1.TeamSpec{Role: tenancyv1alpha1.TeamRoleAdmin, Members: []string{"other@acme.com"}, Workspaces: []string{"lab"}}},
+	}
+
+	roles := Of(workspace, organization, teams, map[string]bool{"gone@acme.com": true})
+
+	expected := Workspace{
+		Admins:  []string{"creator@acme.com", "owner@acme.com"},
+		Editors: []string{"operator@acme.com"},
+		Viewers: []string{"auditor@acme.com"},
+	}
+	if !reflect.DeepEqual(roles, expected) {
+		t.Errorf("expected roles %+v, got %+v", expected, roles)
+	}
+	if role := roles.Role("operator@acme.com"); role != tenancyv1alpha1.TeamRoleEdit {
+		t.Errorf("expected role %s, got %q", tenancyv1alpha1.TeamRoleEdit, role)
+	}
+	if role := roles.Role("gone@acme.com"); role != "" {
+		t.Errorf("expected no role, got %q", role)
+	}
+}
+
+func TestOrganizationOf(t *testing.T) {
+	if name, ok := OrganizationOf(&tenancyv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Namespace: "org-acme"}}); !ok || name != "acme" {
+		t.Errorf("expected organization acme, got %q", name)
+	}
+	if _, ok := OrganizationOf(&tenancyv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Namespace: "jane-at-acme-com"}}); ok {
+		t.Error("expected workspace of user not to be owned by an organization")
+	}
+}
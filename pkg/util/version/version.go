@@ -0,0 +1,20 @@
+// Package version reports the version of faros binaries
+package version
+
+import "runtime/debug"
+
+// Version is set at build time with
+// -ldflags "-X github.com/faroshq/faros-hub/pkg/util/version.Version=<version>"
+var Version = ""
+
+// Get returns the version of the binary, falling back to the module version
+// when not set at build time
+func Get() string {
+	if Version != "" {
+		return Version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	return "dev"
+}